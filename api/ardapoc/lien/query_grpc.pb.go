@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ardapoc/lien/query.proto
+
+package lien
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName        = "/ardapoc.lien.Query/Params"
+	Query_Lien_FullMethodName          = "/ardapoc.lien.Query/Lien"
+	Query_LienAll_FullMethodName       = "/ardapoc.lien.Query/LienAll"
+	Query_PropertyLiens_FullMethodName = "/ardapoc.lien.Query/PropertyLiens"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Lien items.
+	Lien(ctx context.Context, in *QueryGetLienRequest, opts ...grpc.CallOption) (*QueryGetLienResponse, error)
+	LienAll(ctx context.Context, in *QueryAllLienRequest, opts ...grpc.CallOption) (*QueryAllLienResponse, error)
+	// PropertyLiens lists the liens on a property, senior lien first.
+	PropertyLiens(ctx context.Context, in *QueryPropertyLiensRequest, opts ...grpc.CallOption) (*QueryPropertyLiensResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lien(ctx context.Context, in *QueryGetLienRequest, opts ...grpc.CallOption) (*QueryGetLienResponse, error) {
+	out := new(QueryGetLienResponse)
+	err := c.cc.Invoke(ctx, Query_Lien_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LienAll(ctx context.Context, in *QueryAllLienRequest, opts ...grpc.CallOption) (*QueryAllLienResponse, error) {
+	out := new(QueryAllLienResponse)
+	err := c.cc.Invoke(ctx, Query_LienAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PropertyLiens(ctx context.Context, in *QueryPropertyLiensRequest, opts ...grpc.CallOption) (*QueryPropertyLiensResponse, error) {
+	out := new(QueryPropertyLiensResponse)
+	err := c.cc.Invoke(ctx, Query_PropertyLiens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Lien items.
+	Lien(context.Context, *QueryGetLienRequest) (*QueryGetLienResponse, error)
+	LienAll(context.Context, *QueryAllLienRequest) (*QueryAllLienResponse, error)
+	// PropertyLiens lists the liens on a property, senior lien first.
+	PropertyLiens(context.Context, *QueryPropertyLiensRequest) (*QueryPropertyLiensResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Lien(context.Context, *QueryGetLienRequest) (*QueryGetLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lien not implemented")
+}
+func (UnimplementedQueryServer) LienAll(context.Context, *QueryAllLienRequest) (*QueryAllLienResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LienAll not implemented")
+}
+func (UnimplementedQueryServer) PropertyLiens(context.Context, *QueryPropertyLiensRequest) (*QueryPropertyLiensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyLiens not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lien_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lien(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Lien_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lien(ctx, req.(*QueryGetLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LienAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLienRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LienAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LienAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LienAll(ctx, req.(*QueryAllLienRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PropertyLiens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPropertyLiensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PropertyLiens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PropertyLiens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PropertyLiens(ctx, req.(*QueryPropertyLiensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.lien.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Lien",
+			Handler:    _Query_Lien_Handler,
+		},
+		{
+			MethodName: "LienAll",
+			Handler:    _Query_LienAll_Handler,
+		},
+		{
+			MethodName: "PropertyLiens",
+			Handler:    _Query_PropertyLiens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/lien/query.proto",
+}
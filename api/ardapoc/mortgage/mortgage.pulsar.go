@@ -3,28 +3,208 @@ package mortgage
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Mortgage_25_list)(nil)
+
+type _Mortgage_25_list struct {
+	list *[]*MortgageTerms
+}
+
+func (x *_Mortgage_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Mortgage_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Mortgage_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MortgageTerms)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Mortgage_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MortgageTerms)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Mortgage_25_list) AppendMutable() protoreflect.Value {
+	v := new(MortgageTerms)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Mortgage_25_list) NewElement() protoreflect.Value {
+	v := new(MortgageTerms)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Mortgage_29_list)(nil)
+
+type _Mortgage_29_list struct {
+	list *[]*NoteAssignment
+}
+
+func (x *_Mortgage_29_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Mortgage_29_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Mortgage_29_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NoteAssignment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Mortgage_29_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NoteAssignment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Mortgage_29_list) AppendMutable() protoreflect.Value {
+	v := new(NoteAssignment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_29_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Mortgage_29_list) NewElement() protoreflect.Value {
+	v := new(NoteAssignment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_29_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Mortgage_30_list)(nil)
+
+type _Mortgage_30_list struct {
+	list *[]*SyndicateLender
+}
+
+func (x *_Mortgage_30_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Mortgage_30_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Mortgage_30_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SyndicateLender)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Mortgage_30_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SyndicateLender)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Mortgage_30_list) AppendMutable() protoreflect.Value {
+	v := new(SyndicateLender)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_30_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Mortgage_30_list) NewElement() protoreflect.Value {
+	v := new(SyndicateLender)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Mortgage_30_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Mortgage                    protoreflect.MessageDescriptor
-	fd_Mortgage_creator            protoreflect.FieldDescriptor
-	fd_Mortgage_index              protoreflect.FieldDescriptor
-	fd_Mortgage_lender             protoreflect.FieldDescriptor
-	fd_Mortgage_lendee             protoreflect.FieldDescriptor
-	fd_Mortgage_collateral         protoreflect.FieldDescriptor
-	fd_Mortgage_amount             protoreflect.FieldDescriptor
-	fd_Mortgage_interestRate       protoreflect.FieldDescriptor
-	fd_Mortgage_term               protoreflect.FieldDescriptor
-	fd_Mortgage_status             protoreflect.FieldDescriptor
-	fd_Mortgage_outstanding_amount protoreflect.FieldDescriptor
+	md_Mortgage                      protoreflect.MessageDescriptor
+	fd_Mortgage_creator              protoreflect.FieldDescriptor
+	fd_Mortgage_index                protoreflect.FieldDescriptor
+	fd_Mortgage_lender               protoreflect.FieldDescriptor
+	fd_Mortgage_lendee               protoreflect.FieldDescriptor
+	fd_Mortgage_collateral           protoreflect.FieldDescriptor
+	fd_Mortgage_amount               protoreflect.FieldDescriptor
+	fd_Mortgage_legacy_interest_rate protoreflect.FieldDescriptor
+	fd_Mortgage_legacy_term          protoreflect.FieldDescriptor
+	fd_Mortgage_status               protoreflect.FieldDescriptor
+	fd_Mortgage_outstanding_amount   protoreflect.FieldDescriptor
+	fd_Mortgage_interest_rate        protoreflect.FieldDescriptor
+	fd_Mortgage_term_months          protoreflect.FieldDescriptor
+	fd_Mortgage_payment_frequency    protoreflect.FieldDescriptor
+	fd_Mortgage_amortization         protoreflect.FieldDescriptor
+	fd_Mortgage_start_date           protoreflect.FieldDescriptor
+	fd_Mortgage_interest_due         protoreflect.FieldDescriptor
+	fd_Mortgage_periods_accrued      protoreflect.FieldDescriptor
+	fd_Mortgage_amount_paid          protoreflect.FieldDescriptor
+	fd_Mortgage_next_due_date        protoreflect.FieldDescriptor
+	fd_Mortgage_late_fees_due        protoreflect.FieldDescriptor
+	fd_Mortgage_review_time          protoreflect.FieldDescriptor
+	fd_Mortgage_escrowed_shares      protoreflect.FieldDescriptor
+	fd_Mortgage_auction              protoreflect.FieldDescriptor
+	fd_Mortgage_principal            protoreflect.FieldDescriptor
+	fd_Mortgage_terms_history        protoreflect.FieldDescriptor
+	fd_Mortgage_refinance_proposal   protoreflect.FieldDescriptor
+	fd_Mortgage_purchase             protoreflect.FieldDescriptor
+	fd_Mortgage_equity               protoreflect.FieldDescriptor
+	fd_Mortgage_note_assignments     protoreflect.FieldDescriptor
+	fd_Mortgage_syndicate            protoreflect.FieldDescriptor
+	fd_Mortgage_auto_repayment       protoreflect.FieldDescriptor
+	fd_Mortgage_late_fee_charged     protoreflect.FieldDescriptor
+	fd_Mortgage_prepayment           protoreflect.FieldDescriptor
+	fd_Mortgage_prepayment_year      protoreflect.FieldDescriptor
+	fd_Mortgage_prepaid_this_year    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -36,10 +216,35 @@ func init() {
 	fd_Mortgage_lendee = md_Mortgage.Fields().ByName("lendee")
 	fd_Mortgage_collateral = md_Mortgage.Fields().ByName("collateral")
 	fd_Mortgage_amount = md_Mortgage.Fields().ByName("amount")
-	fd_Mortgage_interestRate = md_Mortgage.Fields().ByName("interestRate")
-	fd_Mortgage_term = md_Mortgage.Fields().ByName("term")
+	fd_Mortgage_legacy_interest_rate = md_Mortgage.Fields().ByName("legacy_interest_rate")
+	fd_Mortgage_legacy_term = md_Mortgage.Fields().ByName("legacy_term")
 	fd_Mortgage_status = md_Mortgage.Fields().ByName("status")
 	fd_Mortgage_outstanding_amount = md_Mortgage.Fields().ByName("outstanding_amount")
+	fd_Mortgage_interest_rate = md_Mortgage.Fields().ByName("interest_rate")
+	fd_Mortgage_term_months = md_Mortgage.Fields().ByName("term_months")
+	fd_Mortgage_payment_frequency = md_Mortgage.Fields().ByName("payment_frequency")
+	fd_Mortgage_amortization = md_Mortgage.Fields().ByName("amortization")
+	fd_Mortgage_start_date = md_Mortgage.Fields().ByName("start_date")
+	fd_Mortgage_interest_due = md_Mortgage.Fields().ByName("interest_due")
+	fd_Mortgage_periods_accrued = md_Mortgage.Fields().ByName("periods_accrued")
+	fd_Mortgage_amount_paid = md_Mortgage.Fields().ByName("amount_paid")
+	fd_Mortgage_next_due_date = md_Mortgage.Fields().ByName("next_due_date")
+	fd_Mortgage_late_fees_due = md_Mortgage.Fields().ByName("late_fees_due")
+	fd_Mortgage_review_time = md_Mortgage.Fields().ByName("review_time")
+	fd_Mortgage_escrowed_shares = md_Mortgage.Fields().ByName("escrowed_shares")
+	fd_Mortgage_auction = md_Mortgage.Fields().ByName("auction")
+	fd_Mortgage_principal = md_Mortgage.Fields().ByName("principal")
+	fd_Mortgage_terms_history = md_Mortgage.Fields().ByName("terms_history")
+	fd_Mortgage_refinance_proposal = md_Mortgage.Fields().ByName("refinance_proposal")
+	fd_Mortgage_purchase = md_Mortgage.Fields().ByName("purchase")
+	fd_Mortgage_equity = md_Mortgage.Fields().ByName("equity")
+	fd_Mortgage_note_assignments = md_Mortgage.Fields().ByName("note_assignments")
+	fd_Mortgage_syndicate = md_Mortgage.Fields().ByName("syndicate")
+	fd_Mortgage_auto_repayment = md_Mortgage.Fields().ByName("auto_repayment")
+	fd_Mortgage_late_fee_charged = md_Mortgage.Fields().ByName("late_fee_charged")
+	fd_Mortgage_prepayment = md_Mortgage.Fields().ByName("prepayment")
+	fd_Mortgage_prepayment_year = md_Mortgage.Fields().ByName("prepayment_year")
+	fd_Mortgage_prepaid_this_year = md_Mortgage.Fields().ByName("prepaid_this_year")
 }
 
 var _ protoreflect.Message = (*fastReflection_Mortgage)(nil)
@@ -143,15 +348,15 @@ func (x *fastReflection_Mortgage) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
-	if x.InterestRate != "" {
-		value := protoreflect.ValueOfString(x.InterestRate)
-		if !f(fd_Mortgage_interestRate, value) {
+	if x.LegacyInterestRate != "" {
+		value := protoreflect.ValueOfString(x.LegacyInterestRate)
+		if !f(fd_Mortgage_legacy_interest_rate, value) {
 			return
 		}
 	}
-	if x.Term != "" {
-		value := protoreflect.ValueOfString(x.Term)
-		if !f(fd_Mortgage_term, value) {
+	if x.LegacyTerm != "" {
+		value := protoreflect.ValueOfString(x.LegacyTerm)
+		if !f(fd_Mortgage_legacy_term, value) {
 			return
 		}
 	}
@@ -167,6 +372,156 @@ func (x *fastReflection_Mortgage) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_Mortgage_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_Mortgage_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_Mortgage_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_Mortgage_amortization, value) {
+			return
+		}
+	}
+	if x.StartDate != nil {
+		value := protoreflect.ValueOfMessage(x.StartDate.ProtoReflect())
+		if !f(fd_Mortgage_start_date, value) {
+			return
+		}
+	}
+	if x.InterestDue != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InterestDue)
+		if !f(fd_Mortgage_interest_due, value) {
+			return
+		}
+	}
+	if x.PeriodsAccrued != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PeriodsAccrued)
+		if !f(fd_Mortgage_periods_accrued, value) {
+			return
+		}
+	}
+	if x.AmountPaid != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AmountPaid)
+		if !f(fd_Mortgage_amount_paid, value) {
+			return
+		}
+	}
+	if x.NextDueDate != nil {
+		value := protoreflect.ValueOfMessage(x.NextDueDate.ProtoReflect())
+		if !f(fd_Mortgage_next_due_date, value) {
+			return
+		}
+	}
+	if x.LateFeesDue != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LateFeesDue)
+		if !f(fd_Mortgage_late_fees_due, value) {
+			return
+		}
+	}
+	if x.ReviewTime != nil {
+		value := protoreflect.ValueOfMessage(x.ReviewTime.ProtoReflect())
+		if !f(fd_Mortgage_review_time, value) {
+			return
+		}
+	}
+	if x.EscrowedShares != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EscrowedShares)
+		if !f(fd_Mortgage_escrowed_shares, value) {
+			return
+		}
+	}
+	if x.Auction != nil {
+		value := protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
+		if !f(fd_Mortgage_auction, value) {
+			return
+		}
+	}
+	if x.Principal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Principal)
+		if !f(fd_Mortgage_principal, value) {
+			return
+		}
+	}
+	if len(x.TermsHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Mortgage_25_list{list: &x.TermsHistory})
+		if !f(fd_Mortgage_terms_history, value) {
+			return
+		}
+	}
+	if x.RefinanceProposal != nil {
+		value := protoreflect.ValueOfMessage(x.RefinanceProposal.ProtoReflect())
+		if !f(fd_Mortgage_refinance_proposal, value) {
+			return
+		}
+	}
+	if x.Purchase != nil {
+		value := protoreflect.ValueOfMessage(x.Purchase.ProtoReflect())
+		if !f(fd_Mortgage_purchase, value) {
+			return
+		}
+	}
+	if x.Equity != false {
+		value := protoreflect.ValueOfBool(x.Equity)
+		if !f(fd_Mortgage_equity, value) {
+			return
+		}
+	}
+	if len(x.NoteAssignments) != 0 {
+		value := protoreflect.ValueOfList(&_Mortgage_29_list{list: &x.NoteAssignments})
+		if !f(fd_Mortgage_note_assignments, value) {
+			return
+		}
+	}
+	if len(x.Syndicate) != 0 {
+		value := protoreflect.ValueOfList(&_Mortgage_30_list{list: &x.Syndicate})
+		if !f(fd_Mortgage_syndicate, value) {
+			return
+		}
+	}
+	if x.AutoRepayment != nil {
+		value := protoreflect.ValueOfMessage(x.AutoRepayment.ProtoReflect())
+		if !f(fd_Mortgage_auto_repayment, value) {
+			return
+		}
+	}
+	if x.LateFeeCharged != false {
+		value := protoreflect.ValueOfBool(x.LateFeeCharged)
+		if !f(fd_Mortgage_late_fee_charged, value) {
+			return
+		}
+	}
+	if x.Prepayment != nil {
+		value := protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
+		if !f(fd_Mortgage_prepayment, value) {
+			return
+		}
+	}
+	if x.PrepaymentYear != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PrepaymentYear)
+		if !f(fd_Mortgage_prepayment_year, value) {
+			return
+		}
+	}
+	if x.PrepaidThisYear != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PrepaidThisYear)
+		if !f(fd_Mortgage_prepaid_this_year, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -194,14 +549,64 @@ func (x *fastReflection_Mortgage) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Collateral != ""
 	case "ardapoc.mortgage.Mortgage.amount":
 		return x.Amount != uint64(0)
-	case "ardapoc.mortgage.Mortgage.interestRate":
-		return x.InterestRate != ""
-	case "ardapoc.mortgage.Mortgage.term":
-		return x.Term != ""
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
+		return x.LegacyInterestRate != ""
+	case "ardapoc.mortgage.Mortgage.legacy_term":
+		return x.LegacyTerm != ""
 	case "ardapoc.mortgage.Mortgage.status":
 		return x.Status != 0
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		return x.OutstandingAmount != uint64(0)
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.Mortgage.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.Mortgage.amortization":
+		return x.Amortization != 0
+	case "ardapoc.mortgage.Mortgage.start_date":
+		return x.StartDate != nil
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		return x.InterestDue != uint64(0)
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		return x.PeriodsAccrued != uint32(0)
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		return x.AmountPaid != uint64(0)
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		return x.NextDueDate != nil
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		return x.LateFeesDue != uint64(0)
+	case "ardapoc.mortgage.Mortgage.review_time":
+		return x.ReviewTime != nil
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		return x.EscrowedShares != uint64(0)
+	case "ardapoc.mortgage.Mortgage.auction":
+		return x.Auction != nil
+	case "ardapoc.mortgage.Mortgage.principal":
+		return x.Principal != uint64(0)
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		return len(x.TermsHistory) != 0
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		return x.RefinanceProposal != nil
+	case "ardapoc.mortgage.Mortgage.purchase":
+		return x.Purchase != nil
+	case "ardapoc.mortgage.Mortgage.equity":
+		return x.Equity != false
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		return len(x.NoteAssignments) != 0
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		return len(x.Syndicate) != 0
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		return x.AutoRepayment != nil
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		return x.LateFeeCharged != false
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		return x.Prepayment != nil
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		return x.PrepaymentYear != uint32(0)
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		return x.PrepaidThisYear != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -230,14 +635,64 @@ func (x *fastReflection_Mortgage) Clear(fd protoreflect.FieldDescriptor) {
 		x.Collateral = ""
 	case "ardapoc.mortgage.Mortgage.amount":
 		x.Amount = uint64(0)
-	case "ardapoc.mortgage.Mortgage.interestRate":
-		x.InterestRate = ""
-	case "ardapoc.mortgage.Mortgage.term":
-		x.Term = ""
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
+		x.LegacyInterestRate = ""
+	case "ardapoc.mortgage.Mortgage.legacy_term":
+		x.LegacyTerm = ""
 	case "ardapoc.mortgage.Mortgage.status":
 		x.Status = 0
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		x.OutstandingAmount = uint64(0)
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.Mortgage.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.Mortgage.amortization":
+		x.Amortization = 0
+	case "ardapoc.mortgage.Mortgage.start_date":
+		x.StartDate = nil
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		x.InterestDue = uint64(0)
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		x.PeriodsAccrued = uint32(0)
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		x.AmountPaid = uint64(0)
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		x.NextDueDate = nil
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		x.LateFeesDue = uint64(0)
+	case "ardapoc.mortgage.Mortgage.review_time":
+		x.ReviewTime = nil
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		x.EscrowedShares = uint64(0)
+	case "ardapoc.mortgage.Mortgage.auction":
+		x.Auction = nil
+	case "ardapoc.mortgage.Mortgage.principal":
+		x.Principal = uint64(0)
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		x.TermsHistory = nil
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		x.RefinanceProposal = nil
+	case "ardapoc.mortgage.Mortgage.purchase":
+		x.Purchase = nil
+	case "ardapoc.mortgage.Mortgage.equity":
+		x.Equity = false
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		x.NoteAssignments = nil
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		x.Syndicate = nil
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		x.AutoRepayment = nil
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		x.LateFeeCharged = false
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		x.Prepayment = nil
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		x.PrepaymentYear = uint32(0)
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		x.PrepaidThisYear = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -272,11 +727,11 @@ func (x *fastReflection_Mortgage) Get(descriptor protoreflect.FieldDescriptor) p
 	case "ardapoc.mortgage.Mortgage.amount":
 		value := x.Amount
 		return protoreflect.ValueOfUint64(value)
-	case "ardapoc.mortgage.Mortgage.interestRate":
-		value := x.InterestRate
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
+		value := x.LegacyInterestRate
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.Mortgage.term":
-		value := x.Term
+	case "ardapoc.mortgage.Mortgage.legacy_term":
+		value := x.LegacyTerm
 		return protoreflect.ValueOfString(value)
 	case "ardapoc.mortgage.Mortgage.status":
 		value := x.Status
@@ -284,6 +739,90 @@ func (x *fastReflection_Mortgage) Get(descriptor protoreflect.FieldDescriptor) p
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		value := x.OutstandingAmount
 		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.Mortgage.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.Mortgage.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.Mortgage.start_date":
+		value := x.StartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		value := x.InterestDue
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		value := x.PeriodsAccrued
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		value := x.AmountPaid
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		value := x.NextDueDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		value := x.LateFeesDue
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.review_time":
+		value := x.ReviewTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		value := x.EscrowedShares
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.auction":
+		value := x.Auction
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.principal":
+		value := x.Principal
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		if len(x.TermsHistory) == 0 {
+			return protoreflect.ValueOfList(&_Mortgage_25_list{})
+		}
+		listValue := &_Mortgage_25_list{list: &x.TermsHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		value := x.RefinanceProposal
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.purchase":
+		value := x.Purchase
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.equity":
+		value := x.Equity
+		return protoreflect.ValueOfBool(value)
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		if len(x.NoteAssignments) == 0 {
+			return protoreflect.ValueOfList(&_Mortgage_29_list{})
+		}
+		listValue := &_Mortgage_29_list{list: &x.NoteAssignments}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		if len(x.Syndicate) == 0 {
+			return protoreflect.ValueOfList(&_Mortgage_30_list{})
+		}
+		listValue := &_Mortgage_30_list{list: &x.Syndicate}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		value := x.AutoRepayment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		value := x.LateFeeCharged
+		return protoreflect.ValueOfBool(value)
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		value := x.Prepayment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		value := x.PrepaymentYear
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		value := x.PrepaidThisYear
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -316,14 +855,70 @@ func (x *fastReflection_Mortgage) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Collateral = value.Interface().(string)
 	case "ardapoc.mortgage.Mortgage.amount":
 		x.Amount = value.Uint()
-	case "ardapoc.mortgage.Mortgage.interestRate":
-		x.InterestRate = value.Interface().(string)
-	case "ardapoc.mortgage.Mortgage.term":
-		x.Term = value.Interface().(string)
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
+		x.LegacyInterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.Mortgage.legacy_term":
+		x.LegacyTerm = value.Interface().(string)
 	case "ardapoc.mortgage.Mortgage.status":
 		x.Status = (MortgageStatus)(value.Enum())
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		x.OutstandingAmount = value.Uint()
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.Mortgage.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.Mortgage.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	case "ardapoc.mortgage.Mortgage.start_date":
+		x.StartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		x.InterestDue = value.Uint()
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		x.PeriodsAccrued = uint32(value.Uint())
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		x.AmountPaid = value.Uint()
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		x.NextDueDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		x.LateFeesDue = value.Uint()
+	case "ardapoc.mortgage.Mortgage.review_time":
+		x.ReviewTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		x.EscrowedShares = value.Uint()
+	case "ardapoc.mortgage.Mortgage.auction":
+		x.Auction = value.Message().Interface().(*ForeclosureAuction)
+	case "ardapoc.mortgage.Mortgage.principal":
+		x.Principal = value.Uint()
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		lv := value.List()
+		clv := lv.(*_Mortgage_25_list)
+		x.TermsHistory = *clv.list
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		x.RefinanceProposal = value.Message().Interface().(*MortgageTerms)
+	case "ardapoc.mortgage.Mortgage.purchase":
+		x.Purchase = value.Message().Interface().(*MortgagePurchase)
+	case "ardapoc.mortgage.Mortgage.equity":
+		x.Equity = value.Bool()
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		lv := value.List()
+		clv := lv.(*_Mortgage_29_list)
+		x.NoteAssignments = *clv.list
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		lv := value.List()
+		clv := lv.(*_Mortgage_30_list)
+		x.Syndicate = *clv.list
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		x.AutoRepayment = value.Message().Interface().(*AutoRepayment)
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		x.LateFeeCharged = value.Bool()
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		x.Prepayment = value.Message().Interface().(*PrepaymentTerms)
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		x.PrepaymentYear = uint32(value.Uint())
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		x.PrepaidThisYear = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -344,6 +939,64 @@ func (x *fastReflection_Mortgage) Set(fd protoreflect.FieldDescriptor, value pro
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Mortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Mortgage.start_date":
+		if x.StartDate == nil {
+			x.StartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartDate.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		if x.NextDueDate == nil {
+			x.NextDueDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.NextDueDate.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.review_time":
+		if x.ReviewTime == nil {
+			x.ReviewTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ReviewTime.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.auction":
+		if x.Auction == nil {
+			x.Auction = new(ForeclosureAuction)
+		}
+		return protoreflect.ValueOfMessage(x.Auction.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		if x.TermsHistory == nil {
+			x.TermsHistory = []*MortgageTerms{}
+		}
+		value := &_Mortgage_25_list{list: &x.TermsHistory}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		if x.RefinanceProposal == nil {
+			x.RefinanceProposal = new(MortgageTerms)
+		}
+		return protoreflect.ValueOfMessage(x.RefinanceProposal.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.purchase":
+		if x.Purchase == nil {
+			x.Purchase = new(MortgagePurchase)
+		}
+		return protoreflect.ValueOfMessage(x.Purchase.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		if x.NoteAssignments == nil {
+			x.NoteAssignments = []*NoteAssignment{}
+		}
+		value := &_Mortgage_29_list{list: &x.NoteAssignments}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		if x.Syndicate == nil {
+			x.Syndicate = []*SyndicateLender{}
+		}
+		value := &_Mortgage_30_list{list: &x.Syndicate}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		if x.AutoRepayment == nil {
+			x.AutoRepayment = new(AutoRepayment)
+		}
+		return protoreflect.ValueOfMessage(x.AutoRepayment.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		if x.Prepayment == nil {
+			x.Prepayment = new(PrepaymentTerms)
+		}
+		return protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
 	case "ardapoc.mortgage.Mortgage.creator":
 		panic(fmt.Errorf("field creator of message ardapoc.mortgage.Mortgage is not mutable"))
 	case "ardapoc.mortgage.Mortgage.index":
@@ -356,14 +1009,42 @@ func (x *fastReflection_Mortgage) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field collateral of message ardapoc.mortgage.Mortgage is not mutable"))
 	case "ardapoc.mortgage.Mortgage.amount":
 		panic(fmt.Errorf("field amount of message ardapoc.mortgage.Mortgage is not mutable"))
-	case "ardapoc.mortgage.Mortgage.interestRate":
-		panic(fmt.Errorf("field interestRate of message ardapoc.mortgage.Mortgage is not mutable"))
-	case "ardapoc.mortgage.Mortgage.term":
-		panic(fmt.Errorf("field term of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
+		panic(fmt.Errorf("field legacy_interest_rate of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.legacy_term":
+		panic(fmt.Errorf("field legacy_term of message ardapoc.mortgage.Mortgage is not mutable"))
 	case "ardapoc.mortgage.Mortgage.status":
 		panic(fmt.Errorf("field status of message ardapoc.mortgage.Mortgage is not mutable"))
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		panic(fmt.Errorf("field outstanding_amount of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		panic(fmt.Errorf("field interest_due of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		panic(fmt.Errorf("field periods_accrued of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		panic(fmt.Errorf("field amount_paid of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		panic(fmt.Errorf("field late_fees_due of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		panic(fmt.Errorf("field escrowed_shares of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.principal":
+		panic(fmt.Errorf("field principal of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.equity":
+		panic(fmt.Errorf("field equity of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		panic(fmt.Errorf("field late_fee_charged of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		panic(fmt.Errorf("field prepayment_year of message ardapoc.mortgage.Mortgage is not mutable"))
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		panic(fmt.Errorf("field prepaid_this_year of message ardapoc.mortgage.Mortgage is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -389,14 +1070,75 @@ func (x *fastReflection_Mortgage) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "ardapoc.mortgage.Mortgage.amount":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ardapoc.mortgage.Mortgage.interestRate":
+	case "ardapoc.mortgage.Mortgage.legacy_interest_rate":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.Mortgage.term":
+	case "ardapoc.mortgage.Mortgage.legacy_term":
 		return protoreflect.ValueOfString("")
 	case "ardapoc.mortgage.Mortgage.status":
 		return protoreflect.ValueOfEnum(0)
 	case "ardapoc.mortgage.Mortgage.outstanding_amount":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.Mortgage.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.Mortgage.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.Mortgage.amortization":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.Mortgage.start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.interest_due":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.periods_accrued":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.Mortgage.amount_paid":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.next_due_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.late_fees_due":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.review_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.escrowed_shares":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.auction":
+		m := new(ForeclosureAuction)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.principal":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Mortgage.terms_history":
+		list := []*MortgageTerms{}
+		return protoreflect.ValueOfList(&_Mortgage_25_list{list: &list})
+	case "ardapoc.mortgage.Mortgage.refinance_proposal":
+		m := new(MortgageTerms)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.purchase":
+		m := new(MortgagePurchase)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.equity":
+		return protoreflect.ValueOfBool(false)
+	case "ardapoc.mortgage.Mortgage.note_assignments":
+		list := []*NoteAssignment{}
+		return protoreflect.ValueOfList(&_Mortgage_29_list{list: &list})
+	case "ardapoc.mortgage.Mortgage.syndicate":
+		list := []*SyndicateLender{}
+		return protoreflect.ValueOfList(&_Mortgage_30_list{list: &list})
+	case "ardapoc.mortgage.Mortgage.auto_repayment":
+		m := new(AutoRepayment)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.late_fee_charged":
+		return protoreflect.ValueOfBool(false)
+	case "ardapoc.mortgage.Mortgage.prepayment":
+		m := new(PrepaymentTerms)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Mortgage.prepayment_year":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.Mortgage.prepaid_this_year":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Mortgage"))
@@ -489,11 +1231,11 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 		if x.Amount != 0 {
 			n += 1 + runtime.Sov(uint64(x.Amount))
 		}
-		l = len(x.InterestRate)
+		l = len(x.LegacyInterestRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Term)
+		l = len(x.LegacyTerm)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -503,6 +1245,99 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 		if x.OutstandingAmount != 0 {
 			n += 1 + runtime.Sov(uint64(x.OutstandingAmount))
 		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.StartDate != nil {
+			l = options.Size(x.StartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InterestDue != 0 {
+			n += 2 + runtime.Sov(uint64(x.InterestDue))
+		}
+		if x.PeriodsAccrued != 0 {
+			n += 2 + runtime.Sov(uint64(x.PeriodsAccrued))
+		}
+		if x.AmountPaid != 0 {
+			n += 2 + runtime.Sov(uint64(x.AmountPaid))
+		}
+		if x.NextDueDate != nil {
+			l = options.Size(x.NextDueDate)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.LateFeesDue != 0 {
+			n += 2 + runtime.Sov(uint64(x.LateFeesDue))
+		}
+		if x.ReviewTime != nil {
+			l = options.Size(x.ReviewTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.EscrowedShares != 0 {
+			n += 2 + runtime.Sov(uint64(x.EscrowedShares))
+		}
+		if x.Auction != nil {
+			l = options.Size(x.Auction)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Principal != 0 {
+			n += 2 + runtime.Sov(uint64(x.Principal))
+		}
+		if len(x.TermsHistory) > 0 {
+			for _, e := range x.TermsHistory {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RefinanceProposal != nil {
+			l = options.Size(x.RefinanceProposal)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Purchase != nil {
+			l = options.Size(x.Purchase)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Equity {
+			n += 3
+		}
+		if len(x.NoteAssignments) > 0 {
+			for _, e := range x.NoteAssignments {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Syndicate) > 0 {
+			for _, e := range x.Syndicate {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AutoRepayment != nil {
+			l = options.Size(x.AutoRepayment)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.LateFeeCharged {
+			n += 3
+		}
+		if x.Prepayment != nil {
+			l = options.Size(x.Prepayment)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrepaymentYear != 0 {
+			n += 2 + runtime.Sov(uint64(x.PrepaymentYear))
+		}
+		if x.PrepaidThisYear != 0 {
+			n += 2 + runtime.Sov(uint64(x.PrepaidThisYear))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -532,58 +1367,340 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.OutstandingAmount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutstandingAmount))
+		if x.PrepaidThisYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepaidThisYear))
 			i--
-			dAtA[i] = 0x50
-		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x48
+			dAtA[i] = 0x98
 		}
-		if len(x.Term) > 0 {
-			i -= len(x.Term)
-			copy(dAtA[i:], x.Term)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
+		if x.PrepaymentYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrepaymentYear))
 			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.InterestRate) > 0 {
-			i -= len(x.InterestRate)
-			copy(dAtA[i:], x.InterestRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x90
 		}
-		if x.Amount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+		if x.Prepayment != nil {
+			encoded, err := options.Marshal(x.Prepayment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.Collateral) > 0 {
-			i -= len(x.Collateral)
-			copy(dAtA[i:], x.Collateral)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x8a
 		}
-		if len(x.Lendee) > 0 {
-			i -= len(x.Lendee)
-			copy(dAtA[i:], x.Lendee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+		if x.LateFeeCharged {
 			i--
-			dAtA[i] = 0x22
+			if x.LateFeeCharged {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
 		}
-		if len(x.Lender) > 0 {
-			i -= len(x.Lender)
-			copy(dAtA[i:], x.Lender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+		if x.AutoRepayment != nil {
+			encoded, err := options.Marshal(x.AutoRepayment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
-		if len(x.Index) > 0 {
-			i -= len(x.Index)
+		if len(x.Syndicate) > 0 {
+			for iNdEx := len(x.Syndicate) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Syndicate[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xf2
+			}
+		}
+		if len(x.NoteAssignments) > 0 {
+			for iNdEx := len(x.NoteAssignments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NoteAssignments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xea
+			}
+		}
+		if x.Equity {
+			i--
+			if x.Equity {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if x.Purchase != nil {
+			encoded, err := options.Marshal(x.Purchase)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.RefinanceProposal != nil {
+			encoded, err := options.Marshal(x.RefinanceProposal)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if len(x.TermsHistory) > 0 {
+			for iNdEx := len(x.TermsHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TermsHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if x.Principal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Principal))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.Auction != nil {
+			encoded, err := options.Marshal(x.Auction)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if x.EscrowedShares != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowedShares))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.ReviewTime != nil {
+			encoded, err := options.Marshal(x.ReviewTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.LateFeesDue != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LateFeesDue))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.NextDueDate != nil {
+			encoded, err := options.Marshal(x.NextDueDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.AmountPaid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AmountPaid))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.PeriodsAccrued != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PeriodsAccrued))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.InterestDue != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InterestDue))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.StartDate != nil {
+			encoded, err := options.Marshal(x.StartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.OutstandingAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OutstandingAmount))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.LegacyTerm) > 0 {
+			i -= len(x.LegacyTerm)
+			copy(dAtA[i:], x.LegacyTerm)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyTerm)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.LegacyInterestRate) > 0 {
+			i -= len(x.LegacyInterestRate)
+			copy(dAtA[i:], x.LegacyInterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LegacyInterestRate)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Collateral) > 0 {
+			i -= len(x.Collateral)
+			copy(dAtA[i:], x.Collateral)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
 			copy(dAtA[i:], x.Index)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
 			i--
@@ -826,7 +1943,7 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 				}
 			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyInterestRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -854,11 +1971,11 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				x.LegacyInterestRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyTerm", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -886,7 +2003,7 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Term = string(dAtA[iNdEx:postIndex])
+				x.LegacyTerm = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 9:
 				if wireType != 0 {
@@ -926,212 +2043,6942 @@ func (x *fastReflection_Mortgage) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: ardapoc/mortgage/mortgage.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type MortgageStatus int32
-
-const (
-	MortgageStatus_REQUESTED MortgageStatus = 0
-	MortgageStatus_APPROVED  MortgageStatus = 1
-	MortgageStatus_REJECTED  MortgageStatus = 2
-	MortgageStatus_PAID      MortgageStatus = 3
-	MortgageStatus_CANCELLED MortgageStatus = 4
-)
-
-// Enum value maps for MortgageStatus.
-var (
-	MortgageStatus_name = map[int32]string{
-		0: "REQUESTED",
-		1: "APPROVED",
-		2: "REJECTED",
-		3: "PAID",
-		4: "CANCELLED",
-	}
-	MortgageStatus_value = map[string]int32{
-		"REQUESTED": 0,
-		"APPROVED":  1,
-		"REJECTED":  2,
-		"PAID":      3,
-		"CANCELLED": 4,
-	}
-)
-
-func (x MortgageStatus) Enum() *MortgageStatus {
-	p := new(MortgageStatus)
-	*p = x
-	return p
-}
-
-func (x MortgageStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (MortgageStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ardapoc_mortgage_mortgage_proto_enumTypes[0].Descriptor()
-}
-
-func (MortgageStatus) Type() protoreflect.EnumType {
-	return &file_ardapoc_mortgage_mortgage_proto_enumTypes[0]
-}
-
-func (x MortgageStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use MortgageStatus.Descriptor instead.
-func (MortgageStatus) EnumDescriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{0}
-}
-
-type Mortgage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Creator           string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index             string         `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender            string         `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee            string         `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral        string         `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount            uint64         `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate      string         `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term              string         `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
-	Status            MortgageStatus `protobuf:"varint,9,opt,name=status,proto3,enum=ardapoc.mortgage.MortgageStatus" json:"status,omitempty"`
-	OutstandingAmount uint64         `protobuf:"varint,10,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
-}
-
-func (x *Mortgage) Reset() {
-	*x = Mortgage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Mortgage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Mortgage) ProtoMessage() {}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartDate == nil {
+					x.StartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestDue", wireType)
+				}
+				x.InterestDue = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InterestDue |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PeriodsAccrued", wireType)
+				}
+				x.PeriodsAccrued = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PeriodsAccrued |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountPaid", wireType)
+				}
+				x.AmountPaid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AmountPaid |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextDueDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextDueDate == nil {
+					x.NextDueDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextDueDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LateFeesDue", wireType)
+				}
+				x.LateFeesDue = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LateFeesDue |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReviewTime == nil {
+					x.ReviewTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReviewTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedShares", wireType)
+				}
+				x.EscrowedShares = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowedShares |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Auction == nil {
+					x.Auction = &ForeclosureAuction{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auction); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				}
+				x.Principal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Principal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermsHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TermsHistory = append(x.TermsHistory, &MortgageTerms{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TermsHistory[len(x.TermsHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefinanceProposal", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RefinanceProposal == nil {
+					x.RefinanceProposal = &MortgageTerms{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefinanceProposal); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Purchase == nil {
+					x.Purchase = &MortgagePurchase{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Purchase); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Equity = bool(v != 0)
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoteAssignments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NoteAssignments = append(x.NoteAssignments, &NoteAssignment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NoteAssignments[len(x.NoteAssignments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Syndicate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Syndicate = append(x.Syndicate, &SyndicateLender{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Syndicate[len(x.Syndicate)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRepayment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AutoRepayment == nil {
+					x.AutoRepayment = &AutoRepayment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoRepayment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 32:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LateFeeCharged", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LateFeeCharged = bool(v != 0)
+			case 33:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Prepayment == nil {
+					x.Prepayment = &PrepaymentTerms{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prepayment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 34:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepaymentYear", wireType)
+				}
+				x.PrepaymentYear = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepaymentYear |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepaidThisYear", wireType)
+				}
+				x.PrepaidThisYear = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrepaidThisYear |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AutoRepayment              protoreflect.MessageDescriptor
+	fd_AutoRepayment_next_pull    protoreflect.FieldDescriptor
+	fd_AutoRepayment_successes    protoreflect.FieldDescriptor
+	fd_AutoRepayment_failures     protoreflect.FieldDescriptor
+	fd_AutoRepayment_last_attempt protoreflect.FieldDescriptor
+	fd_AutoRepayment_last_amount  protoreflect.FieldDescriptor
+	fd_AutoRepayment_last_error   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_AutoRepayment = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("AutoRepayment")
+	fd_AutoRepayment_next_pull = md_AutoRepayment.Fields().ByName("next_pull")
+	fd_AutoRepayment_successes = md_AutoRepayment.Fields().ByName("successes")
+	fd_AutoRepayment_failures = md_AutoRepayment.Fields().ByName("failures")
+	fd_AutoRepayment_last_attempt = md_AutoRepayment.Fields().ByName("last_attempt")
+	fd_AutoRepayment_last_amount = md_AutoRepayment.Fields().ByName("last_amount")
+	fd_AutoRepayment_last_error = md_AutoRepayment.Fields().ByName("last_error")
+}
+
+var _ protoreflect.Message = (*fastReflection_AutoRepayment)(nil)
+
+type fastReflection_AutoRepayment AutoRepayment
+
+func (x *AutoRepayment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoRepayment)(x)
+}
+
+func (x *AutoRepayment) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AutoRepayment_messageType fastReflection_AutoRepayment_messageType
+var _ protoreflect.MessageType = fastReflection_AutoRepayment_messageType{}
+
+type fastReflection_AutoRepayment_messageType struct{}
+
+func (x fastReflection_AutoRepayment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoRepayment)(nil)
+}
+func (x fastReflection_AutoRepayment_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoRepayment)
+}
+func (x fastReflection_AutoRepayment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRepayment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AutoRepayment) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRepayment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AutoRepayment) Type() protoreflect.MessageType {
+	return _fastReflection_AutoRepayment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AutoRepayment) New() protoreflect.Message {
+	return new(fastReflection_AutoRepayment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AutoRepayment) Interface() protoreflect.ProtoMessage {
+	return (*AutoRepayment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AutoRepayment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NextPull != nil {
+		value := protoreflect.ValueOfMessage(x.NextPull.ProtoReflect())
+		if !f(fd_AutoRepayment_next_pull, value) {
+			return
+		}
+	}
+	if x.Successes != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Successes)
+		if !f(fd_AutoRepayment_successes, value) {
+			return
+		}
+	}
+	if x.Failures != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Failures)
+		if !f(fd_AutoRepayment_failures, value) {
+			return
+		}
+	}
+	if x.LastAttempt != nil {
+		value := protoreflect.ValueOfMessage(x.LastAttempt.ProtoReflect())
+		if !f(fd_AutoRepayment_last_attempt, value) {
+			return
+		}
+	}
+	if x.LastAmount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastAmount)
+		if !f(fd_AutoRepayment_last_amount, value) {
+			return
+		}
+	}
+	if x.LastError != "" {
+		value := protoreflect.ValueOfString(x.LastError)
+		if !f(fd_AutoRepayment_last_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AutoRepayment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		return x.NextPull != nil
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		return x.Successes != uint32(0)
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		return x.Failures != uint32(0)
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		return x.LastAttempt != nil
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		return x.LastAmount != uint64(0)
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		return x.LastError != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRepayment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		x.NextPull = nil
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		x.Successes = uint32(0)
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		x.Failures = uint32(0)
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		x.LastAttempt = nil
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		x.LastAmount = uint64(0)
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		x.LastError = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AutoRepayment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		value := x.NextPull
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		value := x.Successes
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		value := x.Failures
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		value := x.LastAttempt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		value := x.LastAmount
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		value := x.LastError
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRepayment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		x.NextPull = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		x.Successes = uint32(value.Uint())
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		x.Failures = uint32(value.Uint())
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		x.LastAttempt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		x.LastAmount = value.Uint()
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		x.LastError = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRepayment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		if x.NextPull == nil {
+			x.NextPull = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.NextPull.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		if x.LastAttempt == nil {
+			x.LastAttempt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastAttempt.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		panic(fmt.Errorf("field successes of message ardapoc.mortgage.AutoRepayment is not mutable"))
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		panic(fmt.Errorf("field failures of message ardapoc.mortgage.AutoRepayment is not mutable"))
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		panic(fmt.Errorf("field last_amount of message ardapoc.mortgage.AutoRepayment is not mutable"))
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		panic(fmt.Errorf("field last_error of message ardapoc.mortgage.AutoRepayment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AutoRepayment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.AutoRepayment.next_pull":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.successes":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.AutoRepayment.failures":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.AutoRepayment.last_attempt":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.AutoRepayment.last_amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.AutoRepayment.last_error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.AutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.AutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AutoRepayment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.AutoRepayment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AutoRepayment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRepayment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AutoRepayment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AutoRepayment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AutoRepayment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.NextPull != nil {
+			l = options.Size(x.NextPull)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Successes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Successes))
+		}
+		if x.Failures != 0 {
+			n += 1 + runtime.Sov(uint64(x.Failures))
+		}
+		if x.LastAttempt != nil {
+			l = options.Size(x.LastAttempt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastAmount != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastAmount))
+		}
+		l = len(x.LastError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AutoRepayment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LastError) > 0 {
+			i -= len(x.LastError)
+			copy(dAtA[i:], x.LastError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastError)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.LastAmount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastAmount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.LastAttempt != nil {
+			encoded, err := options.Marshal(x.LastAttempt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Failures != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Failures))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Successes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Successes))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.NextPull != nil {
+			encoded, err := options.Marshal(x.NextPull)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AutoRepayment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRepayment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPull", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextPull == nil {
+					x.NextPull = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextPull); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Successes", wireType)
+				}
+				x.Successes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Successes |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+				}
+				x.Failures = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Failures |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastAttempt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastAttempt == nil {
+					x.LastAttempt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastAttempt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastAmount", wireType)
+				}
+				x.LastAmount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastAmount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PrepaymentTerms                       protoreflect.MessageDescriptor
+	fd_PrepaymentTerms_penalty_rate          protoreflect.FieldDescriptor
+	fd_PrepaymentTerms_lock_in_months        protoreflect.FieldDescriptor
+	fd_PrepaymentTerms_max_yearly_prepayment protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_PrepaymentTerms = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("PrepaymentTerms")
+	fd_PrepaymentTerms_penalty_rate = md_PrepaymentTerms.Fields().ByName("penalty_rate")
+	fd_PrepaymentTerms_lock_in_months = md_PrepaymentTerms.Fields().ByName("lock_in_months")
+	fd_PrepaymentTerms_max_yearly_prepayment = md_PrepaymentTerms.Fields().ByName("max_yearly_prepayment")
+}
+
+var _ protoreflect.Message = (*fastReflection_PrepaymentTerms)(nil)
+
+type fastReflection_PrepaymentTerms PrepaymentTerms
+
+func (x *PrepaymentTerms) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrepaymentTerms)(x)
+}
+
+func (x *PrepaymentTerms) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrepaymentTerms_messageType fastReflection_PrepaymentTerms_messageType
+var _ protoreflect.MessageType = fastReflection_PrepaymentTerms_messageType{}
+
+type fastReflection_PrepaymentTerms_messageType struct{}
+
+func (x fastReflection_PrepaymentTerms_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrepaymentTerms)(nil)
+}
+func (x fastReflection_PrepaymentTerms_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrepaymentTerms)
+}
+func (x fastReflection_PrepaymentTerms_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrepaymentTerms
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrepaymentTerms) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrepaymentTerms
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrepaymentTerms) Type() protoreflect.MessageType {
+	return _fastReflection_PrepaymentTerms_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrepaymentTerms) New() protoreflect.Message {
+	return new(fastReflection_PrepaymentTerms)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrepaymentTerms) Interface() protoreflect.ProtoMessage {
+	return (*PrepaymentTerms)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrepaymentTerms) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PenaltyRate != "" {
+		value := protoreflect.ValueOfString(x.PenaltyRate)
+		if !f(fd_PrepaymentTerms_penalty_rate, value) {
+			return
+		}
+	}
+	if x.LockInMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LockInMonths)
+		if !f(fd_PrepaymentTerms_lock_in_months, value) {
+			return
+		}
+	}
+	if x.MaxYearlyPrepayment != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxYearlyPrepayment)
+		if !f(fd_PrepaymentTerms_max_yearly_prepayment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrepaymentTerms) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		return x.PenaltyRate != ""
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		return x.LockInMonths != uint32(0)
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		return x.MaxYearlyPrepayment != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrepaymentTerms) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		x.PenaltyRate = ""
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		x.LockInMonths = uint32(0)
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		x.MaxYearlyPrepayment = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrepaymentTerms) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		value := x.PenaltyRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		value := x.LockInMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		value := x.MaxYearlyPrepayment
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrepaymentTerms) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		x.PenaltyRate = value.Interface().(string)
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		x.LockInMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		x.MaxYearlyPrepayment = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrepaymentTerms) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		panic(fmt.Errorf("field penalty_rate of message ardapoc.mortgage.PrepaymentTerms is not mutable"))
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		panic(fmt.Errorf("field lock_in_months of message ardapoc.mortgage.PrepaymentTerms is not mutable"))
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		panic(fmt.Errorf("field max_yearly_prepayment of message ardapoc.mortgage.PrepaymentTerms is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrepaymentTerms) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.PrepaymentTerms.penalty_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.PrepaymentTerms.lock_in_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.PrepaymentTerms.max_yearly_prepayment":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.PrepaymentTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.PrepaymentTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrepaymentTerms) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.PrepaymentTerms", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrepaymentTerms) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrepaymentTerms) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrepaymentTerms) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrepaymentTerms) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrepaymentTerms)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PenaltyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockInMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockInMonths))
+		}
+		if x.MaxYearlyPrepayment != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxYearlyPrepayment))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrepaymentTerms)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxYearlyPrepayment != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxYearlyPrepayment))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.LockInMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockInMonths))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PenaltyRate) > 0 {
+			i -= len(x.PenaltyRate)
+			copy(dAtA[i:], x.PenaltyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PenaltyRate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrepaymentTerms)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrepaymentTerms: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrepaymentTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PenaltyRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PenaltyRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockInMonths", wireType)
+				}
+				x.LockInMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockInMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxYearlyPrepayment", wireType)
+				}
+				x.MaxYearlyPrepayment = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxYearlyPrepayment |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SyndicateLender              protoreflect.MessageDescriptor
+	fd_SyndicateLender_lender       protoreflect.FieldDescriptor
+	fd_SyndicateLender_contribution protoreflect.FieldDescriptor
+	fd_SyndicateLender_committed    protoreflect.FieldDescriptor
+	fd_SyndicateLender_received     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_SyndicateLender = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("SyndicateLender")
+	fd_SyndicateLender_lender = md_SyndicateLender.Fields().ByName("lender")
+	fd_SyndicateLender_contribution = md_SyndicateLender.Fields().ByName("contribution")
+	fd_SyndicateLender_committed = md_SyndicateLender.Fields().ByName("committed")
+	fd_SyndicateLender_received = md_SyndicateLender.Fields().ByName("received")
+}
+
+var _ protoreflect.Message = (*fastReflection_SyndicateLender)(nil)
+
+type fastReflection_SyndicateLender SyndicateLender
+
+func (x *SyndicateLender) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SyndicateLender)(x)
+}
+
+func (x *SyndicateLender) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SyndicateLender_messageType fastReflection_SyndicateLender_messageType
+var _ protoreflect.MessageType = fastReflection_SyndicateLender_messageType{}
+
+type fastReflection_SyndicateLender_messageType struct{}
+
+func (x fastReflection_SyndicateLender_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SyndicateLender)(nil)
+}
+func (x fastReflection_SyndicateLender_messageType) New() protoreflect.Message {
+	return new(fastReflection_SyndicateLender)
+}
+func (x fastReflection_SyndicateLender_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SyndicateLender
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SyndicateLender) Descriptor() protoreflect.MessageDescriptor {
+	return md_SyndicateLender
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SyndicateLender) Type() protoreflect.MessageType {
+	return _fastReflection_SyndicateLender_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SyndicateLender) New() protoreflect.Message {
+	return new(fastReflection_SyndicateLender)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SyndicateLender) Interface() protoreflect.ProtoMessage {
+	return (*SyndicateLender)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SyndicateLender) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_SyndicateLender_lender, value) {
+			return
+		}
+	}
+	if x.Contribution != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Contribution)
+		if !f(fd_SyndicateLender_contribution, value) {
+			return
+		}
+	}
+	if x.Committed != false {
+		value := protoreflect.ValueOfBool(x.Committed)
+		if !f(fd_SyndicateLender_committed, value) {
+			return
+		}
+	}
+	if x.Received != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Received)
+		if !f(fd_SyndicateLender_received, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SyndicateLender) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		return x.Contribution != uint64(0)
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		return x.Committed != false
+	case "ardapoc.mortgage.SyndicateLender.received":
+		return x.Received != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SyndicateLender) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		x.Contribution = uint64(0)
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		x.Committed = false
+	case "ardapoc.mortgage.SyndicateLender.received":
+		x.Received = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SyndicateLender) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		value := x.Contribution
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		value := x.Committed
+		return protoreflect.ValueOfBool(value)
+	case "ardapoc.mortgage.SyndicateLender.received":
+		value := x.Received
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SyndicateLender) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		x.Contribution = value.Uint()
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		x.Committed = value.Bool()
+	case "ardapoc.mortgage.SyndicateLender.received":
+		x.Received = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SyndicateLender) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.SyndicateLender is not mutable"))
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		panic(fmt.Errorf("field contribution of message ardapoc.mortgage.SyndicateLender is not mutable"))
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		panic(fmt.Errorf("field committed of message ardapoc.mortgage.SyndicateLender is not mutable"))
+	case "ardapoc.mortgage.SyndicateLender.received":
+		panic(fmt.Errorf("field received of message ardapoc.mortgage.SyndicateLender is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SyndicateLender) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.SyndicateLender.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.SyndicateLender.contribution":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.SyndicateLender.committed":
+		return protoreflect.ValueOfBool(false)
+	case "ardapoc.mortgage.SyndicateLender.received":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.SyndicateLender"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.SyndicateLender does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SyndicateLender) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.SyndicateLender", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SyndicateLender) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SyndicateLender) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SyndicateLender) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SyndicateLender) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SyndicateLender)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Contribution != 0 {
+			n += 1 + runtime.Sov(uint64(x.Contribution))
+		}
+		if x.Committed {
+			n += 2
+		}
+		if x.Received != 0 {
+			n += 1 + runtime.Sov(uint64(x.Received))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SyndicateLender)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Received != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Received))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Committed {
+			i--
+			if x.Committed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Contribution != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Contribution))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SyndicateLender)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SyndicateLender: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SyndicateLender: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+				}
+				x.Contribution = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Contribution |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Committed = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+				}
+				x.Received = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Received |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NoteAssignment      protoreflect.MessageDescriptor
+	fd_NoteAssignment_from protoreflect.FieldDescriptor
+	fd_NoteAssignment_to   protoreflect.FieldDescriptor
+	fd_NoteAssignment_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_NoteAssignment = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("NoteAssignment")
+	fd_NoteAssignment_from = md_NoteAssignment.Fields().ByName("from")
+	fd_NoteAssignment_to = md_NoteAssignment.Fields().ByName("to")
+	fd_NoteAssignment_time = md_NoteAssignment.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_NoteAssignment)(nil)
+
+type fastReflection_NoteAssignment NoteAssignment
+
+func (x *NoteAssignment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NoteAssignment)(x)
+}
+
+func (x *NoteAssignment) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NoteAssignment_messageType fastReflection_NoteAssignment_messageType
+var _ protoreflect.MessageType = fastReflection_NoteAssignment_messageType{}
+
+type fastReflection_NoteAssignment_messageType struct{}
+
+func (x fastReflection_NoteAssignment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NoteAssignment)(nil)
+}
+func (x fastReflection_NoteAssignment_messageType) New() protoreflect.Message {
+	return new(fastReflection_NoteAssignment)
+}
+func (x fastReflection_NoteAssignment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NoteAssignment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NoteAssignment) Descriptor() protoreflect.MessageDescriptor {
+	return md_NoteAssignment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NoteAssignment) Type() protoreflect.MessageType {
+	return _fastReflection_NoteAssignment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NoteAssignment) New() protoreflect.Message {
+	return new(fastReflection_NoteAssignment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NoteAssignment) Interface() protoreflect.ProtoMessage {
+	return (*NoteAssignment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NoteAssignment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_NoteAssignment_from, value) {
+			return
+		}
+	}
+	if x.To != "" {
+		value := protoreflect.ValueOfString(x.To)
+		if !f(fd_NoteAssignment_to, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_NoteAssignment_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NoteAssignment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.from":
+		return x.From != ""
+	case "ardapoc.mortgage.NoteAssignment.to":
+		return x.To != ""
+	case "ardapoc.mortgage.NoteAssignment.time":
+		return x.Time != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NoteAssignment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.from":
+		x.From = ""
+	case "ardapoc.mortgage.NoteAssignment.to":
+		x.To = ""
+	case "ardapoc.mortgage.NoteAssignment.time":
+		x.Time = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NoteAssignment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.NoteAssignment.to":
+		value := x.To
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.NoteAssignment.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NoteAssignment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.from":
+		x.From = value.Interface().(string)
+	case "ardapoc.mortgage.NoteAssignment.to":
+		x.To = value.Interface().(string)
+	case "ardapoc.mortgage.NoteAssignment.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NoteAssignment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "ardapoc.mortgage.NoteAssignment.from":
+		panic(fmt.Errorf("field from of message ardapoc.mortgage.NoteAssignment is not mutable"))
+	case "ardapoc.mortgage.NoteAssignment.to":
+		panic(fmt.Errorf("field to of message ardapoc.mortgage.NoteAssignment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NoteAssignment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.NoteAssignment.from":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.NoteAssignment.to":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.NoteAssignment.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.NoteAssignment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.NoteAssignment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NoteAssignment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.NoteAssignment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NoteAssignment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NoteAssignment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NoteAssignment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NoteAssignment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NoteAssignment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.To)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NoteAssignment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.To) > 0 {
+			i -= len(x.To)
+			copy(dAtA[i:], x.To)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.To)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NoteAssignment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NoteAssignment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NoteAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.To = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MortgagePurchase_1_list)(nil)
+
+type _MortgagePurchase_1_list struct {
+	list *[]string
+}
+
+func (x *_MortgagePurchase_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MortgagePurchase_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MortgagePurchase_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MortgagePurchase_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MortgagePurchase_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MortgagePurchase at list field FromOwners as it is not of Message kind"))
+}
+
+func (x *_MortgagePurchase_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MortgagePurchase_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MortgagePurchase_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MortgagePurchase_2_list)(nil)
+
+type _MortgagePurchase_2_list struct {
+	list *[]uint64
+}
+
+func (x *_MortgagePurchase_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MortgagePurchase_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_MortgagePurchase_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MortgagePurchase_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MortgagePurchase_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MortgagePurchase at list field FromShares as it is not of Message kind"))
+}
+
+func (x *_MortgagePurchase_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MortgagePurchase_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_MortgagePurchase_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MortgagePurchase_4_list)(nil)
+
+type _MortgagePurchase_4_list struct {
+	list *[]string
+}
+
+func (x *_MortgagePurchase_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MortgagePurchase_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MortgagePurchase_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MortgagePurchase_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MortgagePurchase_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MortgagePurchase at list field AcceptedBy as it is not of Message kind"))
+}
+
+func (x *_MortgagePurchase_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MortgagePurchase_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MortgagePurchase_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MortgagePurchase             protoreflect.MessageDescriptor
+	fd_MortgagePurchase_from_owners protoreflect.FieldDescriptor
+	fd_MortgagePurchase_from_shares protoreflect.FieldDescriptor
+	fd_MortgagePurchase_price       protoreflect.FieldDescriptor
+	fd_MortgagePurchase_accepted_by protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_MortgagePurchase = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("MortgagePurchase")
+	fd_MortgagePurchase_from_owners = md_MortgagePurchase.Fields().ByName("from_owners")
+	fd_MortgagePurchase_from_shares = md_MortgagePurchase.Fields().ByName("from_shares")
+	fd_MortgagePurchase_price = md_MortgagePurchase.Fields().ByName("price")
+	fd_MortgagePurchase_accepted_by = md_MortgagePurchase.Fields().ByName("accepted_by")
+}
+
+var _ protoreflect.Message = (*fastReflection_MortgagePurchase)(nil)
+
+type fastReflection_MortgagePurchase MortgagePurchase
+
+func (x *MortgagePurchase) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MortgagePurchase)(x)
+}
+
+func (x *MortgagePurchase) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MortgagePurchase_messageType fastReflection_MortgagePurchase_messageType
+var _ protoreflect.MessageType = fastReflection_MortgagePurchase_messageType{}
+
+type fastReflection_MortgagePurchase_messageType struct{}
+
+func (x fastReflection_MortgagePurchase_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MortgagePurchase)(nil)
+}
+func (x fastReflection_MortgagePurchase_messageType) New() protoreflect.Message {
+	return new(fastReflection_MortgagePurchase)
+}
+func (x fastReflection_MortgagePurchase_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MortgagePurchase
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MortgagePurchase) Descriptor() protoreflect.MessageDescriptor {
+	return md_MortgagePurchase
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MortgagePurchase) Type() protoreflect.MessageType {
+	return _fastReflection_MortgagePurchase_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MortgagePurchase) New() protoreflect.Message {
+	return new(fastReflection_MortgagePurchase)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MortgagePurchase) Interface() protoreflect.ProtoMessage {
+	return (*MortgagePurchase)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MortgagePurchase) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.FromOwners) != 0 {
+		value := protoreflect.ValueOfList(&_MortgagePurchase_1_list{list: &x.FromOwners})
+		if !f(fd_MortgagePurchase_from_owners, value) {
+			return
+		}
+	}
+	if len(x.FromShares) != 0 {
+		value := protoreflect.ValueOfList(&_MortgagePurchase_2_list{list: &x.FromShares})
+		if !f(fd_MortgagePurchase_from_shares, value) {
+			return
+		}
+	}
+	if x.Price != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Price)
+		if !f(fd_MortgagePurchase_price, value) {
+			return
+		}
+	}
+	if len(x.AcceptedBy) != 0 {
+		value := protoreflect.ValueOfList(&_MortgagePurchase_4_list{list: &x.AcceptedBy})
+		if !f(fd_MortgagePurchase_accepted_by, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MortgagePurchase) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		return len(x.FromOwners) != 0
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		return len(x.FromShares) != 0
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		return x.Price != uint64(0)
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		return len(x.AcceptedBy) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgagePurchase) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		x.FromOwners = nil
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		x.FromShares = nil
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		x.Price = uint64(0)
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		x.AcceptedBy = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MortgagePurchase) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		if len(x.FromOwners) == 0 {
+			return protoreflect.ValueOfList(&_MortgagePurchase_1_list{})
+		}
+		listValue := &_MortgagePurchase_1_list{list: &x.FromOwners}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		if len(x.FromShares) == 0 {
+			return protoreflect.ValueOfList(&_MortgagePurchase_2_list{})
+		}
+		listValue := &_MortgagePurchase_2_list{list: &x.FromShares}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		value := x.Price
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		if len(x.AcceptedBy) == 0 {
+			return protoreflect.ValueOfList(&_MortgagePurchase_4_list{})
+		}
+		listValue := &_MortgagePurchase_4_list{list: &x.AcceptedBy}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgagePurchase) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		lv := value.List()
+		clv := lv.(*_MortgagePurchase_1_list)
+		x.FromOwners = *clv.list
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		lv := value.List()
+		clv := lv.(*_MortgagePurchase_2_list)
+		x.FromShares = *clv.list
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		x.Price = value.Uint()
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		lv := value.List()
+		clv := lv.(*_MortgagePurchase_4_list)
+		x.AcceptedBy = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgagePurchase) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		if x.FromOwners == nil {
+			x.FromOwners = []string{}
+		}
+		value := &_MortgagePurchase_1_list{list: &x.FromOwners}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		if x.FromShares == nil {
+			x.FromShares = []uint64{}
+		}
+		value := &_MortgagePurchase_2_list{list: &x.FromShares}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		if x.AcceptedBy == nil {
+			x.AcceptedBy = []string{}
+		}
+		value := &_MortgagePurchase_4_list{list: &x.AcceptedBy}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		panic(fmt.Errorf("field price of message ardapoc.mortgage.MortgagePurchase is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MortgagePurchase) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgagePurchase.from_owners":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MortgagePurchase_1_list{list: &list})
+	case "ardapoc.mortgage.MortgagePurchase.from_shares":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_MortgagePurchase_2_list{list: &list})
+	case "ardapoc.mortgage.MortgagePurchase.price":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MortgagePurchase.accepted_by":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MortgagePurchase_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgagePurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgagePurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MortgagePurchase) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MortgagePurchase", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MortgagePurchase) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgagePurchase) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MortgagePurchase) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MortgagePurchase) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MortgagePurchase)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.FromOwners) > 0 {
+			for _, s := range x.FromOwners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FromShares) > 0 {
+			l = 0
+			for _, e := range x.FromShares {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Price != 0 {
+			n += 1 + runtime.Sov(uint64(x.Price))
+		}
+		if len(x.AcceptedBy) > 0 {
+			for _, s := range x.AcceptedBy {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MortgagePurchase)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AcceptedBy) > 0 {
+			for iNdEx := len(x.AcceptedBy) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AcceptedBy[iNdEx])
+				copy(dAtA[i:], x.AcceptedBy[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AcceptedBy[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Price != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Price))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.FromShares) > 0 {
+			var pksize2 int
+			for _, num := range x.FromShares {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.FromShares {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FromOwners) > 0 {
+			for iNdEx := len(x.FromOwners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FromOwners[iNdEx])
+				copy(dAtA[i:], x.FromOwners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromOwners[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MortgagePurchase)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MortgagePurchase: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MortgagePurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromOwners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FromOwners = append(x.FromOwners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.FromShares = append(x.FromShares, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.FromShares) == 0 {
+						x.FromShares = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.FromShares = append(x.FromShares, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromShares", wireType)
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				x.Price = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Price |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AcceptedBy = append(x.AcceptedBy, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MortgageTerms                   protoreflect.MessageDescriptor
+	fd_MortgageTerms_version           protoreflect.FieldDescriptor
+	fd_MortgageTerms_interest_rate     protoreflect.FieldDescriptor
+	fd_MortgageTerms_term_months       protoreflect.FieldDescriptor
+	fd_MortgageTerms_payment_frequency protoreflect.FieldDescriptor
+	fd_MortgageTerms_amortization      protoreflect.FieldDescriptor
+	fd_MortgageTerms_principal         protoreflect.FieldDescriptor
+	fd_MortgageTerms_start_date        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_MortgageTerms = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("MortgageTerms")
+	fd_MortgageTerms_version = md_MortgageTerms.Fields().ByName("version")
+	fd_MortgageTerms_interest_rate = md_MortgageTerms.Fields().ByName("interest_rate")
+	fd_MortgageTerms_term_months = md_MortgageTerms.Fields().ByName("term_months")
+	fd_MortgageTerms_payment_frequency = md_MortgageTerms.Fields().ByName("payment_frequency")
+	fd_MortgageTerms_amortization = md_MortgageTerms.Fields().ByName("amortization")
+	fd_MortgageTerms_principal = md_MortgageTerms.Fields().ByName("principal")
+	fd_MortgageTerms_start_date = md_MortgageTerms.Fields().ByName("start_date")
+}
+
+var _ protoreflect.Message = (*fastReflection_MortgageTerms)(nil)
+
+type fastReflection_MortgageTerms MortgageTerms
+
+func (x *MortgageTerms) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MortgageTerms)(x)
+}
+
+func (x *MortgageTerms) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MortgageTerms_messageType fastReflection_MortgageTerms_messageType
+var _ protoreflect.MessageType = fastReflection_MortgageTerms_messageType{}
+
+type fastReflection_MortgageTerms_messageType struct{}
+
+func (x fastReflection_MortgageTerms_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MortgageTerms)(nil)
+}
+func (x fastReflection_MortgageTerms_messageType) New() protoreflect.Message {
+	return new(fastReflection_MortgageTerms)
+}
+func (x fastReflection_MortgageTerms_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MortgageTerms
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MortgageTerms) Descriptor() protoreflect.MessageDescriptor {
+	return md_MortgageTerms
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MortgageTerms) Type() protoreflect.MessageType {
+	return _fastReflection_MortgageTerms_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MortgageTerms) New() protoreflect.Message {
+	return new(fastReflection_MortgageTerms)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MortgageTerms) Interface() protoreflect.ProtoMessage {
+	return (*MortgageTerms)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MortgageTerms) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Version != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Version)
+		if !f(fd_MortgageTerms_version, value) {
+			return
+		}
+	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_MortgageTerms_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_MortgageTerms_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_MortgageTerms_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_MortgageTerms_amortization, value) {
+			return
+		}
+	}
+	if x.Principal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Principal)
+		if !f(fd_MortgageTerms_principal, value) {
+			return
+		}
+	}
+	if x.StartDate != nil {
+		value := protoreflect.ValueOfMessage(x.StartDate.ProtoReflect())
+		if !f(fd_MortgageTerms_start_date, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MortgageTerms) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.version":
+		return x.Version != uint32(0)
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		return x.Amortization != 0
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		return x.Principal != uint64(0)
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		return x.StartDate != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgageTerms) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.version":
+		x.Version = uint32(0)
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		x.Amortization = 0
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		x.Principal = uint64(0)
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		x.StartDate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MortgageTerms) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.version":
+		value := x.Version
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		value := x.Principal
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		value := x.StartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgageTerms) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.version":
+		x.Version = uint32(value.Uint())
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		x.Principal = value.Uint()
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		x.StartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgageTerms) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		if x.StartDate == nil {
+			x.StartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartDate.ProtoReflect())
+	case "ardapoc.mortgage.MortgageTerms.version":
+		panic(fmt.Errorf("field version of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		panic(fmt.Errorf("field principal of message ardapoc.mortgage.MortgageTerms is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MortgageTerms) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MortgageTerms.version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MortgageTerms.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MortgageTerms.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MortgageTerms.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MortgageTerms.amortization":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MortgageTerms.principal":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MortgageTerms.start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MortgageTerms"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MortgageTerms does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MortgageTerms) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MortgageTerms", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MortgageTerms) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MortgageTerms) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MortgageTerms) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MortgageTerms) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MortgageTerms)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.Principal != 0 {
+			n += 1 + runtime.Sov(uint64(x.Principal))
+		}
+		if x.StartDate != nil {
+			l = options.Size(x.StartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MortgageTerms)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StartDate != nil {
+			encoded, err := options.Marshal(x.StartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Principal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Principal))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MortgageTerms)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MortgageTerms: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MortgageTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+				}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				}
+				x.Principal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Principal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartDate == nil {
+					x.StartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForeclosureAuction                protoreflect.MessageDescriptor
+	fd_ForeclosureAuction_end_time       protoreflect.FieldDescriptor
+	fd_ForeclosureAuction_highest_bidder protoreflect.FieldDescriptor
+	fd_ForeclosureAuction_highest_bid    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_ForeclosureAuction = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("ForeclosureAuction")
+	fd_ForeclosureAuction_end_time = md_ForeclosureAuction.Fields().ByName("end_time")
+	fd_ForeclosureAuction_highest_bidder = md_ForeclosureAuction.Fields().ByName("highest_bidder")
+	fd_ForeclosureAuction_highest_bid = md_ForeclosureAuction.Fields().ByName("highest_bid")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForeclosureAuction)(nil)
+
+type fastReflection_ForeclosureAuction ForeclosureAuction
+
+func (x *ForeclosureAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForeclosureAuction)(x)
+}
+
+func (x *ForeclosureAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForeclosureAuction_messageType fastReflection_ForeclosureAuction_messageType
+var _ protoreflect.MessageType = fastReflection_ForeclosureAuction_messageType{}
+
+type fastReflection_ForeclosureAuction_messageType struct{}
+
+func (x fastReflection_ForeclosureAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForeclosureAuction)(nil)
+}
+func (x fastReflection_ForeclosureAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForeclosureAuction)
+}
+func (x fastReflection_ForeclosureAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeclosureAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForeclosureAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForeclosureAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForeclosureAuction) Type() protoreflect.MessageType {
+	return _fastReflection_ForeclosureAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForeclosureAuction) New() protoreflect.Message {
+	return new(fastReflection_ForeclosureAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForeclosureAuction) Interface() protoreflect.ProtoMessage {
+	return (*ForeclosureAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForeclosureAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_ForeclosureAuction_end_time, value) {
+			return
+		}
+	}
+	if x.HighestBidder != "" {
+		value := protoreflect.ValueOfString(x.HighestBidder)
+		if !f(fd_ForeclosureAuction_highest_bidder, value) {
+			return
+		}
+	}
+	if x.HighestBid != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HighestBid)
+		if !f(fd_ForeclosureAuction_highest_bid, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForeclosureAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		return x.EndTime != nil
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		return x.HighestBidder != ""
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		return x.HighestBid != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeclosureAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		x.EndTime = nil
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		x.HighestBidder = ""
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		x.HighestBid = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForeclosureAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		value := x.HighestBidder
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		value := x.HighestBid
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeclosureAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		x.HighestBidder = value.Interface().(string)
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		x.HighestBid = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeclosureAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		panic(fmt.Errorf("field highest_bidder of message ardapoc.mortgage.ForeclosureAuction is not mutable"))
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		panic(fmt.Errorf("field highest_bid of message ardapoc.mortgage.ForeclosureAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForeclosureAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.ForeclosureAuction.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bidder":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.ForeclosureAuction.highest_bid":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.ForeclosureAuction"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.ForeclosureAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForeclosureAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.ForeclosureAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForeclosureAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForeclosureAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForeclosureAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForeclosureAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForeclosureAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HighestBidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HighestBid != 0 {
+			n += 1 + runtime.Sov(uint64(x.HighestBid))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForeclosureAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.HighestBid != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HighestBid))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.HighestBidder) > 0 {
+			i -= len(x.HighestBidder)
+			copy(dAtA[i:], x.HighestBidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HighestBidder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForeclosureAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeclosureAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForeclosureAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HighestBidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+				}
+				x.HighestBid = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HighestBid |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Installment           protoreflect.MessageDescriptor
+	fd_Installment_number    protoreflect.FieldDescriptor
+	fd_Installment_due_date  protoreflect.FieldDescriptor
+	fd_Installment_payment   protoreflect.FieldDescriptor
+	fd_Installment_interest  protoreflect.FieldDescriptor
+	fd_Installment_principal protoreflect.FieldDescriptor
+	fd_Installment_balance   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_mortgage_proto_init()
+	md_Installment = File_ardapoc_mortgage_mortgage_proto.Messages().ByName("Installment")
+	fd_Installment_number = md_Installment.Fields().ByName("number")
+	fd_Installment_due_date = md_Installment.Fields().ByName("due_date")
+	fd_Installment_payment = md_Installment.Fields().ByName("payment")
+	fd_Installment_interest = md_Installment.Fields().ByName("interest")
+	fd_Installment_principal = md_Installment.Fields().ByName("principal")
+	fd_Installment_balance = md_Installment.Fields().ByName("balance")
+}
+
+var _ protoreflect.Message = (*fastReflection_Installment)(nil)
+
+type fastReflection_Installment Installment
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Installment)(x)
+}
+
+func (x *Installment) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Installment_messageType fastReflection_Installment_messageType
+var _ protoreflect.MessageType = fastReflection_Installment_messageType{}
+
+type fastReflection_Installment_messageType struct{}
+
+func (x fastReflection_Installment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Installment)(nil)
+}
+func (x fastReflection_Installment_messageType) New() protoreflect.Message {
+	return new(fastReflection_Installment)
+}
+func (x fastReflection_Installment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Installment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Installment) Descriptor() protoreflect.MessageDescriptor {
+	return md_Installment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Installment) Type() protoreflect.MessageType {
+	return _fastReflection_Installment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Installment) New() protoreflect.Message {
+	return new(fastReflection_Installment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Installment) Interface() protoreflect.ProtoMessage {
+	return (*Installment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Installment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Number != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Number)
+		if !f(fd_Installment_number, value) {
+			return
+		}
+	}
+	if x.DueDate != nil {
+		value := protoreflect.ValueOfMessage(x.DueDate.ProtoReflect())
+		if !f(fd_Installment_due_date, value) {
+			return
+		}
+	}
+	if x.Payment != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Payment)
+		if !f(fd_Installment_payment, value) {
+			return
+		}
+	}
+	if x.Interest != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interest)
+		if !f(fd_Installment_interest, value) {
+			return
+		}
+	}
+	if x.Principal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Principal)
+		if !f(fd_Installment_principal, value) {
+			return
+		}
+	}
+	if x.Balance != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Balance)
+		if !f(fd_Installment_balance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Installment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.Installment.number":
+		return x.Number != uint32(0)
+	case "ardapoc.mortgage.Installment.due_date":
+		return x.DueDate != nil
+	case "ardapoc.mortgage.Installment.payment":
+		return x.Payment != uint64(0)
+	case "ardapoc.mortgage.Installment.interest":
+		return x.Interest != uint64(0)
+	case "ardapoc.mortgage.Installment.principal":
+		return x.Principal != uint64(0)
+	case "ardapoc.mortgage.Installment.balance":
+		return x.Balance != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Installment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.Installment.number":
+		x.Number = uint32(0)
+	case "ardapoc.mortgage.Installment.due_date":
+		x.DueDate = nil
+	case "ardapoc.mortgage.Installment.payment":
+		x.Payment = uint64(0)
+	case "ardapoc.mortgage.Installment.interest":
+		x.Interest = uint64(0)
+	case "ardapoc.mortgage.Installment.principal":
+		x.Principal = uint64(0)
+	case "ardapoc.mortgage.Installment.balance":
+		x.Balance = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Installment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.Installment.number":
+		value := x.Number
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.Installment.due_date":
+		value := x.DueDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Installment.payment":
+		value := x.Payment
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Installment.interest":
+		value := x.Interest
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Installment.principal":
+		value := x.Principal
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.Installment.balance":
+		value := x.Balance
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Installment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.Installment.number":
+		x.Number = uint32(value.Uint())
+	case "ardapoc.mortgage.Installment.due_date":
+		x.DueDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.mortgage.Installment.payment":
+		x.Payment = value.Uint()
+	case "ardapoc.mortgage.Installment.interest":
+		x.Interest = value.Uint()
+	case "ardapoc.mortgage.Installment.principal":
+		x.Principal = value.Uint()
+	case "ardapoc.mortgage.Installment.balance":
+		x.Balance = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Installment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.Installment.due_date":
+		if x.DueDate == nil {
+			x.DueDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DueDate.ProtoReflect())
+	case "ardapoc.mortgage.Installment.number":
+		panic(fmt.Errorf("field number of message ardapoc.mortgage.Installment is not mutable"))
+	case "ardapoc.mortgage.Installment.payment":
+		panic(fmt.Errorf("field payment of message ardapoc.mortgage.Installment is not mutable"))
+	case "ardapoc.mortgage.Installment.interest":
+		panic(fmt.Errorf("field interest of message ardapoc.mortgage.Installment is not mutable"))
+	case "ardapoc.mortgage.Installment.principal":
+		panic(fmt.Errorf("field principal of message ardapoc.mortgage.Installment is not mutable"))
+	case "ardapoc.mortgage.Installment.balance":
+		panic(fmt.Errorf("field balance of message ardapoc.mortgage.Installment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Installment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.Installment.number":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.Installment.due_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Installment.payment":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Installment.interest":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Installment.principal":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.Installment.balance":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Installment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.Installment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Installment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.Installment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Installment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Installment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Installment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Installment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Installment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Number != 0 {
+			n += 1 + runtime.Sov(uint64(x.Number))
+		}
+		if x.DueDate != nil {
+			l = options.Size(x.DueDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Payment != 0 {
+			n += 1 + runtime.Sov(uint64(x.Payment))
+		}
+		if x.Interest != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interest))
+		}
+		if x.Principal != 0 {
+			n += 1 + runtime.Sov(uint64(x.Principal))
+		}
+		if x.Balance != 0 {
+			n += 1 + runtime.Sov(uint64(x.Balance))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Installment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Balance != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Balance))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Principal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Principal))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Interest != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interest))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Payment != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Payment))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DueDate != nil {
+			encoded, err := options.Marshal(x.DueDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Number != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Number))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Installment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Installment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Installment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				}
+				x.Number = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Number |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DueDate == nil {
+					x.DueDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DueDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+				}
+				x.Payment = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Payment |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+				}
+				x.Interest = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interest |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				}
+				x.Principal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Principal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				x.Balance = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Balance |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: ardapoc/mortgage/mortgage.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MortgageStatus int32
+
+const (
+	MortgageStatus_REQUESTED MortgageStatus = 0
+	MortgageStatus_APPROVED  MortgageStatus = 1
+	MortgageStatus_REJECTED  MortgageStatus = 2
+	MortgageStatus_PAID      MortgageStatus = 3
+	MortgageStatus_CANCELLED MortgageStatus = 4
+	// DELINQUENT mortgages have an installment unpaid past the grace period.
+	MortgageStatus_DELINQUENT MortgageStatus = 5
+	// DEFAULTED mortgages have an installment unpaid past the default period.
+	MortgageStatus_DEFAULTED MortgageStatus = 6
+	// FORECLOSING mortgages have their escrowed collateral up for auction.
+	MortgageStatus_FORECLOSING MortgageStatus = 7
+	// FORECLOSED mortgages were settled by handing over the escrowed collateral.
+	MortgageStatus_FORECLOSED MortgageStatus = 8
+)
+
+// Enum value maps for MortgageStatus.
+var (
+	MortgageStatus_name = map[int32]string{
+		0: "REQUESTED",
+		1: "APPROVED",
+		2: "REJECTED",
+		3: "PAID",
+		4: "CANCELLED",
+		5: "DELINQUENT",
+		6: "DEFAULTED",
+		7: "FORECLOSING",
+		8: "FORECLOSED",
+	}
+	MortgageStatus_value = map[string]int32{
+		"REQUESTED":   0,
+		"APPROVED":    1,
+		"REJECTED":    2,
+		"PAID":        3,
+		"CANCELLED":   4,
+		"DELINQUENT":  5,
+		"DEFAULTED":   6,
+		"FORECLOSING": 7,
+		"FORECLOSED":  8,
+	}
+)
+
+func (x MortgageStatus) Enum() *MortgageStatus {
+	p := new(MortgageStatus)
+	*p = x
+	return p
+}
+
+func (x MortgageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MortgageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ardapoc_mortgage_mortgage_proto_enumTypes[0].Descriptor()
+}
+
+func (MortgageStatus) Type() protoreflect.EnumType {
+	return &file_ardapoc_mortgage_mortgage_proto_enumTypes[0]
+}
+
+func (x MortgageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MortgageStatus.Descriptor instead.
+func (MortgageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{0}
+}
+
+// PaymentFrequency defines how often mortgage installments fall due.
+type PaymentFrequency int32
+
+const (
+	PaymentFrequency_MONTHLY       PaymentFrequency = 0
+	PaymentFrequency_QUARTERLY     PaymentFrequency = 1
+	PaymentFrequency_SEMI_ANNUALLY PaymentFrequency = 2
+	PaymentFrequency_ANNUALLY      PaymentFrequency = 3
+)
+
+// Enum value maps for PaymentFrequency.
+var (
+	PaymentFrequency_name = map[int32]string{
+		0: "MONTHLY",
+		1: "QUARTERLY",
+		2: "SEMI_ANNUALLY",
+		3: "ANNUALLY",
+	}
+	PaymentFrequency_value = map[string]int32{
+		"MONTHLY":       0,
+		"QUARTERLY":     1,
+		"SEMI_ANNUALLY": 2,
+		"ANNUALLY":      3,
+	}
+)
+
+func (x PaymentFrequency) Enum() *PaymentFrequency {
+	p := new(PaymentFrequency)
+	*p = x
+	return p
+}
+
+func (x PaymentFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_ardapoc_mortgage_mortgage_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentFrequency) Type() protoreflect.EnumType {
+	return &file_ardapoc_mortgage_mortgage_proto_enumTypes[1]
+}
+
+func (x PaymentFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentFrequency.Descriptor instead.
+func (PaymentFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{1}
+}
+
+// AmortizationType defines how the principal of a mortgage is paid down.
+type AmortizationType int32
+
+const (
+	// ANNUITY pays equal installments of interest and principal.
+	AmortizationType_ANNUITY AmortizationType = 0
+	// INTEREST_ONLY pays interest each period and the principal with the last installment.
+	AmortizationType_INTEREST_ONLY AmortizationType = 1
+)
+
+// Enum value maps for AmortizationType.
+var (
+	AmortizationType_name = map[int32]string{
+		0: "ANNUITY",
+		1: "INTEREST_ONLY",
+	}
+	AmortizationType_value = map[string]int32{
+		"ANNUITY":       0,
+		"INTEREST_ONLY": 1,
+	}
+)
+
+func (x AmortizationType) Enum() *AmortizationType {
+	p := new(AmortizationType)
+	*p = x
+	return p
+}
+
+func (x AmortizationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmortizationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ardapoc_mortgage_mortgage_proto_enumTypes[2].Descriptor()
+}
+
+func (AmortizationType) Type() protoreflect.EnumType {
+	return &file_ardapoc_mortgage_mortgage_proto_enumTypes[2]
+}
+
+func (x AmortizationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmortizationType.Descriptor instead.
+func (AmortizationType) EnumDescriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{2}
+}
+
+type Mortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index      string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender     string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Lendee     string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Collateral string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount     uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// legacy_interest_rate and legacy_term hold the free-form terms of mortgages
+	// created before v0.6.0 that could not be parsed.
+	//
+	// Deprecated: Do not use.
+	LegacyInterestRate string `protobuf:"bytes,7,opt,name=legacy_interest_rate,json=legacyInterestRate,proto3" json:"legacy_interest_rate,omitempty"`
+	// Deprecated: Do not use.
+	LegacyTerm string         `protobuf:"bytes,8,opt,name=legacy_term,json=legacyTerm,proto3" json:"legacy_term,omitempty"`
+	Status     MortgageStatus `protobuf:"varint,9,opt,name=status,proto3,enum=ardapoc.mortgage.MortgageStatus" json:"status,omitempty"`
+	// outstanding_amount is the principal still owed.
+	OutstandingAmount uint64 `protobuf:"varint,10,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// interest_rate is the annual interest rate as a decimal, e.g. "0.05" for 5%.
+	InterestRate     string           `protobuf:"bytes,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,12,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,13,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,14,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// start_date is when the mortgage was funded; installments fall due from it.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// interest_due is interest accrued on past installments and not yet paid.
+	InterestDue uint64 `protobuf:"varint,16,opt,name=interest_due,json=interestDue,proto3" json:"interest_due,omitempty"`
+	// periods_accrued is the number of installments whose interest has accrued.
+	PeriodsAccrued uint32 `protobuf:"varint,17,opt,name=periods_accrued,json=periodsAccrued,proto3" json:"periods_accrued,omitempty"`
+	// amount_paid is the total repaid towards the schedule, excluding late fees.
+	AmountPaid uint64 `protobuf:"varint,18,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// next_due_date is the due date of the oldest installment not fully paid.
+	NextDueDate *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
+	// late_fees_due is the late fees charged and not yet paid.
+	LateFeesDue uint64 `protobuf:"varint,20,opt,name=late_fees_due,json=lateFeesDue,proto3" json:"late_fees_due,omitempty"`
+	// review_time is when the mortgage is next checked for late payment.
+	ReviewTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`
+	// escrowed_shares is the number of collateral shares of the lendee held by
+	// the module account while the mortgage is funded.
+	EscrowedShares uint64 `protobuf:"varint,22,opt,name=escrowed_shares,json=escrowedShares,proto3" json:"escrowed_shares,omitempty"`
+	// auction is the foreclosure auction of the escrowed shares, if any.
+	Auction *ForeclosureAuction `protobuf:"bytes,23,opt,name=auction,proto3" json:"auction,omitempty"`
+	// principal is the balance amortized by the current terms. It is zero until
+	// the mortgage is refinanced; until then the terms amortize the amount.
+	Principal uint64 `protobuf:"varint,24,opt,name=principal,proto3" json:"principal,omitempty"`
+	// terms_history lists the terms the mortgage was funded and refinanced on,
+	// oldest first. The last entry is the current version.
+	TermsHistory []*MortgageTerms `protobuf:"bytes,25,rep,name=terms_history,json=termsHistory,proto3" json:"terms_history,omitempty"`
+	// refinance_proposal holds new terms offered by the lender until the lendee
+	// accepts or rejects them.
+	RefinanceProposal *MortgageTerms `protobuf:"bytes,26,opt,name=refinance_proposal,json=refinanceProposal,proto3" json:"refinance_proposal,omitempty"`
+	// purchase is the property purchase the mortgage finances, if any.
+	Purchase *MortgagePurchase `protobuf:"bytes,27,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// equity marks a home equity loan against shares the lendee already owns. It
+	// may rank behind other liens on the collateral and its amount is limited by
+	// the maximum loan-to-value.
+	Equity bool `protobuf:"varint,28,opt,name=equity,proto3" json:"equity,omitempty"`
+	// note_assignments lists the transfers of the loan note, oldest first. The
+	// holder of the note is the lender of the mortgage.
+	NoteAssignments []*NoteAssignment `protobuf:"bytes,29,rep,name=note_assignments,json=noteAssignments,proto3" json:"note_assignments,omitempty"`
+	// syndicate lists the lenders funding a syndicated mortgage with their
+	// contributions, which add up to the amount. It is empty when the lender
+	// funds the mortgage alone. The lender leads the syndicate and acts for it.
+	Syndicate []*SyndicateLender `protobuf:"bytes,30,rep,name=syndicate,proto3" json:"syndicate,omitempty"`
+	// auto_repayment is the lendee's standing instruction to repay the mortgage
+	// automatically, if any.
+	AutoRepayment *AutoRepayment `protobuf:"bytes,31,opt,name=auto_repayment,json=autoRepayment,proto3" json:"auto_repayment,omitempty"`
+	// late_fee_charged is set once the late fee of the current delinquency was
+	// charged and cleared when the mortgage is cured.
+	LateFeeCharged bool `protobuf:"varint,32,opt,name=late_fee_charged,json=lateFeeCharged,proto3" json:"late_fee_charged,omitempty"`
+	// prepayment restricts repaying principal ahead of the schedule. A mortgage
+	// without prepayment terms may be prepaid at any time without penalty.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,33,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+	// prepayment_year is the loan year, counting from zero at funding, that
+	// prepaid_this_year refers to.
+	PrepaymentYear uint32 `protobuf:"varint,34,opt,name=prepayment_year,json=prepaymentYear,proto3" json:"prepayment_year,omitempty"`
+	// prepaid_this_year is the principal prepaid in the prepayment year.
+	PrepaidThisYear uint64 `protobuf:"varint,35,opt,name=prepaid_this_year,json=prepaidThisYear,proto3" json:"prepaid_this_year,omitempty"`
+}
+
+func (x *Mortgage) Reset() {
+	*x = Mortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mortgage) ProtoMessage() {}
+
+// Deprecated: Use Mortgage.ProtoReflect.Descriptor instead.
+func (*Mortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Mortgage) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Mortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *Mortgage) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *Mortgage) GetLendee() string {
+	if x != nil {
+		return x.Lendee
+	}
+	return ""
+}
+
+func (x *Mortgage) GetCollateral() string {
+	if x != nil {
+		return x.Collateral
+	}
+	return ""
+}
+
+func (x *Mortgage) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *Mortgage) GetLegacyInterestRate() string {
+	if x != nil {
+		return x.LegacyInterestRate
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Mortgage) GetLegacyTerm() string {
+	if x != nil {
+		return x.LegacyTerm
+	}
+	return ""
+}
+
+func (x *Mortgage) GetStatus() MortgageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MortgageStatus_REQUESTED
+}
+
+func (x *Mortgage) GetOutstandingAmount() uint64 {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return 0
+}
+
+func (x *Mortgage) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *Mortgage) GetTermMonths() uint32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *Mortgage) GetPaymentFrequency() PaymentFrequency {
+	if x != nil {
+		return x.PaymentFrequency
+	}
+	return PaymentFrequency_MONTHLY
+}
+
+func (x *Mortgage) GetAmortization() AmortizationType {
+	if x != nil {
+		return x.Amortization
+	}
+	return AmortizationType_ANNUITY
+}
+
+func (x *Mortgage) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Mortgage) GetInterestDue() uint64 {
+	if x != nil {
+		return x.InterestDue
+	}
+	return 0
+}
+
+func (x *Mortgage) GetPeriodsAccrued() uint32 {
+	if x != nil {
+		return x.PeriodsAccrued
+	}
+	return 0
+}
+
+func (x *Mortgage) GetAmountPaid() uint64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *Mortgage) GetNextDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return nil
+}
+
+func (x *Mortgage) GetLateFeesDue() uint64 {
+	if x != nil {
+		return x.LateFeesDue
+	}
+	return 0
+}
+
+func (x *Mortgage) GetReviewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewTime
+	}
+	return nil
+}
+
+func (x *Mortgage) GetEscrowedShares() uint64 {
+	if x != nil {
+		return x.EscrowedShares
+	}
+	return 0
+}
+
+func (x *Mortgage) GetAuction() *ForeclosureAuction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+func (x *Mortgage) GetPrincipal() uint64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Mortgage) GetTermsHistory() []*MortgageTerms {
+	if x != nil {
+		return x.TermsHistory
+	}
+	return nil
+}
+
+func (x *Mortgage) GetRefinanceProposal() *MortgageTerms {
+	if x != nil {
+		return x.RefinanceProposal
+	}
+	return nil
+}
+
+func (x *Mortgage) GetPurchase() *MortgagePurchase {
+	if x != nil {
+		return x.Purchase
+	}
+	return nil
+}
+
+func (x *Mortgage) GetEquity() bool {
+	if x != nil {
+		return x.Equity
+	}
+	return false
+}
+
+func (x *Mortgage) GetNoteAssignments() []*NoteAssignment {
+	if x != nil {
+		return x.NoteAssignments
+	}
+	return nil
+}
+
+func (x *Mortgage) GetSyndicate() []*SyndicateLender {
+	if x != nil {
+		return x.Syndicate
+	}
+	return nil
+}
+
+func (x *Mortgage) GetAutoRepayment() *AutoRepayment {
+	if x != nil {
+		return x.AutoRepayment
+	}
+	return nil
+}
+
+func (x *Mortgage) GetLateFeeCharged() bool {
+	if x != nil {
+		return x.LateFeeCharged
+	}
+	return false
+}
+
+func (x *Mortgage) GetPrepayment() *PrepaymentTerms {
+	if x != nil {
+		return x.Prepayment
+	}
+	return nil
+}
+
+func (x *Mortgage) GetPrepaymentYear() uint32 {
+	if x != nil {
+		return x.PrepaymentYear
+	}
+	return 0
+}
+
+func (x *Mortgage) GetPrepaidThisYear() uint64 {
+	if x != nil {
+		return x.PrepaidThisYear
+	}
+	return 0
+}
+
+// AutoRepayment is a lendee's standing instruction to repay a mortgage from an
+// authz SendAuthorization granted to the mortgage module account. What is due
+// is pulled at the end of the block in which an installment falls due.
+type AutoRepayment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// next_pull is when the next installment is pulled.
+	NextPull    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_pull,json=nextPull,proto3" json:"next_pull,omitempty"`
+	Successes   uint32                 `protobuf:"varint,2,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures    uint32                 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastAttempt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastAmount  uint64                 `protobuf:"varint,5,opt,name=last_amount,json=lastAmount,proto3" json:"last_amount,omitempty"`
+	// last_error is why the last pull failed. It is empty if it succeeded.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *AutoRepayment) Reset() {
+	*x = AutoRepayment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoRepayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRepayment) ProtoMessage() {}
+
+// Deprecated: Use AutoRepayment.ProtoReflect.Descriptor instead.
+func (*AutoRepayment) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AutoRepayment) GetNextPull() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextPull
+	}
+	return nil
+}
+
+func (x *AutoRepayment) GetSuccesses() uint32 {
+	if x != nil {
+		return x.Successes
+	}
+	return 0
+}
+
+func (x *AutoRepayment) GetFailures() uint32 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *AutoRepayment) GetLastAttempt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return nil
+}
+
+func (x *AutoRepayment) GetLastAmount() uint64 {
+	if x != nil {
+		return x.LastAmount
+	}
+	return 0
+}
+
+func (x *AutoRepayment) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// PrepaymentTerms are the conditions on which the lendee may repay principal
+// ahead of the amortization schedule.
+type PrepaymentTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// penalty_rate is the penalty charged on the principal prepaid, as a decimal,
+	// e.g. "0.02" for 2%. It is paid to the lenders on top of the repayment.
+	PenaltyRate string `protobuf:"bytes,1,opt,name=penalty_rate,json=penaltyRate,proto3" json:"penalty_rate,omitempty"`
+	// lock_in_months is how long after funding no principal may be prepaid.
+	LockInMonths uint32 `protobuf:"varint,2,opt,name=lock_in_months,json=lockInMonths,proto3" json:"lock_in_months,omitempty"`
+	// max_yearly_prepayment caps the principal prepaid per loan year. Zero means
+	// there is no cap.
+	MaxYearlyPrepayment uint64 `protobuf:"varint,3,opt,name=max_yearly_prepayment,json=maxYearlyPrepayment,proto3" json:"max_yearly_prepayment,omitempty"`
+}
+
+func (x *PrepaymentTerms) Reset() {
+	*x = PrepaymentTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepaymentTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepaymentTerms) ProtoMessage() {}
+
+// Deprecated: Use PrepaymentTerms.ProtoReflect.Descriptor instead.
+func (*PrepaymentTerms) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrepaymentTerms) GetPenaltyRate() string {
+	if x != nil {
+		return x.PenaltyRate
+	}
+	return ""
+}
+
+func (x *PrepaymentTerms) GetLockInMonths() uint32 {
+	if x != nil {
+		return x.LockInMonths
+	}
+	return 0
+}
+
+func (x *PrepaymentTerms) GetMaxYearlyPrepayment() uint64 {
+	if x != nil {
+		return x.MaxYearlyPrepayment
+	}
+	return 0
+}
+
+// SyndicateLender is a member of the syndicate funding a mortgage. Repayments
+// are split among the members in proportion to their contributions.
+type SyndicateLender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender       string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Contribution uint64 `protobuf:"varint,2,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	// committed is set once the lender agreed to fund its contribution.
+	Committed bool `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	// received is the total of the repayments paid to the lender.
+	Received uint64 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (x *SyndicateLender) Reset() {
+	*x = SyndicateLender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyndicateLender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyndicateLender) ProtoMessage() {}
+
+// Deprecated: Use SyndicateLender.ProtoReflect.Descriptor instead.
+func (*SyndicateLender) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SyndicateLender) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *SyndicateLender) GetContribution() uint64 {
+	if x != nil {
+		return x.Contribution
+	}
+	return 0
+}
+
+func (x *SyndicateLender) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *SyndicateLender) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
 
-// Deprecated: Use Mortgage.ProtoReflect.Descriptor instead.
-func (*Mortgage) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{0}
+// NoteAssignment records the transfer of a loan note from one lender to the next.
+type NoteAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *Mortgage) GetCreator() string {
+func (x *NoteAssignment) Reset() {
+	*x = NoteAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteAssignment) ProtoMessage() {}
+
+// Deprecated: Use NoteAssignment.ProtoReflect.Descriptor instead.
+func (*NoteAssignment) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NoteAssignment) GetFrom() string {
 	if x != nil {
-		return x.Creator
+		return x.From
 	}
 	return ""
 }
 
-func (x *Mortgage) GetIndex() string {
+func (x *NoteAssignment) GetTo() string {
 	if x != nil {
-		return x.Index
+		return x.To
 	}
 	return ""
 }
 
-func (x *Mortgage) GetLender() string {
+func (x *NoteAssignment) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Lender
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *Mortgage) GetLendee() string {
+// MortgagePurchase is a purchase of property shares financed by a mortgage.
+// The shares bought are the collateral of the mortgage.
+type MortgagePurchase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromOwners []string `protobuf:"bytes,1,rep,name=from_owners,json=fromOwners,proto3" json:"from_owners,omitempty"`
+	FromShares []uint64 `protobuf:"varint,2,rep,packed,name=from_shares,json=fromShares,proto3" json:"from_shares,omitempty"`
+	// price is the total paid to the sellers, split in proportion to the shares
+	// each one sells. The buyer pays what the mortgage does not cover.
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// accepted_by lists the sellers who agreed to sell at the price with
+	// MsgAcceptPurchase. The purchase is only funded once every seller did.
+	AcceptedBy []string `protobuf:"bytes,4,rep,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"`
+}
+
+func (x *MortgagePurchase) Reset() {
+	*x = MortgagePurchase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MortgagePurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MortgagePurchase) ProtoMessage() {}
+
+// Deprecated: Use MortgagePurchase.ProtoReflect.Descriptor instead.
+func (*MortgagePurchase) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MortgagePurchase) GetFromOwners() []string {
 	if x != nil {
-		return x.Lendee
+		return x.FromOwners
 	}
-	return ""
+	return nil
 }
 
-func (x *Mortgage) GetCollateral() string {
+func (x *MortgagePurchase) GetFromShares() []uint64 {
 	if x != nil {
-		return x.Collateral
+		return x.FromShares
 	}
-	return ""
+	return nil
 }
 
-func (x *Mortgage) GetAmount() uint64 {
+func (x *MortgagePurchase) GetPrice() uint64 {
 	if x != nil {
-		return x.Amount
+		return x.Price
 	}
 	return 0
 }
 
-func (x *Mortgage) GetInterestRate() string {
+func (x *MortgagePurchase) GetAcceptedBy() []string {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return nil
+}
+
+// MortgageTerms is one version of the repayment terms of a mortgage.
+type MortgageTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version          uint32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InterestRate     string           `protobuf:"bytes,2,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,3,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,4,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,5,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// principal is the balance the terms amortize.
+	Principal uint64 `protobuf:"varint,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	// start_date is when the terms took effect.
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+}
+
+func (x *MortgageTerms) Reset() {
+	*x = MortgageTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MortgageTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MortgageTerms) ProtoMessage() {}
+
+// Deprecated: Use MortgageTerms.ProtoReflect.Descriptor instead.
+func (*MortgageTerms) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MortgageTerms) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MortgageTerms) GetInterestRate() string {
 	if x != nil {
 		return x.InterestRate
 	}
 	return ""
 }
 
-func (x *Mortgage) GetTerm() string {
+func (x *MortgageTerms) GetTermMonths() uint32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *MortgageTerms) GetPaymentFrequency() PaymentFrequency {
+	if x != nil {
+		return x.PaymentFrequency
+	}
+	return PaymentFrequency_MONTHLY
+}
+
+func (x *MortgageTerms) GetAmortization() AmortizationType {
+	if x != nil {
+		return x.Amortization
+	}
+	return AmortizationType_ANNUITY
+}
+
+func (x *MortgageTerms) GetPrincipal() uint64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *MortgageTerms) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+// ForeclosureAuction is an auction of the escrowed collateral of a defaulted
+// mortgage. The highest bid is held by the module account until it closes.
+type ForeclosureAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	HighestBidder string                 `protobuf:"bytes,2,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	HighestBid    uint64                 `protobuf:"varint,3,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+}
+
+func (x *ForeclosureAuction) Reset() {
+	*x = ForeclosureAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForeclosureAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeclosureAuction) ProtoMessage() {}
+
+// Deprecated: Use ForeclosureAuction.ProtoReflect.Descriptor instead.
+func (*ForeclosureAuction) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ForeclosureAuction) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ForeclosureAuction) GetHighestBidder() string {
 	if x != nil {
-		return x.Term
+		return x.HighestBidder
 	}
 	return ""
 }
 
-func (x *Mortgage) GetStatus() MortgageStatus {
+func (x *ForeclosureAuction) GetHighestBid() uint64 {
 	if x != nil {
-		return x.Status
+		return x.HighestBid
 	}
-	return MortgageStatus_REQUESTED
+	return 0
 }
 
-func (x *Mortgage) GetOutstandingAmount() uint64 {
+// Installment is one payment of a mortgage's amortization schedule.
+type Installment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Payment   uint64                 `protobuf:"varint,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Interest  uint64                 `protobuf:"varint,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal uint64                 `protobuf:"varint,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	// balance is the principal left after the installment.
+	Balance uint64 `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_mortgage_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_mortgage_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Installment) GetNumber() uint32 {
 	if x != nil {
-		return x.OutstandingAmount
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Installment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Installment) GetPayment() uint64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *Installment) GetInterest() uint64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *Installment) GetPrincipal() uint64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Installment) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
 	}
 	return 0
 }
@@ -1142,45 +8989,251 @@ var file_ardapoc_mortgage_mortgage_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
 	0x67, 0x65, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x10, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67,
-	0x61, 0x67, 0x65, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x02, 0x0a, 0x08, 0x4d, 0x6f,
-	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
-	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2a,
-	0x5a, 0x0a, 0x0e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c,
-	0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x50, 0x41, 0x49, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x4c, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xa4, 0x01, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
-	0x67, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67,
-	0x61, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x4d, 0x58, 0xaa, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61,
-	0x70, 0x6f, 0x63, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xca, 0x02, 0x10, 0x41,
-	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xe2,
-	0x02, 0x1c, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x11, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x67, 0x65, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x0d, 0x0a, 0x08, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65,
+	0x6e, 0x64, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x14, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x23, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33,
+	0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x41,
+	0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x75, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x44, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x5f, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12,
+	0x48, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x44, 0x75, 0x65, 0x12, 0x45, 0x0a,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x3e, 0x0a,
+	0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x0d, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x19, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x54, 0x65,
+	0x72, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x54,
+	0x65, 0x72, 0x6d, 0x73, 0x52, 0x11, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x08, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12,
+	0x51, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0f, 0x6e, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
+	0x73, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x54, 0x68, 0x69, 0x73, 0x59,
+	0x65, 0x61, 0x72, 0x22, 0x95, 0x02, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x75,
+	0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x08,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12,
+	0x31, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72,
+	0x6c, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a,
+	0x0f, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x65, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x38, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x72, 0x74, 0x67,
+	0x61, 0x67, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x22, 0xfb, 0x02, 0x0a, 0x0d, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x2e, 0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x43, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42,
+	0x69, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x75,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2a, 0x9a, 0x01, 0x0a, 0x0e, 0x4d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x49, 0x44, 0x10,
+	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x4c, 0x49, 0x4e, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x05,
+	0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x07,
+	0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x45, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x08,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x55, 0x0a, 0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f,
+	0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x51, 0x55, 0x41, 0x52, 0x54,
+	0x45, 0x52, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x4d, 0x49, 0x5f, 0x41,
+	0x4e, 0x4e, 0x55, 0x41, 0x4c, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4e, 0x4e,
+	0x55, 0x41, 0x4c, 0x4c, 0x59, 0x10, 0x03, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x38, 0x0a,
+	0x10, 0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x4e, 0x55, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10,
+	0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xa4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x42, 0x0d, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x1c, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xa2,
+	0x02, 0x03, 0x41, 0x4d, 0x58, 0xaa, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xca, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70,
+	0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xe2, 0x02, 0x1c, 0x41, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x72, 0x64,
+	0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1195,19 +9248,51 @@ func file_ardapoc_mortgage_mortgage_proto_rawDescGZIP() []byte {
 	return file_ardapoc_mortgage_mortgage_proto_rawDescData
 }
 
-var file_ardapoc_mortgage_mortgage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ardapoc_mortgage_mortgage_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ardapoc_mortgage_mortgage_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ardapoc_mortgage_mortgage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_ardapoc_mortgage_mortgage_proto_goTypes = []interface{}{
-	(MortgageStatus)(0), // 0: ardapoc.mortgage.MortgageStatus
-	(*Mortgage)(nil),    // 1: ardapoc.mortgage.Mortgage
+	(MortgageStatus)(0),           // 0: ardapoc.mortgage.MortgageStatus
+	(PaymentFrequency)(0),         // 1: ardapoc.mortgage.PaymentFrequency
+	(AmortizationType)(0),         // 2: ardapoc.mortgage.AmortizationType
+	(*Mortgage)(nil),              // 3: ardapoc.mortgage.Mortgage
+	(*AutoRepayment)(nil),         // 4: ardapoc.mortgage.AutoRepayment
+	(*PrepaymentTerms)(nil),       // 5: ardapoc.mortgage.PrepaymentTerms
+	(*SyndicateLender)(nil),       // 6: ardapoc.mortgage.SyndicateLender
+	(*NoteAssignment)(nil),        // 7: ardapoc.mortgage.NoteAssignment
+	(*MortgagePurchase)(nil),      // 8: ardapoc.mortgage.MortgagePurchase
+	(*MortgageTerms)(nil),         // 9: ardapoc.mortgage.MortgageTerms
+	(*ForeclosureAuction)(nil),    // 10: ardapoc.mortgage.ForeclosureAuction
+	(*Installment)(nil),           // 11: ardapoc.mortgage.Installment
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_ardapoc_mortgage_mortgage_proto_depIdxs = []int32{
-	0, // 0: ardapoc.mortgage.Mortgage.status:type_name -> ardapoc.mortgage.MortgageStatus
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: ardapoc.mortgage.Mortgage.status:type_name -> ardapoc.mortgage.MortgageStatus
+	1,  // 1: ardapoc.mortgage.Mortgage.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	2,  // 2: ardapoc.mortgage.Mortgage.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	12, // 3: ardapoc.mortgage.Mortgage.start_date:type_name -> google.protobuf.Timestamp
+	12, // 4: ardapoc.mortgage.Mortgage.next_due_date:type_name -> google.protobuf.Timestamp
+	12, // 5: ardapoc.mortgage.Mortgage.review_time:type_name -> google.protobuf.Timestamp
+	10, // 6: ardapoc.mortgage.Mortgage.auction:type_name -> ardapoc.mortgage.ForeclosureAuction
+	9,  // 7: ardapoc.mortgage.Mortgage.terms_history:type_name -> ardapoc.mortgage.MortgageTerms
+	9,  // 8: ardapoc.mortgage.Mortgage.refinance_proposal:type_name -> ardapoc.mortgage.MortgageTerms
+	8,  // 9: ardapoc.mortgage.Mortgage.purchase:type_name -> ardapoc.mortgage.MortgagePurchase
+	7,  // 10: ardapoc.mortgage.Mortgage.note_assignments:type_name -> ardapoc.mortgage.NoteAssignment
+	6,  // 11: ardapoc.mortgage.Mortgage.syndicate:type_name -> ardapoc.mortgage.SyndicateLender
+	4,  // 12: ardapoc.mortgage.Mortgage.auto_repayment:type_name -> ardapoc.mortgage.AutoRepayment
+	5,  // 13: ardapoc.mortgage.Mortgage.prepayment:type_name -> ardapoc.mortgage.PrepaymentTerms
+	12, // 14: ardapoc.mortgage.AutoRepayment.next_pull:type_name -> google.protobuf.Timestamp
+	12, // 15: ardapoc.mortgage.AutoRepayment.last_attempt:type_name -> google.protobuf.Timestamp
+	12, // 16: ardapoc.mortgage.NoteAssignment.time:type_name -> google.protobuf.Timestamp
+	1,  // 17: ardapoc.mortgage.MortgageTerms.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	2,  // 18: ardapoc.mortgage.MortgageTerms.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	12, // 19: ardapoc.mortgage.MortgageTerms.start_date:type_name -> google.protobuf.Timestamp
+	12, // 20: ardapoc.mortgage.ForeclosureAuction.end_time:type_name -> google.protobuf.Timestamp
+	12, // 21: ardapoc.mortgage.Installment.due_date:type_name -> google.protobuf.Timestamp
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_ardapoc_mortgage_mortgage_proto_init() }
@@ -1228,14 +9313,110 @@ func file_ardapoc_mortgage_mortgage_proto_init() {
 				return nil
 			}
 		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoRepayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepaymentTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyndicateLender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MortgagePurchase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MortgageTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForeclosureAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_mortgage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Installment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ardapoc_mortgage_mortgage_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
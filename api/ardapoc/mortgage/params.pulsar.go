@@ -4,23 +4,39 @@ package mortgage
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                      protoreflect.MessageDescriptor
+	fd_Params_grace_period         protoreflect.FieldDescriptor
+	fd_Params_late_fee_rate        protoreflect.FieldDescriptor
+	fd_Params_default_period       protoreflect.FieldDescriptor
+	fd_Params_auction_duration     protoreflect.FieldDescriptor
+	fd_Params_max_loan_to_value    protoreflect.FieldDescriptor
+	fd_Params_vault_interest_share protoreflect.FieldDescriptor
+	fd_Params_max_price_deviation  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_params_proto_init()
 	md_Params = File_ardapoc_mortgage_params_proto.Messages().ByName("Params")
+	fd_Params_grace_period = md_Params.Fields().ByName("grace_period")
+	fd_Params_late_fee_rate = md_Params.Fields().ByName("late_fee_rate")
+	fd_Params_default_period = md_Params.Fields().ByName("default_period")
+	fd_Params_auction_duration = md_Params.Fields().ByName("auction_duration")
+	fd_Params_max_loan_to_value = md_Params.Fields().ByName("max_loan_to_value")
+	fd_Params_vault_interest_share = md_Params.Fields().ByName("vault_interest_share")
+	fd_Params_max_price_deviation = md_Params.Fields().ByName("max_price_deviation")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +104,48 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GracePeriod != nil {
+		value := protoreflect.ValueOfMessage(x.GracePeriod.ProtoReflect())
+		if !f(fd_Params_grace_period, value) {
+			return
+		}
+	}
+	if x.LateFeeRate != "" {
+		value := protoreflect.ValueOfString(x.LateFeeRate)
+		if !f(fd_Params_late_fee_rate, value) {
+			return
+		}
+	}
+	if x.DefaultPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DefaultPeriod.ProtoReflect())
+		if !f(fd_Params_default_period, value) {
+			return
+		}
+	}
+	if x.AuctionDuration != nil {
+		value := protoreflect.ValueOfMessage(x.AuctionDuration.ProtoReflect())
+		if !f(fd_Params_auction_duration, value) {
+			return
+		}
+	}
+	if x.MaxLoanToValue != "" {
+		value := protoreflect.ValueOfString(x.MaxLoanToValue)
+		if !f(fd_Params_max_loan_to_value, value) {
+			return
+		}
+	}
+	if x.VaultInterestShare != "" {
+		value := protoreflect.ValueOfString(x.VaultInterestShare)
+		if !f(fd_Params_vault_interest_share, value) {
+			return
+		}
+	}
+	if x.MaxPriceDeviation != "" {
+		value := protoreflect.ValueOfString(x.MaxPriceDeviation)
+		if !f(fd_Params_max_price_deviation, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +161,20 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		return x.GracePeriod != nil
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		return x.LateFeeRate != ""
+	case "ardapoc.mortgage.Params.default_period":
+		return x.DefaultPeriod != nil
+	case "ardapoc.mortgage.Params.auction_duration":
+		return x.AuctionDuration != nil
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		return x.MaxLoanToValue != ""
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		return x.VaultInterestShare != ""
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		return x.MaxPriceDeviation != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -119,6 +191,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		x.GracePeriod = nil
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		x.LateFeeRate = ""
+	case "ardapoc.mortgage.Params.default_period":
+		x.DefaultPeriod = nil
+	case "ardapoc.mortgage.Params.auction_duration":
+		x.AuctionDuration = nil
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		x.MaxLoanToValue = ""
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		x.VaultInterestShare = ""
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		x.MaxPriceDeviation = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -135,6 +221,27 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		value := x.GracePeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		value := x.LateFeeRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.Params.default_period":
+		value := x.DefaultPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Params.auction_duration":
+		value := x.AuctionDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		value := x.MaxLoanToValue
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		value := x.VaultInterestShare
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		value := x.MaxPriceDeviation
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -155,6 +262,20 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		x.GracePeriod = value.Message().Interface().(*durationpb.Duration)
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		x.LateFeeRate = value.Interface().(string)
+	case "ardapoc.mortgage.Params.default_period":
+		x.DefaultPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "ardapoc.mortgage.Params.auction_duration":
+		x.AuctionDuration = value.Message().Interface().(*durationpb.Duration)
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		x.MaxLoanToValue = value.Interface().(string)
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		x.VaultInterestShare = value.Interface().(string)
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		x.MaxPriceDeviation = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -175,6 +296,29 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		if x.GracePeriod == nil {
+			x.GracePeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.GracePeriod.ProtoReflect())
+	case "ardapoc.mortgage.Params.default_period":
+		if x.DefaultPeriod == nil {
+			x.DefaultPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DefaultPeriod.ProtoReflect())
+	case "ardapoc.mortgage.Params.auction_duration":
+		if x.AuctionDuration == nil {
+			x.AuctionDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.AuctionDuration.ProtoReflect())
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		panic(fmt.Errorf("field late_fee_rate of message ardapoc.mortgage.Params is not mutable"))
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		panic(fmt.Errorf("field max_loan_to_value of message ardapoc.mortgage.Params is not mutable"))
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		panic(fmt.Errorf("field vault_interest_share of message ardapoc.mortgage.Params is not mutable"))
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		panic(fmt.Errorf("field max_price_deviation of message ardapoc.mortgage.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -188,6 +332,23 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.Params.grace_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Params.late_fee_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.Params.default_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Params.auction_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.Params.max_loan_to_value":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.Params.vault_interest_share":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.Params.max_price_deviation":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.Params"))
@@ -257,6 +418,34 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.GracePeriod != nil {
+			l = options.Size(x.GracePeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LateFeeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DefaultPeriod != nil {
+			l = options.Size(x.DefaultPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AuctionDuration != nil {
+			l = options.Size(x.AuctionDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxLoanToValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VaultInterestShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPriceDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +475,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxPriceDeviation) > 0 {
+			i -= len(x.MaxPriceDeviation)
+			copy(dAtA[i:], x.MaxPriceDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPriceDeviation)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.VaultInterestShare) > 0 {
+			i -= len(x.VaultInterestShare)
+			copy(dAtA[i:], x.VaultInterestShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VaultInterestShare)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MaxLoanToValue) > 0 {
+			i -= len(x.MaxLoanToValue)
+			copy(dAtA[i:], x.MaxLoanToValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxLoanToValue)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.AuctionDuration != nil {
+			encoded, err := options.Marshal(x.AuctionDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DefaultPeriod != nil {
+			encoded, err := options.Marshal(x.DefaultPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LateFeeRate) > 0 {
+			i -= len(x.LateFeeRate)
+			copy(dAtA[i:], x.LateFeeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LateFeeRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.GracePeriod != nil {
+			encoded, err := options.Marshal(x.GracePeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +594,242 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GracePeriod == nil {
+					x.GracePeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GracePeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LateFeeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LateFeeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DefaultPeriod == nil {
+					x.DefaultPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DefaultPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AuctionDuration == nil {
+					x.AuctionDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuctionDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLoanToValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxLoanToValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultInterestShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultInterestShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPriceDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +883,29 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// grace_period is how long an installment may stay unpaid after its due date
+	// before the mortgage becomes delinquent.
+	GracePeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// late_fee_rate is the fee charged on the overdue amount when a mortgage
+	// becomes delinquent, as a decimal fraction.
+	LateFeeRate string `protobuf:"bytes,2,opt,name=late_fee_rate,json=lateFeeRate,proto3" json:"late_fee_rate,omitempty"`
+	// default_period is how long an installment may stay unpaid after its due
+	// date before the mortgage defaults.
+	DefaultPeriod *durationpb.Duration `protobuf:"bytes,3,opt,name=default_period,json=defaultPeriod,proto3" json:"default_period,omitempty"`
+	// auction_duration is how long a foreclosure auction takes bids.
+	AuctionDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	// max_loan_to_value is the largest share of the value of a lendee's shares
+	// that equity loans and the liens already on the property may add up to, as
+	// a decimal fraction.
+	MaxLoanToValue string `protobuf:"bytes,5,opt,name=max_loan_to_value,json=maxLoanToValue,proto3" json:"max_loan_to_value,omitempty"`
+	// vault_interest_share is the share of the interest lenders receive that is
+	// paid into the usdarda savings vault, as a decimal fraction.
+	VaultInterestShare string `protobuf:"bytes,6,opt,name=vault_interest_share,json=vaultInterestShare,proto3" json:"vault_interest_share,omitempty"`
+	// max_price_deviation is how far the price of a purchase financed by a
+	// mortgage may be from the current value of the shares bought, as a decimal
+	// fraction of that value.
+	MaxPriceDeviation string `protobuf:"bytes,7,opt,name=max_price_deviation,json=maxPriceDeviation,proto3" json:"max_price_deviation,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +928,55 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_ardapoc_mortgage_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *Params) GetLateFeeRate() string {
+	if x != nil {
+		return x.LateFeeRate
+	}
+	return ""
+}
+
+func (x *Params) GetDefaultPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetAuctionDuration() *durationpb.Duration {
+	if x != nil {
+		return x.AuctionDuration
+	}
+	return nil
+}
+
+func (x *Params) GetMaxLoanToValue() string {
+	if x != nil {
+		return x.MaxLoanToValue
+	}
+	return ""
+}
+
+func (x *Params) GetVaultInterestShare() string {
+	if x != nil {
+		return x.VaultInterestShare
+	}
+	return ""
+}
+
+func (x *Params) GetMaxPriceDeviation() string {
+	if x != nil {
+		return x.MaxPriceDeviation
+	}
+	return ""
+}
+
 var File_ardapoc_mortgage_params_proto protoreflect.FileDescriptor
 
 var file_ardapoc_mortgage_params_proto_rawDesc = []byte{
@@ -417,9 +984,43 @@ var file_ardapoc_mortgage_params_proto_rawDesc = []byte{
 	0x67, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x10, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
 	0x65, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2c, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x61,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x4b, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a,
+	0x0d, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x53, 0x0a, 0x10, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x6f, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x40, 0x0a, 0x14, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x12, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x61,
 	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x78, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
 	0x65, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
 	0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
@@ -449,14 +1050,18 @@ func file_ardapoc_mortgage_params_proto_rawDescGZIP() []byte {
 
 var file_ardapoc_mortgage_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_ardapoc_mortgage_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: ardapoc.mortgage.Params
+	(*Params)(nil),              // 0: ardapoc.mortgage.Params
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
 }
 var file_ardapoc_mortgage_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: ardapoc.mortgage.Params.grace_period:type_name -> google.protobuf.Duration
+	1, // 1: ardapoc.mortgage.Params.default_period:type_name -> google.protobuf.Duration
+	1, // 2: ardapoc.mortgage.Params.auction_duration:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ardapoc_mortgage_params_proto_init() }
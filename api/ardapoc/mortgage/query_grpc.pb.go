@@ -19,9 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName      = "/ardapoc.mortgage.Query/Params"
-	Query_Mortgage_FullMethodName    = "/ardapoc.mortgage.Query/Mortgage"
-	Query_MortgageAll_FullMethodName = "/ardapoc.mortgage.Query/MortgageAll"
+	Query_Params_FullMethodName                   = "/ardapoc.mortgage.Query/Params"
+	Query_Mortgage_FullMethodName                 = "/ardapoc.mortgage.Query/Mortgage"
+	Query_MortgageAll_FullMethodName              = "/ardapoc.mortgage.Query/MortgageAll"
+	Query_DenomAlias_FullMethodName               = "/ardapoc.mortgage.Query/DenomAlias"
+	Query_MortgagesByLender_FullMethodName        = "/ardapoc.mortgage.Query/MortgagesByLender"
+	Query_MortgagesByLendee_FullMethodName        = "/ardapoc.mortgage.Query/MortgagesByLendee"
+	Query_MortgagesByCollateral_FullMethodName    = "/ardapoc.mortgage.Query/MortgagesByCollateral"
+	Query_MortgagesByStatus_FullMethodName        = "/ardapoc.mortgage.Query/MortgagesByStatus"
+	Query_OutstandingTotals_FullMethodName        = "/ardapoc.mortgage.Query/OutstandingTotals"
+	Query_MortgageRequestsByLender_FullMethodName = "/ardapoc.mortgage.Query/MortgageRequestsByLender"
+	Query_MortgageRequestsByLendee_FullMethodName = "/ardapoc.mortgage.Query/MortgageRequestsByLendee"
+	Query_MortgagesByHolder_FullMethodName        = "/ardapoc.mortgage.Query/MortgagesByHolder"
+	Query_MortgageSyndicate_FullMethodName        = "/ardapoc.mortgage.Query/MortgageSyndicate"
+	Query_AmortizationSchedule_FullMethodName     = "/ardapoc.mortgage.Query/AmortizationSchedule"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +44,32 @@ type QueryClient interface {
 	// Queries a list of Mortgage items.
 	Mortgage(ctx context.Context, in *QueryGetMortgageRequest, opts ...grpc.CallOption) (*QueryGetMortgageResponse, error)
 	MortgageAll(ctx context.Context, in *QueryAllMortgageRequest, opts ...grpc.CallOption) (*QueryAllMortgageResponse, error)
+	// DenomAlias resolves a legacy marker denom to the denom that replaced it.
+	DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error)
+	// MortgagesByLender lists the mortgages of a lender, including those it funds
+	// as a member of a syndicate.
+	MortgagesByLender(ctx context.Context, in *QueryMortgagesByLenderRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByLendee lists the mortgages of a lendee.
+	MortgagesByLendee(ctx context.Context, in *QueryMortgagesByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByCollateral lists the mortgages secured by a property.
+	MortgagesByCollateral(ctx context.Context, in *QueryMortgagesByCollateralRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByStatus lists the mortgages with a status.
+	MortgagesByStatus(ctx context.Context, in *QueryMortgagesByStatusRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// OutstandingTotals sums the principal still owed on funded mortgages per
+	// lender and per property.
+	OutstandingTotals(ctx context.Context, in *QueryOutstandingTotalsRequest, opts ...grpc.CallOption) (*QueryOutstandingTotalsResponse, error)
+	// MortgageRequestsByLender lists the pending mortgage requests made to a lender.
+	MortgageRequestsByLender(ctx context.Context, in *QueryMortgageRequestsByLenderRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error)
+	// MortgageRequestsByLendee lists the pending mortgage requests made by a lendee.
+	MortgageRequestsByLendee(ctx context.Context, in *QueryMortgageRequestsByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error)
+	// MortgagesByHolder lists the funded mortgages an address lends to, as holder
+	// of the loan note or as a member of the syndicate.
+	MortgagesByHolder(ctx context.Context, in *QueryMortgagesByHolderRequest, opts ...grpc.CallOption) (*QueryMortgagesByHolderResponse, error)
+	// MortgageSyndicate shows the contribution and outstanding share of each
+	// lender of a mortgage.
+	MortgageSyndicate(ctx context.Context, in *QueryMortgageSyndicateRequest, opts ...grpc.CallOption) (*QueryMortgageSyndicateResponse, error)
+	// AmortizationSchedule returns the installments of a mortgage.
+	AmortizationSchedule(ctx context.Context, in *QueryAmortizationScheduleRequest, opts ...grpc.CallOption) (*QueryAmortizationScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -70,6 +107,105 @@ func (c *queryClient) MortgageAll(ctx context.Context, in *QueryAllMortgageReque
 	return out, nil
 }
 
+func (c *queryClient) DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error) {
+	out := new(QueryDenomAliasResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByLender(ctx context.Context, in *QueryMortgagesByLenderRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, Query_MortgagesByLender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByLendee(ctx context.Context, in *QueryMortgagesByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, Query_MortgagesByLendee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByCollateral(ctx context.Context, in *QueryMortgagesByCollateralRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, Query_MortgagesByCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByStatus(ctx context.Context, in *QueryMortgagesByStatusRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, Query_MortgagesByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingTotals(ctx context.Context, in *QueryOutstandingTotalsRequest, opts ...grpc.CallOption) (*QueryOutstandingTotalsResponse, error) {
+	out := new(QueryOutstandingTotalsResponse)
+	err := c.cc.Invoke(ctx, Query_OutstandingTotals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageRequestsByLender(ctx context.Context, in *QueryMortgageRequestsByLenderRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error) {
+	out := new(QueryMortgageRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_MortgageRequestsByLender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageRequestsByLendee(ctx context.Context, in *QueryMortgageRequestsByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error) {
+	out := new(QueryMortgageRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_MortgageRequestsByLendee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByHolder(ctx context.Context, in *QueryMortgagesByHolderRequest, opts ...grpc.CallOption) (*QueryMortgagesByHolderResponse, error) {
+	out := new(QueryMortgagesByHolderResponse)
+	err := c.cc.Invoke(ctx, Query_MortgagesByHolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageSyndicate(ctx context.Context, in *QueryMortgageSyndicateRequest, opts ...grpc.CallOption) (*QueryMortgageSyndicateResponse, error) {
+	out := new(QueryMortgageSyndicateResponse)
+	err := c.cc.Invoke(ctx, Query_MortgageSyndicate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmortizationSchedule(ctx context.Context, in *QueryAmortizationScheduleRequest, opts ...grpc.CallOption) (*QueryAmortizationScheduleResponse, error) {
+	out := new(QueryAmortizationScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_AmortizationSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -79,6 +215,32 @@ type QueryServer interface {
 	// Queries a list of Mortgage items.
 	Mortgage(context.Context, *QueryGetMortgageRequest) (*QueryGetMortgageResponse, error)
 	MortgageAll(context.Context, *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error)
+	// DenomAlias resolves a legacy marker denom to the denom that replaced it.
+	DenomAlias(context.Context, *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error)
+	// MortgagesByLender lists the mortgages of a lender, including those it funds
+	// as a member of a syndicate.
+	MortgagesByLender(context.Context, *QueryMortgagesByLenderRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByLendee lists the mortgages of a lendee.
+	MortgagesByLendee(context.Context, *QueryMortgagesByLendeeRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByCollateral lists the mortgages secured by a property.
+	MortgagesByCollateral(context.Context, *QueryMortgagesByCollateralRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByStatus lists the mortgages with a status.
+	MortgagesByStatus(context.Context, *QueryMortgagesByStatusRequest) (*QueryMortgagesResponse, error)
+	// OutstandingTotals sums the principal still owed on funded mortgages per
+	// lender and per property.
+	OutstandingTotals(context.Context, *QueryOutstandingTotalsRequest) (*QueryOutstandingTotalsResponse, error)
+	// MortgageRequestsByLender lists the pending mortgage requests made to a lender.
+	MortgageRequestsByLender(context.Context, *QueryMortgageRequestsByLenderRequest) (*QueryMortgageRequestsResponse, error)
+	// MortgageRequestsByLendee lists the pending mortgage requests made by a lendee.
+	MortgageRequestsByLendee(context.Context, *QueryMortgageRequestsByLendeeRequest) (*QueryMortgageRequestsResponse, error)
+	// MortgagesByHolder lists the funded mortgages an address lends to, as holder
+	// of the loan note or as a member of the syndicate.
+	MortgagesByHolder(context.Context, *QueryMortgagesByHolderRequest) (*QueryMortgagesByHolderResponse, error)
+	// MortgageSyndicate shows the contribution and outstanding share of each
+	// lender of a mortgage.
+	MortgageSyndicate(context.Context, *QueryMortgageSyndicateRequest) (*QueryMortgageSyndicateResponse, error)
+	// AmortizationSchedule returns the installments of a mortgage.
+	AmortizationSchedule(context.Context, *QueryAmortizationScheduleRequest) (*QueryAmortizationScheduleResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -95,6 +257,39 @@ func (UnimplementedQueryServer) Mortgage(context.Context, *QueryGetMortgageReque
 func (UnimplementedQueryServer) MortgageAll(context.Context, *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MortgageAll not implemented")
 }
+func (UnimplementedQueryServer) DenomAlias(context.Context, *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAlias not implemented")
+}
+func (UnimplementedQueryServer) MortgagesByLender(context.Context, *QueryMortgagesByLenderRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByLender not implemented")
+}
+func (UnimplementedQueryServer) MortgagesByLendee(context.Context, *QueryMortgagesByLendeeRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByLendee not implemented")
+}
+func (UnimplementedQueryServer) MortgagesByCollateral(context.Context, *QueryMortgagesByCollateralRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByCollateral not implemented")
+}
+func (UnimplementedQueryServer) MortgagesByStatus(context.Context, *QueryMortgagesByStatusRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByStatus not implemented")
+}
+func (UnimplementedQueryServer) OutstandingTotals(context.Context, *QueryOutstandingTotalsRequest) (*QueryOutstandingTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingTotals not implemented")
+}
+func (UnimplementedQueryServer) MortgageRequestsByLender(context.Context, *QueryMortgageRequestsByLenderRequest) (*QueryMortgageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageRequestsByLender not implemented")
+}
+func (UnimplementedQueryServer) MortgageRequestsByLendee(context.Context, *QueryMortgageRequestsByLendeeRequest) (*QueryMortgageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageRequestsByLendee not implemented")
+}
+func (UnimplementedQueryServer) MortgagesByHolder(context.Context, *QueryMortgagesByHolderRequest) (*QueryMortgagesByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByHolder not implemented")
+}
+func (UnimplementedQueryServer) MortgageSyndicate(context.Context, *QueryMortgageSyndicateRequest) (*QueryMortgageSyndicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageSyndicate not implemented")
+}
+func (UnimplementedQueryServer) AmortizationSchedule(context.Context, *QueryAmortizationScheduleRequest) (*QueryAmortizationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmortizationSchedule not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +357,204 @@ func _Query_MortgageAll_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAlias(ctx, req.(*QueryDenomAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByLenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgagesByLender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByLender(ctx, req.(*QueryMortgagesByLenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByLendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByLendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByLendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgagesByLendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByLendee(ctx, req.(*QueryMortgagesByLendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgagesByCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByCollateral(ctx, req.(*QueryMortgagesByCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgagesByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByStatus(ctx, req.(*QueryMortgagesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OutstandingTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingTotals(ctx, req.(*QueryOutstandingTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageRequestsByLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageRequestsByLenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageRequestsByLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgageRequestsByLender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageRequestsByLender(ctx, req.(*QueryMortgageRequestsByLenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageRequestsByLendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageRequestsByLendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageRequestsByLendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgageRequestsByLendee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageRequestsByLendee(ctx, req.(*QueryMortgageRequestsByLendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgagesByHolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByHolder(ctx, req.(*QueryMortgagesByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageSyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MortgageSyndicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageSyndicate(ctx, req.(*QueryMortgageSyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmortizationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmortizationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmortizationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AmortizationSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmortizationSchedule(ctx, req.(*QueryAmortizationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +574,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MortgageAll",
 			Handler:    _Query_MortgageAll_Handler,
 		},
+		{
+			MethodName: "DenomAlias",
+			Handler:    _Query_DenomAlias_Handler,
+		},
+		{
+			MethodName: "MortgagesByLender",
+			Handler:    _Query_MortgagesByLender_Handler,
+		},
+		{
+			MethodName: "MortgagesByLendee",
+			Handler:    _Query_MortgagesByLendee_Handler,
+		},
+		{
+			MethodName: "MortgagesByCollateral",
+			Handler:    _Query_MortgagesByCollateral_Handler,
+		},
+		{
+			MethodName: "MortgagesByStatus",
+			Handler:    _Query_MortgagesByStatus_Handler,
+		},
+		{
+			MethodName: "OutstandingTotals",
+			Handler:    _Query_OutstandingTotals_Handler,
+		},
+		{
+			MethodName: "MortgageRequestsByLender",
+			Handler:    _Query_MortgageRequestsByLender_Handler,
+		},
+		{
+			MethodName: "MortgageRequestsByLendee",
+			Handler:    _Query_MortgageRequestsByLendee_Handler,
+		},
+		{
+			MethodName: "MortgagesByHolder",
+			Handler:    _Query_MortgagesByHolder_Handler,
+		},
+		{
+			MethodName: "MortgageSyndicate",
+			Handler:    _Query_MortgageSyndicate_Handler,
+		},
+		{
+			MethodName: "AmortizationSchedule",
+			Handler:    _Query_AmortizationSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/mortgage/query.proto",
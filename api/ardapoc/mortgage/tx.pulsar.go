@@ -872,39 +872,27 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgCreateMortgage              protoreflect.MessageDescriptor
-	fd_MsgCreateMortgage_creator      protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_index        protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_lender       protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_lendee       protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_collateral   protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_amount       protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_interestRate protoreflect.FieldDescriptor
-	fd_MsgCreateMortgage_term         protoreflect.FieldDescriptor
+	md_MsgDeleteMortgage         protoreflect.MessageDescriptor
+	fd_MsgDeleteMortgage_creator protoreflect.FieldDescriptor
+	fd_MsgDeleteMortgage_index   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgCreateMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgCreateMortgage")
-	fd_MsgCreateMortgage_creator = md_MsgCreateMortgage.Fields().ByName("creator")
-	fd_MsgCreateMortgage_index = md_MsgCreateMortgage.Fields().ByName("index")
-	fd_MsgCreateMortgage_lender = md_MsgCreateMortgage.Fields().ByName("lender")
-	fd_MsgCreateMortgage_lendee = md_MsgCreateMortgage.Fields().ByName("lendee")
-	fd_MsgCreateMortgage_collateral = md_MsgCreateMortgage.Fields().ByName("collateral")
-	fd_MsgCreateMortgage_amount = md_MsgCreateMortgage.Fields().ByName("amount")
-	fd_MsgCreateMortgage_interestRate = md_MsgCreateMortgage.Fields().ByName("interestRate")
-	fd_MsgCreateMortgage_term = md_MsgCreateMortgage.Fields().ByName("term")
+	md_MsgDeleteMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDeleteMortgage")
+	fd_MsgDeleteMortgage_creator = md_MsgDeleteMortgage.Fields().ByName("creator")
+	fd_MsgDeleteMortgage_index = md_MsgDeleteMortgage.Fields().ByName("index")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateMortgage)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgDeleteMortgage)(nil)
 
-type fastReflection_MsgCreateMortgage MsgCreateMortgage
+type fastReflection_MsgDeleteMortgage MsgDeleteMortgage
 
-func (x *MsgCreateMortgage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateMortgage)(x)
+func (x *MsgDeleteMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteMortgage)(x)
 }
 
-func (x *MsgCreateMortgage) slowProtoReflect() protoreflect.Message {
+func (x *MsgDeleteMortgage) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -916,43 +904,43 @@ func (x *MsgCreateMortgage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateMortgage_messageType fastReflection_MsgCreateMortgage_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateMortgage_messageType{}
+var _fastReflection_MsgDeleteMortgage_messageType fastReflection_MsgDeleteMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteMortgage_messageType{}
 
-type fastReflection_MsgCreateMortgage_messageType struct{}
+type fastReflection_MsgDeleteMortgage_messageType struct{}
 
-func (x fastReflection_MsgCreateMortgage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateMortgage)(nil)
+func (x fastReflection_MsgDeleteMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteMortgage)(nil)
 }
-func (x fastReflection_MsgCreateMortgage_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateMortgage)
+func (x fastReflection_MsgDeleteMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteMortgage)
 }
-func (x fastReflection_MsgCreateMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateMortgage
+func (x fastReflection_MsgDeleteMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteMortgage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateMortgage) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateMortgage
+func (x *fastReflection_MsgDeleteMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteMortgage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateMortgage) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateMortgage_messageType
+func (x *fastReflection_MsgDeleteMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteMortgage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateMortgage) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateMortgage)
+func (x *fastReflection_MsgDeleteMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteMortgage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateMortgage) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateMortgage)(x)
+func (x *fastReflection_MsgDeleteMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteMortgage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -960,52 +948,16 @@ func (x *fastReflection_MsgCreateMortgage) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgDeleteMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgCreateMortgage_creator, value) {
+		if !f(fd_MsgDeleteMortgage_creator, value) {
 			return
 		}
 	}
 	if x.Index != "" {
 		value := protoreflect.ValueOfString(x.Index)
-		if !f(fd_MsgCreateMortgage_index, value) {
-			return
-		}
-	}
-	if x.Lender != "" {
-		value := protoreflect.ValueOfString(x.Lender)
-		if !f(fd_MsgCreateMortgage_lender, value) {
-			return
-		}
-	}
-	if x.Lendee != "" {
-		value := protoreflect.ValueOfString(x.Lendee)
-		if !f(fd_MsgCreateMortgage_lendee, value) {
-			return
-		}
-	}
-	if x.Collateral != "" {
-		value := protoreflect.ValueOfString(x.Collateral)
-		if !f(fd_MsgCreateMortgage_collateral, value) {
-			return
-		}
-	}
-	if x.Amount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Amount)
-		if !f(fd_MsgCreateMortgage_amount, value) {
-			return
-		}
-	}
-	if x.InterestRate != "" {
-		value := protoreflect.ValueOfString(x.InterestRate)
-		if !f(fd_MsgCreateMortgage_interestRate, value) {
-			return
-		}
-	}
-	if x.Term != "" {
-		value := protoreflect.ValueOfString(x.Term)
-		if !f(fd_MsgCreateMortgage_term, value) {
+		if !f(fd_MsgDeleteMortgage_index, value) {
 			return
 		}
 	}
@@ -1022,29 +974,17 @@ func (x *fastReflection_MsgCreateMortgage) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgDeleteMortgage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
 		return x.Creator != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
 		return x.Index != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		return x.Lender != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		return x.Lendee != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		return x.Collateral != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		return x.Amount != uint64(0)
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
-		return x.InterestRate != ""
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
-		return x.Term != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1054,29 +994,17 @@ func (x *fastReflection_MsgCreateMortgage) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgDeleteMortgage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
 		x.Creator = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
 		x.Index = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		x.Lender = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		x.Lendee = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		x.Collateral = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		x.Amount = uint64(0)
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
-		x.InterestRate = ""
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
-		x.Term = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1086,37 +1014,19 @@ func (x *fastReflection_MsgCreateMortgage) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
 		value := x.Index
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		value := x.Lender
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		value := x.Lendee
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		value := x.Collateral
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		value := x.Amount
-		return protoreflect.ValueOfUint64(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
-		value := x.InterestRate
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
-		value := x.Term
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1130,29 +1040,17 @@ func (x *fastReflection_MsgCreateMortgage) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgDeleteMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
 		x.Creator = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
 		x.Index = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		x.Lender = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		x.Lendee = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		x.Collateral = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		x.Amount = value.Uint()
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
-		x.InterestRate = value.Interface().(string)
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
-		x.Term = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1166,68 +1064,44 @@ func (x *fastReflection_MsgCreateMortgage) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
-		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
-		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		panic(fmt.Errorf("field collateral of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
-		panic(fmt.Errorf("field interestRate of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
-		panic(fmt.Errorf("field term of message ardapoc.mortgage.MsgCreateMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
+		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgDeleteMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgDeleteMortgage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgCreateMortgage.creator":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.index":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.lender":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.lendee":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.collateral":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.amount":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "ardapoc.mortgage.MsgCreateMortgage.interestRate":
+	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgCreateMortgage.term":
+	case "ardapoc.mortgage.MsgDeleteMortgage.index":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgDeleteMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgCreateMortgage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDeleteMortgage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1235,7 +1109,7 @@ func (x *fastReflection_MsgCreateMortgage) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateMortgage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgDeleteMortgage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1246,7 +1120,7 @@ func (x *fastReflection_MsgCreateMortgage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgDeleteMortgage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1258,7 +1132,7 @@ func (x *fastReflection_MsgCreateMortgage) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateMortgage) IsValid() bool {
+func (x *fastReflection_MsgDeleteMortgage) IsValid() bool {
 	return x != nil
 }
 
@@ -1268,9 +1142,9 @@ func (x *fastReflection_MsgCreateMortgage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateMortgage)
+		x := input.Message.Interface().(*MsgDeleteMortgage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1290,29 +1164,6 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Lender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Lendee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Collateral)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Amount != 0 {
-			n += 1 + runtime.Sov(uint64(x.Amount))
-		}
-		l = len(x.InterestRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Term)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1323,7 +1174,7 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateMortgage)
+		x := input.Message.Interface().(*MsgDeleteMortgage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1342,46 +1193,6 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Term) > 0 {
-			i -= len(x.Term)
-			copy(dAtA[i:], x.Term)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.InterestRate) > 0 {
-			i -= len(x.InterestRate)
-			copy(dAtA[i:], x.InterestRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.Amount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.Collateral) > 0 {
-			i -= len(x.Collateral)
-			copy(dAtA[i:], x.Collateral)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Lendee) > 0 {
-			i -= len(x.Lendee)
-			copy(dAtA[i:], x.Lendee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Lender) > 0 {
-			i -= len(x.Lender)
-			copy(dAtA[i:], x.Lender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
-			i--
-			dAtA[i] = 0x1a
-		}
 		if len(x.Index) > 0 {
 			i -= len(x.Index)
 			copy(dAtA[i:], x.Index)
@@ -1407,7 +1218,7 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateMortgage)
+		x := input.Message.Interface().(*MsgDeleteMortgage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1439,10 +1250,10 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateMortgage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1509,199 +1320,20 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 				}
 				x.Index = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Lender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Lendee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Collateral = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				x.Amount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Amount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.InterestRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Term = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -1724,23 +1356,23 @@ func (x *fastReflection_MsgCreateMortgage) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgCreateMortgageResponse protoreflect.MessageDescriptor
+	md_MsgDeleteMortgageResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgCreateMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgCreateMortgageResponse")
+	md_MsgDeleteMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDeleteMortgageResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateMortgageResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgDeleteMortgageResponse)(nil)
 
-type fastReflection_MsgCreateMortgageResponse MsgCreateMortgageResponse
+type fastReflection_MsgDeleteMortgageResponse MsgDeleteMortgageResponse
 
-func (x *MsgCreateMortgageResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateMortgageResponse)(x)
+func (x *MsgDeleteMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteMortgageResponse)(x)
 }
 
-func (x *MsgCreateMortgageResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgDeleteMortgageResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,43 +1384,43 @@ func (x *MsgCreateMortgageResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateMortgageResponse_messageType fastReflection_MsgCreateMortgageResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateMortgageResponse_messageType{}
+var _fastReflection_MsgDeleteMortgageResponse_messageType fastReflection_MsgDeleteMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteMortgageResponse_messageType{}
 
-type fastReflection_MsgCreateMortgageResponse_messageType struct{}
+type fastReflection_MsgDeleteMortgageResponse_messageType struct{}
 
-func (x fastReflection_MsgCreateMortgageResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateMortgageResponse)(nil)
+func (x fastReflection_MsgDeleteMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteMortgageResponse)(nil)
 }
-func (x fastReflection_MsgCreateMortgageResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateMortgageResponse)
+func (x fastReflection_MsgDeleteMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteMortgageResponse)
 }
-func (x fastReflection_MsgCreateMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateMortgageResponse
+func (x fastReflection_MsgDeleteMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteMortgageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateMortgageResponse
+func (x *fastReflection_MsgDeleteMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteMortgageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateMortgageResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateMortgageResponse_messageType
+func (x *fastReflection_MsgDeleteMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteMortgageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateMortgageResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateMortgageResponse)
+func (x *fastReflection_MsgDeleteMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteMortgageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateMortgageResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateMortgageResponse)(x)
+func (x *fastReflection_MsgDeleteMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteMortgageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1796,7 +1428,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgDeleteMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1810,13 +1442,13 @@ func (x *fastReflection_MsgCreateMortgageResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgDeleteMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1826,13 +1458,13 @@ func (x *fastReflection_MsgCreateMortgageResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgDeleteMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1842,13 +1474,13 @@ func (x *fastReflection_MsgCreateMortgageResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1862,13 +1494,13 @@ func (x *fastReflection_MsgCreateMortgageResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgDeleteMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1882,36 +1514,36 @@ func (x *fastReflection_MsgCreateMortgageResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDeleteMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCreateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgCreateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgDeleteMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgCreateMortgageResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDeleteMortgageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1919,7 +1551,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateMortgageResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgDeleteMortgageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1930,7 +1562,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgDeleteMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1942,7 +1574,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateMortgageResponse) IsValid() bool {
+func (x *fastReflection_MsgDeleteMortgageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1952,9 +1584,9 @@ func (x *fastReflection_MsgCreateMortgageResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateMortgageResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateMortgageResponse)
+		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1976,7 +1608,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateMortgageResponse)
+		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2006,7 +1638,7 @@ func (x *fastReflection_MsgCreateMortgageResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateMortgageResponse)
+		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2038,10 +1670,10 @@ func (x *fastReflection_MsgCreateMortgageResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateMortgageResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2080,39 +1712,29 @@ func (x *fastReflection_MsgCreateMortgageResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgUpdateMortgage              protoreflect.MessageDescriptor
-	fd_MsgUpdateMortgage_creator      protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_index        protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_lender       protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_lendee       protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_collateral   protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_amount       protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_interestRate protoreflect.FieldDescriptor
-	fd_MsgUpdateMortgage_term         protoreflect.FieldDescriptor
+	md_MsgRepayMortgage            protoreflect.MessageDescriptor
+	fd_MsgRepayMortgage_creator    protoreflect.FieldDescriptor
+	fd_MsgRepayMortgage_mortgageId protoreflect.FieldDescriptor
+	fd_MsgRepayMortgage_amount     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgUpdateMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgUpdateMortgage")
-	fd_MsgUpdateMortgage_creator = md_MsgUpdateMortgage.Fields().ByName("creator")
-	fd_MsgUpdateMortgage_index = md_MsgUpdateMortgage.Fields().ByName("index")
-	fd_MsgUpdateMortgage_lender = md_MsgUpdateMortgage.Fields().ByName("lender")
-	fd_MsgUpdateMortgage_lendee = md_MsgUpdateMortgage.Fields().ByName("lendee")
-	fd_MsgUpdateMortgage_collateral = md_MsgUpdateMortgage.Fields().ByName("collateral")
-	fd_MsgUpdateMortgage_amount = md_MsgUpdateMortgage.Fields().ByName("amount")
-	fd_MsgUpdateMortgage_interestRate = md_MsgUpdateMortgage.Fields().ByName("interestRate")
-	fd_MsgUpdateMortgage_term = md_MsgUpdateMortgage.Fields().ByName("term")
+	md_MsgRepayMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRepayMortgage")
+	fd_MsgRepayMortgage_creator = md_MsgRepayMortgage.Fields().ByName("creator")
+	fd_MsgRepayMortgage_mortgageId = md_MsgRepayMortgage.Fields().ByName("mortgageId")
+	fd_MsgRepayMortgage_amount = md_MsgRepayMortgage.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateMortgage)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRepayMortgage)(nil)
 
-type fastReflection_MsgUpdateMortgage MsgUpdateMortgage
+type fastReflection_MsgRepayMortgage MsgRepayMortgage
 
-func (x *MsgUpdateMortgage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMortgage)(x)
+func (x *MsgRepayMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayMortgage)(x)
 }
 
-func (x *MsgUpdateMortgage) slowProtoReflect() protoreflect.Message {
+func (x *MsgRepayMortgage) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2124,43 +1746,43 @@ func (x *MsgUpdateMortgage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateMortgage_messageType fastReflection_MsgUpdateMortgage_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateMortgage_messageType{}
+var _fastReflection_MsgRepayMortgage_messageType fastReflection_MsgRepayMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayMortgage_messageType{}
 
-type fastReflection_MsgUpdateMortgage_messageType struct{}
+type fastReflection_MsgRepayMortgage_messageType struct{}
 
-func (x fastReflection_MsgUpdateMortgage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMortgage)(nil)
+func (x fastReflection_MsgRepayMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayMortgage)(nil)
 }
-func (x fastReflection_MsgUpdateMortgage_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMortgage)
+func (x fastReflection_MsgRepayMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayMortgage)
 }
-func (x fastReflection_MsgUpdateMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMortgage
+func (x fastReflection_MsgRepayMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayMortgage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateMortgage) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMortgage
+func (x *fastReflection_MsgRepayMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayMortgage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateMortgage) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateMortgage_messageType
+func (x *fastReflection_MsgRepayMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayMortgage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateMortgage) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMortgage)
+func (x *fastReflection_MsgRepayMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayMortgage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateMortgage) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateMortgage)(x)
+func (x *fastReflection_MsgRepayMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayMortgage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2168,52 +1790,22 @@ func (x *fastReflection_MsgUpdateMortgage) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRepayMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgUpdateMortgage_creator, value) {
-			return
-		}
-	}
-	if x.Index != "" {
-		value := protoreflect.ValueOfString(x.Index)
-		if !f(fd_MsgUpdateMortgage_index, value) {
-			return
-		}
-	}
-	if x.Lender != "" {
-		value := protoreflect.ValueOfString(x.Lender)
-		if !f(fd_MsgUpdateMortgage_lender, value) {
-			return
-		}
-	}
-	if x.Lendee != "" {
-		value := protoreflect.ValueOfString(x.Lendee)
-		if !f(fd_MsgUpdateMortgage_lendee, value) {
+		if !f(fd_MsgRepayMortgage_creator, value) {
 			return
 		}
 	}
-	if x.Collateral != "" {
-		value := protoreflect.ValueOfString(x.Collateral)
-		if !f(fd_MsgUpdateMortgage_collateral, value) {
+	if x.MortgageId != "" {
+		value := protoreflect.ValueOfString(x.MortgageId)
+		if !f(fd_MsgRepayMortgage_mortgageId, value) {
 			return
 		}
 	}
 	if x.Amount != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Amount)
-		if !f(fd_MsgUpdateMortgage_amount, value) {
-			return
-		}
-	}
-	if x.InterestRate != "" {
-		value := protoreflect.ValueOfString(x.InterestRate)
-		if !f(fd_MsgUpdateMortgage_interestRate, value) {
-			return
-		}
-	}
-	if x.Term != "" {
-		value := protoreflect.ValueOfString(x.Term)
-		if !f(fd_MsgUpdateMortgage_term, value) {
+		if !f(fd_MsgRepayMortgage_amount, value) {
 			return
 		}
 	}
@@ -2230,29 +1822,19 @@ func (x *fastReflection_MsgUpdateMortgage) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRepayMortgage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
 		return x.Creator != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		return x.Index != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		return x.Lender != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
-		return x.Lendee != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
-		return x.Collateral != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+		return x.MortgageId != ""
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
 		return x.Amount != uint64(0)
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		return x.InterestRate != ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		return x.Term != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2262,29 +1844,19 @@ func (x *fastReflection_MsgUpdateMortgage) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRepayMortgage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
 		x.Creator = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		x.Index = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		x.Lender = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
-		x.Lendee = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
-		x.Collateral = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+		x.MortgageId = ""
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
 		x.Amount = uint64(0)
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		x.InterestRate = ""
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		x.Term = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2294,37 +1866,22 @@ func (x *fastReflection_MsgUpdateMortgage) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		value := x.Index
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		value := x.Lender
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
-		value := x.Lendee
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
-		value := x.Collateral
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+		value := x.MortgageId
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
 		value := x.Amount
 		return protoreflect.ValueOfUint64(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		value := x.InterestRate
-		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		value := x.Term
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2338,29 +1895,19 @@ func (x *fastReflection_MsgUpdateMortgage) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRepayMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
 		x.Creator = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		x.Index = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		x.Lender = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
-		x.Lendee = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
-		x.Collateral = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+		x.MortgageId = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
 		x.Amount = value.Uint()
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		x.InterestRate = value.Interface().(string)
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		x.Term = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2374,68 +1921,48 @@ func (x *fastReflection_MsgUpdateMortgage) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
-		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
-		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
-		panic(fmt.Errorf("field collateral of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
-		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		panic(fmt.Errorf("field interestRate of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		panic(fmt.Errorf("field term of message ardapoc.mortgage.MsgUpdateMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
+		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+		panic(fmt.Errorf("field mortgageId of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
+		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgUpdateMortgage.creator":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.index":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.lender":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.lendee":
+	case "ardapoc.mortgage.MsgRepayMortgage.creator":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.collateral":
+	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.amount":
+	case "ardapoc.mortgage.MsgRepayMortgage.amount":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "ardapoc.mortgage.MsgUpdateMortgage.interestRate":
-		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgUpdateMortgage.term":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRepayMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgUpdateMortgage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRepayMortgage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2443,7 +1970,7 @@ func (x *fastReflection_MsgUpdateMortgage) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateMortgage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRepayMortgage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2454,7 +1981,7 @@ func (x *fastReflection_MsgUpdateMortgage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRepayMortgage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2466,7 +1993,7 @@ func (x *fastReflection_MsgUpdateMortgage) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateMortgage) IsValid() bool {
+func (x *fastReflection_MsgRepayMortgage) IsValid() bool {
 	return x != nil
 }
 
@@ -2476,9 +2003,9 @@ func (x *fastReflection_MsgUpdateMortgage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateMortgage)
+		x := input.Message.Interface().(*MsgRepayMortgage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2494,33 +2021,13 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Index)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Lender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Lendee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Collateral)
+		l = len(x.MortgageId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Amount != 0 {
 			n += 1 + runtime.Sov(uint64(x.Amount))
 		}
-		l = len(x.InterestRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Term)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2531,7 +2038,7 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMortgage)
+		x := input.Message.Interface().(*MsgRepayMortgage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2550,50 +2057,15 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Term) > 0 {
-			i -= len(x.Term)
-			copy(dAtA[i:], x.Term)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Term)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.InterestRate) > 0 {
-			i -= len(x.InterestRate)
-			copy(dAtA[i:], x.InterestRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
-			i--
-			dAtA[i] = 0x3a
-		}
 		if x.Amount != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
 			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.Collateral) > 0 {
-			i -= len(x.Collateral)
-			copy(dAtA[i:], x.Collateral)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Lendee) > 0 {
-			i -= len(x.Lendee)
-			copy(dAtA[i:], x.Lendee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Lender) > 0 {
-			i -= len(x.Lender)
-			copy(dAtA[i:], x.Lender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
-			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Index) > 0 {
-			i -= len(x.Index)
-			copy(dAtA[i:], x.Index)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+		if len(x.MortgageId) > 0 {
+			i -= len(x.MortgageId)
+			copy(dAtA[i:], x.MortgageId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MortgageId)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -2615,7 +2087,7 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMortgage)
+		x := input.Message.Interface().(*MsgRepayMortgage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2647,10 +2119,10 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMortgage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2687,7 +2159,7 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MortgageId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2715,105 +2187,9 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Index = string(dAtA[iNdEx:postIndex])
+				x.MortgageId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Lender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Lendee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Collateral = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
@@ -2832,77 +2208,13 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.InterestRate = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Term = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -2932,23 +2244,31 @@ func (x *fastReflection_MsgUpdateMortgage) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateMortgageResponse protoreflect.MessageDescriptor
+	md_MsgRepayMortgageResponse           protoreflect.MessageDescriptor
+	fd_MsgRepayMortgageResponse_late_fees protoreflect.FieldDescriptor
+	fd_MsgRepayMortgageResponse_interest  protoreflect.FieldDescriptor
+	fd_MsgRepayMortgageResponse_principal protoreflect.FieldDescriptor
+	fd_MsgRepayMortgageResponse_penalty   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgUpdateMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgUpdateMortgageResponse")
+	md_MsgRepayMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRepayMortgageResponse")
+	fd_MsgRepayMortgageResponse_late_fees = md_MsgRepayMortgageResponse.Fields().ByName("late_fees")
+	fd_MsgRepayMortgageResponse_interest = md_MsgRepayMortgageResponse.Fields().ByName("interest")
+	fd_MsgRepayMortgageResponse_principal = md_MsgRepayMortgageResponse.Fields().ByName("principal")
+	fd_MsgRepayMortgageResponse_penalty = md_MsgRepayMortgageResponse.Fields().ByName("penalty")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateMortgageResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRepayMortgageResponse)(nil)
 
-type fastReflection_MsgUpdateMortgageResponse MsgUpdateMortgageResponse
+type fastReflection_MsgRepayMortgageResponse MsgRepayMortgageResponse
 
-func (x *MsgUpdateMortgageResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMortgageResponse)(x)
+func (x *MsgRepayMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayMortgageResponse)(x)
 }
 
-func (x *MsgUpdateMortgageResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRepayMortgageResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2960,43 +2280,43 @@ func (x *MsgUpdateMortgageResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateMortgageResponse_messageType fastReflection_MsgUpdateMortgageResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateMortgageResponse_messageType{}
+var _fastReflection_MsgRepayMortgageResponse_messageType fastReflection_MsgRepayMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayMortgageResponse_messageType{}
 
-type fastReflection_MsgUpdateMortgageResponse_messageType struct{}
+type fastReflection_MsgRepayMortgageResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateMortgageResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateMortgageResponse)(nil)
+func (x fastReflection_MsgRepayMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayMortgageResponse)(nil)
 }
-func (x fastReflection_MsgUpdateMortgageResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMortgageResponse)
+func (x fastReflection_MsgRepayMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayMortgageResponse)
 }
-func (x fastReflection_MsgUpdateMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMortgageResponse
+func (x fastReflection_MsgRepayMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayMortgageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateMortgageResponse
+func (x *fastReflection_MsgRepayMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayMortgageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateMortgageResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateMortgageResponse_messageType
+func (x *fastReflection_MsgRepayMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayMortgageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateMortgageResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateMortgageResponse)
+func (x *fastReflection_MsgRepayMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayMortgageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateMortgageResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateMortgageResponse)(x)
+func (x *fastReflection_MsgRepayMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayMortgageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3004,7 +2324,31 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRepayMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LateFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LateFees)
+		if !f(fd_MsgRepayMortgageResponse_late_fees, value) {
+			return
+		}
+	}
+	if x.Interest != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interest)
+		if !f(fd_MsgRepayMortgageResponse_interest, value) {
+			return
+		}
+	}
+	if x.Principal != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Principal)
+		if !f(fd_MsgRepayMortgageResponse_principal, value) {
+			return
+		}
+	}
+	if x.Penalty != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Penalty)
+		if !f(fd_MsgRepayMortgageResponse_penalty, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3018,13 +2362,21 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRepayMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		return x.LateFees != uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		return x.Interest != uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		return x.Principal != uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		return x.Penalty != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3034,13 +2386,21 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRepayMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		x.LateFees = uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		x.Interest = uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		x.Principal = uint64(0)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		x.Penalty = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3050,13 +2410,25 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		value := x.LateFees
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		value := x.Interest
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		value := x.Principal
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		value := x.Penalty
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3070,13 +2442,21 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRepayMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		x.LateFees = value.Uint()
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		x.Interest = value.Uint()
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		x.Principal = value.Uint()
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		x.Penalty = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3090,36 +2470,52 @@ func (x *fastReflection_MsgUpdateMortgageResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		panic(fmt.Errorf("field late_fees of message ardapoc.mortgage.MsgRepayMortgageResponse is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		panic(fmt.Errorf("field interest of message ardapoc.mortgage.MsgRepayMortgageResponse is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		panic(fmt.Errorf("field principal of message ardapoc.mortgage.MsgRepayMortgageResponse is not mutable"))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		panic(fmt.Errorf("field penalty of message ardapoc.mortgage.MsgRepayMortgageResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRepayMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.late_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.interest":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.principal":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MsgRepayMortgageResponse.penalty":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgUpdateMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgUpdateMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRepayMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgUpdateMortgageResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRepayMortgageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3127,7 +2523,7 @@ func (x *fastReflection_MsgUpdateMortgageResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateMortgageResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRepayMortgageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3138,7 +2534,7 @@ func (x *fastReflection_MsgUpdateMortgageResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRepayMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3150,7 +2546,7 @@ func (x *fastReflection_MsgUpdateMortgageResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateMortgageResponse) IsValid() bool {
+func (x *fastReflection_MsgRepayMortgageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3160,9 +2556,9 @@ func (x *fastReflection_MsgUpdateMortgageResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateMortgageResponse)
+		x := input.Message.Interface().(*MsgRepayMortgageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3174,6 +2570,18 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		if x.LateFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.LateFees))
+		}
+		if x.Interest != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interest))
+		}
+		if x.Principal != 0 {
+			n += 1 + runtime.Sov(uint64(x.Principal))
+		}
+		if x.Penalty != 0 {
+			n += 1 + runtime.Sov(uint64(x.Penalty))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3184,7 +2592,7 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMortgageResponse)
+		x := input.Message.Interface().(*MsgRepayMortgageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3203,6 +2611,26 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Penalty != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Penalty))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Principal != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Principal))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Interest != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interest))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LateFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LateFees))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3214,7 +2642,7 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateMortgageResponse)
+		x := input.Message.Interface().(*MsgRepayMortgageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3246,12 +2674,88 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMortgageResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LateFees", wireType)
+				}
+				x.LateFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LateFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+				}
+				x.Interest = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interest |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+				}
+				x.Principal = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Principal |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+				}
+				x.Penalty = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Penalty |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3287,28 +2791,99 @@ func (x *fastReflection_MsgUpdateMortgageResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
+var _ protoreflect.List = (*_MsgRequestMortgage_11_list)(nil)
+
+type _MsgRequestMortgage_11_list struct {
+	list *[]*SyndicateLender
+}
+
+func (x *_MsgRequestMortgage_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRequestMortgage_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRequestMortgage_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SyndicateLender)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRequestMortgage_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SyndicateLender)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRequestMortgage_11_list) AppendMutable() protoreflect.Value {
+	v := new(SyndicateLender)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestMortgage_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRequestMortgage_11_list) NewElement() protoreflect.Value {
+	v := new(SyndicateLender)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRequestMortgage_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgDeleteMortgage         protoreflect.MessageDescriptor
-	fd_MsgDeleteMortgage_creator protoreflect.FieldDescriptor
-	fd_MsgDeleteMortgage_index   protoreflect.FieldDescriptor
+	md_MsgRequestMortgage                   protoreflect.MessageDescriptor
+	fd_MsgRequestMortgage_lendee            protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_index             protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_lender            protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_collateral        protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_amount            protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_interest_rate     protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_term_months       protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_payment_frequency protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_amortization      protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_purchase          protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_syndicate         protoreflect.FieldDescriptor
+	fd_MsgRequestMortgage_prepayment        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgDeleteMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDeleteMortgage")
-	fd_MsgDeleteMortgage_creator = md_MsgDeleteMortgage.Fields().ByName("creator")
-	fd_MsgDeleteMortgage_index = md_MsgDeleteMortgage.Fields().ByName("index")
+	md_MsgRequestMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRequestMortgage")
+	fd_MsgRequestMortgage_lendee = md_MsgRequestMortgage.Fields().ByName("lendee")
+	fd_MsgRequestMortgage_index = md_MsgRequestMortgage.Fields().ByName("index")
+	fd_MsgRequestMortgage_lender = md_MsgRequestMortgage.Fields().ByName("lender")
+	fd_MsgRequestMortgage_collateral = md_MsgRequestMortgage.Fields().ByName("collateral")
+	fd_MsgRequestMortgage_amount = md_MsgRequestMortgage.Fields().ByName("amount")
+	fd_MsgRequestMortgage_interest_rate = md_MsgRequestMortgage.Fields().ByName("interest_rate")
+	fd_MsgRequestMortgage_term_months = md_MsgRequestMortgage.Fields().ByName("term_months")
+	fd_MsgRequestMortgage_payment_frequency = md_MsgRequestMortgage.Fields().ByName("payment_frequency")
+	fd_MsgRequestMortgage_amortization = md_MsgRequestMortgage.Fields().ByName("amortization")
+	fd_MsgRequestMortgage_purchase = md_MsgRequestMortgage.Fields().ByName("purchase")
+	fd_MsgRequestMortgage_syndicate = md_MsgRequestMortgage.Fields().ByName("syndicate")
+	fd_MsgRequestMortgage_prepayment = md_MsgRequestMortgage.Fields().ByName("prepayment")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeleteMortgage)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRequestMortgage)(nil)
 
-type fastReflection_MsgDeleteMortgage MsgDeleteMortgage
+type fastReflection_MsgRequestMortgage MsgRequestMortgage
 
-func (x *MsgDeleteMortgage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeleteMortgage)(x)
+func (x *MsgRequestMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestMortgage)(x)
 }
 
-func (x *MsgDeleteMortgage) slowProtoReflect() protoreflect.Message {
+func (x *MsgRequestMortgage) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3320,43 +2895,43 @@ func (x *MsgDeleteMortgage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeleteMortgage_messageType fastReflection_MsgDeleteMortgage_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeleteMortgage_messageType{}
+var _fastReflection_MsgRequestMortgage_messageType fastReflection_MsgRequestMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestMortgage_messageType{}
 
-type fastReflection_MsgDeleteMortgage_messageType struct{}
+type fastReflection_MsgRequestMortgage_messageType struct{}
 
-func (x fastReflection_MsgDeleteMortgage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeleteMortgage)(nil)
+func (x fastReflection_MsgRequestMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestMortgage)(nil)
 }
-func (x fastReflection_MsgDeleteMortgage_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteMortgage)
+func (x fastReflection_MsgRequestMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestMortgage)
 }
-func (x fastReflection_MsgDeleteMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteMortgage
+func (x fastReflection_MsgRequestMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestMortgage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeleteMortgage) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteMortgage
+func (x *fastReflection_MsgRequestMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestMortgage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeleteMortgage) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeleteMortgage_messageType
+func (x *fastReflection_MsgRequestMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestMortgage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeleteMortgage) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteMortgage)
+func (x *fastReflection_MsgRequestMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestMortgage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeleteMortgage) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeleteMortgage)(x)
+func (x *fastReflection_MsgRequestMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestMortgage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3364,21 +2939,81 @@ func (x *fastReflection_MsgDeleteMortgage) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeleteMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgDeleteMortgage_creator, value) {
+func (x *fastReflection_MsgRequestMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgRequestMortgage_lendee, value) {
 			return
 		}
 	}
 	if x.Index != "" {
 		value := protoreflect.ValueOfString(x.Index)
-		if !f(fd_MsgDeleteMortgage_index, value) {
+		if !f(fd_MsgRequestMortgage_index, value) {
 			return
 		}
 	}
-}
-
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgRequestMortgage_lender, value) {
+			return
+		}
+	}
+	if x.Collateral != "" {
+		value := protoreflect.ValueOfString(x.Collateral)
+		if !f(fd_MsgRequestMortgage_collateral, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgRequestMortgage_amount, value) {
+			return
+		}
+	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_MsgRequestMortgage_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_MsgRequestMortgage_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_MsgRequestMortgage_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_MsgRequestMortgage_amortization, value) {
+			return
+		}
+	}
+	if x.Purchase != nil {
+		value := protoreflect.ValueOfMessage(x.Purchase.ProtoReflect())
+		if !f(fd_MsgRequestMortgage_purchase, value) {
+			return
+		}
+	}
+	if len(x.Syndicate) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRequestMortgage_11_list{list: &x.Syndicate})
+		if !f(fd_MsgRequestMortgage_syndicate, value) {
+			return
+		}
+	}
+	if x.Prepayment != nil {
+		value := protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
+		if !f(fd_MsgRequestMortgage_prepayment, value) {
+			return
+		}
+	}
+}
+
 // Has reports whether a field is populated.
 //
 // Some fields have the property of nullability where it is possible to
@@ -3390,17 +3025,37 @@ func (x *fastReflection_MsgDeleteMortgage) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeleteMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRequestMortgage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
-		return x.Creator != ""
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
 		return x.Index != ""
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		return x.Collateral != ""
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		return x.Amount != uint64(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		return x.Amortization != 0
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		return x.Purchase != nil
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		return len(x.Syndicate) != 0
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		return x.Prepayment != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3410,17 +3065,37 @@ func (x *fastReflection_MsgDeleteMortgage) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRequestMortgage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
-		x.Creator = ""
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
 		x.Index = ""
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		x.Collateral = ""
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		x.Amount = uint64(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		x.Amortization = 0
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		x.Purchase = nil
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		x.Syndicate = nil
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		x.Prepayment = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3430,19 +3105,52 @@ func (x *fastReflection_MsgDeleteMortgage) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeleteMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
-		value := x.Creator
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
+		value := x.Lendee
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
 		value := x.Index
 		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		value := x.Purchase
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		if len(x.Syndicate) == 0 {
+			return protoreflect.ValueOfList(&_MsgRequestMortgage_11_list{})
+		}
+		listValue := &_MsgRequestMortgage_11_list{list: &x.Syndicate}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		value := x.Prepayment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3456,17 +3164,39 @@ func (x *fastReflection_MsgDeleteMortgage) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRequestMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
-		x.Creator = value.Interface().(string)
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
 		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		x.Collateral = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		x.Amount = value.Uint()
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		x.Purchase = value.Message().Interface().(*MortgagePurchase)
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		lv := value.List()
+		clv := lv.(*_MsgRequestMortgage_11_list)
+		x.Syndicate = *clv.list
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		x.Prepayment = value.Message().Interface().(*PrepaymentTerms)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3480,44 +3210,97 @@ func (x *fastReflection_MsgDeleteMortgage) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
-		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgDeleteMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
-		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgDeleteMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		if x.Purchase == nil {
+			x.Purchase = new(MortgagePurchase)
+		}
+		return protoreflect.ValueOfMessage(x.Purchase.ProtoReflect())
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		if x.Syndicate == nil {
+			x.Syndicate = []*SyndicateLender{}
+		}
+		value := &_MsgRequestMortgage_11_list{list: &x.Syndicate}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		if x.Prepayment == nil {
+			x.Prepayment = new(PrepaymentTerms)
+		}
+		return protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		panic(fmt.Errorf("field collateral of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.MsgRequestMortgage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeleteMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgDeleteMortgage.creator":
+	case "ardapoc.mortgage.MsgRequestMortgage.lendee":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgDeleteMortgage.index":
+	case "ardapoc.mortgage.MsgRequestMortgage.index":
 		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestMortgage.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestMortgage.collateral":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestMortgage.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MsgRequestMortgage.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestMortgage.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MsgRequestMortgage.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.amortization":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgRequestMortgage.purchase":
+		m := new(MortgagePurchase)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.mortgage.MsgRequestMortgage.syndicate":
+		list := []*SyndicateLender{}
+		return protoreflect.ValueOfList(&_MsgRequestMortgage_11_list{list: &list})
+	case "ardapoc.mortgage.MsgRequestMortgage.prepayment":
+		m := new(PrepaymentTerms)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeleteMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRequestMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDeleteMortgage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRequestMortgage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3525,7 +3308,7 @@ func (x *fastReflection_MsgDeleteMortgage) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeleteMortgage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRequestMortgage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3536,7 +3319,7 @@ func (x *fastReflection_MsgDeleteMortgage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRequestMortgage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3548,7 +3331,7 @@ func (x *fastReflection_MsgDeleteMortgage) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeleteMortgage) IsValid() bool {
+func (x *fastReflection_MsgRequestMortgage) IsValid() bool {
 	return x != nil
 }
 
@@ -3558,9 +3341,9 @@ func (x *fastReflection_MsgDeleteMortgage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRequestMortgage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeleteMortgage)
+		x := input.Message.Interface().(*MsgRequestMortgage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3572,7 +3355,7 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
+		l = len(x.Lendee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3580,6 +3363,44 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Collateral)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.Purchase != nil {
+			l = options.Size(x.Purchase)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Syndicate) > 0 {
+			for _, e := range x.Syndicate {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Prepayment != nil {
+			l = options.Size(x.Prepayment)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3590,7 +3411,7 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteMortgage)
+		x := input.Message.Interface().(*MsgRequestMortgage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3609,6 +3430,91 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Prepayment != nil {
+			encoded, err := options.Marshal(x.Prepayment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.Syndicate) > 0 {
+			for iNdEx := len(x.Syndicate) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Syndicate[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.Purchase != nil {
+			encoded, err := options.Marshal(x.Purchase)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Collateral) > 0 {
+			i -= len(x.Collateral)
+			copy(dAtA[i:], x.Collateral)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Index) > 0 {
 			i -= len(x.Index)
 			copy(dAtA[i:], x.Index)
@@ -3616,10 +3522,10 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3634,7 +3540,7 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteMortgage)
+		x := input.Message.Interface().(*MsgRequestMortgage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3666,15 +3572,15 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestMortgage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3702,7 +3608,7 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Lendee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -3736,20 +3642,298 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 				}
 				x.Index = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collateral = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+				}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Purchase == nil {
+					x.Purchase = &MortgagePurchase{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Purchase); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Syndicate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Syndicate = append(x.Syndicate, &SyndicateLender{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Syndicate[len(x.Syndicate)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Prepayment == nil {
+					x.Prepayment = &PrepaymentTerms{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prepayment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -3772,23 +3956,23 @@ func (x *fastReflection_MsgDeleteMortgage) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgDeleteMortgageResponse protoreflect.MessageDescriptor
+	md_MsgRequestMortgageResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgDeleteMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDeleteMortgageResponse")
+	md_MsgRequestMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRequestMortgageResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeleteMortgageResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRequestMortgageResponse)(nil)
 
-type fastReflection_MsgDeleteMortgageResponse MsgDeleteMortgageResponse
+type fastReflection_MsgRequestMortgageResponse MsgRequestMortgageResponse
 
-func (x *MsgDeleteMortgageResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeleteMortgageResponse)(x)
+func (x *MsgRequestMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestMortgageResponse)(x)
 }
 
-func (x *MsgDeleteMortgageResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRequestMortgageResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3800,43 +3984,43 @@ func (x *MsgDeleteMortgageResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeleteMortgageResponse_messageType fastReflection_MsgDeleteMortgageResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeleteMortgageResponse_messageType{}
+var _fastReflection_MsgRequestMortgageResponse_messageType fastReflection_MsgRequestMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestMortgageResponse_messageType{}
 
-type fastReflection_MsgDeleteMortgageResponse_messageType struct{}
+type fastReflection_MsgRequestMortgageResponse_messageType struct{}
 
-func (x fastReflection_MsgDeleteMortgageResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeleteMortgageResponse)(nil)
+func (x fastReflection_MsgRequestMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestMortgageResponse)(nil)
 }
-func (x fastReflection_MsgDeleteMortgageResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteMortgageResponse)
+func (x fastReflection_MsgRequestMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestMortgageResponse)
 }
-func (x fastReflection_MsgDeleteMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteMortgageResponse
+func (x fastReflection_MsgRequestMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestMortgageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeleteMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteMortgageResponse
+func (x *fastReflection_MsgRequestMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestMortgageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeleteMortgageResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeleteMortgageResponse_messageType
+func (x *fastReflection_MsgRequestMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestMortgageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeleteMortgageResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteMortgageResponse)
+func (x *fastReflection_MsgRequestMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestMortgageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeleteMortgageResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeleteMortgageResponse)(x)
+func (x *fastReflection_MsgRequestMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestMortgageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3844,7 +4028,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeleteMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRequestMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3858,13 +4042,13 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeleteMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRequestMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3874,13 +4058,13 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRequestMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3890,13 +4074,13 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeleteMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3910,13 +4094,13 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRequestMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3930,36 +4114,36 @@ func (x *fastReflection_MsgDeleteMortgageResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeleteMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRequestMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDeleteMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgDeleteMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeleteMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRequestMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDeleteMortgageResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRequestMortgageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3967,7 +4151,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeleteMortgageResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRequestMortgageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3978,7 +4162,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRequestMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3990,7 +4174,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeleteMortgageResponse) IsValid() bool {
+func (x *fastReflection_MsgRequestMortgageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4000,9 +4184,9 @@ func (x *fastReflection_MsgDeleteMortgageResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRequestMortgageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
+		x := input.Message.Interface().(*MsgRequestMortgageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4024,7 +4208,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
+		x := input.Message.Interface().(*MsgRequestMortgageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4054,7 +4238,7 @@ func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteMortgageResponse)
+		x := input.Message.Interface().(*MsgRequestMortgageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4086,10 +4270,10 @@ func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgageResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestMortgageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4128,29 +4312,27 @@ func (x *fastReflection_MsgDeleteMortgageResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgRepayMortgage            protoreflect.MessageDescriptor
-	fd_MsgRepayMortgage_creator    protoreflect.FieldDescriptor
-	fd_MsgRepayMortgage_mortgageId protoreflect.FieldDescriptor
-	fd_MsgRepayMortgage_amount     protoreflect.FieldDescriptor
+	md_MsgApproveMortgage        protoreflect.MessageDescriptor
+	fd_MsgApproveMortgage_lender protoreflect.FieldDescriptor
+	fd_MsgApproveMortgage_index  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgRepayMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRepayMortgage")
-	fd_MsgRepayMortgage_creator = md_MsgRepayMortgage.Fields().ByName("creator")
-	fd_MsgRepayMortgage_mortgageId = md_MsgRepayMortgage.Fields().ByName("mortgageId")
-	fd_MsgRepayMortgage_amount = md_MsgRepayMortgage.Fields().ByName("amount")
+	md_MsgApproveMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgApproveMortgage")
+	fd_MsgApproveMortgage_lender = md_MsgApproveMortgage.Fields().ByName("lender")
+	fd_MsgApproveMortgage_index = md_MsgApproveMortgage.Fields().ByName("index")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRepayMortgage)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgApproveMortgage)(nil)
 
-type fastReflection_MsgRepayMortgage MsgRepayMortgage
+type fastReflection_MsgApproveMortgage MsgApproveMortgage
 
-func (x *MsgRepayMortgage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRepayMortgage)(x)
+func (x *MsgApproveMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgApproveMortgage)(x)
 }
 
-func (x *MsgRepayMortgage) slowProtoReflect() protoreflect.Message {
+func (x *MsgApproveMortgage) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4162,43 +4344,43 @@ func (x *MsgRepayMortgage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRepayMortgage_messageType fastReflection_MsgRepayMortgage_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRepayMortgage_messageType{}
+var _fastReflection_MsgApproveMortgage_messageType fastReflection_MsgApproveMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgApproveMortgage_messageType{}
 
-type fastReflection_MsgRepayMortgage_messageType struct{}
+type fastReflection_MsgApproveMortgage_messageType struct{}
 
-func (x fastReflection_MsgRepayMortgage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRepayMortgage)(nil)
+func (x fastReflection_MsgApproveMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgApproveMortgage)(nil)
 }
-func (x fastReflection_MsgRepayMortgage_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayMortgage)
+func (x fastReflection_MsgApproveMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveMortgage)
 }
-func (x fastReflection_MsgRepayMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayMortgage
+func (x fastReflection_MsgApproveMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveMortgage
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRepayMortgage) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayMortgage
+func (x *fastReflection_MsgApproveMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveMortgage
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRepayMortgage) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRepayMortgage_messageType
+func (x *fastReflection_MsgApproveMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgApproveMortgage_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRepayMortgage) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayMortgage)
+func (x *fastReflection_MsgApproveMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveMortgage)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRepayMortgage) Interface() protoreflect.ProtoMessage {
-	return (*MsgRepayMortgage)(x)
+func (x *fastReflection_MsgApproveMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgApproveMortgage)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4206,22 +4388,16 @@ func (x *fastReflection_MsgRepayMortgage) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRepayMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgRepayMortgage_creator, value) {
-			return
-		}
-	}
-	if x.MortgageId != "" {
-		value := protoreflect.ValueOfString(x.MortgageId)
-		if !f(fd_MsgRepayMortgage_mortgageId, value) {
+func (x *fastReflection_MsgApproveMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgApproveMortgage_lender, value) {
 			return
 		}
 	}
-	if x.Amount != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Amount)
-		if !f(fd_MsgRepayMortgage_amount, value) {
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgApproveMortgage_index, value) {
 			return
 		}
 	}
@@ -4238,19 +4414,17 @@ func (x *fastReflection_MsgRepayMortgage) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRepayMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgApproveMortgage) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
-		return x.Creator != ""
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
-		return x.MortgageId != ""
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		return x.Amount != uint64(0)
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
+		return x.Index != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4260,19 +4434,17 @@ func (x *fastReflection_MsgRepayMortgage) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgApproveMortgage) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
-		x.Creator = ""
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
-		x.MortgageId = ""
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		x.Amount = uint64(0)
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
+		x.Index = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4282,22 +4454,19 @@ func (x *fastReflection_MsgRepayMortgage) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRepayMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
-		value := x.Creator
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
+		value := x.Lender
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
-		value := x.MortgageId
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
+		value := x.Index
 		return protoreflect.ValueOfString(value)
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		value := x.Amount
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4311,19 +4480,17 @@ func (x *fastReflection_MsgRepayMortgage) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgApproveMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
-		x.Creator = value.Interface().(string)
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
-		x.MortgageId = value.Interface().(string)
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		x.Amount = value.Uint()
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
+		x.Index = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4337,48 +4504,44 @@ func (x *fastReflection_MsgRepayMortgage) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
-		panic(fmt.Errorf("field creator of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
-		panic(fmt.Errorf("field mortgageId of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgRepayMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgApproveMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgApproveMortgage is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRepayMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "ardapoc.mortgage.MsgRepayMortgage.creator":
+	case "ardapoc.mortgage.MsgApproveMortgage.lender":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgRepayMortgage.mortgageId":
+	case "ardapoc.mortgage.MsgApproveMortgage.index":
 		return protoreflect.ValueOfString("")
-	case "ardapoc.mortgage.MsgRepayMortgage.amount":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgage"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgage does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRepayMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgApproveMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRepayMortgage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgApproveMortgage", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4386,7 +4549,7 @@ func (x *fastReflection_MsgRepayMortgage) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRepayMortgage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgApproveMortgage) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4397,7 +4560,7 @@ func (x *fastReflection_MsgRepayMortgage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgApproveMortgage) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4409,7 +4572,7 @@ func (x *fastReflection_MsgRepayMortgage) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRepayMortgage) IsValid() bool {
+func (x *fastReflection_MsgApproveMortgage) IsValid() bool {
 	return x != nil
 }
 
@@ -4419,9 +4582,9 @@ func (x *fastReflection_MsgRepayMortgage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgApproveMortgage) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRepayMortgage)
+		x := input.Message.Interface().(*MsgApproveMortgage)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4433,17 +4596,14 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
+		l = len(x.Lender)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MortgageId)
+		l = len(x.Index)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Amount != 0 {
-			n += 1 + runtime.Sov(uint64(x.Amount))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4454,7 +4614,7 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayMortgage)
+		x := input.Message.Interface().(*MsgApproveMortgage)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4473,22 +4633,17 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.MortgageId) > 0 {
-			i -= len(x.MortgageId)
-			copy(dAtA[i:], x.MortgageId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MortgageId)))
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4503,7 +4658,7 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayMortgage)
+		x := input.Message.Interface().(*MsgApproveMortgage)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4535,15 +4690,15 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveMortgage: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4571,11 +4726,11 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Lender = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MortgageId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4603,27 +4758,8 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MortgageId = string(dAtA[iNdEx:postIndex])
+				x.Index = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				x.Amount = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Amount |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4660,23 +4796,23 @@ func (x *fastReflection_MsgRepayMortgage) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRepayMortgageResponse protoreflect.MessageDescriptor
+	md_MsgApproveMortgageResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_ardapoc_mortgage_tx_proto_init()
-	md_MsgRepayMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRepayMortgageResponse")
+	md_MsgApproveMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgApproveMortgageResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRepayMortgageResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgApproveMortgageResponse)(nil)
 
-type fastReflection_MsgRepayMortgageResponse MsgRepayMortgageResponse
+type fastReflection_MsgApproveMortgageResponse MsgApproveMortgageResponse
 
-func (x *MsgRepayMortgageResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRepayMortgageResponse)(x)
+func (x *MsgApproveMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgApproveMortgageResponse)(x)
 }
 
-func (x *MsgRepayMortgageResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgApproveMortgageResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4688,43 +4824,43 @@ func (x *MsgRepayMortgageResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRepayMortgageResponse_messageType fastReflection_MsgRepayMortgageResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRepayMortgageResponse_messageType{}
+var _fastReflection_MsgApproveMortgageResponse_messageType fastReflection_MsgApproveMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgApproveMortgageResponse_messageType{}
 
-type fastReflection_MsgRepayMortgageResponse_messageType struct{}
+type fastReflection_MsgApproveMortgageResponse_messageType struct{}
 
-func (x fastReflection_MsgRepayMortgageResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRepayMortgageResponse)(nil)
+func (x fastReflection_MsgApproveMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgApproveMortgageResponse)(nil)
 }
-func (x fastReflection_MsgRepayMortgageResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayMortgageResponse)
+func (x fastReflection_MsgApproveMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveMortgageResponse)
 }
-func (x fastReflection_MsgRepayMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayMortgageResponse
+func (x fastReflection_MsgApproveMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveMortgageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRepayMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayMortgageResponse
+func (x *fastReflection_MsgApproveMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgApproveMortgageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRepayMortgageResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRepayMortgageResponse_messageType
+func (x *fastReflection_MsgApproveMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgApproveMortgageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRepayMortgageResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayMortgageResponse)
+func (x *fastReflection_MsgApproveMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgApproveMortgageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRepayMortgageResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRepayMortgageResponse)(x)
+func (x *fastReflection_MsgApproveMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgApproveMortgageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4732,7 +4868,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRepayMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgApproveMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4746,13 +4882,13 @@ func (x *fastReflection_MsgRepayMortgageResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRepayMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgApproveMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4762,13 +4898,13 @@ func (x *fastReflection_MsgRepayMortgageResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgApproveMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4778,13 +4914,13 @@ func (x *fastReflection_MsgRepayMortgageResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRepayMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4798,13 +4934,13 @@ func (x *fastReflection_MsgRepayMortgageResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgApproveMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4818,36 +4954,36 @@ func (x *fastReflection_MsgRepayMortgageResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRepayMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgApproveMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRepayMortgageResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgApproveMortgageResponse"))
 		}
-		panic(fmt.Errorf("message ardapoc.mortgage.MsgRepayMortgageResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgApproveMortgageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRepayMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgApproveMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRepayMortgageResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgApproveMortgageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4855,7 +4991,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRepayMortgageResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgApproveMortgageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4866,7 +5002,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgApproveMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4878,7 +5014,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRepayMortgageResponse) IsValid() bool {
+func (x *fastReflection_MsgApproveMortgageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4888,9 +5024,9 @@ func (x *fastReflection_MsgRepayMortgageResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgApproveMortgageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRepayMortgageResponse)
+		x := input.Message.Interface().(*MsgApproveMortgageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4912,7 +5048,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayMortgageResponse)
+		x := input.Message.Interface().(*MsgApproveMortgageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4942,7 +5078,7 @@ func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayMortgageResponse)
+		x := input.Message.Interface().(*MsgApproveMortgageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4974,10 +5110,10 @@ func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgageResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveMortgageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgApproveMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5015,471 +5151,14424 @@ func (x *fastReflection_MsgRepayMortgageResponse) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: ardapoc/mortgage/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgRejectMortgage        protoreflect.MessageDescriptor
+	fd_MsgRejectMortgage_lender protoreflect.FieldDescriptor
+	fd_MsgRejectMortgage_index  protoreflect.FieldDescriptor
 )
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRejectMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRejectMortgage")
+	fd_MsgRejectMortgage_lender = md_MsgRejectMortgage.Fields().ByName("lender")
+	fd_MsgRejectMortgage_index = md_MsgRejectMortgage.Fields().ByName("index")
+}
 
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// NOTE: All parameters must be supplied.
-	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgRejectMortgage)(nil)
+
+type fastReflection_MsgRejectMortgage MsgRejectMortgage
+
+func (x *MsgRejectMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRejectMortgage)(x)
 }
 
-func (x *MsgUpdateParams) Reset() {
-	*x = MsgUpdateParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[0]
+func (x *MsgRejectMortgage) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRejectMortgage_messageType fastReflection_MsgRejectMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRejectMortgage_messageType{}
+
+type fastReflection_MsgRejectMortgage_messageType struct{}
+
+func (x fastReflection_MsgRejectMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRejectMortgage)(nil)
+}
+func (x fastReflection_MsgRejectMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectMortgage)
+}
+func (x fastReflection_MsgRejectMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectMortgage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRejectMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectMortgage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRejectMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRejectMortgage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRejectMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectMortgage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRejectMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgRejectMortgage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRejectMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgRejectMortgage_lender, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgRejectMortgage_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRejectMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRejectMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgRejectMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgRejectMortgage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRejectMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectMortgage.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRejectMortgage.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRejectMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRejectMortgage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRejectMortgage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRejectMortgage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRejectMortgage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRejectMortgage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
 	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectMortgage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectMortgage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectMortgage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRejectMortgageResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRejectMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRejectMortgageResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRejectMortgageResponse)(nil)
+
+type fastReflection_MsgRejectMortgageResponse MsgRejectMortgageResponse
+
+func (x *MsgRejectMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRejectMortgageResponse)(x)
+}
+
+func (x *MsgRejectMortgageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRejectMortgageResponse_messageType fastReflection_MsgRejectMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRejectMortgageResponse_messageType{}
+
+type fastReflection_MsgRejectMortgageResponse_messageType struct{}
+
+func (x fastReflection_MsgRejectMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRejectMortgageResponse)(nil)
+}
+func (x fastReflection_MsgRejectMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectMortgageResponse)
+}
+func (x fastReflection_MsgRejectMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectMortgageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRejectMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectMortgageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRejectMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRejectMortgageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRejectMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectMortgageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRejectMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRejectMortgageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRejectMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRejectMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRejectMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRejectMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRejectMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRejectMortgageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRejectMortgageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRejectMortgageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRejectMortgageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRejectMortgageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectMortgageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectMortgageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectMortgageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMortgageRequest        protoreflect.MessageDescriptor
+	fd_MsgCancelMortgageRequest_lendee protoreflect.FieldDescriptor
+	fd_MsgCancelMortgageRequest_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgCancelMortgageRequest = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgCancelMortgageRequest")
+	fd_MsgCancelMortgageRequest_lendee = md_MsgCancelMortgageRequest.Fields().ByName("lendee")
+	fd_MsgCancelMortgageRequest_index = md_MsgCancelMortgageRequest.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMortgageRequest)(nil)
+
+type fastReflection_MsgCancelMortgageRequest MsgCancelMortgageRequest
+
+func (x *MsgCancelMortgageRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMortgageRequest)(x)
+}
+
+func (x *MsgCancelMortgageRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMortgageRequest_messageType fastReflection_MsgCancelMortgageRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMortgageRequest_messageType{}
+
+type fastReflection_MsgCancelMortgageRequest_messageType struct{}
+
+func (x fastReflection_MsgCancelMortgageRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMortgageRequest)(nil)
+}
+func (x fastReflection_MsgCancelMortgageRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMortgageRequest)
+}
+func (x fastReflection_MsgCancelMortgageRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMortgageRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMortgageRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMortgageRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMortgageRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMortgageRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMortgageRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMortgageRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMortgageRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMortgageRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMortgageRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgCancelMortgageRequest_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgCancelMortgageRequest_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMortgageRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMortgageRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgCancelMortgageRequest is not mutable"))
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgCancelMortgageRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMortgageRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgCancelMortgageRequest.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequest"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMortgageRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgCancelMortgageRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMortgageRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMortgageRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMortgageRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMortgageRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMortgageRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMortgageRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMortgageRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCancelMortgageRequestResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgCancelMortgageRequestResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgCancelMortgageRequestResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCancelMortgageRequestResponse)(nil)
+
+type fastReflection_MsgCancelMortgageRequestResponse MsgCancelMortgageRequestResponse
+
+func (x *MsgCancelMortgageRequestResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelMortgageRequestResponse)(x)
+}
+
+func (x *MsgCancelMortgageRequestResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCancelMortgageRequestResponse_messageType fastReflection_MsgCancelMortgageRequestResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelMortgageRequestResponse_messageType{}
+
+type fastReflection_MsgCancelMortgageRequestResponse_messageType struct{}
+
+func (x fastReflection_MsgCancelMortgageRequestResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelMortgageRequestResponse)(nil)
+}
+func (x fastReflection_MsgCancelMortgageRequestResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMortgageRequestResponse)
+}
+func (x fastReflection_MsgCancelMortgageRequestResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMortgageRequestResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelMortgageRequestResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelMortgageRequestResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelMortgageRequestResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelMortgageRequestResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgCancelMortgageRequestResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgCancelMortgageRequestResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgCancelMortgageRequestResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCancelMortgageRequestResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCancelMortgageRequestResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMortgageRequestResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCancelMortgageRequestResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMortgageRequestResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelMortgageRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPurchaseWithMortgage        protoreflect.MessageDescriptor
+	fd_MsgPurchaseWithMortgage_lender protoreflect.FieldDescriptor
+	fd_MsgPurchaseWithMortgage_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgPurchaseWithMortgage = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgPurchaseWithMortgage")
+	fd_MsgPurchaseWithMortgage_lender = md_MsgPurchaseWithMortgage.Fields().ByName("lender")
+	fd_MsgPurchaseWithMortgage_index = md_MsgPurchaseWithMortgage.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseWithMortgage)(nil)
+
+type fastReflection_MsgPurchaseWithMortgage MsgPurchaseWithMortgage
+
+func (x *MsgPurchaseWithMortgage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseWithMortgage)(x)
+}
+
+func (x *MsgPurchaseWithMortgage) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurchaseWithMortgage_messageType fastReflection_MsgPurchaseWithMortgage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseWithMortgage_messageType{}
+
+type fastReflection_MsgPurchaseWithMortgage_messageType struct{}
+
+func (x fastReflection_MsgPurchaseWithMortgage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseWithMortgage)(nil)
+}
+func (x fastReflection_MsgPurchaseWithMortgage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseWithMortgage)
+}
+func (x fastReflection_MsgPurchaseWithMortgage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseWithMortgage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurchaseWithMortgage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseWithMortgage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurchaseWithMortgage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseWithMortgage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurchaseWithMortgage) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseWithMortgage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurchaseWithMortgage) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseWithMortgage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurchaseWithMortgage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgPurchaseWithMortgage_lender, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgPurchaseWithMortgage_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurchaseWithMortgage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurchaseWithMortgage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgPurchaseWithMortgage is not mutable"))
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgPurchaseWithMortgage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurchaseWithMortgage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgPurchaseWithMortgage.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgage"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurchaseWithMortgage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgPurchaseWithMortgage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurchaseWithMortgage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurchaseWithMortgage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurchaseWithMortgage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseWithMortgage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseWithMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPurchaseWithMortgageResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgPurchaseWithMortgageResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgPurchaseWithMortgageResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPurchaseWithMortgageResponse)(nil)
+
+type fastReflection_MsgPurchaseWithMortgageResponse MsgPurchaseWithMortgageResponse
+
+func (x *MsgPurchaseWithMortgageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseWithMortgageResponse)(x)
+}
+
+func (x *MsgPurchaseWithMortgageResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPurchaseWithMortgageResponse_messageType fastReflection_MsgPurchaseWithMortgageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPurchaseWithMortgageResponse_messageType{}
+
+type fastReflection_MsgPurchaseWithMortgageResponse_messageType struct{}
+
+func (x fastReflection_MsgPurchaseWithMortgageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPurchaseWithMortgageResponse)(nil)
+}
+func (x fastReflection_MsgPurchaseWithMortgageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseWithMortgageResponse)
+}
+func (x fastReflection_MsgPurchaseWithMortgageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseWithMortgageResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPurchaseWithMortgageResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPurchaseWithMortgageResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgPurchaseWithMortgageResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgPurchaseWithMortgageResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgPurchaseWithMortgageResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgPurchaseWithMortgageResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgPurchaseWithMortgageResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPurchaseWithMortgageResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgageResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgageResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPurchaseWithMortgageResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseWithMortgageResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPurchaseWithMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptPurchase        protoreflect.MessageDescriptor
+	fd_MsgAcceptPurchase_seller protoreflect.FieldDescriptor
+	fd_MsgAcceptPurchase_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgAcceptPurchase = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgAcceptPurchase")
+	fd_MsgAcceptPurchase_seller = md_MsgAcceptPurchase.Fields().ByName("seller")
+	fd_MsgAcceptPurchase_index = md_MsgAcceptPurchase.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptPurchase)(nil)
+
+type fastReflection_MsgAcceptPurchase MsgAcceptPurchase
+
+func (x *MsgAcceptPurchase) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptPurchase)(x)
+}
+
+func (x *MsgAcceptPurchase) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptPurchase_messageType fastReflection_MsgAcceptPurchase_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptPurchase_messageType{}
+
+type fastReflection_MsgAcceptPurchase_messageType struct{}
+
+func (x fastReflection_MsgAcceptPurchase_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptPurchase)(nil)
+}
+func (x fastReflection_MsgAcceptPurchase_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptPurchase)
+}
+func (x fastReflection_MsgAcceptPurchase_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptPurchase
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptPurchase) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptPurchase
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptPurchase) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptPurchase_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptPurchase) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptPurchase)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptPurchase) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptPurchase)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptPurchase) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Seller != "" {
+		value := protoreflect.ValueOfString(x.Seller)
+		if !f(fd_MsgAcceptPurchase_seller, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgAcceptPurchase_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptPurchase) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		return x.Seller != ""
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchase) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		x.Seller = ""
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptPurchase) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		value := x.Seller
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchase) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		x.Seller = value.Interface().(string)
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchase) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		panic(fmt.Errorf("field seller of message ardapoc.mortgage.MsgAcceptPurchase is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgAcceptPurchase is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptPurchase) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptPurchase.seller":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgAcceptPurchase.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchase"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchase does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptPurchase) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgAcceptPurchase", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptPurchase) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchase) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptPurchase) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptPurchase) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptPurchase)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptPurchase)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptPurchase)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptPurchase: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptPurchaseResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgAcceptPurchaseResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgAcceptPurchaseResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptPurchaseResponse)(nil)
+
+type fastReflection_MsgAcceptPurchaseResponse MsgAcceptPurchaseResponse
+
+func (x *MsgAcceptPurchaseResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptPurchaseResponse)(x)
+}
+
+func (x *MsgAcceptPurchaseResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptPurchaseResponse_messageType fastReflection_MsgAcceptPurchaseResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptPurchaseResponse_messageType{}
+
+type fastReflection_MsgAcceptPurchaseResponse_messageType struct{}
+
+func (x fastReflection_MsgAcceptPurchaseResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptPurchaseResponse)(nil)
+}
+func (x fastReflection_MsgAcceptPurchaseResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptPurchaseResponse)
+}
+func (x fastReflection_MsgAcceptPurchaseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptPurchaseResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptPurchaseResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptPurchaseResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptPurchaseResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptPurchaseResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptPurchaseResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchaseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptPurchaseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptPurchaseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptPurchaseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptPurchaseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgAcceptPurchaseResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptPurchaseResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptPurchaseResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptPurchaseResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptPurchaseResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptPurchaseResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptPurchaseResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptPurchaseResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptPurchaseResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptPurchaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRequestEquityLoan                   protoreflect.MessageDescriptor
+	fd_MsgRequestEquityLoan_lendee            protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_index             protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_lender            protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_collateral        protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_amount            protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_interest_rate     protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_term_months       protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_payment_frequency protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_amortization      protoreflect.FieldDescriptor
+	fd_MsgRequestEquityLoan_prepayment        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRequestEquityLoan = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRequestEquityLoan")
+	fd_MsgRequestEquityLoan_lendee = md_MsgRequestEquityLoan.Fields().ByName("lendee")
+	fd_MsgRequestEquityLoan_index = md_MsgRequestEquityLoan.Fields().ByName("index")
+	fd_MsgRequestEquityLoan_lender = md_MsgRequestEquityLoan.Fields().ByName("lender")
+	fd_MsgRequestEquityLoan_collateral = md_MsgRequestEquityLoan.Fields().ByName("collateral")
+	fd_MsgRequestEquityLoan_amount = md_MsgRequestEquityLoan.Fields().ByName("amount")
+	fd_MsgRequestEquityLoan_interest_rate = md_MsgRequestEquityLoan.Fields().ByName("interest_rate")
+	fd_MsgRequestEquityLoan_term_months = md_MsgRequestEquityLoan.Fields().ByName("term_months")
+	fd_MsgRequestEquityLoan_payment_frequency = md_MsgRequestEquityLoan.Fields().ByName("payment_frequency")
+	fd_MsgRequestEquityLoan_amortization = md_MsgRequestEquityLoan.Fields().ByName("amortization")
+	fd_MsgRequestEquityLoan_prepayment = md_MsgRequestEquityLoan.Fields().ByName("prepayment")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestEquityLoan)(nil)
+
+type fastReflection_MsgRequestEquityLoan MsgRequestEquityLoan
+
+func (x *MsgRequestEquityLoan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestEquityLoan)(x)
+}
+
+func (x *MsgRequestEquityLoan) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestEquityLoan_messageType fastReflection_MsgRequestEquityLoan_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestEquityLoan_messageType{}
+
+type fastReflection_MsgRequestEquityLoan_messageType struct{}
+
+func (x fastReflection_MsgRequestEquityLoan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestEquityLoan)(nil)
+}
+func (x fastReflection_MsgRequestEquityLoan_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestEquityLoan)
+}
+func (x fastReflection_MsgRequestEquityLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestEquityLoan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestEquityLoan) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestEquityLoan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestEquityLoan) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestEquityLoan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestEquityLoan) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestEquityLoan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestEquityLoan) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestEquityLoan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestEquityLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgRequestEquityLoan_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgRequestEquityLoan_index, value) {
+			return
+		}
+	}
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgRequestEquityLoan_lender, value) {
+			return
+		}
+	}
+	if x.Collateral != "" {
+		value := protoreflect.ValueOfString(x.Collateral)
+		if !f(fd_MsgRequestEquityLoan_collateral, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgRequestEquityLoan_amount, value) {
+			return
+		}
+	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_MsgRequestEquityLoan_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_MsgRequestEquityLoan_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_MsgRequestEquityLoan_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_MsgRequestEquityLoan_amortization, value) {
+			return
+		}
+	}
+	if x.Prepayment != nil {
+		value := protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
+		if !f(fd_MsgRequestEquityLoan_prepayment, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestEquityLoan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		return x.Collateral != ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		return x.Amount != uint64(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		return x.Amortization != 0
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		return x.Prepayment != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		x.Collateral = ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		x.Amount = uint64(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		x.Amortization = 0
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		x.Prepayment = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestEquityLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		value := x.Prepayment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		x.Collateral = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		x.Amount = value.Uint()
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		x.Prepayment = value.Message().Interface().(*PrepaymentTerms)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		if x.Prepayment == nil {
+			x.Prepayment = new(PrepaymentTerms)
+		}
+		return protoreflect.ValueOfMessage(x.Prepayment.ProtoReflect())
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		panic(fmt.Errorf("field collateral of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.MsgRequestEquityLoan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestEquityLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestEquityLoan.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestEquityLoan.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestEquityLoan.collateral":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRequestEquityLoan.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.amortization":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgRequestEquityLoan.prepayment":
+		m := new(PrepaymentTerms)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoan"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestEquityLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRequestEquityLoan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestEquityLoan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestEquityLoan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestEquityLoan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestEquityLoan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Collateral)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.Prepayment != nil {
+			l = options.Size(x.Prepayment)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestEquityLoan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Prepayment != nil {
+			encoded, err := options.Marshal(x.Prepayment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Collateral) > 0 {
+			i -= len(x.Collateral)
+			copy(dAtA[i:], x.Collateral)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestEquityLoan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestEquityLoan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestEquityLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collateral = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+				}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Prepayment == nil {
+					x.Prepayment = &PrepaymentTerms{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prepayment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRequestEquityLoanResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRequestEquityLoanResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRequestEquityLoanResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRequestEquityLoanResponse)(nil)
+
+type fastReflection_MsgRequestEquityLoanResponse MsgRequestEquityLoanResponse
+
+func (x *MsgRequestEquityLoanResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRequestEquityLoanResponse)(x)
+}
+
+func (x *MsgRequestEquityLoanResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRequestEquityLoanResponse_messageType fastReflection_MsgRequestEquityLoanResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRequestEquityLoanResponse_messageType{}
+
+type fastReflection_MsgRequestEquityLoanResponse_messageType struct{}
+
+func (x fastReflection_MsgRequestEquityLoanResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRequestEquityLoanResponse)(nil)
+}
+func (x fastReflection_MsgRequestEquityLoanResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestEquityLoanResponse)
+}
+func (x fastReflection_MsgRequestEquityLoanResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestEquityLoanResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRequestEquityLoanResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRequestEquityLoanResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRequestEquityLoanResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRequestEquityLoanResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRequestEquityLoanResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoanResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRequestEquityLoanResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRequestEquityLoanResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRequestEquityLoanResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRequestEquityLoanResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRequestEquityLoanResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRequestEquityLoanResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRequestEquityLoanResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRequestEquityLoanResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRequestEquityLoanResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRequestEquityLoanResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestEquityLoanResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRequestEquityLoanResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestEquityLoanResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRequestEquityLoanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgForeclose         protoreflect.MessageDescriptor
+	fd_MsgForeclose_lender  protoreflect.FieldDescriptor
+	fd_MsgForeclose_index   protoreflect.FieldDescriptor
+	fd_MsgForeclose_auction protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgForeclose = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgForeclose")
+	fd_MsgForeclose_lender = md_MsgForeclose.Fields().ByName("lender")
+	fd_MsgForeclose_index = md_MsgForeclose.Fields().ByName("index")
+	fd_MsgForeclose_auction = md_MsgForeclose.Fields().ByName("auction")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForeclose)(nil)
+
+type fastReflection_MsgForeclose MsgForeclose
+
+func (x *MsgForeclose) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForeclose)(x)
+}
+
+func (x *MsgForeclose) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForeclose_messageType fastReflection_MsgForeclose_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForeclose_messageType{}
+
+type fastReflection_MsgForeclose_messageType struct{}
+
+func (x fastReflection_MsgForeclose_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForeclose)(nil)
+}
+func (x fastReflection_MsgForeclose_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForeclose)
+}
+func (x fastReflection_MsgForeclose_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForeclose
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForeclose) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForeclose
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForeclose) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForeclose_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForeclose) New() protoreflect.Message {
+	return new(fastReflection_MsgForeclose)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForeclose) Interface() protoreflect.ProtoMessage {
+	return (*MsgForeclose)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForeclose) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgForeclose_lender, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgForeclose_index, value) {
+			return
+		}
+	}
+	if x.Auction != false {
+		value := protoreflect.ValueOfBool(x.Auction)
+		if !f(fd_MsgForeclose_auction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForeclose) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgForeclose.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		return x.Auction != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForeclose) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgForeclose.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		x.Auction = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForeclose) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgForeclose.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		value := x.Auction
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForeclose) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgForeclose.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		x.Auction = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForeclose) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgForeclose is not mutable"))
+	case "ardapoc.mortgage.MsgForeclose.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgForeclose is not mutable"))
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		panic(fmt.Errorf("field auction of message ardapoc.mortgage.MsgForeclose is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForeclose) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgForeclose.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgForeclose.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgForeclose.auction":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForeclose"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForeclose does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForeclose) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgForeclose", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForeclose) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForeclose) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForeclose) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForeclose) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForeclose)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Auction {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForeclose)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Auction {
+			i--
+			if x.Auction {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForeclose)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForeclose: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForeclose: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Auction = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgForecloseResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgForecloseResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgForecloseResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgForecloseResponse)(nil)
+
+type fastReflection_MsgForecloseResponse MsgForecloseResponse
+
+func (x *MsgForecloseResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgForecloseResponse)(x)
+}
+
+func (x *MsgForecloseResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgForecloseResponse_messageType fastReflection_MsgForecloseResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgForecloseResponse_messageType{}
+
+type fastReflection_MsgForecloseResponse_messageType struct{}
+
+func (x fastReflection_MsgForecloseResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgForecloseResponse)(nil)
+}
+func (x fastReflection_MsgForecloseResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgForecloseResponse)
+}
+func (x fastReflection_MsgForecloseResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForecloseResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgForecloseResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgForecloseResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgForecloseResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgForecloseResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgForecloseResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgForecloseResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgForecloseResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgForecloseResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgForecloseResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgForecloseResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForecloseResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgForecloseResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForecloseResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForecloseResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgForecloseResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgForecloseResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgForecloseResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgForecloseResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgForecloseResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgForecloseResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgForecloseResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgForecloseResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgForecloseResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgForecloseResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForecloseResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgForecloseResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForecloseResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgForecloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBidForeclosure        protoreflect.MessageDescriptor
+	fd_MsgBidForeclosure_bidder protoreflect.FieldDescriptor
+	fd_MsgBidForeclosure_index  protoreflect.FieldDescriptor
+	fd_MsgBidForeclosure_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgBidForeclosure = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgBidForeclosure")
+	fd_MsgBidForeclosure_bidder = md_MsgBidForeclosure.Fields().ByName("bidder")
+	fd_MsgBidForeclosure_index = md_MsgBidForeclosure.Fields().ByName("index")
+	fd_MsgBidForeclosure_amount = md_MsgBidForeclosure.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBidForeclosure)(nil)
+
+type fastReflection_MsgBidForeclosure MsgBidForeclosure
+
+func (x *MsgBidForeclosure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBidForeclosure)(x)
+}
+
+func (x *MsgBidForeclosure) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBidForeclosure_messageType fastReflection_MsgBidForeclosure_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBidForeclosure_messageType{}
+
+type fastReflection_MsgBidForeclosure_messageType struct{}
+
+func (x fastReflection_MsgBidForeclosure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBidForeclosure)(nil)
+}
+func (x fastReflection_MsgBidForeclosure_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBidForeclosure)
+}
+func (x fastReflection_MsgBidForeclosure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidForeclosure
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBidForeclosure) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidForeclosure
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBidForeclosure) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBidForeclosure_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBidForeclosure) New() protoreflect.Message {
+	return new(fastReflection_MsgBidForeclosure)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBidForeclosure) Interface() protoreflect.ProtoMessage {
+	return (*MsgBidForeclosure)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBidForeclosure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Bidder != "" {
+		value := protoreflect.ValueOfString(x.Bidder)
+		if !f(fd_MsgBidForeclosure_bidder, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgBidForeclosure_index, value) {
+			return
+		}
+	}
+	if x.Amount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Amount)
+		if !f(fd_MsgBidForeclosure_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBidForeclosure) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		return x.Bidder != ""
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		return x.Amount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosure) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		x.Bidder = ""
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		x.Amount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBidForeclosure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		value := x.Amount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		x.Bidder = value.Interface().(string)
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		x.Amount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		panic(fmt.Errorf("field bidder of message ardapoc.mortgage.MsgBidForeclosure is not mutable"))
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgBidForeclosure is not mutable"))
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		panic(fmt.Errorf("field amount of message ardapoc.mortgage.MsgBidForeclosure is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBidForeclosure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgBidForeclosure.bidder":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgBidForeclosure.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgBidForeclosure.amount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosure"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosure does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBidForeclosure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgBidForeclosure", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBidForeclosure) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosure) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBidForeclosure) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBidForeclosure) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBidForeclosure)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidForeclosure)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidForeclosure)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidForeclosure: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidForeclosure: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				x.Amount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBidForeclosureResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgBidForeclosureResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgBidForeclosureResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBidForeclosureResponse)(nil)
+
+type fastReflection_MsgBidForeclosureResponse MsgBidForeclosureResponse
+
+func (x *MsgBidForeclosureResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBidForeclosureResponse)(x)
+}
+
+func (x *MsgBidForeclosureResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBidForeclosureResponse_messageType fastReflection_MsgBidForeclosureResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBidForeclosureResponse_messageType{}
+
+type fastReflection_MsgBidForeclosureResponse_messageType struct{}
+
+func (x fastReflection_MsgBidForeclosureResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBidForeclosureResponse)(nil)
+}
+func (x fastReflection_MsgBidForeclosureResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBidForeclosureResponse)
+}
+func (x fastReflection_MsgBidForeclosureResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidForeclosureResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBidForeclosureResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidForeclosureResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBidForeclosureResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBidForeclosureResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBidForeclosureResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBidForeclosureResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBidForeclosureResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBidForeclosureResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBidForeclosureResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBidForeclosureResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosureResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBidForeclosureResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosureResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosureResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBidForeclosureResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgBidForeclosureResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgBidForeclosureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBidForeclosureResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgBidForeclosureResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBidForeclosureResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidForeclosureResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBidForeclosureResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBidForeclosureResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBidForeclosureResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidForeclosureResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidForeclosureResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidForeclosureResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidForeclosureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProposeRefinance                   protoreflect.MessageDescriptor
+	fd_MsgProposeRefinance_lender            protoreflect.FieldDescriptor
+	fd_MsgProposeRefinance_index             protoreflect.FieldDescriptor
+	fd_MsgProposeRefinance_interest_rate     protoreflect.FieldDescriptor
+	fd_MsgProposeRefinance_term_months       protoreflect.FieldDescriptor
+	fd_MsgProposeRefinance_payment_frequency protoreflect.FieldDescriptor
+	fd_MsgProposeRefinance_amortization      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgProposeRefinance = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgProposeRefinance")
+	fd_MsgProposeRefinance_lender = md_MsgProposeRefinance.Fields().ByName("lender")
+	fd_MsgProposeRefinance_index = md_MsgProposeRefinance.Fields().ByName("index")
+	fd_MsgProposeRefinance_interest_rate = md_MsgProposeRefinance.Fields().ByName("interest_rate")
+	fd_MsgProposeRefinance_term_months = md_MsgProposeRefinance.Fields().ByName("term_months")
+	fd_MsgProposeRefinance_payment_frequency = md_MsgProposeRefinance.Fields().ByName("payment_frequency")
+	fd_MsgProposeRefinance_amortization = md_MsgProposeRefinance.Fields().ByName("amortization")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProposeRefinance)(nil)
+
+type fastReflection_MsgProposeRefinance MsgProposeRefinance
+
+func (x *MsgProposeRefinance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeRefinance)(x)
+}
+
+func (x *MsgProposeRefinance) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProposeRefinance_messageType fastReflection_MsgProposeRefinance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeRefinance_messageType{}
+
+type fastReflection_MsgProposeRefinance_messageType struct{}
+
+func (x fastReflection_MsgProposeRefinance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeRefinance)(nil)
+}
+func (x fastReflection_MsgProposeRefinance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeRefinance)
+}
+func (x fastReflection_MsgProposeRefinance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeRefinance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeRefinance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeRefinance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeRefinance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeRefinance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeRefinance) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeRefinance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeRefinance) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeRefinance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeRefinance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgProposeRefinance_lender, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgProposeRefinance_index, value) {
+			return
+		}
+	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_MsgProposeRefinance_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_MsgProposeRefinance_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_MsgProposeRefinance_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_MsgProposeRefinance_amortization, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeRefinance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		return x.Amortization != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		x.Amortization = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeRefinance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.MsgProposeRefinance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeRefinance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgProposeRefinance.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgProposeRefinance.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgProposeRefinance.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgProposeRefinance.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MsgProposeRefinance.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgProposeRefinance.amortization":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeRefinance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgProposeRefinance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeRefinance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeRefinance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeRefinance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeRefinance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeRefinance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeRefinance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeRefinance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+				}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProposeRefinanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgProposeRefinanceResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgProposeRefinanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProposeRefinanceResponse)(nil)
+
+type fastReflection_MsgProposeRefinanceResponse MsgProposeRefinanceResponse
+
+func (x *MsgProposeRefinanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeRefinanceResponse)(x)
+}
+
+func (x *MsgProposeRefinanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProposeRefinanceResponse_messageType fastReflection_MsgProposeRefinanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeRefinanceResponse_messageType{}
+
+type fastReflection_MsgProposeRefinanceResponse_messageType struct{}
+
+func (x fastReflection_MsgProposeRefinanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeRefinanceResponse)(nil)
+}
+func (x fastReflection_MsgProposeRefinanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeRefinanceResponse)
+}
+func (x fastReflection_MsgProposeRefinanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeRefinanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeRefinanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeRefinanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeRefinanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeRefinanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeRefinanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeRefinanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeRefinanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeRefinanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeRefinanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeRefinanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeRefinanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeRefinanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgProposeRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgProposeRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeRefinanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgProposeRefinanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeRefinanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeRefinanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeRefinanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeRefinanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeRefinanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeRefinanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeRefinanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeRefinanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptRefinance                   protoreflect.MessageDescriptor
+	fd_MsgAcceptRefinance_lendee            protoreflect.FieldDescriptor
+	fd_MsgAcceptRefinance_index             protoreflect.FieldDescriptor
+	fd_MsgAcceptRefinance_interest_rate     protoreflect.FieldDescriptor
+	fd_MsgAcceptRefinance_term_months       protoreflect.FieldDescriptor
+	fd_MsgAcceptRefinance_payment_frequency protoreflect.FieldDescriptor
+	fd_MsgAcceptRefinance_amortization      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgAcceptRefinance = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgAcceptRefinance")
+	fd_MsgAcceptRefinance_lendee = md_MsgAcceptRefinance.Fields().ByName("lendee")
+	fd_MsgAcceptRefinance_index = md_MsgAcceptRefinance.Fields().ByName("index")
+	fd_MsgAcceptRefinance_interest_rate = md_MsgAcceptRefinance.Fields().ByName("interest_rate")
+	fd_MsgAcceptRefinance_term_months = md_MsgAcceptRefinance.Fields().ByName("term_months")
+	fd_MsgAcceptRefinance_payment_frequency = md_MsgAcceptRefinance.Fields().ByName("payment_frequency")
+	fd_MsgAcceptRefinance_amortization = md_MsgAcceptRefinance.Fields().ByName("amortization")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptRefinance)(nil)
+
+type fastReflection_MsgAcceptRefinance MsgAcceptRefinance
+
+func (x *MsgAcceptRefinance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptRefinance)(x)
+}
+
+func (x *MsgAcceptRefinance) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptRefinance_messageType fastReflection_MsgAcceptRefinance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptRefinance_messageType{}
+
+type fastReflection_MsgAcceptRefinance_messageType struct{}
+
+func (x fastReflection_MsgAcceptRefinance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptRefinance)(nil)
+}
+func (x fastReflection_MsgAcceptRefinance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptRefinance)
+}
+func (x fastReflection_MsgAcceptRefinance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptRefinance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptRefinance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptRefinance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptRefinance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptRefinance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptRefinance) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptRefinance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptRefinance) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptRefinance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptRefinance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgAcceptRefinance_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgAcceptRefinance_index, value) {
+			return
+		}
+	}
+	if x.InterestRate != "" {
+		value := protoreflect.ValueOfString(x.InterestRate)
+		if !f(fd_MsgAcceptRefinance_interest_rate, value) {
+			return
+		}
+	}
+	if x.TermMonths != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TermMonths)
+		if !f(fd_MsgAcceptRefinance_term_months, value) {
+			return
+		}
+	}
+	if x.PaymentFrequency != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PaymentFrequency))
+		if !f(fd_MsgAcceptRefinance_payment_frequency, value) {
+			return
+		}
+	}
+	if x.Amortization != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Amortization))
+		if !f(fd_MsgAcceptRefinance_amortization, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptRefinance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		return x.InterestRate != ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		return x.TermMonths != uint32(0)
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		return x.PaymentFrequency != 0
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		return x.Amortization != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		x.InterestRate = ""
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		x.TermMonths = uint32(0)
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		x.PaymentFrequency = 0
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		x.Amortization = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptRefinance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		value := x.InterestRate
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		value := x.TermMonths
+		return protoreflect.ValueOfUint32(value)
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		value := x.PaymentFrequency
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		value := x.Amortization
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		x.InterestRate = value.Interface().(string)
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		x.TermMonths = uint32(value.Uint())
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		x.PaymentFrequency = (PaymentFrequency)(value.Enum())
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		x.Amortization = (AmortizationType)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		panic(fmt.Errorf("field interest_rate of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		panic(fmt.Errorf("field term_months of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		panic(fmt.Errorf("field payment_frequency of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		panic(fmt.Errorf("field amortization of message ardapoc.mortgage.MsgAcceptRefinance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptRefinance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgAcceptRefinance.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgAcceptRefinance.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgAcceptRefinance.interest_rate":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgAcceptRefinance.term_months":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "ardapoc.mortgage.MsgAcceptRefinance.payment_frequency":
+		return protoreflect.ValueOfEnum(0)
+	case "ardapoc.mortgage.MsgAcceptRefinance.amortization":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptRefinance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgAcceptRefinance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptRefinance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptRefinance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptRefinance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptRefinance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InterestRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TermMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.TermMonths))
+		}
+		if x.PaymentFrequency != 0 {
+			n += 1 + runtime.Sov(uint64(x.PaymentFrequency))
+		}
+		if x.Amortization != 0 {
+			n += 1 + runtime.Sov(uint64(x.Amortization))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptRefinance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amortization != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Amortization))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.PaymentFrequency != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PaymentFrequency))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TermMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TermMonths))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.InterestRate) > 0 {
+			i -= len(x.InterestRate)
+			copy(dAtA[i:], x.InterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InterestRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptRefinance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptRefinance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InterestRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+				}
+				x.TermMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TermMonths |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+				}
+				x.PaymentFrequency = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+				}
+				x.Amortization = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Amortization |= AmortizationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAcceptRefinanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgAcceptRefinanceResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgAcceptRefinanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAcceptRefinanceResponse)(nil)
+
+type fastReflection_MsgAcceptRefinanceResponse MsgAcceptRefinanceResponse
+
+func (x *MsgAcceptRefinanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptRefinanceResponse)(x)
+}
+
+func (x *MsgAcceptRefinanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAcceptRefinanceResponse_messageType fastReflection_MsgAcceptRefinanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptRefinanceResponse_messageType{}
+
+type fastReflection_MsgAcceptRefinanceResponse_messageType struct{}
+
+func (x fastReflection_MsgAcceptRefinanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptRefinanceResponse)(nil)
+}
+func (x fastReflection_MsgAcceptRefinanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptRefinanceResponse)
+}
+func (x fastReflection_MsgAcceptRefinanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptRefinanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptRefinanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptRefinanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAcceptRefinanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptRefinanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptRefinanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAcceptRefinanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgAcceptRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgAcceptRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAcceptRefinanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgAcceptRefinanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAcceptRefinanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAcceptRefinanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAcceptRefinanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAcceptRefinanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAcceptRefinanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptRefinanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAcceptRefinanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptRefinanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRejectRefinance        protoreflect.MessageDescriptor
+	fd_MsgRejectRefinance_lendee protoreflect.FieldDescriptor
+	fd_MsgRejectRefinance_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRejectRefinance = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRejectRefinance")
+	fd_MsgRejectRefinance_lendee = md_MsgRejectRefinance.Fields().ByName("lendee")
+	fd_MsgRejectRefinance_index = md_MsgRejectRefinance.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRejectRefinance)(nil)
+
+type fastReflection_MsgRejectRefinance MsgRejectRefinance
+
+func (x *MsgRejectRefinance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRejectRefinance)(x)
+}
+
+func (x *MsgRejectRefinance) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRejectRefinance_messageType fastReflection_MsgRejectRefinance_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRejectRefinance_messageType{}
+
+type fastReflection_MsgRejectRefinance_messageType struct{}
+
+func (x fastReflection_MsgRejectRefinance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRejectRefinance)(nil)
+}
+func (x fastReflection_MsgRejectRefinance_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectRefinance)
+}
+func (x fastReflection_MsgRejectRefinance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectRefinance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRejectRefinance) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectRefinance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRejectRefinance) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRejectRefinance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRejectRefinance) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectRefinance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRejectRefinance) Interface() protoreflect.ProtoMessage {
+	return (*MsgRejectRefinance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRejectRefinance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgRejectRefinance_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgRejectRefinance_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRejectRefinance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRejectRefinance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgRejectRefinance is not mutable"))
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgRejectRefinance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRejectRefinance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgRejectRefinance.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgRejectRefinance.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinance"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRejectRefinance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRejectRefinance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRejectRefinance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRejectRefinance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRejectRefinance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRejectRefinance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectRefinance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectRefinance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectRefinance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRejectRefinanceResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgRejectRefinanceResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgRejectRefinanceResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRejectRefinanceResponse)(nil)
+
+type fastReflection_MsgRejectRefinanceResponse MsgRejectRefinanceResponse
+
+func (x *MsgRejectRefinanceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRejectRefinanceResponse)(x)
+}
+
+func (x *MsgRejectRefinanceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRejectRefinanceResponse_messageType fastReflection_MsgRejectRefinanceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRejectRefinanceResponse_messageType{}
+
+type fastReflection_MsgRejectRefinanceResponse_messageType struct{}
+
+func (x fastReflection_MsgRejectRefinanceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRejectRefinanceResponse)(nil)
+}
+func (x fastReflection_MsgRejectRefinanceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectRefinanceResponse)
+}
+func (x fastReflection_MsgRejectRefinanceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectRefinanceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRejectRefinanceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRejectRefinanceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRejectRefinanceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRejectRefinanceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRejectRefinanceResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRejectRefinanceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRejectRefinanceResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRejectRefinanceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRejectRefinanceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRejectRefinanceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinanceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRejectRefinanceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinanceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinanceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRejectRefinanceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgRejectRefinanceResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgRejectRefinanceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRejectRefinanceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgRejectRefinanceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRejectRefinanceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRejectRefinanceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRejectRefinanceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRejectRefinanceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRejectRefinanceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectRefinanceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRejectRefinanceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectRefinanceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRejectRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferLoanNote           protoreflect.MessageDescriptor
+	fd_MsgTransferLoanNote_holder    protoreflect.FieldDescriptor
+	fd_MsgTransferLoanNote_index     protoreflect.FieldDescriptor
+	fd_MsgTransferLoanNote_recipient protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgTransferLoanNote = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgTransferLoanNote")
+	fd_MsgTransferLoanNote_holder = md_MsgTransferLoanNote.Fields().ByName("holder")
+	fd_MsgTransferLoanNote_index = md_MsgTransferLoanNote.Fields().ByName("index")
+	fd_MsgTransferLoanNote_recipient = md_MsgTransferLoanNote.Fields().ByName("recipient")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferLoanNote)(nil)
+
+type fastReflection_MsgTransferLoanNote MsgTransferLoanNote
+
+func (x *MsgTransferLoanNote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferLoanNote)(x)
+}
+
+func (x *MsgTransferLoanNote) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferLoanNote_messageType fastReflection_MsgTransferLoanNote_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferLoanNote_messageType{}
+
+type fastReflection_MsgTransferLoanNote_messageType struct{}
+
+func (x fastReflection_MsgTransferLoanNote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferLoanNote)(nil)
+}
+func (x fastReflection_MsgTransferLoanNote_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferLoanNote)
+}
+func (x fastReflection_MsgTransferLoanNote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferLoanNote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferLoanNote) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferLoanNote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferLoanNote) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferLoanNote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferLoanNote) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferLoanNote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferLoanNote) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferLoanNote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferLoanNote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Holder != "" {
+		value := protoreflect.ValueOfString(x.Holder)
+		if !f(fd_MsgTransferLoanNote_holder, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgTransferLoanNote_index, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_MsgTransferLoanNote_recipient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferLoanNote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		return x.Holder != ""
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		return x.Index != ""
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		return x.Recipient != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		x.Holder = ""
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		x.Index = ""
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		x.Recipient = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferLoanNote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		value := x.Holder
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		x.Holder = value.Interface().(string)
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		x.Index = value.Interface().(string)
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		x.Recipient = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		panic(fmt.Errorf("field holder of message ardapoc.mortgage.MsgTransferLoanNote is not mutable"))
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgTransferLoanNote is not mutable"))
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		panic(fmt.Errorf("field recipient of message ardapoc.mortgage.MsgTransferLoanNote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferLoanNote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgTransferLoanNote.holder":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgTransferLoanNote.index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgTransferLoanNote.recipient":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNote"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferLoanNote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgTransferLoanNote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferLoanNote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferLoanNote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferLoanNote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferLoanNote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Holder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferLoanNote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Holder) > 0 {
+			i -= len(x.Holder)
+			copy(dAtA[i:], x.Holder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Holder)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferLoanNote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferLoanNote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferLoanNote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Holder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferLoanNoteResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgTransferLoanNoteResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgTransferLoanNoteResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferLoanNoteResponse)(nil)
+
+type fastReflection_MsgTransferLoanNoteResponse MsgTransferLoanNoteResponse
+
+func (x *MsgTransferLoanNoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferLoanNoteResponse)(x)
+}
+
+func (x *MsgTransferLoanNoteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferLoanNoteResponse_messageType fastReflection_MsgTransferLoanNoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferLoanNoteResponse_messageType{}
+
+type fastReflection_MsgTransferLoanNoteResponse_messageType struct{}
+
+func (x fastReflection_MsgTransferLoanNoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferLoanNoteResponse)(nil)
+}
+func (x fastReflection_MsgTransferLoanNoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferLoanNoteResponse)
+}
+func (x fastReflection_MsgTransferLoanNoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferLoanNoteResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferLoanNoteResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferLoanNoteResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferLoanNoteResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferLoanNoteResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferLoanNoteResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferLoanNoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgTransferLoanNoteResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgTransferLoanNoteResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferLoanNoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgTransferLoanNoteResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferLoanNoteResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferLoanNoteResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferLoanNoteResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferLoanNoteResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferLoanNoteResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferLoanNoteResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferLoanNoteResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferLoanNoteResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferLoanNoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgJoinSyndicate        protoreflect.MessageDescriptor
+	fd_MsgJoinSyndicate_lender protoreflect.FieldDescriptor
+	fd_MsgJoinSyndicate_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgJoinSyndicate = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgJoinSyndicate")
+	fd_MsgJoinSyndicate_lender = md_MsgJoinSyndicate.Fields().ByName("lender")
+	fd_MsgJoinSyndicate_index = md_MsgJoinSyndicate.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgJoinSyndicate)(nil)
+
+type fastReflection_MsgJoinSyndicate MsgJoinSyndicate
+
+func (x *MsgJoinSyndicate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgJoinSyndicate)(x)
+}
+
+func (x *MsgJoinSyndicate) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgJoinSyndicate_messageType fastReflection_MsgJoinSyndicate_messageType
+var _ protoreflect.MessageType = fastReflection_MsgJoinSyndicate_messageType{}
+
+type fastReflection_MsgJoinSyndicate_messageType struct{}
+
+func (x fastReflection_MsgJoinSyndicate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgJoinSyndicate)(nil)
+}
+func (x fastReflection_MsgJoinSyndicate_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinSyndicate)
+}
+func (x fastReflection_MsgJoinSyndicate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinSyndicate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgJoinSyndicate) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinSyndicate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgJoinSyndicate) Type() protoreflect.MessageType {
+	return _fastReflection_MsgJoinSyndicate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgJoinSyndicate) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinSyndicate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgJoinSyndicate) Interface() protoreflect.ProtoMessage {
+	return (*MsgJoinSyndicate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgJoinSyndicate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lender != "" {
+		value := protoreflect.ValueOfString(x.Lender)
+		if !f(fd_MsgJoinSyndicate_lender, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgJoinSyndicate_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgJoinSyndicate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		return x.Lender != ""
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		x.Lender = ""
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgJoinSyndicate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		value := x.Lender
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		x.Lender = value.Interface().(string)
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		panic(fmt.Errorf("field lender of message ardapoc.mortgage.MsgJoinSyndicate is not mutable"))
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgJoinSyndicate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgJoinSyndicate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgJoinSyndicate.lender":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgJoinSyndicate.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicate"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgJoinSyndicate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgJoinSyndicate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgJoinSyndicate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgJoinSyndicate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgJoinSyndicate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgJoinSyndicate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinSyndicate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lender) > 0 {
+			i -= len(x.Lender)
+			copy(dAtA[i:], x.Lender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinSyndicate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinSyndicate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinSyndicate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgJoinSyndicateResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgJoinSyndicateResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgJoinSyndicateResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgJoinSyndicateResponse)(nil)
+
+type fastReflection_MsgJoinSyndicateResponse MsgJoinSyndicateResponse
+
+func (x *MsgJoinSyndicateResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgJoinSyndicateResponse)(x)
+}
+
+func (x *MsgJoinSyndicateResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgJoinSyndicateResponse_messageType fastReflection_MsgJoinSyndicateResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgJoinSyndicateResponse_messageType{}
+
+type fastReflection_MsgJoinSyndicateResponse_messageType struct{}
+
+func (x fastReflection_MsgJoinSyndicateResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgJoinSyndicateResponse)(nil)
+}
+func (x fastReflection_MsgJoinSyndicateResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinSyndicateResponse)
+}
+func (x fastReflection_MsgJoinSyndicateResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinSyndicateResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgJoinSyndicateResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgJoinSyndicateResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgJoinSyndicateResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgJoinSyndicateResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgJoinSyndicateResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgJoinSyndicateResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgJoinSyndicateResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgJoinSyndicateResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgJoinSyndicateResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgJoinSyndicateResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicateResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgJoinSyndicateResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicateResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicateResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgJoinSyndicateResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgJoinSyndicateResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgJoinSyndicateResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgJoinSyndicateResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgJoinSyndicateResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgJoinSyndicateResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgJoinSyndicateResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgJoinSyndicateResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgJoinSyndicateResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgJoinSyndicateResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinSyndicateResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgJoinSyndicateResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinSyndicateResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgJoinSyndicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEnableAutoRepayment        protoreflect.MessageDescriptor
+	fd_MsgEnableAutoRepayment_lendee protoreflect.FieldDescriptor
+	fd_MsgEnableAutoRepayment_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgEnableAutoRepayment = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgEnableAutoRepayment")
+	fd_MsgEnableAutoRepayment_lendee = md_MsgEnableAutoRepayment.Fields().ByName("lendee")
+	fd_MsgEnableAutoRepayment_index = md_MsgEnableAutoRepayment.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEnableAutoRepayment)(nil)
+
+type fastReflection_MsgEnableAutoRepayment MsgEnableAutoRepayment
+
+func (x *MsgEnableAutoRepayment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEnableAutoRepayment)(x)
+}
+
+func (x *MsgEnableAutoRepayment) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEnableAutoRepayment_messageType fastReflection_MsgEnableAutoRepayment_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEnableAutoRepayment_messageType{}
+
+type fastReflection_MsgEnableAutoRepayment_messageType struct{}
+
+func (x fastReflection_MsgEnableAutoRepayment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEnableAutoRepayment)(nil)
+}
+func (x fastReflection_MsgEnableAutoRepayment_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEnableAutoRepayment)
+}
+func (x fastReflection_MsgEnableAutoRepayment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEnableAutoRepayment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEnableAutoRepayment) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEnableAutoRepayment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEnableAutoRepayment) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEnableAutoRepayment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEnableAutoRepayment) New() protoreflect.Message {
+	return new(fastReflection_MsgEnableAutoRepayment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEnableAutoRepayment) Interface() protoreflect.ProtoMessage {
+	return (*MsgEnableAutoRepayment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEnableAutoRepayment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgEnableAutoRepayment_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgEnableAutoRepayment_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEnableAutoRepayment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepayment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEnableAutoRepayment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepayment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepayment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgEnableAutoRepayment is not mutable"))
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgEnableAutoRepayment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEnableAutoRepayment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgEnableAutoRepayment.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEnableAutoRepayment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgEnableAutoRepayment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEnableAutoRepayment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepayment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEnableAutoRepayment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEnableAutoRepayment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEnableAutoRepayment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEnableAutoRepayment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEnableAutoRepayment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEnableAutoRepayment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEnableAutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgEnableAutoRepaymentResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgEnableAutoRepaymentResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgEnableAutoRepaymentResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgEnableAutoRepaymentResponse)(nil)
+
+type fastReflection_MsgEnableAutoRepaymentResponse MsgEnableAutoRepaymentResponse
+
+func (x *MsgEnableAutoRepaymentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgEnableAutoRepaymentResponse)(x)
+}
+
+func (x *MsgEnableAutoRepaymentResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgEnableAutoRepaymentResponse_messageType fastReflection_MsgEnableAutoRepaymentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgEnableAutoRepaymentResponse_messageType{}
+
+type fastReflection_MsgEnableAutoRepaymentResponse_messageType struct{}
+
+func (x fastReflection_MsgEnableAutoRepaymentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgEnableAutoRepaymentResponse)(nil)
+}
+func (x fastReflection_MsgEnableAutoRepaymentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgEnableAutoRepaymentResponse)
+}
+func (x fastReflection_MsgEnableAutoRepaymentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEnableAutoRepaymentResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgEnableAutoRepaymentResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgEnableAutoRepaymentResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgEnableAutoRepaymentResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgEnableAutoRepaymentResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgEnableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgEnableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgEnableAutoRepaymentResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgEnableAutoRepaymentResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgEnableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEnableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgEnableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEnableAutoRepaymentResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgEnableAutoRepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDisableAutoRepayment        protoreflect.MessageDescriptor
+	fd_MsgDisableAutoRepayment_lendee protoreflect.FieldDescriptor
+	fd_MsgDisableAutoRepayment_index  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgDisableAutoRepayment = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDisableAutoRepayment")
+	fd_MsgDisableAutoRepayment_lendee = md_MsgDisableAutoRepayment.Fields().ByName("lendee")
+	fd_MsgDisableAutoRepayment_index = md_MsgDisableAutoRepayment.Fields().ByName("index")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDisableAutoRepayment)(nil)
+
+type fastReflection_MsgDisableAutoRepayment MsgDisableAutoRepayment
+
+func (x *MsgDisableAutoRepayment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDisableAutoRepayment)(x)
+}
+
+func (x *MsgDisableAutoRepayment) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDisableAutoRepayment_messageType fastReflection_MsgDisableAutoRepayment_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDisableAutoRepayment_messageType{}
+
+type fastReflection_MsgDisableAutoRepayment_messageType struct{}
+
+func (x fastReflection_MsgDisableAutoRepayment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDisableAutoRepayment)(nil)
+}
+func (x fastReflection_MsgDisableAutoRepayment_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDisableAutoRepayment)
+}
+func (x fastReflection_MsgDisableAutoRepayment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisableAutoRepayment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDisableAutoRepayment) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisableAutoRepayment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDisableAutoRepayment) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDisableAutoRepayment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDisableAutoRepayment) New() protoreflect.Message {
+	return new(fastReflection_MsgDisableAutoRepayment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDisableAutoRepayment) Interface() protoreflect.ProtoMessage {
+	return (*MsgDisableAutoRepayment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDisableAutoRepayment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lendee != "" {
+		value := protoreflect.ValueOfString(x.Lendee)
+		if !f(fd_MsgDisableAutoRepayment_lendee, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_MsgDisableAutoRepayment_index, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDisableAutoRepayment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		return x.Lendee != ""
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		return x.Index != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepayment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		x.Lendee = ""
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		x.Index = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDisableAutoRepayment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		value := x.Lendee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepayment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		x.Lendee = value.Interface().(string)
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		x.Index = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepayment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		panic(fmt.Errorf("field lendee of message ardapoc.mortgage.MsgDisableAutoRepayment is not mutable"))
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		panic(fmt.Errorf("field index of message ardapoc.mortgage.MsgDisableAutoRepayment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDisableAutoRepayment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.lendee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.mortgage.MsgDisableAutoRepayment.index":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepayment"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepayment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDisableAutoRepayment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDisableAutoRepayment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDisableAutoRepayment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepayment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDisableAutoRepayment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDisableAutoRepayment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDisableAutoRepayment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lendee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisableAutoRepayment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lendee) > 0 {
+			i -= len(x.Lendee)
+			copy(dAtA[i:], x.Lendee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lendee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisableAutoRepayment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisableAutoRepayment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisableAutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lendee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDisableAutoRepaymentResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_ardapoc_mortgage_tx_proto_init()
+	md_MsgDisableAutoRepaymentResponse = File_ardapoc_mortgage_tx_proto.Messages().ByName("MsgDisableAutoRepaymentResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDisableAutoRepaymentResponse)(nil)
+
+type fastReflection_MsgDisableAutoRepaymentResponse MsgDisableAutoRepaymentResponse
+
+func (x *MsgDisableAutoRepaymentResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDisableAutoRepaymentResponse)(x)
+}
+
+func (x *MsgDisableAutoRepaymentResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_mortgage_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDisableAutoRepaymentResponse_messageType fastReflection_MsgDisableAutoRepaymentResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDisableAutoRepaymentResponse_messageType{}
+
+type fastReflection_MsgDisableAutoRepaymentResponse_messageType struct{}
+
+func (x fastReflection_MsgDisableAutoRepaymentResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDisableAutoRepaymentResponse)(nil)
+}
+func (x fastReflection_MsgDisableAutoRepaymentResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDisableAutoRepaymentResponse)
+}
+func (x fastReflection_MsgDisableAutoRepaymentResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisableAutoRepaymentResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisableAutoRepaymentResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDisableAutoRepaymentResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDisableAutoRepaymentResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDisableAutoRepaymentResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.mortgage.MsgDisableAutoRepaymentResponse"))
+		}
+		panic(fmt.Errorf("message ardapoc.mortgage.MsgDisableAutoRepaymentResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.mortgage.MsgDisableAutoRepaymentResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDisableAutoRepaymentResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDisableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisableAutoRepaymentResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisableAutoRepaymentResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisableAutoRepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: ardapoc/mortgage/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All parameters must be supplied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{1}
+}
+
+type MsgDeleteMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgDeleteMortgage) Reset() {
+	*x = MsgDeleteMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeleteMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeleteMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgDeleteMortgage.ProtoReflect.Descriptor instead.
+func (*MsgDeleteMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgDeleteMortgage) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgDeleteMortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgDeleteMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgDeleteMortgageResponse) Reset() {
+	*x = MsgDeleteMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeleteMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeleteMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDeleteMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgDeleteMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{3}
+}
+
+type MsgRepayMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	MortgageId string `protobuf:"bytes,2,opt,name=mortgageId,proto3" json:"mortgageId,omitempty"`
+	Amount     uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgRepayMortgage) Reset() {
+	*x = MsgRepayMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRepayMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRepayMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgRepayMortgage.ProtoReflect.Descriptor instead.
+func (*MsgRepayMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgRepayMortgage) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRepayMortgage) GetMortgageId() string {
+	if x != nil {
+		return x.MortgageId
+	}
+	return ""
+}
+
+func (x *MsgRepayMortgage) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// MsgRepayMortgageResponse breaks a repayment down into the late fees,
+// interest and principal it settled and the prepayment penalty charged on top.
+type MsgRepayMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LateFees  uint64 `protobuf:"varint,1,opt,name=late_fees,json=lateFees,proto3" json:"late_fees,omitempty"`
+	Interest  uint64 `protobuf:"varint,2,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal uint64 `protobuf:"varint,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Penalty   uint64 `protobuf:"varint,4,opt,name=penalty,proto3" json:"penalty,omitempty"`
+}
+
+func (x *MsgRepayMortgageResponse) Reset() {
+	*x = MsgRepayMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRepayMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRepayMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRepayMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgRepayMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgRepayMortgageResponse) GetLateFees() uint64 {
+	if x != nil {
+		return x.LateFees
+	}
+	return 0
+}
+
+func (x *MsgRepayMortgageResponse) GetInterest() uint64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *MsgRepayMortgageResponse) GetPrincipal() uint64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *MsgRepayMortgageResponse) GetPenalty() uint64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+type MsgRequestMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender           string           `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Collateral       string           `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount           uint64           `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	InterestRate     string           `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,8,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,9,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// purchase makes the mortgage finance buying the collateral shares. Such a
+	// request is approved with MsgPurchaseWithMortgage.
+	Purchase *MortgagePurchase `protobuf:"bytes,10,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// syndicate makes the mortgage funded by several lenders, led by the lender.
+	// Each other member commits with MsgJoinSyndicate before the lender approves.
+	Syndicate []*SyndicateLender `protobuf:"bytes,11,rep,name=syndicate,proto3" json:"syndicate,omitempty"`
+	// prepayment restricts repaying principal ahead of the schedule, if set.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,12,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+}
+
+func (x *MsgRequestMortgage) Reset() {
+	*x = MsgRequestMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestMortgage.ProtoReflect.Descriptor instead.
+func (*MsgRequestMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgRequestMortgage) GetLendee() string {
+	if x != nil {
+		return x.Lendee
+	}
+	return ""
+}
+
+func (x *MsgRequestMortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *MsgRequestMortgage) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgRequestMortgage) GetCollateral() string {
+	if x != nil {
+		return x.Collateral
+	}
+	return ""
+}
+
+func (x *MsgRequestMortgage) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MsgRequestMortgage) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *MsgRequestMortgage) GetTermMonths() uint32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *MsgRequestMortgage) GetPaymentFrequency() PaymentFrequency {
+	if x != nil {
+		return x.PaymentFrequency
+	}
+	return PaymentFrequency_MONTHLY
+}
+
+func (x *MsgRequestMortgage) GetAmortization() AmortizationType {
+	if x != nil {
+		return x.Amortization
+	}
+	return AmortizationType_ANNUITY
+}
+
+func (x *MsgRequestMortgage) GetPurchase() *MortgagePurchase {
+	if x != nil {
+		return x.Purchase
+	}
+	return nil
+}
+
+func (x *MsgRequestMortgage) GetSyndicate() []*SyndicateLender {
+	if x != nil {
+		return x.Syndicate
+	}
+	return nil
+}
+
+func (x *MsgRequestMortgage) GetPrepayment() *PrepaymentTerms {
+	if x != nil {
+		return x.Prepayment
+	}
+	return nil
+}
+
+type MsgRequestMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRequestMortgageResponse) Reset() {
+	*x = MsgRequestMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgRequestMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{7}
+}
+
+type MsgApproveMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgApproveMortgage) Reset() {
+	*x = MsgApproveMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgApproveMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgApproveMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgApproveMortgage.ProtoReflect.Descriptor instead.
+func (*MsgApproveMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgApproveMortgage) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgApproveMortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgApproveMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgApproveMortgageResponse) Reset() {
+	*x = MsgApproveMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgApproveMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgApproveMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgApproveMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgApproveMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgRejectMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgRejectMortgage) Reset() {
+	*x = MsgRejectMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRejectMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRejectMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgRejectMortgage.ProtoReflect.Descriptor instead.
+func (*MsgRejectMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgRejectMortgage) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgRejectMortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgRejectMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRejectMortgageResponse) Reset() {
+	*x = MsgRejectMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRejectMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRejectMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRejectMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgRejectMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{11}
+}
+
+type MsgCancelMortgageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgCancelMortgageRequest) Reset() {
+	*x = MsgCancelMortgageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelMortgageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelMortgageRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelMortgageRequest.ProtoReflect.Descriptor instead.
+func (*MsgCancelMortgageRequest) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgCancelMortgageRequest) GetLendee() string {
+	if x != nil {
+		return x.Lendee
+	}
+	return ""
+}
+
+func (x *MsgCancelMortgageRequest) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgCancelMortgageRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgCancelMortgageRequestResponse) Reset() {
+	*x = MsgCancelMortgageRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelMortgageRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelMortgageRequestResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelMortgageRequestResponse.ProtoReflect.Descriptor instead.
+func (*MsgCancelMortgageRequestResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{13}
+}
+
+type MsgPurchaseWithMortgage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgPurchaseWithMortgage) Reset() {
+	*x = MsgPurchaseWithMortgage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPurchaseWithMortgage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPurchaseWithMortgage) ProtoMessage() {}
+
+// Deprecated: Use MsgPurchaseWithMortgage.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseWithMortgage) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgPurchaseWithMortgage) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgPurchaseWithMortgage) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgPurchaseWithMortgageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgPurchaseWithMortgageResponse) Reset() {
+	*x = MsgPurchaseWithMortgageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPurchaseWithMortgageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPurchaseWithMortgageResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPurchaseWithMortgageResponse.ProtoReflect.Descriptor instead.
+func (*MsgPurchaseWithMortgageResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{15}
+}
+
+type MsgAcceptPurchase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgAcceptPurchase) Reset() {
+	*x = MsgAcceptPurchase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcceptPurchase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptPurchase) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptPurchase.ProtoReflect.Descriptor instead.
+func (*MsgAcceptPurchase) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgAcceptPurchase) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *MsgAcceptPurchase) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+type MsgAcceptPurchaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAcceptPurchaseResponse) Reset() {
+	*x = MsgAcceptPurchaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcceptPurchaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptPurchaseResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{17}
+}
+
+type MsgRequestEquityLoan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender           string           `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Collateral       string           `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount           uint64           `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	InterestRate     string           `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,8,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,9,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// prepayment restricts repaying principal ahead of the schedule, if set.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,10,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+}
+
+func (x *MsgRequestEquityLoan) Reset() {
+	*x = MsgRequestEquityLoan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestEquityLoan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestEquityLoan) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestEquityLoan.ProtoReflect.Descriptor instead.
+func (*MsgRequestEquityLoan) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgRequestEquityLoan) GetLendee() string {
+	if x != nil {
+		return x.Lendee
+	}
+	return ""
+}
+
+func (x *MsgRequestEquityLoan) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *MsgRequestEquityLoan) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgRequestEquityLoan) GetCollateral() string {
+	if x != nil {
+		return x.Collateral
+	}
+	return ""
+}
+
+func (x *MsgRequestEquityLoan) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MsgRequestEquityLoan) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
+
+func (x *MsgRequestEquityLoan) GetTermMonths() uint32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *MsgRequestEquityLoan) GetPaymentFrequency() PaymentFrequency {
+	if x != nil {
+		return x.PaymentFrequency
+	}
+	return PaymentFrequency_MONTHLY
+}
+
+func (x *MsgRequestEquityLoan) GetAmortization() AmortizationType {
+	if x != nil {
+		return x.Amortization
+	}
+	return AmortizationType_ANNUITY
+}
+
+func (x *MsgRequestEquityLoan) GetPrepayment() *PrepaymentTerms {
+	if x != nil {
+		return x.Prepayment
+	}
+	return nil
+}
+
+type MsgRequestEquityLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRequestEquityLoanResponse) Reset() {
+	*x = MsgRequestEquityLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRequestEquityLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRequestEquityLoanResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRequestEquityLoanResponse.ProtoReflect.Descriptor instead.
+func (*MsgRequestEquityLoanResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{19}
+}
+
+type MsgForeclose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// auction puts the escrowed shares up for auction instead of handing them to
+	// the lender. The proceeds pay off the debt and any surplus goes to the lendee.
+	Auction bool `protobuf:"varint,3,opt,name=auction,proto3" json:"auction,omitempty"`
+}
+
+func (x *MsgForeclose) Reset() {
+	*x = MsgForeclose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForeclose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForeclose) ProtoMessage() {}
+
+// Deprecated: Use MsgForeclose.ProtoReflect.Descriptor instead.
+func (*MsgForeclose) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgForeclose) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
+}
+
+func (x *MsgForeclose) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *MsgForeclose) GetAuction() bool {
+	if x != nil {
+		return x.Auction
+	}
+	return false
+}
+
+type MsgForecloseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgForecloseResponse) Reset() {
+	*x = MsgForecloseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgForecloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgForecloseResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgForecloseResponse.ProtoReflect.Descriptor instead.
+func (*MsgForecloseResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{21}
+}
+
+type MsgBidForeclosure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgBidForeclosure) Reset() {
+	*x = MsgBidForeclosure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBidForeclosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBidForeclosure) ProtoMessage() {}
+
+// Deprecated: Use MsgBidForeclosure.ProtoReflect.Descriptor instead.
+func (*MsgBidForeclosure) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgBidForeclosure) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *MsgBidForeclosure) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *MsgBidForeclosure) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type MsgBidForeclosureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgBidForeclosureResponse) Reset() {
+	*x = MsgBidForeclosureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBidForeclosureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBidForeclosureResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBidForeclosureResponse.ProtoReflect.Descriptor instead.
+func (*MsgBidForeclosureResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{23}
+}
+
+type MsgProposeRefinance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender           string           `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	InterestRate     string           `protobuf:"bytes,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,5,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,6,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+}
+
+func (x *MsgProposeRefinance) Reset() {
+	*x = MsgProposeRefinance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgProposeRefinance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgProposeRefinance) ProtoMessage() {}
+
+// Deprecated: Use MsgProposeRefinance.ProtoReflect.Descriptor instead.
+func (*MsgProposeRefinance) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MsgProposeRefinance) GetLender() string {
+	if x != nil {
+		return x.Lender
+	}
+	return ""
 }
 
-func (x *MsgUpdateParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MsgProposeRefinance) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
 }
 
-func (*MsgUpdateParams) ProtoMessage() {}
+func (x *MsgProposeRefinance) GetInterestRate() string {
+	if x != nil {
+		return x.InterestRate
+	}
+	return ""
+}
 
-// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{0}
+func (x *MsgProposeRefinance) GetTermMonths() uint32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
 }
 
-func (x *MsgUpdateParams) GetAuthority() string {
+func (x *MsgProposeRefinance) GetPaymentFrequency() PaymentFrequency {
 	if x != nil {
-		return x.Authority
+		return x.PaymentFrequency
 	}
-	return ""
+	return PaymentFrequency_MONTHLY
 }
 
-func (x *MsgUpdateParams) GetParams() *Params {
+func (x *MsgProposeRefinance) GetAmortization() AmortizationType {
 	if x != nil {
-		return x.Params
+		return x.Amortization
 	}
-	return nil
+	return AmortizationType_ANNUITY
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+type MsgProposeRefinanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateParamsResponse) Reset() {
-	*x = MsgUpdateParamsResponse{}
+func (x *MsgProposeRefinanceResponse) Reset() {
+	*x = MsgProposeRefinanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[1]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateParamsResponse) String() string {
+func (x *MsgProposeRefinanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateParamsResponse) ProtoMessage() {}
+func (*MsgProposeRefinanceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgProposeRefinanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgProposeRefinanceResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{25}
 }
 
-type MsgCreateMortgage struct {
+// MsgAcceptRefinance accepts the pending refinance proposal of a mortgage. The
+// terms must match the proposal, so a proposal changed in the meantime is not
+// accepted by accident.
+type MsgAcceptRefinance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender       string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee       string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral   string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount       uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate string `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term         string `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	InterestRate     string           `protobuf:"bytes,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,5,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,6,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
 }
 
-func (x *MsgCreateMortgage) Reset() {
-	*x = MsgCreateMortgage{}
+func (x *MsgAcceptRefinance) Reset() {
+	*x = MsgAcceptRefinance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[2]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateMortgage) String() string {
+func (x *MsgAcceptRefinance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateMortgage) ProtoMessage() {}
+func (*MsgAcceptRefinance) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateMortgage.ProtoReflect.Descriptor instead.
-func (*MsgCreateMortgage) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgAcceptRefinance.ProtoReflect.Descriptor instead.
+func (*MsgAcceptRefinance) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgCreateMortgage) GetCreator() string {
+func (x *MsgAcceptRefinance) GetLendee() string {
 	if x != nil {
-		return x.Creator
+		return x.Lendee
 	}
 	return ""
 }
 
-func (x *MsgCreateMortgage) GetIndex() string {
+func (x *MsgAcceptRefinance) GetIndex() string {
 	if x != nil {
 		return x.Index
 	}
 	return ""
 }
 
-func (x *MsgCreateMortgage) GetLender() string {
+func (x *MsgAcceptRefinance) GetInterestRate() string {
 	if x != nil {
-		return x.Lender
+		return x.InterestRate
 	}
 	return ""
 }
 
-func (x *MsgCreateMortgage) GetLendee() string {
+func (x *MsgAcceptRefinance) GetTermMonths() uint32 {
 	if x != nil {
-		return x.Lendee
+		return x.TermMonths
 	}
-	return ""
+	return 0
 }
 
-func (x *MsgCreateMortgage) GetCollateral() string {
+func (x *MsgAcceptRefinance) GetPaymentFrequency() PaymentFrequency {
 	if x != nil {
-		return x.Collateral
+		return x.PaymentFrequency
 	}
-	return ""
+	return PaymentFrequency_MONTHLY
 }
 
-func (x *MsgCreateMortgage) GetAmount() uint64 {
+func (x *MsgAcceptRefinance) GetAmortization() AmortizationType {
 	if x != nil {
-		return x.Amount
+		return x.Amortization
 	}
-	return 0
+	return AmortizationType_ANNUITY
+}
+
+type MsgAcceptRefinanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAcceptRefinanceResponse) Reset() {
+	*x = MsgAcceptRefinanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcceptRefinanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptRefinanceResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptRefinanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptRefinanceResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{27}
+}
+
+type MsgRejectRefinance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgRejectRefinance) Reset() {
+	*x = MsgRejectRefinance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRejectRefinance) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MsgCreateMortgage) GetInterestRate() string {
+func (*MsgRejectRefinance) ProtoMessage() {}
+
+// Deprecated: Use MsgRejectRefinance.ProtoReflect.Descriptor instead.
+func (*MsgRejectRefinance) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MsgRejectRefinance) GetLendee() string {
 	if x != nil {
-		return x.InterestRate
+		return x.Lendee
 	}
 	return ""
 }
 
-func (x *MsgCreateMortgage) GetTerm() string {
+func (x *MsgRejectRefinance) GetIndex() string {
 	if x != nil {
-		return x.Term
+		return x.Index
 	}
 	return ""
 }
 
-type MsgCreateMortgageResponse struct {
+type MsgRejectRefinanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgCreateMortgageResponse) Reset() {
-	*x = MsgCreateMortgageResponse{}
+func (x *MsgRejectRefinanceResponse) Reset() {
+	*x = MsgRejectRefinanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[3]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateMortgageResponse) String() string {
+func (x *MsgRejectRefinanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateMortgageResponse) ProtoMessage() {}
+func (*MsgRejectRefinanceResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateMortgageResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateMortgageResponse) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgRejectRefinanceResponse.ProtoReflect.Descriptor instead.
+func (*MsgRejectRefinanceResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{29}
 }
 
-type MsgUpdateMortgage struct {
+type MsgTransferLoanNote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender       string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee       string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral   string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount       uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate string `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term         string `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
+	Holder    string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Index     string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (x *MsgUpdateMortgage) Reset() {
-	*x = MsgUpdateMortgage{}
+func (x *MsgTransferLoanNote) Reset() {
+	*x = MsgTransferLoanNote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[4]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateMortgage) String() string {
+func (x *MsgTransferLoanNote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateMortgage) ProtoMessage() {}
+func (*MsgTransferLoanNote) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateMortgage.ProtoReflect.Descriptor instead.
-func (*MsgUpdateMortgage) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgTransferLoanNote.ProtoReflect.Descriptor instead.
+func (*MsgTransferLoanNote) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgUpdateMortgage) GetCreator() string {
+func (x *MsgTransferLoanNote) GetHolder() string {
 	if x != nil {
-		return x.Creator
+		return x.Holder
 	}
 	return ""
 }
 
-func (x *MsgUpdateMortgage) GetIndex() string {
+func (x *MsgTransferLoanNote) GetIndex() string {
 	if x != nil {
 		return x.Index
 	}
 	return ""
 }
 
-func (x *MsgUpdateMortgage) GetLender() string {
+func (x *MsgTransferLoanNote) GetRecipient() string {
 	if x != nil {
-		return x.Lender
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *MsgUpdateMortgage) GetLendee() string {
-	if x != nil {
-		return x.Lendee
-	}
-	return ""
+type MsgTransferLoanNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateMortgage) GetCollateral() string {
-	if x != nil {
-		return x.Collateral
+func (x *MsgTransferLoanNoteResponse) Reset() {
+	*x = MsgTransferLoanNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgUpdateMortgage) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+func (x *MsgTransferLoanNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferLoanNoteResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferLoanNoteResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferLoanNoteResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{31}
+}
+
+type MsgJoinSyndicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *MsgJoinSyndicate) Reset() {
+	*x = MsgJoinSyndicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgUpdateMortgage) GetInterestRate() string {
+func (x *MsgJoinSyndicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgJoinSyndicate) ProtoMessage() {}
+
+// Deprecated: Use MsgJoinSyndicate.ProtoReflect.Descriptor instead.
+func (*MsgJoinSyndicate) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MsgJoinSyndicate) GetLender() string {
 	if x != nil {
-		return x.InterestRate
+		return x.Lender
 	}
 	return ""
 }
 
-func (x *MsgUpdateMortgage) GetTerm() string {
+func (x *MsgJoinSyndicate) GetIndex() string {
 	if x != nil {
-		return x.Term
+		return x.Index
 	}
 	return ""
 }
 
-type MsgUpdateMortgageResponse struct {
+type MsgJoinSyndicateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateMortgageResponse) Reset() {
-	*x = MsgUpdateMortgageResponse{}
+func (x *MsgJoinSyndicateResponse) Reset() {
+	*x = MsgJoinSyndicateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[5]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateMortgageResponse) String() string {
+func (x *MsgJoinSyndicateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateMortgageResponse) ProtoMessage() {}
+func (*MsgJoinSyndicateResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateMortgageResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateMortgageResponse) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgJoinSyndicateResponse.ProtoReflect.Descriptor instead.
+func (*MsgJoinSyndicateResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{33}
 }
 
-type MsgDeleteMortgage struct {
+type MsgEnableAutoRepayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *MsgDeleteMortgage) Reset() {
-	*x = MsgDeleteMortgage{}
+func (x *MsgEnableAutoRepayment) Reset() {
+	*x = MsgEnableAutoRepayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[6]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeleteMortgage) String() string {
+func (x *MsgEnableAutoRepayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeleteMortgage) ProtoMessage() {}
+func (*MsgEnableAutoRepayment) ProtoMessage() {}
 
-// Deprecated: Use MsgDeleteMortgage.ProtoReflect.Descriptor instead.
-func (*MsgDeleteMortgage) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgEnableAutoRepayment.ProtoReflect.Descriptor instead.
+func (*MsgEnableAutoRepayment) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MsgDeleteMortgage) GetCreator() string {
+func (x *MsgEnableAutoRepayment) GetLendee() string {
 	if x != nil {
-		return x.Creator
+		return x.Lendee
 	}
 	return ""
 }
 
-func (x *MsgDeleteMortgage) GetIndex() string {
+func (x *MsgEnableAutoRepayment) GetIndex() string {
 	if x != nil {
 		return x.Index
 	}
 	return ""
 }
 
-type MsgDeleteMortgageResponse struct {
+type MsgEnableAutoRepaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgDeleteMortgageResponse) Reset() {
-	*x = MsgDeleteMortgageResponse{}
+func (x *MsgEnableAutoRepaymentResponse) Reset() {
+	*x = MsgEnableAutoRepaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[7]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgDeleteMortgageResponse) String() string {
+func (x *MsgEnableAutoRepaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgDeleteMortgageResponse) ProtoMessage() {}
+func (*MsgEnableAutoRepaymentResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgDeleteMortgageResponse.ProtoReflect.Descriptor instead.
-func (*MsgDeleteMortgageResponse) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgEnableAutoRepaymentResponse.ProtoReflect.Descriptor instead.
+func (*MsgEnableAutoRepaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{35}
 }
 
-type MsgRepayMortgage struct {
+type MsgDisableAutoRepayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	MortgageId string `protobuf:"bytes,2,opt,name=mortgageId,proto3" json:"mortgageId,omitempty"`
-	Amount     uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *MsgRepayMortgage) Reset() {
-	*x = MsgRepayMortgage{}
+func (x *MsgDisableAutoRepayment) Reset() {
+	*x = MsgDisableAutoRepayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[8]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayMortgage) String() string {
+func (x *MsgDisableAutoRepayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayMortgage) ProtoMessage() {}
+func (*MsgDisableAutoRepayment) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayMortgage.ProtoReflect.Descriptor instead.
-func (*MsgRepayMortgage) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgDisableAutoRepayment.ProtoReflect.Descriptor instead.
+func (*MsgDisableAutoRepayment) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MsgRepayMortgage) GetCreator() string {
+func (x *MsgDisableAutoRepayment) GetLendee() string {
 	if x != nil {
-		return x.Creator
+		return x.Lendee
 	}
 	return ""
 }
 
-func (x *MsgRepayMortgage) GetMortgageId() string {
+func (x *MsgDisableAutoRepayment) GetIndex() string {
 	if x != nil {
-		return x.MortgageId
+		return x.Index
 	}
 	return ""
 }
 
-func (x *MsgRepayMortgage) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type MsgRepayMortgageResponse struct {
+type MsgDisableAutoRepaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgRepayMortgageResponse) Reset() {
-	*x = MsgRepayMortgageResponse{}
+func (x *MsgDisableAutoRepaymentResponse) Reset() {
+	*x = MsgDisableAutoRepaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[9]
+		mi := &file_ardapoc_mortgage_tx_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayMortgageResponse) String() string {
+func (x *MsgDisableAutoRepaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayMortgageResponse) ProtoMessage() {}
+func (*MsgDisableAutoRepaymentResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayMortgageResponse.ProtoReflect.Descriptor instead.
-func (*MsgRepayMortgageResponse) Descriptor() ([]byte, []int) {
-	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgDisableAutoRepaymentResponse.ProtoReflect.Descriptor instead.
+func (*MsgDisableAutoRepaymentResponse) Descriptor() ([]byte, []int) {
+	return file_ardapoc_mortgage_tx_proto_rawDescGZIP(), []int{37}
 }
 
 var File_ardapoc_mortgage_tx_proto protoreflect.FileDescriptor
@@ -5511,99 +19600,365 @@ var file_ardapoc_mortgage_tx_proto_rawDesc = []byte{
 	0x78, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73,
 	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52,
-	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
-	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0xc8, 0x04, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06,
 	0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x6d, 0x6f,
+	0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61,
+	0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x52, 0x08, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x73,
+	0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x2e, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4f, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d,
+	0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4e, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x18,
+	0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x21, 0x0a,
+	0x1f, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4e, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x03,
+	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x71, 0x75, 0x69,
+	0x74, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x3a, 0x0c, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0c, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73,
-	0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x72, 0x74, 0x67,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82,
-	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf7, 0x03, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
-	0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72,
-	0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12,
-	0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74,
-	0x67, 0x61, 0x67, 0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d,
-	0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x62, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67,
-	0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f,
-	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70,
-	0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
-	0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2b, 0x2e, 0x61,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72,
+	0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61,
+	0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x66, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x42, 0x69, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
+	0x2a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x42,
+	0x69, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61,
 	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
-	0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x52, 0x65, 0x70,
-	0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x64,
-	0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2a,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70,
+	0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x6d, 0x6f, 0x72,
+	0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x6d,
+	0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x73, 0x12, 0x4f, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70,
+	0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x41, 0x6d, 0x6f, 0x72,
+	0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x61, 0x6d,
+	0x6f, 0x72, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x68, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x79,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x79, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53,
+	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e,
+	0x64, 0x65, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x3a, 0x0b,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x6c, 0x65, 0x6e, 0x64, 0x65, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x4d,
+	0x73, 0x67, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc6,
+	0x0f, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5c, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x29, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2a, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x65, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x2b,
 	0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67,
-	0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a,
-	0x01, 0x42, 0x9e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
-	0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67,
-	0x61, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x4d, 0x58, 0xaa, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61,
-	0x70, 0x6f, 0x63, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xca, 0x02, 0x10, 0x41,
-	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xe2,
-	0x02, 0x1c, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x11, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
-	0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x72, 0x74, 0x67,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d,
+	0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x32, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67,
+	0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d,
+	0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x1a, 0x31, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x23, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x4c,
+	0x6f, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x1a, 0x2e, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x12,
+	0x25, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x6f,
+	0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x1a, 0x2d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x79, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x4a, 0x6f, 0x69,
+	0x6e, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x1a, 0x2a, 0x2e, 0x61, 0x72, 0x64,
+	0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73,
+	0x67, 0x4a, 0x6f, 0x69, 0x6e, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x30, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x29, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74,
+	0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x75, 0x74, 0x6f, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x31, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x09, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x1a, 0x26, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0e, 0x42, 0x69, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x69, 0x64,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x1a, 0x2b, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x73, 0x67, 0x42, 0x69, 0x64, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x1a, 0x2d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f,
+	0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x2c, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d,
+	0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x61,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x2e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x1a, 0x2c, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x9e, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65,
+	0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x72, 0x64,
+	0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2f, 0x6d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0xa2, 0x02, 0x03, 0x41, 0x4d, 0x58, 0xaa,
+	0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61,
+	0x67, 0x65, 0xca, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x4d, 0x6f, 0x72,
+	0x74, 0x67, 0x61, 0x67, 0x65, 0xe2, 0x02, 0x1c, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a,
+	0x4d, 0x6f, 0x72, 0x74, 0x67, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5618,37 +19973,110 @@ func file_ardapoc_mortgage_tx_proto_rawDescGZIP() []byte {
 	return file_ardapoc_mortgage_tx_proto_rawDescData
 }
 
-var file_ardapoc_mortgage_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ardapoc_mortgage_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_ardapoc_mortgage_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateParams)(nil),           // 0: ardapoc.mortgage.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),   // 1: ardapoc.mortgage.MsgUpdateParamsResponse
-	(*MsgCreateMortgage)(nil),         // 2: ardapoc.mortgage.MsgCreateMortgage
-	(*MsgCreateMortgageResponse)(nil), // 3: ardapoc.mortgage.MsgCreateMortgageResponse
-	(*MsgUpdateMortgage)(nil),         // 4: ardapoc.mortgage.MsgUpdateMortgage
-	(*MsgUpdateMortgageResponse)(nil), // 5: ardapoc.mortgage.MsgUpdateMortgageResponse
-	(*MsgDeleteMortgage)(nil),         // 6: ardapoc.mortgage.MsgDeleteMortgage
-	(*MsgDeleteMortgageResponse)(nil), // 7: ardapoc.mortgage.MsgDeleteMortgageResponse
-	(*MsgRepayMortgage)(nil),          // 8: ardapoc.mortgage.MsgRepayMortgage
-	(*MsgRepayMortgageResponse)(nil),  // 9: ardapoc.mortgage.MsgRepayMortgageResponse
-	(*Params)(nil),                    // 10: ardapoc.mortgage.Params
+	(*MsgUpdateParams)(nil),                  // 0: ardapoc.mortgage.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),          // 1: ardapoc.mortgage.MsgUpdateParamsResponse
+	(*MsgDeleteMortgage)(nil),                // 2: ardapoc.mortgage.MsgDeleteMortgage
+	(*MsgDeleteMortgageResponse)(nil),        // 3: ardapoc.mortgage.MsgDeleteMortgageResponse
+	(*MsgRepayMortgage)(nil),                 // 4: ardapoc.mortgage.MsgRepayMortgage
+	(*MsgRepayMortgageResponse)(nil),         // 5: ardapoc.mortgage.MsgRepayMortgageResponse
+	(*MsgRequestMortgage)(nil),               // 6: ardapoc.mortgage.MsgRequestMortgage
+	(*MsgRequestMortgageResponse)(nil),       // 7: ardapoc.mortgage.MsgRequestMortgageResponse
+	(*MsgApproveMortgage)(nil),               // 8: ardapoc.mortgage.MsgApproveMortgage
+	(*MsgApproveMortgageResponse)(nil),       // 9: ardapoc.mortgage.MsgApproveMortgageResponse
+	(*MsgRejectMortgage)(nil),                // 10: ardapoc.mortgage.MsgRejectMortgage
+	(*MsgRejectMortgageResponse)(nil),        // 11: ardapoc.mortgage.MsgRejectMortgageResponse
+	(*MsgCancelMortgageRequest)(nil),         // 12: ardapoc.mortgage.MsgCancelMortgageRequest
+	(*MsgCancelMortgageRequestResponse)(nil), // 13: ardapoc.mortgage.MsgCancelMortgageRequestResponse
+	(*MsgPurchaseWithMortgage)(nil),          // 14: ardapoc.mortgage.MsgPurchaseWithMortgage
+	(*MsgPurchaseWithMortgageResponse)(nil),  // 15: ardapoc.mortgage.MsgPurchaseWithMortgageResponse
+	(*MsgAcceptPurchase)(nil),                // 16: ardapoc.mortgage.MsgAcceptPurchase
+	(*MsgAcceptPurchaseResponse)(nil),        // 17: ardapoc.mortgage.MsgAcceptPurchaseResponse
+	(*MsgRequestEquityLoan)(nil),             // 18: ardapoc.mortgage.MsgRequestEquityLoan
+	(*MsgRequestEquityLoanResponse)(nil),     // 19: ardapoc.mortgage.MsgRequestEquityLoanResponse
+	(*MsgForeclose)(nil),                     // 20: ardapoc.mortgage.MsgForeclose
+	(*MsgForecloseResponse)(nil),             // 21: ardapoc.mortgage.MsgForecloseResponse
+	(*MsgBidForeclosure)(nil),                // 22: ardapoc.mortgage.MsgBidForeclosure
+	(*MsgBidForeclosureResponse)(nil),        // 23: ardapoc.mortgage.MsgBidForeclosureResponse
+	(*MsgProposeRefinance)(nil),              // 24: ardapoc.mortgage.MsgProposeRefinance
+	(*MsgProposeRefinanceResponse)(nil),      // 25: ardapoc.mortgage.MsgProposeRefinanceResponse
+	(*MsgAcceptRefinance)(nil),               // 26: ardapoc.mortgage.MsgAcceptRefinance
+	(*MsgAcceptRefinanceResponse)(nil),       // 27: ardapoc.mortgage.MsgAcceptRefinanceResponse
+	(*MsgRejectRefinance)(nil),               // 28: ardapoc.mortgage.MsgRejectRefinance
+	(*MsgRejectRefinanceResponse)(nil),       // 29: ardapoc.mortgage.MsgRejectRefinanceResponse
+	(*MsgTransferLoanNote)(nil),              // 30: ardapoc.mortgage.MsgTransferLoanNote
+	(*MsgTransferLoanNoteResponse)(nil),      // 31: ardapoc.mortgage.MsgTransferLoanNoteResponse
+	(*MsgJoinSyndicate)(nil),                 // 32: ardapoc.mortgage.MsgJoinSyndicate
+	(*MsgJoinSyndicateResponse)(nil),         // 33: ardapoc.mortgage.MsgJoinSyndicateResponse
+	(*MsgEnableAutoRepayment)(nil),           // 34: ardapoc.mortgage.MsgEnableAutoRepayment
+	(*MsgEnableAutoRepaymentResponse)(nil),   // 35: ardapoc.mortgage.MsgEnableAutoRepaymentResponse
+	(*MsgDisableAutoRepayment)(nil),          // 36: ardapoc.mortgage.MsgDisableAutoRepayment
+	(*MsgDisableAutoRepaymentResponse)(nil),  // 37: ardapoc.mortgage.MsgDisableAutoRepaymentResponse
+	(*Params)(nil),                           // 38: ardapoc.mortgage.Params
+	(PaymentFrequency)(0),                    // 39: ardapoc.mortgage.PaymentFrequency
+	(AmortizationType)(0),                    // 40: ardapoc.mortgage.AmortizationType
+	(*MortgagePurchase)(nil),                 // 41: ardapoc.mortgage.MortgagePurchase
+	(*SyndicateLender)(nil),                  // 42: ardapoc.mortgage.SyndicateLender
+	(*PrepaymentTerms)(nil),                  // 43: ardapoc.mortgage.PrepaymentTerms
 }
 var file_ardapoc_mortgage_tx_proto_depIdxs = []int32{
-	10, // 0: ardapoc.mortgage.MsgUpdateParams.params:type_name -> ardapoc.mortgage.Params
-	0,  // 1: ardapoc.mortgage.Msg.UpdateParams:input_type -> ardapoc.mortgage.MsgUpdateParams
-	2,  // 2: ardapoc.mortgage.Msg.CreateMortgage:input_type -> ardapoc.mortgage.MsgCreateMortgage
-	4,  // 3: ardapoc.mortgage.Msg.UpdateMortgage:input_type -> ardapoc.mortgage.MsgUpdateMortgage
-	6,  // 4: ardapoc.mortgage.Msg.DeleteMortgage:input_type -> ardapoc.mortgage.MsgDeleteMortgage
-	8,  // 5: ardapoc.mortgage.Msg.RepayMortgage:input_type -> ardapoc.mortgage.MsgRepayMortgage
-	1,  // 6: ardapoc.mortgage.Msg.UpdateParams:output_type -> ardapoc.mortgage.MsgUpdateParamsResponse
-	3,  // 7: ardapoc.mortgage.Msg.CreateMortgage:output_type -> ardapoc.mortgage.MsgCreateMortgageResponse
-	5,  // 8: ardapoc.mortgage.Msg.UpdateMortgage:output_type -> ardapoc.mortgage.MsgUpdateMortgageResponse
-	7,  // 9: ardapoc.mortgage.Msg.DeleteMortgage:output_type -> ardapoc.mortgage.MsgDeleteMortgageResponse
-	9,  // 10: ardapoc.mortgage.Msg.RepayMortgage:output_type -> ardapoc.mortgage.MsgRepayMortgageResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	38, // 0: ardapoc.mortgage.MsgUpdateParams.params:type_name -> ardapoc.mortgage.Params
+	39, // 1: ardapoc.mortgage.MsgRequestMortgage.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	40, // 2: ardapoc.mortgage.MsgRequestMortgage.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	41, // 3: ardapoc.mortgage.MsgRequestMortgage.purchase:type_name -> ardapoc.mortgage.MortgagePurchase
+	42, // 4: ardapoc.mortgage.MsgRequestMortgage.syndicate:type_name -> ardapoc.mortgage.SyndicateLender
+	43, // 5: ardapoc.mortgage.MsgRequestMortgage.prepayment:type_name -> ardapoc.mortgage.PrepaymentTerms
+	39, // 6: ardapoc.mortgage.MsgRequestEquityLoan.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	40, // 7: ardapoc.mortgage.MsgRequestEquityLoan.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	43, // 8: ardapoc.mortgage.MsgRequestEquityLoan.prepayment:type_name -> ardapoc.mortgage.PrepaymentTerms
+	39, // 9: ardapoc.mortgage.MsgProposeRefinance.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	40, // 10: ardapoc.mortgage.MsgProposeRefinance.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	39, // 11: ardapoc.mortgage.MsgAcceptRefinance.payment_frequency:type_name -> ardapoc.mortgage.PaymentFrequency
+	40, // 12: ardapoc.mortgage.MsgAcceptRefinance.amortization:type_name -> ardapoc.mortgage.AmortizationType
+	0,  // 13: ardapoc.mortgage.Msg.UpdateParams:input_type -> ardapoc.mortgage.MsgUpdateParams
+	2,  // 14: ardapoc.mortgage.Msg.DeleteMortgage:input_type -> ardapoc.mortgage.MsgDeleteMortgage
+	4,  // 15: ardapoc.mortgage.Msg.RepayMortgage:input_type -> ardapoc.mortgage.MsgRepayMortgage
+	6,  // 16: ardapoc.mortgage.Msg.RequestMortgage:input_type -> ardapoc.mortgage.MsgRequestMortgage
+	8,  // 17: ardapoc.mortgage.Msg.ApproveMortgage:input_type -> ardapoc.mortgage.MsgApproveMortgage
+	10, // 18: ardapoc.mortgage.Msg.RejectMortgage:input_type -> ardapoc.mortgage.MsgRejectMortgage
+	12, // 19: ardapoc.mortgage.Msg.CancelMortgageRequest:input_type -> ardapoc.mortgage.MsgCancelMortgageRequest
+	14, // 20: ardapoc.mortgage.Msg.PurchaseWithMortgage:input_type -> ardapoc.mortgage.MsgPurchaseWithMortgage
+	16, // 21: ardapoc.mortgage.Msg.AcceptPurchase:input_type -> ardapoc.mortgage.MsgAcceptPurchase
+	18, // 22: ardapoc.mortgage.Msg.RequestEquityLoan:input_type -> ardapoc.mortgage.MsgRequestEquityLoan
+	30, // 23: ardapoc.mortgage.Msg.TransferLoanNote:input_type -> ardapoc.mortgage.MsgTransferLoanNote
+	32, // 24: ardapoc.mortgage.Msg.JoinSyndicate:input_type -> ardapoc.mortgage.MsgJoinSyndicate
+	34, // 25: ardapoc.mortgage.Msg.EnableAutoRepayment:input_type -> ardapoc.mortgage.MsgEnableAutoRepayment
+	36, // 26: ardapoc.mortgage.Msg.DisableAutoRepayment:input_type -> ardapoc.mortgage.MsgDisableAutoRepayment
+	20, // 27: ardapoc.mortgage.Msg.Foreclose:input_type -> ardapoc.mortgage.MsgForeclose
+	22, // 28: ardapoc.mortgage.Msg.BidForeclosure:input_type -> ardapoc.mortgage.MsgBidForeclosure
+	24, // 29: ardapoc.mortgage.Msg.ProposeRefinance:input_type -> ardapoc.mortgage.MsgProposeRefinance
+	26, // 30: ardapoc.mortgage.Msg.AcceptRefinance:input_type -> ardapoc.mortgage.MsgAcceptRefinance
+	28, // 31: ardapoc.mortgage.Msg.RejectRefinance:input_type -> ardapoc.mortgage.MsgRejectRefinance
+	1,  // 32: ardapoc.mortgage.Msg.UpdateParams:output_type -> ardapoc.mortgage.MsgUpdateParamsResponse
+	3,  // 33: ardapoc.mortgage.Msg.DeleteMortgage:output_type -> ardapoc.mortgage.MsgDeleteMortgageResponse
+	5,  // 34: ardapoc.mortgage.Msg.RepayMortgage:output_type -> ardapoc.mortgage.MsgRepayMortgageResponse
+	7,  // 35: ardapoc.mortgage.Msg.RequestMortgage:output_type -> ardapoc.mortgage.MsgRequestMortgageResponse
+	9,  // 36: ardapoc.mortgage.Msg.ApproveMortgage:output_type -> ardapoc.mortgage.MsgApproveMortgageResponse
+	11, // 37: ardapoc.mortgage.Msg.RejectMortgage:output_type -> ardapoc.mortgage.MsgRejectMortgageResponse
+	13, // 38: ardapoc.mortgage.Msg.CancelMortgageRequest:output_type -> ardapoc.mortgage.MsgCancelMortgageRequestResponse
+	15, // 39: ardapoc.mortgage.Msg.PurchaseWithMortgage:output_type -> ardapoc.mortgage.MsgPurchaseWithMortgageResponse
+	17, // 40: ardapoc.mortgage.Msg.AcceptPurchase:output_type -> ardapoc.mortgage.MsgAcceptPurchaseResponse
+	19, // 41: ardapoc.mortgage.Msg.RequestEquityLoan:output_type -> ardapoc.mortgage.MsgRequestEquityLoanResponse
+	31, // 42: ardapoc.mortgage.Msg.TransferLoanNote:output_type -> ardapoc.mortgage.MsgTransferLoanNoteResponse
+	33, // 43: ardapoc.mortgage.Msg.JoinSyndicate:output_type -> ardapoc.mortgage.MsgJoinSyndicateResponse
+	35, // 44: ardapoc.mortgage.Msg.EnableAutoRepayment:output_type -> ardapoc.mortgage.MsgEnableAutoRepaymentResponse
+	37, // 45: ardapoc.mortgage.Msg.DisableAutoRepayment:output_type -> ardapoc.mortgage.MsgDisableAutoRepaymentResponse
+	21, // 46: ardapoc.mortgage.Msg.Foreclose:output_type -> ardapoc.mortgage.MsgForecloseResponse
+	23, // 47: ardapoc.mortgage.Msg.BidForeclosure:output_type -> ardapoc.mortgage.MsgBidForeclosureResponse
+	25, // 48: ardapoc.mortgage.Msg.ProposeRefinance:output_type -> ardapoc.mortgage.MsgProposeRefinanceResponse
+	27, // 49: ardapoc.mortgage.Msg.AcceptRefinance:output_type -> ardapoc.mortgage.MsgAcceptRefinanceResponse
+	29, // 50: ardapoc.mortgage.Msg.RejectRefinance:output_type -> ardapoc.mortgage.MsgRejectRefinanceResponse
+	32, // [32:51] is the sub-list for method output_type
+	13, // [13:32] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_ardapoc_mortgage_tx_proto_init() }
@@ -5671,8 +20099,68 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParamsResponse); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteMortgage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteMortgageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayMortgage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayMortgageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestMortgage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5683,8 +20171,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateMortgage); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestMortgageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5695,8 +20183,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateMortgageResponse); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgApproveMortgage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5707,8 +20195,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateMortgage); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgApproveMortgageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5719,8 +20207,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateMortgageResponse); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRejectMortgage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5731,8 +20219,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeleteMortgage); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRejectMortgageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5743,8 +20231,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeleteMortgageResponse); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMortgageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5755,8 +20243,8 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRepayMortgage); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelMortgageRequestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5767,8 +20255,284 @@ func file_ardapoc_mortgage_tx_proto_init() {
 				return nil
 			}
 		}
-		file_ardapoc_mortgage_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRepayMortgageResponse); i {
+		file_ardapoc_mortgage_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseWithMortgage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPurchaseWithMortgageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptPurchase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptPurchaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestEquityLoan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRequestEquityLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForeclose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgForecloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBidForeclosure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBidForeclosureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeRefinance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeRefinanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptRefinance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAcceptRefinanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRejectRefinance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRejectRefinanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferLoanNote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferLoanNoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgJoinSyndicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgJoinSyndicateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEnableAutoRepayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgEnableAutoRepaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDisableAutoRepayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ardapoc_mortgage_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDisableAutoRepaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5786,7 +20550,7 @@ func file_ardapoc_mortgage_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ardapoc_mortgage_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName   = "/ardapoc.mortgage.Msg/UpdateParams"
-	Msg_CreateMortgage_FullMethodName = "/ardapoc.mortgage.Msg/CreateMortgage"
-	Msg_UpdateMortgage_FullMethodName = "/ardapoc.mortgage.Msg/UpdateMortgage"
-	Msg_DeleteMortgage_FullMethodName = "/ardapoc.mortgage.Msg/DeleteMortgage"
-	Msg_RepayMortgage_FullMethodName  = "/ardapoc.mortgage.Msg/RepayMortgage"
+	Msg_UpdateParams_FullMethodName          = "/ardapoc.mortgage.Msg/UpdateParams"
+	Msg_DeleteMortgage_FullMethodName        = "/ardapoc.mortgage.Msg/DeleteMortgage"
+	Msg_RepayMortgage_FullMethodName         = "/ardapoc.mortgage.Msg/RepayMortgage"
+	Msg_RequestMortgage_FullMethodName       = "/ardapoc.mortgage.Msg/RequestMortgage"
+	Msg_ApproveMortgage_FullMethodName       = "/ardapoc.mortgage.Msg/ApproveMortgage"
+	Msg_RejectMortgage_FullMethodName        = "/ardapoc.mortgage.Msg/RejectMortgage"
+	Msg_CancelMortgageRequest_FullMethodName = "/ardapoc.mortgage.Msg/CancelMortgageRequest"
+	Msg_PurchaseWithMortgage_FullMethodName  = "/ardapoc.mortgage.Msg/PurchaseWithMortgage"
+	Msg_AcceptPurchase_FullMethodName        = "/ardapoc.mortgage.Msg/AcceptPurchase"
+	Msg_RequestEquityLoan_FullMethodName     = "/ardapoc.mortgage.Msg/RequestEquityLoan"
+	Msg_TransferLoanNote_FullMethodName      = "/ardapoc.mortgage.Msg/TransferLoanNote"
+	Msg_JoinSyndicate_FullMethodName         = "/ardapoc.mortgage.Msg/JoinSyndicate"
+	Msg_EnableAutoRepayment_FullMethodName   = "/ardapoc.mortgage.Msg/EnableAutoRepayment"
+	Msg_DisableAutoRepayment_FullMethodName  = "/ardapoc.mortgage.Msg/DisableAutoRepayment"
+	Msg_Foreclose_FullMethodName             = "/ardapoc.mortgage.Msg/Foreclose"
+	Msg_BidForeclosure_FullMethodName        = "/ardapoc.mortgage.Msg/BidForeclosure"
+	Msg_ProposeRefinance_FullMethodName      = "/ardapoc.mortgage.Msg/ProposeRefinance"
+	Msg_AcceptRefinance_FullMethodName       = "/ardapoc.mortgage.Msg/AcceptRefinance"
+	Msg_RejectRefinance_FullMethodName       = "/ardapoc.mortgage.Msg/RejectRefinance"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,10 +47,45 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateMortgage(ctx context.Context, in *MsgCreateMortgage, opts ...grpc.CallOption) (*MsgCreateMortgageResponse, error)
-	UpdateMortgage(ctx context.Context, in *MsgUpdateMortgage, opts ...grpc.CallOption) (*MsgUpdateMortgageResponse, error)
 	DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error)
 	RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error)
+	// RequestMortgage records a lendee's request for a mortgage. Nothing moves
+	// until the lender approves it.
+	RequestMortgage(ctx context.Context, in *MsgRequestMortgage, opts ...grpc.CallOption) (*MsgRequestMortgageResponse, error)
+	ApproveMortgage(ctx context.Context, in *MsgApproveMortgage, opts ...grpc.CallOption) (*MsgApproveMortgageResponse, error)
+	RejectMortgage(ctx context.Context, in *MsgRejectMortgage, opts ...grpc.CallOption) (*MsgRejectMortgageResponse, error)
+	CancelMortgageRequest(ctx context.Context, in *MsgCancelMortgageRequest, opts ...grpc.CallOption) (*MsgCancelMortgageRequestResponse, error)
+	// PurchaseWithMortgage approves a mortgage request that finances a property
+	// purchase. Funding the mortgage, paying the sellers, transferring the shares
+	// to the lendee and escrowing them happen together or not at all. Every
+	// seller must have accepted the purchase with AcceptPurchase.
+	PurchaseWithMortgage(ctx context.Context, in *MsgPurchaseWithMortgage, opts ...grpc.CallOption) (*MsgPurchaseWithMortgageResponse, error)
+	// AcceptPurchase agrees to sell a seller's shares at the price of a
+	// mortgage request that finances their purchase.
+	AcceptPurchase(ctx context.Context, in *MsgAcceptPurchase, opts ...grpc.CallOption) (*MsgAcceptPurchaseResponse, error)
+	// RequestEquityLoan requests a home equity loan against property shares the
+	// lendee owns. The lender approves it with ApproveMortgage.
+	RequestEquityLoan(ctx context.Context, in *MsgRequestEquityLoan, opts ...grpc.CallOption) (*MsgRequestEquityLoanResponse, error)
+	// TransferLoanNote assigns the lender's position in a mortgage to someone
+	// else. The recipient becomes the lender and receives future repayments.
+	TransferLoanNote(ctx context.Context, in *MsgTransferLoanNote, opts ...grpc.CallOption) (*MsgTransferLoanNoteResponse, error)
+	// JoinSyndicate commits a member of the syndicate of a mortgage request to
+	// fund its contribution once the lender approves the request.
+	JoinSyndicate(ctx context.Context, in *MsgJoinSyndicate, opts ...grpc.CallOption) (*MsgJoinSyndicateResponse, error)
+	// EnableAutoRepayment makes the mortgage module pull each installment from
+	// the lendee when it falls due. The lendee must have granted the module
+	// account a SendAuthorization through x/authz.
+	EnableAutoRepayment(ctx context.Context, in *MsgEnableAutoRepayment, opts ...grpc.CallOption) (*MsgEnableAutoRepaymentResponse, error)
+	DisableAutoRepayment(ctx context.Context, in *MsgDisableAutoRepayment, opts ...grpc.CallOption) (*MsgDisableAutoRepaymentResponse, error)
+	// Foreclose lets the lender of a defaulted mortgage take the escrowed
+	// collateral shares or put them up for auction.
+	Foreclose(ctx context.Context, in *MsgForeclose, opts ...grpc.CallOption) (*MsgForecloseResponse, error)
+	BidForeclosure(ctx context.Context, in *MsgBidForeclosure, opts ...grpc.CallOption) (*MsgBidForeclosureResponse, error)
+	// ProposeRefinance offers the lendee new repayment terms for the balance of
+	// an active mortgage. They only take effect once the lendee accepts them.
+	ProposeRefinance(ctx context.Context, in *MsgProposeRefinance, opts ...grpc.CallOption) (*MsgProposeRefinanceResponse, error)
+	AcceptRefinance(ctx context.Context, in *MsgAcceptRefinance, opts ...grpc.CallOption) (*MsgAcceptRefinanceResponse, error)
+	RejectRefinance(ctx context.Context, in *MsgRejectRefinance, opts ...grpc.CallOption) (*MsgRejectRefinanceResponse, error)
 }
 
 type msgClient struct {
@@ -56,36 +105,162 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
-func (c *msgClient) CreateMortgage(ctx context.Context, in *MsgCreateMortgage, opts ...grpc.CallOption) (*MsgCreateMortgageResponse, error) {
-	out := new(MsgCreateMortgageResponse)
-	err := c.cc.Invoke(ctx, Msg_CreateMortgage_FullMethodName, in, out, opts...)
+func (c *msgClient) DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error) {
+	out := new(MsgDeleteMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteMortgage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) UpdateMortgage(ctx context.Context, in *MsgUpdateMortgage, opts ...grpc.CallOption) (*MsgUpdateMortgageResponse, error) {
-	out := new(MsgUpdateMortgageResponse)
-	err := c.cc.Invoke(ctx, Msg_UpdateMortgage_FullMethodName, in, out, opts...)
+func (c *msgClient) RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error) {
+	out := new(MsgRepayMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_RepayMortgage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error) {
-	out := new(MsgDeleteMortgageResponse)
-	err := c.cc.Invoke(ctx, Msg_DeleteMortgage_FullMethodName, in, out, opts...)
+func (c *msgClient) RequestMortgage(ctx context.Context, in *MsgRequestMortgage, opts ...grpc.CallOption) (*MsgRequestMortgageResponse, error) {
+	out := new(MsgRequestMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestMortgage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error) {
-	out := new(MsgRepayMortgageResponse)
-	err := c.cc.Invoke(ctx, Msg_RepayMortgage_FullMethodName, in, out, opts...)
+func (c *msgClient) ApproveMortgage(ctx context.Context, in *MsgApproveMortgage, opts ...grpc.CallOption) (*MsgApproveMortgageResponse, error) {
+	out := new(MsgApproveMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMortgage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectMortgage(ctx context.Context, in *MsgRejectMortgage, opts ...grpc.CallOption) (*MsgRejectMortgageResponse, error) {
+	out := new(MsgRejectMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectMortgage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMortgageRequest(ctx context.Context, in *MsgCancelMortgageRequest, opts ...grpc.CallOption) (*MsgCancelMortgageRequestResponse, error) {
+	out := new(MsgCancelMortgageRequestResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMortgageRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurchaseWithMortgage(ctx context.Context, in *MsgPurchaseWithMortgage, opts ...grpc.CallOption) (*MsgPurchaseWithMortgageResponse, error) {
+	out := new(MsgPurchaseWithMortgageResponse)
+	err := c.cc.Invoke(ctx, Msg_PurchaseWithMortgage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPurchase(ctx context.Context, in *MsgAcceptPurchase, opts ...grpc.CallOption) (*MsgAcceptPurchaseResponse, error) {
+	out := new(MsgAcceptPurchaseResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptPurchase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestEquityLoan(ctx context.Context, in *MsgRequestEquityLoan, opts ...grpc.CallOption) (*MsgRequestEquityLoanResponse, error) {
+	out := new(MsgRequestEquityLoanResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestEquityLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLoanNote(ctx context.Context, in *MsgTransferLoanNote, opts ...grpc.CallOption) (*MsgTransferLoanNoteResponse, error) {
+	out := new(MsgTransferLoanNoteResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferLoanNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinSyndicate(ctx context.Context, in *MsgJoinSyndicate, opts ...grpc.CallOption) (*MsgJoinSyndicateResponse, error) {
+	out := new(MsgJoinSyndicateResponse)
+	err := c.cc.Invoke(ctx, Msg_JoinSyndicate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableAutoRepayment(ctx context.Context, in *MsgEnableAutoRepayment, opts ...grpc.CallOption) (*MsgEnableAutoRepaymentResponse, error) {
+	out := new(MsgEnableAutoRepaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_EnableAutoRepayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableAutoRepayment(ctx context.Context, in *MsgDisableAutoRepayment, opts ...grpc.CallOption) (*MsgDisableAutoRepaymentResponse, error) {
+	out := new(MsgDisableAutoRepaymentResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableAutoRepayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Foreclose(ctx context.Context, in *MsgForeclose, opts ...grpc.CallOption) (*MsgForecloseResponse, error) {
+	out := new(MsgForecloseResponse)
+	err := c.cc.Invoke(ctx, Msg_Foreclose_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidForeclosure(ctx context.Context, in *MsgBidForeclosure, opts ...grpc.CallOption) (*MsgBidForeclosureResponse, error) {
+	out := new(MsgBidForeclosureResponse)
+	err := c.cc.Invoke(ctx, Msg_BidForeclosure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeRefinance(ctx context.Context, in *MsgProposeRefinance, opts ...grpc.CallOption) (*MsgProposeRefinanceResponse, error) {
+	out := new(MsgProposeRefinanceResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposeRefinance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptRefinance(ctx context.Context, in *MsgAcceptRefinance, opts ...grpc.CallOption) (*MsgAcceptRefinanceResponse, error) {
+	out := new(MsgAcceptRefinanceResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptRefinance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectRefinance(ctx context.Context, in *MsgRejectRefinance, opts ...grpc.CallOption) (*MsgRejectRefinanceResponse, error) {
+	out := new(MsgRejectRefinanceResponse)
+	err := c.cc.Invoke(ctx, Msg_RejectRefinance_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,10 +274,45 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateMortgage(context.Context, *MsgCreateMortgage) (*MsgCreateMortgageResponse, error)
-	UpdateMortgage(context.Context, *MsgUpdateMortgage) (*MsgUpdateMortgageResponse, error)
 	DeleteMortgage(context.Context, *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error)
 	RepayMortgage(context.Context, *MsgRepayMortgage) (*MsgRepayMortgageResponse, error)
+	// RequestMortgage records a lendee's request for a mortgage. Nothing moves
+	// until the lender approves it.
+	RequestMortgage(context.Context, *MsgRequestMortgage) (*MsgRequestMortgageResponse, error)
+	ApproveMortgage(context.Context, *MsgApproveMortgage) (*MsgApproveMortgageResponse, error)
+	RejectMortgage(context.Context, *MsgRejectMortgage) (*MsgRejectMortgageResponse, error)
+	CancelMortgageRequest(context.Context, *MsgCancelMortgageRequest) (*MsgCancelMortgageRequestResponse, error)
+	// PurchaseWithMortgage approves a mortgage request that finances a property
+	// purchase. Funding the mortgage, paying the sellers, transferring the shares
+	// to the lendee and escrowing them happen together or not at all. Every
+	// seller must have accepted the purchase with AcceptPurchase.
+	PurchaseWithMortgage(context.Context, *MsgPurchaseWithMortgage) (*MsgPurchaseWithMortgageResponse, error)
+	// AcceptPurchase agrees to sell a seller's shares at the price of a
+	// mortgage request that finances their purchase.
+	AcceptPurchase(context.Context, *MsgAcceptPurchase) (*MsgAcceptPurchaseResponse, error)
+	// RequestEquityLoan requests a home equity loan against property shares the
+	// lendee owns. The lender approves it with ApproveMortgage.
+	RequestEquityLoan(context.Context, *MsgRequestEquityLoan) (*MsgRequestEquityLoanResponse, error)
+	// TransferLoanNote assigns the lender's position in a mortgage to someone
+	// else. The recipient becomes the lender and receives future repayments.
+	TransferLoanNote(context.Context, *MsgTransferLoanNote) (*MsgTransferLoanNoteResponse, error)
+	// JoinSyndicate commits a member of the syndicate of a mortgage request to
+	// fund its contribution once the lender approves the request.
+	JoinSyndicate(context.Context, *MsgJoinSyndicate) (*MsgJoinSyndicateResponse, error)
+	// EnableAutoRepayment makes the mortgage module pull each installment from
+	// the lendee when it falls due. The lendee must have granted the module
+	// account a SendAuthorization through x/authz.
+	EnableAutoRepayment(context.Context, *MsgEnableAutoRepayment) (*MsgEnableAutoRepaymentResponse, error)
+	DisableAutoRepayment(context.Context, *MsgDisableAutoRepayment) (*MsgDisableAutoRepaymentResponse, error)
+	// Foreclose lets the lender of a defaulted mortgage take the escrowed
+	// collateral shares or put them up for auction.
+	Foreclose(context.Context, *MsgForeclose) (*MsgForecloseResponse, error)
+	BidForeclosure(context.Context, *MsgBidForeclosure) (*MsgBidForeclosureResponse, error)
+	// ProposeRefinance offers the lendee new repayment terms for the balance of
+	// an active mortgage. They only take effect once the lendee accepts them.
+	ProposeRefinance(context.Context, *MsgProposeRefinance) (*MsgProposeRefinanceResponse, error)
+	AcceptRefinance(context.Context, *MsgAcceptRefinance) (*MsgAcceptRefinanceResponse, error)
+	RejectRefinance(context.Context, *MsgRejectRefinance) (*MsgRejectRefinanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -113,18 +323,60 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-func (UnimplementedMsgServer) CreateMortgage(context.Context, *MsgCreateMortgage) (*MsgCreateMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMortgage not implemented")
-}
-func (UnimplementedMsgServer) UpdateMortgage(context.Context, *MsgUpdateMortgage) (*MsgUpdateMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMortgage not implemented")
-}
 func (UnimplementedMsgServer) DeleteMortgage(context.Context, *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMortgage not implemented")
 }
 func (UnimplementedMsgServer) RepayMortgage(context.Context, *MsgRepayMortgage) (*MsgRepayMortgageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RepayMortgage not implemented")
 }
+func (UnimplementedMsgServer) RequestMortgage(context.Context, *MsgRequestMortgage) (*MsgRequestMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMortgage not implemented")
+}
+func (UnimplementedMsgServer) ApproveMortgage(context.Context, *MsgApproveMortgage) (*MsgApproveMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMortgage not implemented")
+}
+func (UnimplementedMsgServer) RejectMortgage(context.Context, *MsgRejectMortgage) (*MsgRejectMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMortgage not implemented")
+}
+func (UnimplementedMsgServer) CancelMortgageRequest(context.Context, *MsgCancelMortgageRequest) (*MsgCancelMortgageRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMortgageRequest not implemented")
+}
+func (UnimplementedMsgServer) PurchaseWithMortgage(context.Context, *MsgPurchaseWithMortgage) (*MsgPurchaseWithMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseWithMortgage not implemented")
+}
+func (UnimplementedMsgServer) AcceptPurchase(context.Context, *MsgAcceptPurchase) (*MsgAcceptPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPurchase not implemented")
+}
+func (UnimplementedMsgServer) RequestEquityLoan(context.Context, *MsgRequestEquityLoan) (*MsgRequestEquityLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEquityLoan not implemented")
+}
+func (UnimplementedMsgServer) TransferLoanNote(context.Context, *MsgTransferLoanNote) (*MsgTransferLoanNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLoanNote not implemented")
+}
+func (UnimplementedMsgServer) JoinSyndicate(context.Context, *MsgJoinSyndicate) (*MsgJoinSyndicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinSyndicate not implemented")
+}
+func (UnimplementedMsgServer) EnableAutoRepayment(context.Context, *MsgEnableAutoRepayment) (*MsgEnableAutoRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAutoRepayment not implemented")
+}
+func (UnimplementedMsgServer) DisableAutoRepayment(context.Context, *MsgDisableAutoRepayment) (*MsgDisableAutoRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAutoRepayment not implemented")
+}
+func (UnimplementedMsgServer) Foreclose(context.Context, *MsgForeclose) (*MsgForecloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Foreclose not implemented")
+}
+func (UnimplementedMsgServer) BidForeclosure(context.Context, *MsgBidForeclosure) (*MsgBidForeclosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidForeclosure not implemented")
+}
+func (UnimplementedMsgServer) ProposeRefinance(context.Context, *MsgProposeRefinance) (*MsgProposeRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRefinance not implemented")
+}
+func (UnimplementedMsgServer) AcceptRefinance(context.Context, *MsgAcceptRefinance) (*MsgAcceptRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRefinance not implemented")
+}
+func (UnimplementedMsgServer) RejectRefinance(context.Context, *MsgRejectRefinance) (*MsgRejectRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRefinance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -156,74 +408,326 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMortgage)
+func _Msg_DeleteMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteMortgage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateMortgage(ctx, in)
+		return srv.(MsgServer).DeleteMortgage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_CreateMortgage_FullMethodName,
+		FullMethod: Msg_DeleteMortgage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMortgage(ctx, req.(*MsgCreateMortgage))
+		return srv.(MsgServer).DeleteMortgage(ctx, req.(*MsgDeleteMortgage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMortgage)
+func _Msg_RepayMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayMortgage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateMortgage(ctx, in)
+		return srv.(MsgServer).RepayMortgage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_UpdateMortgage_FullMethodName,
+		FullMethod: Msg_RepayMortgage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMortgage(ctx, req.(*MsgUpdateMortgage))
+		return srv.(MsgServer).RepayMortgage(ctx, req.(*MsgRepayMortgage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DeleteMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteMortgage)
+func _Msg_RequestMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestMortgage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DeleteMortgage(ctx, in)
+		return srv.(MsgServer).RequestMortgage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_DeleteMortgage_FullMethodName,
+		FullMethod: Msg_RequestMortgage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteMortgage(ctx, req.(*MsgDeleteMortgage))
+		return srv.(MsgServer).RequestMortgage(ctx, req.(*MsgRequestMortgage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RepayMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayMortgage)
+func _Msg_ApproveMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMortgage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RepayMortgage(ctx, in)
+		return srv.(MsgServer).ApproveMortgage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_RepayMortgage_FullMethodName,
+		FullMethod: Msg_ApproveMortgage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayMortgage(ctx, req.(*MsgRepayMortgage))
+		return srv.(MsgServer).ApproveMortgage(ctx, req.(*MsgApproveMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectMortgage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectMortgage(ctx, req.(*MsgRejectMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMortgageRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMortgageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMortgageRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMortgageRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMortgageRequest(ctx, req.(*MsgCancelMortgageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseWithMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseWithMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseWithMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurchaseWithMortgage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseWithMortgage(ctx, req.(*MsgPurchaseWithMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPurchase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPurchase(ctx, req.(*MsgAcceptPurchase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestEquityLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestEquityLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestEquityLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestEquityLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestEquityLoan(ctx, req.(*MsgRequestEquityLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLoanNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLoanNote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLoanNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferLoanNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLoanNote(ctx, req.(*MsgTransferLoanNote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinSyndicate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_JoinSyndicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinSyndicate(ctx, req.(*MsgJoinSyndicate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableAutoRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableAutoRepayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableAutoRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EnableAutoRepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableAutoRepayment(ctx, req.(*MsgEnableAutoRepayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableAutoRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableAutoRepayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableAutoRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableAutoRepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableAutoRepayment(ctx, req.(*MsgDisableAutoRepayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Foreclose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForeclose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Foreclose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Foreclose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Foreclose(ctx, req.(*MsgForeclose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidForeclosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidForeclosure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidForeclosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BidForeclosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidForeclosure(ctx, req.(*MsgBidForeclosure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposeRefinance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRefinance(ctx, req.(*MsgProposeRefinance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptRefinance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptRefinance(ctx, req.(*MsgAcceptRefinance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RejectRefinance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRefinance(ctx, req.(*MsgRejectRefinance))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -239,14 +743,6 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
-		{
-			MethodName: "CreateMortgage",
-			Handler:    _Msg_CreateMortgage_Handler,
-		},
-		{
-			MethodName: "UpdateMortgage",
-			Handler:    _Msg_UpdateMortgage_Handler,
-		},
 		{
 			MethodName: "DeleteMortgage",
 			Handler:    _Msg_DeleteMortgage_Handler,
@@ -255,6 +751,70 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RepayMortgage",
 			Handler:    _Msg_RepayMortgage_Handler,
 		},
+		{
+			MethodName: "RequestMortgage",
+			Handler:    _Msg_RequestMortgage_Handler,
+		},
+		{
+			MethodName: "ApproveMortgage",
+			Handler:    _Msg_ApproveMortgage_Handler,
+		},
+		{
+			MethodName: "RejectMortgage",
+			Handler:    _Msg_RejectMortgage_Handler,
+		},
+		{
+			MethodName: "CancelMortgageRequest",
+			Handler:    _Msg_CancelMortgageRequest_Handler,
+		},
+		{
+			MethodName: "PurchaseWithMortgage",
+			Handler:    _Msg_PurchaseWithMortgage_Handler,
+		},
+		{
+			MethodName: "AcceptPurchase",
+			Handler:    _Msg_AcceptPurchase_Handler,
+		},
+		{
+			MethodName: "RequestEquityLoan",
+			Handler:    _Msg_RequestEquityLoan_Handler,
+		},
+		{
+			MethodName: "TransferLoanNote",
+			Handler:    _Msg_TransferLoanNote_Handler,
+		},
+		{
+			MethodName: "JoinSyndicate",
+			Handler:    _Msg_JoinSyndicate_Handler,
+		},
+		{
+			MethodName: "EnableAutoRepayment",
+			Handler:    _Msg_EnableAutoRepayment_Handler,
+		},
+		{
+			MethodName: "DisableAutoRepayment",
+			Handler:    _Msg_DisableAutoRepayment_Handler,
+		},
+		{
+			MethodName: "Foreclose",
+			Handler:    _Msg_Foreclose_Handler,
+		},
+		{
+			MethodName: "BidForeclosure",
+			Handler:    _Msg_BidForeclosure_Handler,
+		},
+		{
+			MethodName: "ProposeRefinance",
+			Handler:    _Msg_ProposeRefinance_Handler,
+		},
+		{
+			MethodName: "AcceptRefinance",
+			Handler:    _Msg_AcceptRefinance_Handler,
+		},
+		{
+			MethodName: "RejectRefinance",
+			Handler:    _Msg_RejectRefinance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/mortgage/tx.proto",
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ardapoc/oracle/query.proto
+
+package oracle
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Params_FullMethodName               = "/ardapoc.oracle.Query/Params"
+	Query_RegionIndex_FullMethodName          = "/ardapoc.oracle.Query/RegionIndex"
+	Query_RegionIndexAll_FullMethodName       = "/ardapoc.oracle.Query/RegionIndexAll"
+	Query_PropertyValuation_FullMethodName    = "/ardapoc.oracle.Query/PropertyValuation"
+	Query_PropertyValuationAll_FullMethodName = "/ardapoc.oracle.Query/PropertyValuationAll"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the price indexes of the regions.
+	RegionIndex(ctx context.Context, in *QueryGetRegionIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionIndexResponse, error)
+	RegionIndexAll(ctx context.Context, in *QueryAllRegionIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionIndexResponse, error)
+	// Queries the oracle valuations of properties.
+	PropertyValuation(ctx context.Context, in *QueryGetPropertyValuationRequest, opts ...grpc.CallOption) (*QueryGetPropertyValuationResponse, error)
+	PropertyValuationAll(ctx context.Context, in *QueryAllPropertyValuationRequest, opts ...grpc.CallOption) (*QueryAllPropertyValuationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegionIndex(ctx context.Context, in *QueryGetRegionIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionIndexResponse, error) {
+	out := new(QueryGetRegionIndexResponse)
+	err := c.cc.Invoke(ctx, Query_RegionIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegionIndexAll(ctx context.Context, in *QueryAllRegionIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionIndexResponse, error) {
+	out := new(QueryAllRegionIndexResponse)
+	err := c.cc.Invoke(ctx, Query_RegionIndexAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PropertyValuation(ctx context.Context, in *QueryGetPropertyValuationRequest, opts ...grpc.CallOption) (*QueryGetPropertyValuationResponse, error) {
+	out := new(QueryGetPropertyValuationResponse)
+	err := c.cc.Invoke(ctx, Query_PropertyValuation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PropertyValuationAll(ctx context.Context, in *QueryAllPropertyValuationRequest, opts ...grpc.CallOption) (*QueryAllPropertyValuationResponse, error) {
+	out := new(QueryAllPropertyValuationResponse)
+	err := c.cc.Invoke(ctx, Query_PropertyValuationAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the price indexes of the regions.
+	RegionIndex(context.Context, *QueryGetRegionIndexRequest) (*QueryGetRegionIndexResponse, error)
+	RegionIndexAll(context.Context, *QueryAllRegionIndexRequest) (*QueryAllRegionIndexResponse, error)
+	// Queries the oracle valuations of properties.
+	PropertyValuation(context.Context, *QueryGetPropertyValuationRequest) (*QueryGetPropertyValuationResponse, error)
+	PropertyValuationAll(context.Context, *QueryAllPropertyValuationRequest) (*QueryAllPropertyValuationResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) RegionIndex(context.Context, *QueryGetRegionIndexRequest) (*QueryGetRegionIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionIndex not implemented")
+}
+func (UnimplementedQueryServer) RegionIndexAll(context.Context, *QueryAllRegionIndexRequest) (*QueryAllRegionIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionIndexAll not implemented")
+}
+func (UnimplementedQueryServer) PropertyValuation(context.Context, *QueryGetPropertyValuationRequest) (*QueryGetPropertyValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyValuation not implemented")
+}
+func (UnimplementedQueryServer) PropertyValuationAll(context.Context, *QueryAllPropertyValuationRequest) (*QueryAllPropertyValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyValuationAll not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegionIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRegionIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegionIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegionIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegionIndex(ctx, req.(*QueryGetRegionIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegionIndexAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRegionIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegionIndexAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RegionIndexAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegionIndexAll(ctx, req.(*QueryAllRegionIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PropertyValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPropertyValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PropertyValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PropertyValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PropertyValuation(ctx, req.(*QueryGetPropertyValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PropertyValuationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPropertyValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PropertyValuationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PropertyValuationAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PropertyValuationAll(ctx, req.(*QueryAllPropertyValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.oracle.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RegionIndex",
+			Handler:    _Query_RegionIndex_Handler,
+		},
+		{
+			MethodName: "RegionIndexAll",
+			Handler:    _Query_RegionIndexAll_Handler,
+		},
+		{
+			MethodName: "PropertyValuation",
+			Handler:    _Query_PropertyValuation_Handler,
+		},
+		{
+			MethodName: "PropertyValuationAll",
+			Handler:    _Query_PropertyValuationAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/oracle/query.proto",
+}
@@ -174,6 +174,11 @@ var (
 	fd_Property_owner_information        protoreflect.FieldDescriptor
 	fd_Property_tenant_id                protoreflect.FieldDescriptor
 	fd_Property_unit_number              protoreflect.FieldDescriptor
+	fd_Property_metadata_uri             protoreflect.FieldDescriptor
+	fd_Property_nft_class_id             protoreflect.FieldDescriptor
+	fd_Property_nft_id                   protoreflect.FieldDescriptor
+	fd_Property_valuation_index          protoreflect.FieldDescriptor
+	fd_Property_manager                  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -196,6 +201,11 @@ func init() {
 	fd_Property_owner_information = md_Property.Fields().ByName("owner_information")
 	fd_Property_tenant_id = md_Property.Fields().ByName("tenant_id")
 	fd_Property_unit_number = md_Property.Fields().ByName("unit_number")
+	fd_Property_metadata_uri = md_Property.Fields().ByName("metadata_uri")
+	fd_Property_nft_class_id = md_Property.Fields().ByName("nft_class_id")
+	fd_Property_nft_id = md_Property.Fields().ByName("nft_id")
+	fd_Property_valuation_index = md_Property.Fields().ByName("valuation_index")
+	fd_Property_manager = md_Property.Fields().ByName("manager")
 }
 
 var _ protoreflect.Message = (*fastReflection_Property)(nil)
@@ -365,6 +375,36 @@ func (x *fastReflection_Property) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.MetadataUri != "" {
+		value := protoreflect.ValueOfString(x.MetadataUri)
+		if !f(fd_Property_metadata_uri, value) {
+			return
+		}
+	}
+	if x.NftClassId != "" {
+		value := protoreflect.ValueOfString(x.NftClassId)
+		if !f(fd_Property_nft_class_id, value) {
+			return
+		}
+	}
+	if x.NftId != "" {
+		value := protoreflect.ValueOfString(x.NftId)
+		if !f(fd_Property_nft_id, value) {
+			return
+		}
+	}
+	if x.ValuationIndex != "" {
+		value := protoreflect.ValueOfString(x.ValuationIndex)
+		if !f(fd_Property_valuation_index, value) {
+			return
+		}
+	}
+	if x.Manager != "" {
+		value := protoreflect.ValueOfString(x.Manager)
+		if !f(fd_Property_manager, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -414,6 +454,16 @@ func (x *fastReflection_Property) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TenantId != ""
 	case "ardapoc.property.Property.unit_number":
 		return x.UnitNumber != ""
+	case "ardapoc.property.Property.metadata_uri":
+		return x.MetadataUri != ""
+	case "ardapoc.property.Property.nft_class_id":
+		return x.NftClassId != ""
+	case "ardapoc.property.Property.nft_id":
+		return x.NftId != ""
+	case "ardapoc.property.Property.valuation_index":
+		return x.ValuationIndex != ""
+	case "ardapoc.property.Property.manager":
+		return x.Manager != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -464,6 +514,16 @@ func (x *fastReflection_Property) Clear(fd protoreflect.FieldDescriptor) {
 		x.TenantId = ""
 	case "ardapoc.property.Property.unit_number":
 		x.UnitNumber = ""
+	case "ardapoc.property.Property.metadata_uri":
+		x.MetadataUri = ""
+	case "ardapoc.property.Property.nft_class_id":
+		x.NftClassId = ""
+	case "ardapoc.property.Property.nft_id":
+		x.NftId = ""
+	case "ardapoc.property.Property.valuation_index":
+		x.ValuationIndex = ""
+	case "ardapoc.property.Property.manager":
+		x.Manager = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -540,6 +600,21 @@ func (x *fastReflection_Property) Get(descriptor protoreflect.FieldDescriptor) p
 	case "ardapoc.property.Property.unit_number":
 		value := x.UnitNumber
 		return protoreflect.ValueOfString(value)
+	case "ardapoc.property.Property.metadata_uri":
+		value := x.MetadataUri
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.property.Property.nft_class_id":
+		value := x.NftClassId
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.property.Property.nft_id":
+		value := x.NftId
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.property.Property.valuation_index":
+		value := x.ValuationIndex
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.property.Property.manager":
+		value := x.Manager
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -600,6 +675,16 @@ func (x *fastReflection_Property) Set(fd protoreflect.FieldDescriptor, value pro
 		x.TenantId = value.Interface().(string)
 	case "ardapoc.property.Property.unit_number":
 		x.UnitNumber = value.Interface().(string)
+	case "ardapoc.property.Property.metadata_uri":
+		x.MetadataUri = value.Interface().(string)
+	case "ardapoc.property.Property.nft_class_id":
+		x.NftClassId = value.Interface().(string)
+	case "ardapoc.property.Property.nft_id":
+		x.NftId = value.Interface().(string)
+	case "ardapoc.property.Property.valuation_index":
+		x.ValuationIndex = value.Interface().(string)
+	case "ardapoc.property.Property.manager":
+		x.Manager = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -666,6 +751,16 @@ func (x *fastReflection_Property) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field tenant_id of message ardapoc.property.Property is not mutable"))
 	case "ardapoc.property.Property.unit_number":
 		panic(fmt.Errorf("field unit_number of message ardapoc.property.Property is not mutable"))
+	case "ardapoc.property.Property.metadata_uri":
+		panic(fmt.Errorf("field metadata_uri of message ardapoc.property.Property is not mutable"))
+	case "ardapoc.property.Property.nft_class_id":
+		panic(fmt.Errorf("field nft_class_id of message ardapoc.property.Property is not mutable"))
+	case "ardapoc.property.Property.nft_id":
+		panic(fmt.Errorf("field nft_id of message ardapoc.property.Property is not mutable"))
+	case "ardapoc.property.Property.valuation_index":
+		panic(fmt.Errorf("field valuation_index of message ardapoc.property.Property is not mutable"))
+	case "ardapoc.property.Property.manager":
+		panic(fmt.Errorf("field manager of message ardapoc.property.Property is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -716,6 +811,16 @@ func (x *fastReflection_Property) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "ardapoc.property.Property.unit_number":
 		return protoreflect.ValueOfString("")
+	case "ardapoc.property.Property.metadata_uri":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.property.Property.nft_class_id":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.property.Property.nft_id":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.property.Property.valuation_index":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.property.Property.manager":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.property.Property"))
@@ -859,6 +964,26 @@ func (x *fastReflection_Property) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.MetadataUri)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftClassId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NftId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ValuationIndex)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Manager)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -888,6 +1013,51 @@ func (x *fastReflection_Property) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Manager) > 0 {
+			i -= len(x.Manager)
+			copy(dAtA[i:], x.Manager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Manager)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.ValuationIndex) > 0 {
+			i -= len(x.ValuationIndex)
+			copy(dAtA[i:], x.ValuationIndex)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ValuationIndex)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.NftId) > 0 {
+			i -= len(x.NftId)
+			copy(dAtA[i:], x.NftId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.NftClassId) > 0 {
+			i -= len(x.NftClassId)
+			copy(dAtA[i:], x.NftClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NftClassId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.MetadataUri) > 0 {
+			i -= len(x.MetadataUri)
+			copy(dAtA[i:], x.MetadataUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataUri)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 		if len(x.UnitNumber) > 0 {
 			i -= len(x.UnitNumber)
 			copy(dAtA[i:], x.UnitNumber)
@@ -1659,6 +1829,166 @@ func (x *fastReflection_Property) ProtoMethods() *protoiface.Methods {
 				}
 				x.UnitNumber = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MetadataUri = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NftId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValuationIndex", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValuationIndex = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Manager = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2278,6 +2608,16 @@ type Property struct {
 	OwnerInformation        string `protobuf:"bytes,15,opt,name=owner_information,json=ownerInformation,proto3" json:"owner_information,omitempty"`                      // legal entity or individual that owns it
 	TenantId                string `protobuf:"bytes,16,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                                              // name of the occupying tenant and their ID
 	UnitNumber              string `protobuf:"bytes,17,opt,name=unit_number,json=unitNumber,proto3" json:"unit_number,omitempty"`                                        // unit number / apartment number
+	// title NFT representing the property in x/nft
+	MetadataUri string `protobuf:"bytes,18,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"` // off-chain metadata document for the title
+	NftClassId  string `protobuf:"bytes,19,opt,name=nft_class_id,json=nftClassId,proto3" json:"nft_class_id,omitempty"`  // x/nft class of the property's region
+	NftId       string `protobuf:"bytes,20,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`                   // x/nft token id; share tokens are fractions of it
+	// oracle price index of the region when the property was registered; the
+	// property's value moves with the region index from there
+	ValuationIndex string `protobuf:"bytes,21,opt,name=valuation_index,json=valuationIndex,proto3" json:"valuation_index,omitempty"`
+	// manager runs the property on behalf of its owners, who choose it through a
+	// shareholder proposal
+	Manager string `protobuf:"bytes,22,opt,name=manager,proto3" json:"manager,omitempty"`
 }
 
 func (x *Property) Reset() {
@@ -2419,6 +2759,41 @@ func (x *Property) GetUnitNumber() string {
 	return ""
 }
 
+func (x *Property) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
+func (x *Property) GetNftClassId() string {
+	if x != nil {
+		return x.NftClassId
+	}
+	return ""
+}
+
+func (x *Property) GetNftId() string {
+	if x != nil {
+		return x.NftId
+	}
+	return ""
+}
+
+func (x *Property) GetValuationIndex() string {
+	if x != nil {
+		return x.ValuationIndex
+	}
+	return ""
+}
+
+func (x *Property) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
 type Transfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2476,7 +2851,7 @@ var file_ardapoc_property_property_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
 	0x74, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x10, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x22, 0xde, 0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x72, 0x74, 0x79, 0x22, 0xfd, 0x05, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
@@ -2514,23 +2889,32 @@ var file_ardapoc_property_property_proto_rawDesc = []byte{
 	0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
 	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x74, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0xa4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70,
-	0x6f, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x0d, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x72,
-	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
-	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x58,
-	0xaa, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0xca, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
-	0x5c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a,
-	0x3a, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x66, 0x74, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e,
+	0x66, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x66, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x66, 0x74, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0xa4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x0d, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x61, 0x72, 0x64,
+	0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0xa2, 0x02, 0x03, 0x41, 0x50, 0x58, 0xaa,
+	0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0xca, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0xe2, 0x02, 0x1c, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName      = "/ardapoc.property.Query/Params"
-	Query_PropertyAll_FullMethodName = "/ardapoc.property.Query/PropertyAll"
-	Query_Property_FullMethodName    = "/ardapoc.property.Query/Property"
+	Query_Params_FullMethodName            = "/ardapoc.property.Query/Params"
+	Query_PropertyAll_FullMethodName       = "/ardapoc.property.Query/PropertyAll"
+	Query_Property_FullMethodName          = "/ardapoc.property.Query/Property"
+	Query_DenomAlias_FullMethodName        = "/ardapoc.property.Query/DenomAlias"
+	Query_GovernanceAddress_FullMethodName = "/ardapoc.property.Query/GovernanceAddress"
+	Query_ProposalAll_FullMethodName       = "/ardapoc.property.Query/ProposalAll"
+	Query_Proposal_FullMethodName          = "/ardapoc.property.Query/Proposal"
+	Query_Votes_FullMethodName             = "/ardapoc.property.Query/Votes"
+	Query_FeesCollected_FullMethodName     = "/ardapoc.property.Query/FeesCollected"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,6 +38,17 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	PropertyAll(ctx context.Context, in *QueryAllPropertyRequest, opts ...grpc.CallOption) (*QueryAllPropertyResponse, error)
 	Property(ctx context.Context, in *QueryGetPropertyRequest, opts ...grpc.CallOption) (*QueryGetPropertyResponse, error)
+	// DenomAlias resolves a legacy share denom to the denom that replaced it.
+	DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error)
+	// GovernanceAddress returns the account that executes the passed proposals
+	// of a property.
+	GovernanceAddress(ctx context.Context, in *QueryGovernanceAddressRequest, opts ...grpc.CallOption) (*QueryGovernanceAddressResponse, error)
+	ProposalAll(ctx context.Context, in *QueryAllProposalRequest, opts ...grpc.CallOption) (*QueryAllProposalResponse, error)
+	Proposal(ctx context.Context, in *QueryGetProposalRequest, opts ...grpc.CallOption) (*QueryGetProposalResponse, error)
+	// Votes returns the votes cast on a proposal.
+	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// FeesCollected returns the fees collected in a region, per period.
+	FeesCollected(ctx context.Context, in *QueryFeesCollectedRequest, opts ...grpc.CallOption) (*QueryFeesCollectedResponse, error)
 }
 
 type queryClient struct {
@@ -69,6 +86,60 @@ func (c *queryClient) Property(ctx context.Context, in *QueryGetPropertyRequest,
 	return out, nil
 }
 
+func (c *queryClient) DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error) {
+	out := new(QueryDenomAliasResponse)
+	err := c.cc.Invoke(ctx, Query_DenomAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceAddress(ctx context.Context, in *QueryGovernanceAddressRequest, opts ...grpc.CallOption) (*QueryGovernanceAddressResponse, error) {
+	out := new(QueryGovernanceAddressResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalAll(ctx context.Context, in *QueryAllProposalRequest, opts ...grpc.CallOption) (*QueryAllProposalResponse, error) {
+	out := new(QueryAllProposalResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryGetProposalRequest, opts ...grpc.CallOption) (*QueryGetProposalResponse, error) {
+	out := new(QueryGetProposalResponse)
+	err := c.cc.Invoke(ctx, Query_Proposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, Query_Votes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeesCollected(ctx context.Context, in *QueryFeesCollectedRequest, opts ...grpc.CallOption) (*QueryFeesCollectedResponse, error) {
+	out := new(QueryFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, Query_FeesCollected_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -77,6 +148,17 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	PropertyAll(context.Context, *QueryAllPropertyRequest) (*QueryAllPropertyResponse, error)
 	Property(context.Context, *QueryGetPropertyRequest) (*QueryGetPropertyResponse, error)
+	// DenomAlias resolves a legacy share denom to the denom that replaced it.
+	DenomAlias(context.Context, *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error)
+	// GovernanceAddress returns the account that executes the passed proposals
+	// of a property.
+	GovernanceAddress(context.Context, *QueryGovernanceAddressRequest) (*QueryGovernanceAddressResponse, error)
+	ProposalAll(context.Context, *QueryAllProposalRequest) (*QueryAllProposalResponse, error)
+	Proposal(context.Context, *QueryGetProposalRequest) (*QueryGetProposalResponse, error)
+	// Votes returns the votes cast on a proposal.
+	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// FeesCollected returns the fees collected in a region, per period.
+	FeesCollected(context.Context, *QueryFeesCollectedRequest) (*QueryFeesCollectedResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -93,6 +175,24 @@ func (UnimplementedQueryServer) PropertyAll(context.Context, *QueryAllPropertyRe
 func (UnimplementedQueryServer) Property(context.Context, *QueryGetPropertyRequest) (*QueryGetPropertyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Property not implemented")
 }
+func (UnimplementedQueryServer) DenomAlias(context.Context, *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAlias not implemented")
+}
+func (UnimplementedQueryServer) GovernanceAddress(context.Context, *QueryGovernanceAddressRequest) (*QueryGovernanceAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceAddress not implemented")
+}
+func (UnimplementedQueryServer) ProposalAll(context.Context, *QueryAllProposalRequest) (*QueryAllProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalAll not implemented")
+}
+func (UnimplementedQueryServer) Proposal(context.Context, *QueryGetProposalRequest) (*QueryGetProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (UnimplementedQueryServer) Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+}
+func (UnimplementedQueryServer) FeesCollected(context.Context, *QueryFeesCollectedRequest) (*QueryFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeesCollected not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +260,114 @@ func _Query_Property_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAlias(ctx, req.(*QueryDenomAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceAddress(ctx, req.(*QueryGovernanceAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalAll(ctx, req.(*QueryAllProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Proposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryGetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Votes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Votes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeesCollected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeesCollected(ctx, req.(*QueryFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +387,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Property",
 			Handler:    _Query_Property_Handler,
 		},
+		{
+			MethodName: "DenomAlias",
+			Handler:    _Query_DenomAlias_Handler,
+		},
+		{
+			MethodName: "GovernanceAddress",
+			Handler:    _Query_GovernanceAddress_Handler,
+		},
+		{
+			MethodName: "ProposalAll",
+			Handler:    _Query_ProposalAll_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Votes",
+			Handler:    _Query_Votes_Handler,
+		},
+		{
+			MethodName: "FeesCollected",
+			Handler:    _Query_FeesCollected_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/property/query.proto",
@@ -23,6 +23,9 @@ const (
 	Msg_RegisterProperty_FullMethodName     = "/ardapoc.property.Msg/RegisterProperty"
 	Msg_TransferShares_FullMethodName       = "/ardapoc.property.Msg/TransferShares"
 	Msg_EditPropertyMetadata_FullMethodName = "/ardapoc.property.Msg/EditPropertyMetadata"
+	Msg_SubmitProposal_FullMethodName       = "/ardapoc.property.Msg/SubmitProposal"
+	Msg_Vote_FullMethodName                 = "/ardapoc.property.Msg/Vote"
+	Msg_SetPropertyManager_FullMethodName   = "/ardapoc.property.Msg/SetPropertyManager"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,14 @@ type MsgClient interface {
 	RegisterProperty(ctx context.Context, in *MsgRegisterProperty, opts ...grpc.CallOption) (*MsgRegisterPropertyResponse, error)
 	TransferShares(ctx context.Context, in *MsgTransferShares, opts ...grpc.CallOption) (*MsgTransferSharesResponse, error)
 	EditPropertyMetadata(ctx context.Context, in *MsgEditPropertyMetadata, opts ...grpc.CallOption) (*MsgEditPropertyMetadataResponse, error)
+	// SubmitProposal puts a decision to the shareholders of a property.
+	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
+	// Vote casts the vote of a shareholder on a proposal.
+	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// SetPropertyManager appoints the manager of a property. It is signed by the
+	// property's governance account, so the owners choose the manager through a
+	// proposal.
+	SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error)
 }
 
 type msgClient struct {
@@ -81,6 +92,33 @@ func (c *msgClient) EditPropertyMetadata(ctx context.Context, in *MsgEditPropert
 	return out, nil
 }
 
+func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
+	out := new(MsgSubmitProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error) {
+	out := new(MsgVoteResponse)
+	err := c.cc.Invoke(ctx, Msg_Vote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error) {
+	out := new(MsgSetPropertyManagerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPropertyManager_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +129,14 @@ type MsgServer interface {
 	RegisterProperty(context.Context, *MsgRegisterProperty) (*MsgRegisterPropertyResponse, error)
 	TransferShares(context.Context, *MsgTransferShares) (*MsgTransferSharesResponse, error)
 	EditPropertyMetadata(context.Context, *MsgEditPropertyMetadata) (*MsgEditPropertyMetadataResponse, error)
+	// SubmitProposal puts a decision to the shareholders of a property.
+	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
+	// Vote casts the vote of a shareholder on a proposal.
+	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// SetPropertyManager appoints the manager of a property. It is signed by the
+	// property's governance account, so the owners choose the manager through a
+	// proposal.
+	SetPropertyManager(context.Context, *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -110,6 +156,15 @@ func (UnimplementedMsgServer) TransferShares(context.Context, *MsgTransferShares
 func (UnimplementedMsgServer) EditPropertyMetadata(context.Context, *MsgEditPropertyMetadata) (*MsgEditPropertyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditPropertyMetadata not implemented")
 }
+func (UnimplementedMsgServer) SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
+}
+func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (UnimplementedMsgServer) SetPropertyManager(context.Context, *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPropertyManager not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -195,6 +250,60 @@ func _Msg_EditPropertyMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Vote(ctx, req.(*MsgVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPropertyManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPropertyManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPropertyManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPropertyManager_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPropertyManager(ctx, req.(*MsgSetPropertyManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +327,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditPropertyMetadata",
 			Handler:    _Msg_EditPropertyMetadata_Handler,
 		},
+		{
+			MethodName: "SubmitProposal",
+			Handler:    _Msg_SubmitProposal_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Msg_Vote_Handler,
+		},
+		{
+			MethodName: "SetPropertyManager",
+			Handler:    _Msg_SetPropertyManager_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/property/tx.proto",
@@ -14,15 +14,384 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*MintInfo
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintInfo)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintInfo)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(MintInfo)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(MintInfo)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*Minter
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Minter)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(Minter)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(Minter)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field FrozenAddresses as it is not of Message kind"))
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field Attestors as it is not of Message kind"))
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*ReserveAttestation
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReserveAttestation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReserveAttestation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(ReserveAttestation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(ReserveAttestation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*WithdrawalRequest
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WithdrawalRequest)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WithdrawalRequest)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(WithdrawalRequest)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(WithdrawalRequest)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*VaultLoan
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultLoan)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultLoan)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(VaultLoan)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(VaultLoan)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_params              protoreflect.FieldDescriptor
+	fd_GenesisState_mintInfoList        protoreflect.FieldDescriptor
+	fd_GenesisState_minters             protoreflect.FieldDescriptor
+	fd_GenesisState_frozen_addresses    protoreflect.FieldDescriptor
+	fd_GenesisState_paused              protoreflect.FieldDescriptor
+	fd_GenesisState_attestors           protoreflect.FieldDescriptor
+	fd_GenesisState_attestations        protoreflect.FieldDescriptor
+	fd_GenesisState_attestation_count   protoreflect.FieldDescriptor
+	fd_GenesisState_vault               protoreflect.FieldDescriptor
+	fd_GenesisState_withdrawal_requests protoreflect.FieldDescriptor
+	fd_GenesisState_withdrawal_count    protoreflect.FieldDescriptor
+	fd_GenesisState_vault_loans         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_usdarda_genesis_proto_init()
 	md_GenesisState = File_ardapoc_usdarda_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_mintInfoList = md_GenesisState.Fields().ByName("mintInfoList")
+	fd_GenesisState_minters = md_GenesisState.Fields().ByName("minters")
+	fd_GenesisState_frozen_addresses = md_GenesisState.Fields().ByName("frozen_addresses")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_attestors = md_GenesisState.Fields().ByName("attestors")
+	fd_GenesisState_attestations = md_GenesisState.Fields().ByName("attestations")
+	fd_GenesisState_attestation_count = md_GenesisState.Fields().ByName("attestation_count")
+	fd_GenesisState_vault = md_GenesisState.Fields().ByName("vault")
+	fd_GenesisState_withdrawal_requests = md_GenesisState.Fields().ByName("withdrawal_requests")
+	fd_GenesisState_withdrawal_count = md_GenesisState.Fields().ByName("withdrawal_count")
+	fd_GenesisState_vault_loans = md_GenesisState.Fields().ByName("vault_loans")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +465,72 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.MintInfoList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.MintInfoList})
+		if !f(fd_GenesisState_mintInfoList, value) {
+			return
+		}
+	}
+	if len(x.Minters) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Minters})
+		if !f(fd_GenesisState_minters, value) {
+			return
+		}
+	}
+	if len(x.FrozenAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.FrozenAddresses})
+		if !f(fd_GenesisState_frozen_addresses, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if len(x.Attestors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Attestors})
+		if !f(fd_GenesisState_attestors, value) {
+			return
+		}
+	}
+	if len(x.Attestations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.Attestations})
+		if !f(fd_GenesisState_attestations, value) {
+			return
+		}
+	}
+	if x.AttestationCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AttestationCount)
+		if !f(fd_GenesisState_attestation_count, value) {
+			return
+		}
+	}
+	if x.Vault != nil {
+		value := protoreflect.ValueOfMessage(x.Vault.ProtoReflect())
+		if !f(fd_GenesisState_vault, value) {
+			return
+		}
+	}
+	if len(x.WithdrawalRequests) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.WithdrawalRequests})
+		if !f(fd_GenesisState_withdrawal_requests, value) {
+			return
+		}
+	}
+	if x.WithdrawalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WithdrawalCount)
+		if !f(fd_GenesisState_withdrawal_count, value) {
+			return
+		}
+	}
+	if len(x.VaultLoans) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.VaultLoans})
+		if !f(fd_GenesisState_vault_loans, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +548,28 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "ardapoc.usdarda.GenesisState.params":
 		return x.Params != nil
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		return len(x.MintInfoList) != 0
+	case "ardapoc.usdarda.GenesisState.minters":
+		return len(x.Minters) != 0
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		return len(x.FrozenAddresses) != 0
+	case "ardapoc.usdarda.GenesisState.paused":
+		return x.Paused != false
+	case "ardapoc.usdarda.GenesisState.attestors":
+		return len(x.Attestors) != 0
+	case "ardapoc.usdarda.GenesisState.attestations":
+		return len(x.Attestations) != 0
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		return x.AttestationCount != uint64(0)
+	case "ardapoc.usdarda.GenesisState.vault":
+		return x.Vault != nil
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		return len(x.WithdrawalRequests) != 0
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		return x.WithdrawalCount != uint64(0)
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		return len(x.VaultLoans) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -131,6 +588,28 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "ardapoc.usdarda.GenesisState.params":
 		x.Params = nil
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		x.MintInfoList = nil
+	case "ardapoc.usdarda.GenesisState.minters":
+		x.Minters = nil
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		x.FrozenAddresses = nil
+	case "ardapoc.usdarda.GenesisState.paused":
+		x.Paused = false
+	case "ardapoc.usdarda.GenesisState.attestors":
+		x.Attestors = nil
+	case "ardapoc.usdarda.GenesisState.attestations":
+		x.Attestations = nil
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		x.AttestationCount = uint64(0)
+	case "ardapoc.usdarda.GenesisState.vault":
+		x.Vault = nil
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		x.WithdrawalRequests = nil
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		x.WithdrawalCount = uint64(0)
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		x.VaultLoans = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -150,6 +629,60 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "ardapoc.usdarda.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		if len(x.MintInfoList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.MintInfoList}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.minters":
+		if len(x.Minters) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Minters}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		if len(x.FrozenAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.FrozenAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "ardapoc.usdarda.GenesisState.attestors":
+		if len(x.Attestors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.attestations":
+		if len(x.Attestations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		value := x.AttestationCount
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.usdarda.GenesisState.vault":
+		value := x.Vault
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		if len(x.WithdrawalRequests) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.WithdrawalRequests}
+		return protoreflect.ValueOfList(listValue)
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		value := x.WithdrawalCount
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		if len(x.VaultLoans) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.VaultLoans}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -172,6 +705,42 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "ardapoc.usdarda.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.MintInfoList = *clv.list
+	case "ardapoc.usdarda.GenesisState.minters":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Minters = *clv.list
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.FrozenAddresses = *clv.list
+	case "ardapoc.usdarda.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "ardapoc.usdarda.GenesisState.attestors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Attestors = *clv.list
+	case "ardapoc.usdarda.GenesisState.attestations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.Attestations = *clv.list
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		x.AttestationCount = value.Uint()
+	case "ardapoc.usdarda.GenesisState.vault":
+		x.Vault = value.Message().Interface().(*Vault)
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.WithdrawalRequests = *clv.list
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		x.WithdrawalCount = value.Uint()
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.VaultLoans = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -197,6 +766,59 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		if x.MintInfoList == nil {
+			x.MintInfoList = []*MintInfo{}
+		}
+		value := &_GenesisState_2_list{list: &x.MintInfoList}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.minters":
+		if x.Minters == nil {
+			x.Minters = []*Minter{}
+		}
+		value := &_GenesisState_3_list{list: &x.Minters}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		if x.FrozenAddresses == nil {
+			x.FrozenAddresses = []string{}
+		}
+		value := &_GenesisState_4_list{list: &x.FrozenAddresses}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.attestors":
+		if x.Attestors == nil {
+			x.Attestors = []string{}
+		}
+		value := &_GenesisState_6_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.attestations":
+		if x.Attestations == nil {
+			x.Attestations = []*ReserveAttestation{}
+		}
+		value := &_GenesisState_7_list{list: &x.Attestations}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.vault":
+		if x.Vault == nil {
+			x.Vault = new(Vault)
+		}
+		return protoreflect.ValueOfMessage(x.Vault.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		if x.WithdrawalRequests == nil {
+			x.WithdrawalRequests = []*WithdrawalRequest{}
+		}
+		value := &_GenesisState_10_list{list: &x.WithdrawalRequests}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		if x.VaultLoans == nil {
+			x.VaultLoans = []*VaultLoan{}
+		}
+		value := &_GenesisState_12_list{list: &x.VaultLoans}
+		return protoreflect.ValueOfList(value)
+	case "ardapoc.usdarda.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message ardapoc.usdarda.GenesisState is not mutable"))
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		panic(fmt.Errorf("field attestation_count of message ardapoc.usdarda.GenesisState is not mutable"))
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		panic(fmt.Errorf("field withdrawal_count of message ardapoc.usdarda.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -213,6 +835,36 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "ardapoc.usdarda.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.mintInfoList":
+		list := []*MintInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.minters":
+		list := []*Minter{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.frozen_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "ardapoc.usdarda.GenesisState.attestors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.attestations":
+		list := []*ReserveAttestation{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.attestation_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.usdarda.GenesisState.vault":
+		m := new(Vault)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.usdarda.GenesisState.withdrawal_requests":
+		list := []*WithdrawalRequest{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "ardapoc.usdarda.GenesisState.withdrawal_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.usdarda.GenesisState.vault_loans":
+		list := []*VaultLoan{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.GenesisState"))
@@ -286,6 +938,61 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.MintInfoList) > 0 {
+			for _, e := range x.MintInfoList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Minters) > 0 {
+			for _, e := range x.Minters {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FrozenAddresses) > 0 {
+			for _, s := range x.FrozenAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Paused {
+			n += 2
+		}
+		if len(x.Attestors) > 0 {
+			for _, s := range x.Attestors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Attestations) > 0 {
+			for _, e := range x.Attestations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AttestationCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.AttestationCount))
+		}
+		if x.Vault != nil {
+			l = options.Size(x.Vault)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WithdrawalRequests) > 0 {
+			for _, e := range x.WithdrawalRequests {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.WithdrawalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.WithdrawalCount))
+		}
+		if len(x.VaultLoans) > 0 {
+			for _, e := range x.VaultLoans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,8 +1022,45 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if len(x.VaultLoans) > 0 {
+			for iNdEx := len(x.VaultLoans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VaultLoans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.WithdrawalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WithdrawalCount))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.WithdrawalRequests) > 0 {
+			for iNdEx := len(x.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WithdrawalRequests[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.Vault != nil {
+			encoded, err := options.Marshal(x.Vault)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -327,35 +1071,130 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x4a
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.AttestationCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AttestationCount))
+			i--
+			dAtA[i] = 0x40
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		if len(x.Attestations) > 0 {
+			for iNdEx := len(x.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Attestations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
 		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
+		if len(x.Attestors) > 0 {
+			for iNdEx := len(x.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attestors[iNdEx])
+				copy(dAtA[i:], x.Attestors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestors[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.FrozenAddresses) > 0 {
+			for iNdEx := len(x.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FrozenAddresses[iNdEx])
+				copy(dAtA[i:], x.FrozenAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FrozenAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Minters) > 0 {
+			for iNdEx := len(x.Minters) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Minters[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.MintInfoList) > 0 {
+			for iNdEx := len(x.MintInfoList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintInfoList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
@@ -414,6 +1253,334 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintInfoList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintInfoList = append(x.MintInfoList, &MintInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintInfoList[len(x.MintInfoList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Minters = append(x.Minters, &Minter{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Minters[len(x.Minters)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FrozenAddresses = append(x.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestors = append(x.Attestors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestations = append(x.Attestations, &ReserveAttestation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Attestations[len(x.Attestations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
+				}
+				x.AttestationCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AttestationCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Vault == nil {
+					x.Vault = &Vault{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Vault); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WithdrawalRequests = append(x.WithdrawalRequests, &WithdrawalRequest{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WithdrawalRequests[len(x.WithdrawalRequests)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+				}
+				x.WithdrawalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WithdrawalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultLoans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultLoans = append(x.VaultLoans, &VaultLoan{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VaultLoans[len(x.VaultLoans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -469,7 +1636,20 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params       *Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	MintInfoList []*MintInfo `protobuf:"bytes,2,rep,name=mintInfoList,proto3" json:"mintInfoList,omitempty"`
+	Minters      []*Minter   `protobuf:"bytes,3,rep,name=minters,proto3" json:"minters,omitempty"`
+	// frozen_addresses can neither send nor receive usdarda.
+	FrozenAddresses []string `protobuf:"bytes,4,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty"`
+	// paused halts all usdarda transfers.
+	Paused             bool                  `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	Attestors          []string              `protobuf:"bytes,6,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	Attestations       []*ReserveAttestation `protobuf:"bytes,7,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	AttestationCount   uint64                `protobuf:"varint,8,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	Vault              *Vault                `protobuf:"bytes,9,opt,name=vault,proto3" json:"vault,omitempty"`
+	WithdrawalRequests []*WithdrawalRequest  `protobuf:"bytes,10,rep,name=withdrawal_requests,json=withdrawalRequests,proto3" json:"withdrawal_requests,omitempty"`
+	WithdrawalCount    uint64                `protobuf:"varint,11,opt,name=withdrawal_count,json=withdrawalCount,proto3" json:"withdrawal_count,omitempty"`
+	VaultLoans         []*VaultLoan          `protobuf:"bytes,12,rep,name=vault_loans,json=vaultLoans,proto3" json:"vault_loans,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +1679,83 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetMintInfoList() []*MintInfo {
+	if x != nil {
+		return x.MintInfoList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMinters() []*Minter {
+	if x != nil {
+		return x.Minters
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFrozenAddresses() []string {
+	if x != nil {
+		return x.FrozenAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisState) GetAttestors() []string {
+	if x != nil {
+		return x.Attestors
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAttestations() []*ReserveAttestation {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAttestationCount() uint64 {
+	if x != nil {
+		return x.AttestationCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetVault() *Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWithdrawalRequests() []*WithdrawalRequest {
+	if x != nil {
+		return x.WithdrawalRequests
+	}
+	return nil
+}
+
+func (x *GenesisState) GetWithdrawalCount() uint64 {
+	if x != nil {
+		return x.WithdrawalCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetVaultLoans() []*VaultLoan {
+	if x != nil {
+		return x.VaultLoans
+	}
+	return nil
+}
+
 var File_ardapoc_usdarda_genesis_proto protoreflect.FileDescriptor
 
 var file_ardapoc_usdarda_genesis_proto_rawDesc = []byte{
@@ -509,22 +1766,67 @@ var file_ardapoc_usdarda_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x72, 0x64, 0x61, 0x70,
 	0x6f, 0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
-	0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61,
-	0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x42, 0x0c, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x61, 0x72, 0x64,
-	0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
-	0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x41, 0x55, 0x58, 0xaa, 0x02,
-	0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61,
-	0xca, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73, 0x64, 0x61, 0x72,
-	0x64, 0x61, 0xe2, 0x02, 0x1b, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73, 0x64,
-	0x61, 0x72, 0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x55, 0x73, 0x64, 0x61,
-	0x72, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f,
+	0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x75,
+	0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xa2, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73,
+	0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x43, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e,
+	0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4d,
+	0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75,
+	0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x59,
+	0x0a, 0x13, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2e, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x1b, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64,
+	0x61, 0x70, 0x6f, 0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x41,
+	0x55, 0x58, 0xaa, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x55, 0x73, 0x64,
+	0x61, 0x72, 0x64, 0x61, 0xca, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55,
+	0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xe2, 0x02, 0x1b, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63,
+	0x5c, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a,
+	0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -541,16 +1843,28 @@ func file_ardapoc_usdarda_genesis_proto_rawDescGZIP() []byte {
 
 var file_ardapoc_usdarda_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_ardapoc_usdarda_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: ardapoc.usdarda.GenesisState
-	(*Params)(nil),       // 1: ardapoc.usdarda.Params
+	(*GenesisState)(nil),       // 0: ardapoc.usdarda.GenesisState
+	(*Params)(nil),             // 1: ardapoc.usdarda.Params
+	(*MintInfo)(nil),           // 2: ardapoc.usdarda.MintInfo
+	(*Minter)(nil),             // 3: ardapoc.usdarda.Minter
+	(*ReserveAttestation)(nil), // 4: ardapoc.usdarda.ReserveAttestation
+	(*Vault)(nil),              // 5: ardapoc.usdarda.Vault
+	(*WithdrawalRequest)(nil),  // 6: ardapoc.usdarda.WithdrawalRequest
+	(*VaultLoan)(nil),          // 7: ardapoc.usdarda.VaultLoan
 }
 var file_ardapoc_usdarda_genesis_proto_depIdxs = []int32{
 	1, // 0: ardapoc.usdarda.GenesisState.params:type_name -> ardapoc.usdarda.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: ardapoc.usdarda.GenesisState.mintInfoList:type_name -> ardapoc.usdarda.MintInfo
+	3, // 2: ardapoc.usdarda.GenesisState.minters:type_name -> ardapoc.usdarda.Minter
+	4, // 3: ardapoc.usdarda.GenesisState.attestations:type_name -> ardapoc.usdarda.ReserveAttestation
+	5, // 4: ardapoc.usdarda.GenesisState.vault:type_name -> ardapoc.usdarda.Vault
+	6, // 5: ardapoc.usdarda.GenesisState.withdrawal_requests:type_name -> ardapoc.usdarda.WithdrawalRequest
+	7, // 6: ardapoc.usdarda.GenesisState.vault_loans:type_name -> ardapoc.usdarda.VaultLoan
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_ardapoc_usdarda_genesis_proto_init() }
@@ -559,6 +1873,10 @@ func file_ardapoc_usdarda_genesis_proto_init() {
 		return
 	}
 	file_ardapoc_usdarda_params_proto_init()
+	file_ardapoc_usdarda_mint_info_proto_init()
+	file_ardapoc_usdarda_minter_proto_init()
+	file_ardapoc_usdarda_reserve_proto_init()
+	file_ardapoc_usdarda_vault_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ardapoc_usdarda_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
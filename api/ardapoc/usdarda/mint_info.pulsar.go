@@ -0,0 +1,1005 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package usdarda
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_MintInfo                 protoreflect.MessageDescriptor
+	fd_MintInfo_property_id     protoreflect.FieldDescriptor
+	fd_MintInfo_minted          protoreflect.FieldDescriptor
+	fd_MintInfo_burned          protoreflect.FieldDescriptor
+	fd_MintInfo_stability_fees  protoreflect.FieldDescriptor
+	fd_MintInfo_fees_accrued_at protoreflect.FieldDescriptor
+	fd_MintInfo_liquidating     protoreflect.FieldDescriptor
+	fd_MintInfo_review_time     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_ardapoc_usdarda_mint_info_proto_init()
+	md_MintInfo = File_ardapoc_usdarda_mint_info_proto.Messages().ByName("MintInfo")
+	fd_MintInfo_property_id = md_MintInfo.Fields().ByName("property_id")
+	fd_MintInfo_minted = md_MintInfo.Fields().ByName("minted")
+	fd_MintInfo_burned = md_MintInfo.Fields().ByName("burned")
+	fd_MintInfo_stability_fees = md_MintInfo.Fields().ByName("stability_fees")
+	fd_MintInfo_fees_accrued_at = md_MintInfo.Fields().ByName("fees_accrued_at")
+	fd_MintInfo_liquidating = md_MintInfo.Fields().ByName("liquidating")
+	fd_MintInfo_review_time = md_MintInfo.Fields().ByName("review_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintInfo)(nil)
+
+type fastReflection_MintInfo MintInfo
+
+func (x *MintInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintInfo)(x)
+}
+
+func (x *MintInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_ardapoc_usdarda_mint_info_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintInfo_messageType fastReflection_MintInfo_messageType
+var _ protoreflect.MessageType = fastReflection_MintInfo_messageType{}
+
+type fastReflection_MintInfo_messageType struct{}
+
+func (x fastReflection_MintInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintInfo)(nil)
+}
+func (x fastReflection_MintInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintInfo)
+}
+func (x fastReflection_MintInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintInfo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintInfo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintInfo) Type() protoreflect.MessageType {
+	return _fastReflection_MintInfo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintInfo) New() protoreflect.Message {
+	return new(fastReflection_MintInfo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintInfo) Interface() protoreflect.ProtoMessage {
+	return (*MintInfo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PropertyId != "" {
+		value := protoreflect.ValueOfString(x.PropertyId)
+		if !f(fd_MintInfo_property_id, value) {
+			return
+		}
+	}
+	if x.Minted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Minted)
+		if !f(fd_MintInfo_minted, value) {
+			return
+		}
+	}
+	if x.Burned != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Burned)
+		if !f(fd_MintInfo_burned, value) {
+			return
+		}
+	}
+	if x.StabilityFees != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StabilityFees)
+		if !f(fd_MintInfo_stability_fees, value) {
+			return
+		}
+	}
+	if x.FeesAccruedAt != nil {
+		value := protoreflect.ValueOfMessage(x.FeesAccruedAt.ProtoReflect())
+		if !f(fd_MintInfo_fees_accrued_at, value) {
+			return
+		}
+	}
+	if x.Liquidating != false {
+		value := protoreflect.ValueOfBool(x.Liquidating)
+		if !f(fd_MintInfo_liquidating, value) {
+			return
+		}
+	}
+	if x.ReviewTime != nil {
+		value := protoreflect.ValueOfMessage(x.ReviewTime.ProtoReflect())
+		if !f(fd_MintInfo_review_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "ardapoc.usdarda.MintInfo.property_id":
+		return x.PropertyId != ""
+	case "ardapoc.usdarda.MintInfo.minted":
+		return x.Minted != uint64(0)
+	case "ardapoc.usdarda.MintInfo.burned":
+		return x.Burned != uint64(0)
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		return x.StabilityFees != uint64(0)
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		return x.FeesAccruedAt != nil
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		return x.Liquidating != false
+	case "ardapoc.usdarda.MintInfo.review_time":
+		return x.ReviewTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "ardapoc.usdarda.MintInfo.property_id":
+		x.PropertyId = ""
+	case "ardapoc.usdarda.MintInfo.minted":
+		x.Minted = uint64(0)
+	case "ardapoc.usdarda.MintInfo.burned":
+		x.Burned = uint64(0)
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		x.StabilityFees = uint64(0)
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		x.FeesAccruedAt = nil
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		x.Liquidating = false
+	case "ardapoc.usdarda.MintInfo.review_time":
+		x.ReviewTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "ardapoc.usdarda.MintInfo.property_id":
+		value := x.PropertyId
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.usdarda.MintInfo.minted":
+		value := x.Minted
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.usdarda.MintInfo.burned":
+		value := x.Burned
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		value := x.StabilityFees
+		return protoreflect.ValueOfUint64(value)
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		value := x.FeesAccruedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		value := x.Liquidating
+		return protoreflect.ValueOfBool(value)
+	case "ardapoc.usdarda.MintInfo.review_time":
+		value := x.ReviewTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "ardapoc.usdarda.MintInfo.property_id":
+		x.PropertyId = value.Interface().(string)
+	case "ardapoc.usdarda.MintInfo.minted":
+		x.Minted = value.Uint()
+	case "ardapoc.usdarda.MintInfo.burned":
+		x.Burned = value.Uint()
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		x.StabilityFees = value.Uint()
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		x.FeesAccruedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		x.Liquidating = value.Bool()
+	case "ardapoc.usdarda.MintInfo.review_time":
+		x.ReviewTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		if x.FeesAccruedAt == nil {
+			x.FeesAccruedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.FeesAccruedAt.ProtoReflect())
+	case "ardapoc.usdarda.MintInfo.review_time":
+		if x.ReviewTime == nil {
+			x.ReviewTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ReviewTime.ProtoReflect())
+	case "ardapoc.usdarda.MintInfo.property_id":
+		panic(fmt.Errorf("field property_id of message ardapoc.usdarda.MintInfo is not mutable"))
+	case "ardapoc.usdarda.MintInfo.minted":
+		panic(fmt.Errorf("field minted of message ardapoc.usdarda.MintInfo is not mutable"))
+	case "ardapoc.usdarda.MintInfo.burned":
+		panic(fmt.Errorf("field burned of message ardapoc.usdarda.MintInfo is not mutable"))
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		panic(fmt.Errorf("field stability_fees of message ardapoc.usdarda.MintInfo is not mutable"))
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		panic(fmt.Errorf("field liquidating of message ardapoc.usdarda.MintInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "ardapoc.usdarda.MintInfo.property_id":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.usdarda.MintInfo.minted":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.usdarda.MintInfo.burned":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.usdarda.MintInfo.stability_fees":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "ardapoc.usdarda.MintInfo.fees_accrued_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "ardapoc.usdarda.MintInfo.liquidating":
+		return protoreflect.ValueOfBool(false)
+	case "ardapoc.usdarda.MintInfo.review_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.MintInfo"))
+		}
+		panic(fmt.Errorf("message ardapoc.usdarda.MintInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in ardapoc.usdarda.MintInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PropertyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Minted != 0 {
+			n += 1 + runtime.Sov(uint64(x.Minted))
+		}
+		if x.Burned != 0 {
+			n += 1 + runtime.Sov(uint64(x.Burned))
+		}
+		if x.StabilityFees != 0 {
+			n += 1 + runtime.Sov(uint64(x.StabilityFees))
+		}
+		if x.FeesAccruedAt != nil {
+			l = options.Size(x.FeesAccruedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Liquidating {
+			n += 2
+		}
+		if x.ReviewTime != nil {
+			l = options.Size(x.ReviewTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ReviewTime != nil {
+			encoded, err := options.Marshal(x.ReviewTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Liquidating {
+			i--
+			if x.Liquidating {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.FeesAccruedAt != nil {
+			encoded, err := options.Marshal(x.FeesAccruedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.StabilityFees != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StabilityFees))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Burned != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Burned))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Minted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Minted))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PropertyId) > 0 {
+			i -= len(x.PropertyId)
+			copy(dAtA[i:], x.PropertyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PropertyId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PropertyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+				}
+				x.Minted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Minted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+				}
+				x.Burned = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Burned |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StabilityFees", wireType)
+				}
+				x.StabilityFees = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StabilityFees |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeesAccruedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeesAccruedAt == nil {
+					x.FeesAccruedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeesAccruedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Liquidating", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Liquidating = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ReviewTime == nil {
+					x.ReviewTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReviewTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: ardapoc/usdarda/mint_info.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MintInfo records the usdarda minted and burned against a property. While
+// anything is owed, usdarda holds a lien on the property in x/lien for it.
+type MintInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Minted     uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	Burned     uint64 `protobuf:"varint,3,opt,name=burned,proto3" json:"burned,omitempty"`
+	// stability_fees is the stability fee accrued and not yet paid. Burns pay
+	// it before the amount minted.
+	StabilityFees uint64 `protobuf:"varint,4,opt,name=stability_fees,json=stabilityFees,proto3" json:"stability_fees,omitempty"`
+	// fees_accrued_at is when the stability fee last accrued.
+	FeesAccruedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=fees_accrued_at,json=feesAccruedAt,proto3" json:"fees_accrued_at,omitempty"`
+	// liquidating is set while the property's value is below the liquidation
+	// threshold of what is owed, so that liquidators may repay the debt for
+	// shares.
+	Liquidating bool `protobuf:"varint,6,opt,name=liquidating,proto3" json:"liquidating,omitempty"`
+	// review_time is when the BeginBlocker next accrues the stability fee and
+	// checks the property's value against what is owed.
+	ReviewTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`
+}
+
+func (x *MintInfo) Reset() {
+	*x = MintInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ardapoc_usdarda_mint_info_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintInfo) ProtoMessage() {}
+
+// Deprecated: Use MintInfo.ProtoReflect.Descriptor instead.
+func (*MintInfo) Descriptor() ([]byte, []int) {
+	return file_ardapoc_usdarda_mint_info_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MintInfo) GetPropertyId() string {
+	if x != nil {
+		return x.PropertyId
+	}
+	return ""
+}
+
+func (x *MintInfo) GetMinted() uint64 {
+	if x != nil {
+		return x.Minted
+	}
+	return 0
+}
+
+func (x *MintInfo) GetBurned() uint64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+func (x *MintInfo) GetStabilityFees() uint64 {
+	if x != nil {
+		return x.StabilityFees
+	}
+	return 0
+}
+
+func (x *MintInfo) GetFeesAccruedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FeesAccruedAt
+	}
+	return nil
+}
+
+func (x *MintInfo) GetLiquidating() bool {
+	if x != nil {
+		return x.Liquidating
+	}
+	return false
+}
+
+func (x *MintInfo) GetReviewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewTime
+	}
+	return nil
+}
+
+var File_ardapoc_usdarda_mint_info_proto protoreflect.FileDescriptor
+
+var file_ardapoc_usdarda_mint_info_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64,
+	0x61, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72,
+	0x64, 0x61, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x02, 0x0a, 0x08, 0x4d, 0x69,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x4c,
+	0x0a, 0x0f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x66,
+	0x65, 0x65, 0x73, 0x41, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x45,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x9e, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72,
+	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x42, 0x0d, 0x4d,
+	0x69, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b,
+	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61,
+	0x70, 0x6f, 0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x41, 0x55,
+	0x58, 0xaa, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x55, 0x73, 0x64, 0x61,
+	0x72, 0x64, 0x61, 0xca, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73,
+	0x64, 0x61, 0x72, 0x64, 0x61, 0xe2, 0x02, 0x1b, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c,
+	0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x55,
+	0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ardapoc_usdarda_mint_info_proto_rawDescOnce sync.Once
+	file_ardapoc_usdarda_mint_info_proto_rawDescData = file_ardapoc_usdarda_mint_info_proto_rawDesc
+)
+
+func file_ardapoc_usdarda_mint_info_proto_rawDescGZIP() []byte {
+	file_ardapoc_usdarda_mint_info_proto_rawDescOnce.Do(func() {
+		file_ardapoc_usdarda_mint_info_proto_rawDescData = protoimpl.X.CompressGZIP(file_ardapoc_usdarda_mint_info_proto_rawDescData)
+	})
+	return file_ardapoc_usdarda_mint_info_proto_rawDescData
+}
+
+var file_ardapoc_usdarda_mint_info_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ardapoc_usdarda_mint_info_proto_goTypes = []interface{}{
+	(*MintInfo)(nil),              // 0: ardapoc.usdarda.MintInfo
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_ardapoc_usdarda_mint_info_proto_depIdxs = []int32{
+	1, // 0: ardapoc.usdarda.MintInfo.fees_accrued_at:type_name -> google.protobuf.Timestamp
+	1, // 1: ardapoc.usdarda.MintInfo.review_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ardapoc_usdarda_mint_info_proto_init() }
+func file_ardapoc_usdarda_mint_info_proto_init() {
+	if File_ardapoc_usdarda_mint_info_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ardapoc_usdarda_mint_info_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ardapoc_usdarda_mint_info_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ardapoc_usdarda_mint_info_proto_goTypes,
+		DependencyIndexes: file_ardapoc_usdarda_mint_info_proto_depIdxs,
+		MessageInfos:      file_ardapoc_usdarda_mint_info_proto_msgTypes,
+	}.Build()
+	File_ardapoc_usdarda_mint_info_proto = out.File
+	file_ardapoc_usdarda_mint_info_proto_rawDesc = nil
+	file_ardapoc_usdarda_mint_info_proto_goTypes = nil
+	file_ardapoc_usdarda_mint_info_proto_depIdxs = nil
+}
@@ -4,6 +4,7 @@ package usdarda
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -15,12 +16,22 @@ import (
 )
 
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                       protoreflect.MessageDescriptor
+	fd_Params_min_collateral_ratio  protoreflect.FieldDescriptor
+	fd_Params_liquidation_threshold protoreflect.FieldDescriptor
+	fd_Params_stability_fee         protoreflect.FieldDescriptor
+	fd_Params_liquidation_discount  protoreflect.FieldDescriptor
+	fd_Params_min_reserve_coverage  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_ardapoc_usdarda_params_proto_init()
 	md_Params = File_ardapoc_usdarda_params_proto.Messages().ByName("Params")
+	fd_Params_min_collateral_ratio = md_Params.Fields().ByName("min_collateral_ratio")
+	fd_Params_liquidation_threshold = md_Params.Fields().ByName("liquidation_threshold")
+	fd_Params_stability_fee = md_Params.Fields().ByName("stability_fee")
+	fd_Params_liquidation_discount = md_Params.Fields().ByName("liquidation_discount")
+	fd_Params_min_reserve_coverage = md_Params.Fields().ByName("min_reserve_coverage")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +99,36 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinCollateralRatio != "" {
+		value := protoreflect.ValueOfString(x.MinCollateralRatio)
+		if !f(fd_Params_min_collateral_ratio, value) {
+			return
+		}
+	}
+	if x.LiquidationThreshold != "" {
+		value := protoreflect.ValueOfString(x.LiquidationThreshold)
+		if !f(fd_Params_liquidation_threshold, value) {
+			return
+		}
+	}
+	if x.StabilityFee != "" {
+		value := protoreflect.ValueOfString(x.StabilityFee)
+		if !f(fd_Params_stability_fee, value) {
+			return
+		}
+	}
+	if x.LiquidationDiscount != "" {
+		value := protoreflect.ValueOfString(x.LiquidationDiscount)
+		if !f(fd_Params_liquidation_discount, value) {
+			return
+		}
+	}
+	if x.MinReserveCoverage != "" {
+		value := protoreflect.ValueOfString(x.MinReserveCoverage)
+		if !f(fd_Params_min_reserve_coverage, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +144,16 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		return x.MinCollateralRatio != ""
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		return x.LiquidationThreshold != ""
+	case "ardapoc.usdarda.Params.stability_fee":
+		return x.StabilityFee != ""
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		return x.LiquidationDiscount != ""
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		return x.MinReserveCoverage != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -119,6 +170,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		x.MinCollateralRatio = ""
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		x.LiquidationThreshold = ""
+	case "ardapoc.usdarda.Params.stability_fee":
+		x.StabilityFee = ""
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		x.LiquidationDiscount = ""
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		x.MinReserveCoverage = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -135,6 +196,21 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		value := x.MinCollateralRatio
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		value := x.LiquidationThreshold
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.usdarda.Params.stability_fee":
+		value := x.StabilityFee
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		value := x.LiquidationDiscount
+		return protoreflect.ValueOfString(value)
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		value := x.MinReserveCoverage
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -155,6 +231,16 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		x.MinCollateralRatio = value.Interface().(string)
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		x.LiquidationThreshold = value.Interface().(string)
+	case "ardapoc.usdarda.Params.stability_fee":
+		x.StabilityFee = value.Interface().(string)
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		x.LiquidationDiscount = value.Interface().(string)
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		x.MinReserveCoverage = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -175,6 +261,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		panic(fmt.Errorf("field min_collateral_ratio of message ardapoc.usdarda.Params is not mutable"))
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		panic(fmt.Errorf("field liquidation_threshold of message ardapoc.usdarda.Params is not mutable"))
+	case "ardapoc.usdarda.Params.stability_fee":
+		panic(fmt.Errorf("field stability_fee of message ardapoc.usdarda.Params is not mutable"))
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		panic(fmt.Errorf("field liquidation_discount of message ardapoc.usdarda.Params is not mutable"))
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		panic(fmt.Errorf("field min_reserve_coverage of message ardapoc.usdarda.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -188,6 +284,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "ardapoc.usdarda.Params.min_collateral_ratio":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.usdarda.Params.liquidation_threshold":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.usdarda.Params.stability_fee":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.usdarda.Params.liquidation_discount":
+		return protoreflect.ValueOfString("")
+	case "ardapoc.usdarda.Params.min_reserve_coverage":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: ardapoc.usdarda.Params"))
@@ -257,6 +363,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.MinCollateralRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StabilityFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationDiscount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinReserveCoverage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +412,41 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MinReserveCoverage) > 0 {
+			i -= len(x.MinReserveCoverage)
+			copy(dAtA[i:], x.MinReserveCoverage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinReserveCoverage)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.LiquidationDiscount) > 0 {
+			i -= len(x.LiquidationDiscount)
+			copy(dAtA[i:], x.LiquidationDiscount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationDiscount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.StabilityFee) > 0 {
+			i -= len(x.StabilityFee)
+			copy(dAtA[i:], x.StabilityFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StabilityFee)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LiquidationThreshold) > 0 {
+			i -= len(x.LiquidationThreshold)
+			copy(dAtA[i:], x.LiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationThreshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinCollateralRatio) > 0 {
+			i -= len(x.MinCollateralRatio)
+			copy(dAtA[i:], x.MinCollateralRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCollateralRatio)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +496,166 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCollateralRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinCollateralRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StabilityFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationDiscount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationDiscount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoverage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinReserveCoverage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +709,23 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// min_collateral_ratio is the lowest ratio of a property's value to the
+	// usdarda owed against it that a mint may leave, as a decimal, e.g. "1.5".
+	MinCollateralRatio string `protobuf:"bytes,1,opt,name=min_collateral_ratio,json=minCollateralRatio,proto3" json:"min_collateral_ratio,omitempty"`
+	// liquidation_threshold is the ratio of a property's value to the usdarda
+	// owed against it below which the property is liquidated.
+	LiquidationThreshold string `protobuf:"bytes,2,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+	// stability_fee is the annual fee charged on the usdarda minted against a
+	// property, as a decimal fraction.
+	StabilityFee string `protobuf:"bytes,3,opt,name=stability_fee,json=stabilityFee,proto3" json:"stability_fee,omitempty"`
+	// liquidation_discount is the discount on the property value at which
+	// liquidators receive shares, as a decimal fraction.
+	LiquidationDiscount string `protobuf:"bytes,4,opt,name=liquidation_discount,json=liquidationDiscount,proto3" json:"liquidation_discount,omitempty"`
+	// min_reserve_coverage is the lowest share of the usdarda supply that must be
+	// backed by attested reserves and property collateral for new mints, as a
+	// decimal, e.g. "1".
+	MinReserveCoverage string `protobuf:"bytes,5,opt,name=min_reserve_coverage,json=minReserveCoverage,proto3" json:"min_reserve_coverage,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +748,41 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_ardapoc_usdarda_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMinCollateralRatio() string {
+	if x != nil {
+		return x.MinCollateralRatio
+	}
+	return ""
+}
+
+func (x *Params) GetLiquidationThreshold() string {
+	if x != nil {
+		return x.LiquidationThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetStabilityFee() string {
+	if x != nil {
+		return x.StabilityFee
+	}
+	return ""
+}
+
+func (x *Params) GetLiquidationDiscount() string {
+	if x != nil {
+		return x.LiquidationDiscount
+	}
+	return ""
+}
+
+func (x *Params) GetMinReserveCoverage() string {
+	if x != nil {
+		return x.MinReserveCoverage
+	}
+	return ""
+}
+
 var File_ardapoc_usdarda_params_proto protoreflect.FileDescriptor
 
 var file_ardapoc_usdarda_params_proto_rawDesc = []byte{
@@ -417,21 +790,43 @@ var file_ardapoc_usdarda_params_proto_rawDesc = []byte{
 	0x61, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
 	0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x61, 0x72, 0x64,
-	0x61, 0x70, 0x6f, 0x63, 0x2f, 0x78, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72,
-	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x61, 0x72,
-	0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
-	0x63, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x41, 0x55, 0x58, 0xaa,
-	0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64,
-	0x61, 0xca, 0x02, 0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73, 0x64, 0x61,
-	0x72, 0x64, 0x61, 0xe2, 0x02, 0x1b, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73,
-	0x64, 0x61, 0x72, 0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x55, 0x73, 0x64,
-	0x61, 0x72, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x40,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x43, 0x0a, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x14, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x12, 0x41, 0x0a, 0x14, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x13, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a,
+	0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x3a,
+	0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f,
+	0x63, 0x2f, 0x78, 0x2f, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x2f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x9c, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x64, 0x61, 0x70,
+	0x6f, 0x63, 0x2e, 0x75, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x61, 0x72, 0x64, 0x61, 0x70,
+	0x6f, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2f, 0x75,
+	0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x41, 0x55, 0x58, 0xaa, 0x02, 0x0f, 0x41,
+	0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x2e, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61, 0xca, 0x02,
+	0x0f, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64, 0x61,
+	0xe2, 0x02, 0x1b, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x5c, 0x55, 0x73, 0x64, 0x61, 0x72,
+	0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x10, 0x41, 0x72, 0x64, 0x61, 0x70, 0x6f, 0x63, 0x3a, 0x3a, 0x55, 0x73, 0x64, 0x61, 0x72, 0x64,
+	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,7 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/ardapoc.usdarda.Query/Params"
+	Query_Params_FullMethodName             = "/ardapoc.usdarda.Query/Params"
+	Query_MintInfo_FullMethodName           = "/ardapoc.usdarda.Query/MintInfo"
+	Query_TotalOutstanding_FullMethodName   = "/ardapoc.usdarda.Query/TotalOutstanding"
+	Query_Liquidations_FullMethodName       = "/ardapoc.usdarda.Query/Liquidations"
+	Query_Minter_FullMethodName             = "/ardapoc.usdarda.Query/Minter"
+	Query_Minters_FullMethodName            = "/ardapoc.usdarda.Query/Minters"
+	Query_FrozenAddresses_FullMethodName    = "/ardapoc.usdarda.Query/FrozenAddresses"
+	Query_Paused_FullMethodName             = "/ardapoc.usdarda.Query/Paused"
+	Query_Attestors_FullMethodName          = "/ardapoc.usdarda.Query/Attestors"
+	Query_Attestations_FullMethodName       = "/ardapoc.usdarda.Query/Attestations"
+	Query_ReserveCoverage_FullMethodName    = "/ardapoc.usdarda.Query/ReserveCoverage"
+	Query_Vault_FullMethodName              = "/ardapoc.usdarda.Query/Vault"
+	Query_VaultApy_FullMethodName           = "/ardapoc.usdarda.Query/VaultApy"
+	Query_VaultBalance_FullMethodName       = "/ardapoc.usdarda.Query/VaultBalance"
+	Query_WithdrawalRequests_FullMethodName = "/ardapoc.usdarda.Query/WithdrawalRequests"
+	Query_VaultLoans_FullMethodName         = "/ardapoc.usdarda.Query/VaultLoans"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +43,38 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// MintInfo queries the usdarda minted and burned against a property.
+	MintInfo(ctx context.Context, in *QueryMintInfoRequest, opts ...grpc.CallOption) (*QueryMintInfoResponse, error)
+	// TotalOutstanding queries the usdarda outstanding against all properties.
+	TotalOutstanding(ctx context.Context, in *QueryTotalOutstandingRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingResponse, error)
+	// Liquidations lists the properties in liquidation.
+	Liquidations(ctx context.Context, in *QueryLiquidationsRequest, opts ...grpc.CallOption) (*QueryLiquidationsResponse, error)
+	// Minter shows the allowances of a minter.
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// Minters lists the minters.
+	Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error)
+	// FrozenAddresses lists the addresses that cannot send or receive usdarda.
+	FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error)
+	// Paused shows whether usdarda transfers are halted.
+	Paused(ctx context.Context, in *QueryPausedRequest, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	// Attestors lists the addresses allowed to attest reserves.
+	Attestors(ctx context.Context, in *QueryAttestorsRequest, opts ...grpc.CallOption) (*QueryAttestorsResponse, error)
+	// Attestations lists the reserve attestations, oldest first.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// ReserveCoverage compares the latest reserve attestation and the usdarda
+	// minted against property with the usdarda supply.
+	ReserveCoverage(ctx context.Context, in *QueryReserveCoverageRequest, opts ...grpc.CallOption) (*QueryReserveCoverageResponse, error)
+	// Vault shows the balance of the savings vault and its share price.
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
+	// VaultApy shows the annualized yield of the savings vault.
+	VaultApy(ctx context.Context, in *QueryVaultApyRequest, opts ...grpc.CallOption) (*QueryVaultApyResponse, error)
+	// VaultBalance shows the vault shares of an address and the usdarda they
+	// are worth.
+	VaultBalance(ctx context.Context, in *QueryVaultBalanceRequest, opts ...grpc.CallOption) (*QueryVaultBalanceResponse, error)
+	// WithdrawalRequests lists the queued vault withdrawals, oldest first.
+	WithdrawalRequests(ctx context.Context, in *QueryWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRequestsResponse, error)
+	// VaultLoans lists the loans out of the vault.
+	VaultLoans(ctx context.Context, in *QueryVaultLoansRequest, opts ...grpc.CallOption) (*QueryVaultLoansResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +94,179 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) MintInfo(ctx context.Context, in *QueryMintInfoRequest, opts ...grpc.CallOption) (*QueryMintInfoResponse, error) {
+	out := new(QueryMintInfoResponse)
+	err := c.cc.Invoke(ctx, Query_MintInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalOutstanding(ctx context.Context, in *QueryTotalOutstandingRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingResponse, error) {
+	out := new(QueryTotalOutstandingResponse)
+	err := c.cc.Invoke(ctx, Query_TotalOutstanding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Liquidations(ctx context.Context, in *QueryLiquidationsRequest, opts ...grpc.CallOption) (*QueryLiquidationsResponse, error) {
+	out := new(QueryLiquidationsResponse)
+	err := c.cc.Invoke(ctx, Query_Liquidations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, Query_Minter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error) {
+	out := new(QueryMintersResponse)
+	err := c.cc.Invoke(ctx, Query_Minters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error) {
+	out := new(QueryFrozenAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_FrozenAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPausedRequest, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, Query_Paused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestors(ctx context.Context, in *QueryAttestorsRequest, opts ...grpc.CallOption) (*QueryAttestorsResponse, error) {
+	out := new(QueryAttestorsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveCoverage(ctx context.Context, in *QueryReserveCoverageRequest, opts ...grpc.CallOption) (*QueryReserveCoverageResponse, error) {
+	out := new(QueryReserveCoverageResponse)
+	err := c.cc.Invoke(ctx, Query_ReserveCoverage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, Query_Vault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultApy(ctx context.Context, in *QueryVaultApyRequest, opts ...grpc.CallOption) (*QueryVaultApyResponse, error) {
+	out := new(QueryVaultApyResponse)
+	err := c.cc.Invoke(ctx, Query_VaultApy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultBalance(ctx context.Context, in *QueryVaultBalanceRequest, opts ...grpc.CallOption) (*QueryVaultBalanceResponse, error) {
+	out := new(QueryVaultBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_VaultBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawalRequests(ctx context.Context, in *QueryWithdrawalRequestsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRequestsResponse, error) {
+	out := new(QueryWithdrawalRequestsResponse)
+	err := c.cc.Invoke(ctx, Query_WithdrawalRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VaultLoans(ctx context.Context, in *QueryVaultLoansRequest, opts ...grpc.CallOption) (*QueryVaultLoansResponse, error) {
+	out := new(QueryVaultLoansResponse)
+	err := c.cc.Invoke(ctx, Query_VaultLoans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// MintInfo queries the usdarda minted and burned against a property.
+	MintInfo(context.Context, *QueryMintInfoRequest) (*QueryMintInfoResponse, error)
+	// TotalOutstanding queries the usdarda outstanding against all properties.
+	TotalOutstanding(context.Context, *QueryTotalOutstandingRequest) (*QueryTotalOutstandingResponse, error)
+	// Liquidations lists the properties in liquidation.
+	Liquidations(context.Context, *QueryLiquidationsRequest) (*QueryLiquidationsResponse, error)
+	// Minter shows the allowances of a minter.
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// Minters lists the minters.
+	Minters(context.Context, *QueryMintersRequest) (*QueryMintersResponse, error)
+	// FrozenAddresses lists the addresses that cannot send or receive usdarda.
+	FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error)
+	// Paused shows whether usdarda transfers are halted.
+	Paused(context.Context, *QueryPausedRequest) (*QueryPausedResponse, error)
+	// Attestors lists the addresses allowed to attest reserves.
+	Attestors(context.Context, *QueryAttestorsRequest) (*QueryAttestorsResponse, error)
+	// Attestations lists the reserve attestations, oldest first.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// ReserveCoverage compares the latest reserve attestation and the usdarda
+	// minted against property with the usdarda supply.
+	ReserveCoverage(context.Context, *QueryReserveCoverageRequest) (*QueryReserveCoverageResponse, error)
+	// Vault shows the balance of the savings vault and its share price.
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
+	// VaultApy shows the annualized yield of the savings vault.
+	VaultApy(context.Context, *QueryVaultApyRequest) (*QueryVaultApyResponse, error)
+	// VaultBalance shows the vault shares of an address and the usdarda they
+	// are worth.
+	VaultBalance(context.Context, *QueryVaultBalanceRequest) (*QueryVaultBalanceResponse, error)
+	// WithdrawalRequests lists the queued vault withdrawals, oldest first.
+	WithdrawalRequests(context.Context, *QueryWithdrawalRequestsRequest) (*QueryWithdrawalRequestsResponse, error)
+	// VaultLoans lists the loans out of the vault.
+	VaultLoans(context.Context, *QueryVaultLoansRequest) (*QueryVaultLoansResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +277,51 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) MintInfo(context.Context, *QueryMintInfoRequest) (*QueryMintInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintInfo not implemented")
+}
+func (UnimplementedQueryServer) TotalOutstanding(context.Context, *QueryTotalOutstandingRequest) (*QueryTotalOutstandingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalOutstanding not implemented")
+}
+func (UnimplementedQueryServer) Liquidations(context.Context, *QueryLiquidationsRequest) (*QueryLiquidationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidations not implemented")
+}
+func (UnimplementedQueryServer) Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (UnimplementedQueryServer) Minters(context.Context, *QueryMintersRequest) (*QueryMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (UnimplementedQueryServer) FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAddresses not implemented")
+}
+func (UnimplementedQueryServer) Paused(context.Context, *QueryPausedRequest) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (UnimplementedQueryServer) Attestors(context.Context, *QueryAttestorsRequest) (*QueryAttestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestors not implemented")
+}
+func (UnimplementedQueryServer) Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (UnimplementedQueryServer) ReserveCoverage(context.Context, *QueryReserveCoverageRequest) (*QueryReserveCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveCoverage not implemented")
+}
+func (UnimplementedQueryServer) Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (UnimplementedQueryServer) VaultApy(context.Context, *QueryVaultApyRequest) (*QueryVaultApyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultApy not implemented")
+}
+func (UnimplementedQueryServer) VaultBalance(context.Context, *QueryVaultBalanceRequest) (*QueryVaultBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultBalance not implemented")
+}
+func (UnimplementedQueryServer) WithdrawalRequests(context.Context, *QueryWithdrawalRequestsRequest) (*QueryWithdrawalRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalRequests not implemented")
+}
+func (UnimplementedQueryServer) VaultLoans(context.Context, *QueryVaultLoansRequest) (*QueryVaultLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VaultLoans not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +353,276 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MintInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintInfo(ctx, req.(*QueryMintInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalOutstanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalOutstandingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalOutstanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalOutstanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalOutstanding(ctx, req.(*QueryTotalOutstandingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Liquidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Liquidations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Liquidations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Liquidations(ctx, req.(*QueryLiquidationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Minter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Minters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FrozenAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAddresses(ctx, req.(*QueryFrozenAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestors(ctx, req.(*QueryAttestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReserveCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReserveCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveCoverage(ctx, req.(*QueryReserveCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Vault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultApy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultApyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultApy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultApy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultApy(ctx, req.(*QueryVaultApyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultBalance(ctx, req.(*QueryVaultBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawalRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WithdrawalRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalRequests(ctx, req.(*QueryWithdrawalRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VaultLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VaultLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VaultLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VaultLoans(ctx, req.(*QueryVaultLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +634,66 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MintInfo",
+			Handler:    _Query_MintInfo_Handler,
+		},
+		{
+			MethodName: "TotalOutstanding",
+			Handler:    _Query_TotalOutstanding_Handler,
+		},
+		{
+			MethodName: "Liquidations",
+			Handler:    _Query_Liquidations_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "FrozenAddresses",
+			Handler:    _Query_FrozenAddresses_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Attestors",
+			Handler:    _Query_Attestors_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "ReserveCoverage",
+			Handler:    _Query_ReserveCoverage_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
+		{
+			MethodName: "VaultApy",
+			Handler:    _Query_VaultApy_Handler,
+		},
+		{
+			MethodName: "VaultBalance",
+			Handler:    _Query_VaultBalance_Handler,
+		},
+		{
+			MethodName: "WithdrawalRequests",
+			Handler:    _Query_WithdrawalRequests_Handler,
+		},
+		{
+			MethodName: "VaultLoans",
+			Handler:    _Query_VaultLoans_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/usdarda/query.proto",
@@ -19,7 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/ardapoc.usdarda.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName        = "/ardapoc.usdarda.Msg/UpdateParams"
+	Msg_MintAgainstProperty_FullMethodName = "/ardapoc.usdarda.Msg/MintAgainstProperty"
+	Msg_BurnForProperty_FullMethodName     = "/ardapoc.usdarda.Msg/BurnForProperty"
+	Msg_Liquidate_FullMethodName           = "/ardapoc.usdarda.Msg/Liquidate"
+	Msg_ConfigureMinter_FullMethodName     = "/ardapoc.usdarda.Msg/ConfigureMinter"
+	Msg_RemoveMinter_FullMethodName        = "/ardapoc.usdarda.Msg/RemoveMinter"
+	Msg_Mint_FullMethodName                = "/ardapoc.usdarda.Msg/Mint"
+	Msg_Burn_FullMethodName                = "/ardapoc.usdarda.Msg/Burn"
+	Msg_FreezeAddress_FullMethodName       = "/ardapoc.usdarda.Msg/FreezeAddress"
+	Msg_UnfreezeAddress_FullMethodName     = "/ardapoc.usdarda.Msg/UnfreezeAddress"
+	Msg_SetPaused_FullMethodName           = "/ardapoc.usdarda.Msg/SetPaused"
+	Msg_RegisterAttestor_FullMethodName    = "/ardapoc.usdarda.Msg/RegisterAttestor"
+	Msg_RemoveAttestor_FullMethodName      = "/ardapoc.usdarda.Msg/RemoveAttestor"
+	Msg_AttestReserves_FullMethodName      = "/ardapoc.usdarda.Msg/AttestReserves"
+	Msg_DepositToVault_FullMethodName      = "/ardapoc.usdarda.Msg/DepositToVault"
+	Msg_WithdrawFromVault_FullMethodName   = "/ardapoc.usdarda.Msg/WithdrawFromVault"
+	Msg_LendFromVault_FullMethodName       = "/ardapoc.usdarda.Msg/LendFromVault"
+	Msg_RepayVaultLoan_FullMethodName      = "/ardapoc.usdarda.Msg/RepayVaultLoan"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +46,45 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// MintAgainstProperty mints usdarda against the value of a property.
+	MintAgainstProperty(ctx context.Context, in *MsgMintAgainstProperty, opts ...grpc.CallOption) (*MsgMintAgainstPropertyResponse, error)
+	// BurnForProperty burns usdarda to pay down what was minted against a
+	// property.
+	BurnForProperty(ctx context.Context, in *MsgBurnForProperty, opts ...grpc.CallOption) (*MsgBurnForPropertyResponse, error)
+	// Liquidate repays usdarda owed against a property in liquidation in
+	// exchange for shares of the property.
+	Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error)
+	// ConfigureMinter grants an address, or resets, allowances to mint and burn
+	// usdarda. Only the authority may configure minters.
+	ConfigureMinter(ctx context.Context, in *MsgConfigureMinter, opts ...grpc.CallOption) (*MsgConfigureMinterResponse, error)
+	// RemoveMinter revokes the allowances of a minter.
+	RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error)
+	// Mint mints usdarda to a recipient out of a minter's allowance.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn burns a minter's usdarda out of its allowance.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// FreezeAddress stops an address from sending or receiving usdarda.
+	FreezeAddress(ctx context.Context, in *MsgFreezeAddress, opts ...grpc.CallOption) (*MsgFreezeAddressResponse, error)
+	// UnfreezeAddress lifts the freeze of an address.
+	UnfreezeAddress(ctx context.Context, in *MsgUnfreezeAddress, opts ...grpc.CallOption) (*MsgUnfreezeAddressResponse, error)
+	// SetPaused halts or resumes all usdarda transfers.
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	// RegisterAttestor allows an address to attest reserves.
+	RegisterAttestor(ctx context.Context, in *MsgRegisterAttestor, opts ...grpc.CallOption) (*MsgRegisterAttestorResponse, error)
+	// RemoveAttestor revokes an attestor.
+	RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error)
+	// AttestReserves records the reserves backing usdarda.
+	AttestReserves(ctx context.Context, in *MsgAttestReserves, opts ...grpc.CallOption) (*MsgAttestReservesResponse, error)
+	// DepositToVault deposits usdarda in the savings vault for vault shares.
+	DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault redeems vault shares for usdarda, or queues the
+	// withdrawal while the vault lacks liquidity.
+	WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error)
+	// LendFromVault lends usdarda held by the vault to a borrower. Only the
+	// authority may lend.
+	LendFromVault(ctx context.Context, in *MsgLendFromVault, opts ...grpc.CallOption) (*MsgLendFromVaultResponse, error)
+	// RepayVaultLoan repays principal and pays interest on a vault loan.
+	RepayVaultLoan(ctx context.Context, in *MsgRepayVaultLoan, opts ...grpc.CallOption) (*MsgRepayVaultLoanResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +104,159 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) MintAgainstProperty(ctx context.Context, in *MsgMintAgainstProperty, opts ...grpc.CallOption) (*MsgMintAgainstPropertyResponse, error) {
+	out := new(MsgMintAgainstPropertyResponse)
+	err := c.cc.Invoke(ctx, Msg_MintAgainstProperty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnForProperty(ctx context.Context, in *MsgBurnForProperty, opts ...grpc.CallOption) (*MsgBurnForPropertyResponse, error) {
+	out := new(MsgBurnForPropertyResponse)
+	err := c.cc.Invoke(ctx, Msg_BurnForProperty_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Liquidate(ctx context.Context, in *MsgLiquidate, opts ...grpc.CallOption) (*MsgLiquidateResponse, error) {
+	out := new(MsgLiquidateResponse)
+	err := c.cc.Invoke(ctx, Msg_Liquidate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfigureMinter(ctx context.Context, in *MsgConfigureMinter, opts ...grpc.CallOption) (*MsgConfigureMinterResponse, error) {
+	out := new(MsgConfigureMinterResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfigureMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveMinter(ctx context.Context, in *MsgRemoveMinter, opts ...grpc.CallOption) (*MsgRemoveMinterResponse, error) {
+	out := new(MsgRemoveMinterResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, Msg_Mint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_Burn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeAddress(ctx context.Context, in *MsgFreezeAddress, opts ...grpc.CallOption) (*MsgFreezeAddressResponse, error) {
+	out := new(MsgFreezeAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAddress(ctx context.Context, in *MsgUnfreezeAddress, opts ...grpc.CallOption) (*MsgUnfreezeAddressResponse, error) {
+	out := new(MsgUnfreezeAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAttestor(ctx context.Context, in *MsgRegisterAttestor, opts ...grpc.CallOption) (*MsgRegisterAttestorResponse, error) {
+	out := new(MsgRegisterAttestorResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterAttestor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAttestor(ctx context.Context, in *MsgRemoveAttestor, opts ...grpc.CallOption) (*MsgRemoveAttestorResponse, error) {
+	out := new(MsgRemoveAttestorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAttestor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestReserves(ctx context.Context, in *MsgAttestReserves, opts ...grpc.CallOption) (*MsgAttestReservesResponse, error) {
+	out := new(MsgAttestReservesResponse)
+	err := c.cc.Invoke(ctx, Msg_AttestReserves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error) {
+	out := new(MsgDepositToVaultResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositToVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error) {
+	out := new(MsgWithdrawFromVaultResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawFromVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LendFromVault(ctx context.Context, in *MsgLendFromVault, opts ...grpc.CallOption) (*MsgLendFromVaultResponse, error) {
+	out := new(MsgLendFromVaultResponse)
+	err := c.cc.Invoke(ctx, Msg_LendFromVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepayVaultLoan(ctx context.Context, in *MsgRepayVaultLoan, opts ...grpc.CallOption) (*MsgRepayVaultLoanResponse, error) {
+	out := new(MsgRepayVaultLoanResponse)
+	err := c.cc.Invoke(ctx, Msg_RepayVaultLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +264,45 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// MintAgainstProperty mints usdarda against the value of a property.
+	MintAgainstProperty(context.Context, *MsgMintAgainstProperty) (*MsgMintAgainstPropertyResponse, error)
+	// BurnForProperty burns usdarda to pay down what was minted against a
+	// property.
+	BurnForProperty(context.Context, *MsgBurnForProperty) (*MsgBurnForPropertyResponse, error)
+	// Liquidate repays usdarda owed against a property in liquidation in
+	// exchange for shares of the property.
+	Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error)
+	// ConfigureMinter grants an address, or resets, allowances to mint and burn
+	// usdarda. Only the authority may configure minters.
+	ConfigureMinter(context.Context, *MsgConfigureMinter) (*MsgConfigureMinterResponse, error)
+	// RemoveMinter revokes the allowances of a minter.
+	RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error)
+	// Mint mints usdarda to a recipient out of a minter's allowance.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// Burn burns a minter's usdarda out of its allowance.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// FreezeAddress stops an address from sending or receiving usdarda.
+	FreezeAddress(context.Context, *MsgFreezeAddress) (*MsgFreezeAddressResponse, error)
+	// UnfreezeAddress lifts the freeze of an address.
+	UnfreezeAddress(context.Context, *MsgUnfreezeAddress) (*MsgUnfreezeAddressResponse, error)
+	// SetPaused halts or resumes all usdarda transfers.
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	// RegisterAttestor allows an address to attest reserves.
+	RegisterAttestor(context.Context, *MsgRegisterAttestor) (*MsgRegisterAttestorResponse, error)
+	// RemoveAttestor revokes an attestor.
+	RemoveAttestor(context.Context, *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error)
+	// AttestReserves records the reserves backing usdarda.
+	AttestReserves(context.Context, *MsgAttestReserves) (*MsgAttestReservesResponse, error)
+	// DepositToVault deposits usdarda in the savings vault for vault shares.
+	DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error)
+	// WithdrawFromVault redeems vault shares for usdarda, or queues the
+	// withdrawal while the vault lacks liquidity.
+	WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error)
+	// LendFromVault lends usdarda held by the vault to a borrower. Only the
+	// authority may lend.
+	LendFromVault(context.Context, *MsgLendFromVault) (*MsgLendFromVaultResponse, error)
+	// RepayVaultLoan repays principal and pays interest on a vault loan.
+	RepayVaultLoan(context.Context, *MsgRepayVaultLoan) (*MsgRepayVaultLoanResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +313,57 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) MintAgainstProperty(context.Context, *MsgMintAgainstProperty) (*MsgMintAgainstPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintAgainstProperty not implemented")
+}
+func (UnimplementedMsgServer) BurnForProperty(context.Context, *MsgBurnForProperty) (*MsgBurnForPropertyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnForProperty not implemented")
+}
+func (UnimplementedMsgServer) Liquidate(context.Context, *MsgLiquidate) (*MsgLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liquidate not implemented")
+}
+func (UnimplementedMsgServer) ConfigureMinter(context.Context, *MsgConfigureMinter) (*MsgConfigureMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureMinter not implemented")
+}
+func (UnimplementedMsgServer) RemoveMinter(context.Context, *MsgRemoveMinter) (*MsgRemoveMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMinter not implemented")
+}
+func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (UnimplementedMsgServer) FreezeAddress(context.Context, *MsgFreezeAddress) (*MsgFreezeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAddress not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeAddress(context.Context, *MsgUnfreezeAddress) (*MsgUnfreezeAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAddress not implemented")
+}
+func (UnimplementedMsgServer) SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (UnimplementedMsgServer) RegisterAttestor(context.Context, *MsgRegisterAttestor) (*MsgRegisterAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAttestor not implemented")
+}
+func (UnimplementedMsgServer) RemoveAttestor(context.Context, *MsgRemoveAttestor) (*MsgRemoveAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttestor not implemented")
+}
+func (UnimplementedMsgServer) AttestReserves(context.Context, *MsgAttestReserves) (*MsgAttestReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestReserves not implemented")
+}
+func (UnimplementedMsgServer) DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToVault not implemented")
+}
+func (UnimplementedMsgServer) WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromVault not implemented")
+}
+func (UnimplementedMsgServer) LendFromVault(context.Context, *MsgLendFromVault) (*MsgLendFromVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LendFromVault not implemented")
+}
+func (UnimplementedMsgServer) RepayVaultLoan(context.Context, *MsgRepayVaultLoan) (*MsgRepayVaultLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayVaultLoan not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +395,312 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MintAgainstProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintAgainstProperty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintAgainstProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintAgainstProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintAgainstProperty(ctx, req.(*MsgMintAgainstProperty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnForProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnForProperty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnForProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BurnForProperty_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnForProperty(ctx, req.(*MsgBurnForProperty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Liquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Liquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Liquidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Liquidate(ctx, req.(*MsgLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfigureMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfigureMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfigureMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfigureMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfigureMinter(ctx, req.(*MsgConfigureMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMinter(ctx, req.(*MsgRemoveMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Burn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAddress(ctx, req.(*MsgFreezeAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAddress(ctx, req.(*MsgUnfreezeAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterAttestor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAttestor(ctx, req.(*MsgRegisterAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAttestor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAttestor(ctx, req.(*MsgRemoveAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestReserves)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AttestReserves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestReserves(ctx, req.(*MsgAttestReserves))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositToVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToVault(ctx, req.(*MsgDepositToVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawFromVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromVault(ctx, req.(*MsgWithdrawFromVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LendFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLendFromVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LendFromVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LendFromVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LendFromVault(ctx, req.(*MsgLendFromVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayVaultLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayVaultLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayVaultLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RepayVaultLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayVaultLoan(ctx, req.(*MsgRepayVaultLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +712,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "MintAgainstProperty",
+			Handler:    _Msg_MintAgainstProperty_Handler,
+		},
+		{
+			MethodName: "BurnForProperty",
+			Handler:    _Msg_BurnForProperty_Handler,
+		},
+		{
+			MethodName: "Liquidate",
+			Handler:    _Msg_Liquidate_Handler,
+		},
+		{
+			MethodName: "ConfigureMinter",
+			Handler:    _Msg_ConfigureMinter_Handler,
+		},
+		{
+			MethodName: "RemoveMinter",
+			Handler:    _Msg_RemoveMinter_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "FreezeAddress",
+			Handler:    _Msg_FreezeAddress_Handler,
+		},
+		{
+			MethodName: "UnfreezeAddress",
+			Handler:    _Msg_UnfreezeAddress_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "RegisterAttestor",
+			Handler:    _Msg_RegisterAttestor_Handler,
+		},
+		{
+			MethodName: "RemoveAttestor",
+			Handler:    _Msg_RemoveAttestor_Handler,
+		},
+		{
+			MethodName: "AttestReserves",
+			Handler:    _Msg_AttestReserves_Handler,
+		},
+		{
+			MethodName: "DepositToVault",
+			Handler:    _Msg_DepositToVault_Handler,
+		},
+		{
+			MethodName: "WithdrawFromVault",
+			Handler:    _Msg_WithdrawFromVault_Handler,
+		},
+		{
+			MethodName: "LendFromVault",
+			Handler:    _Msg_LendFromVault_Handler,
+		},
+		{
+			MethodName: "RepayVaultLoan",
+			Handler:    _Msg_RepayVaultLoan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ardapoc/usdarda/tx.proto",
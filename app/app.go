@@ -76,7 +76,9 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	ardamodulekeeper "github.com/ardaglobal/arda-poc/x/arda/keeper"
+	lienmodulekeeper "github.com/ardaglobal/arda-poc/x/lien/keeper"
 	mortgagemodulekeeper "github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	oraclemodulekeeper "github.com/ardaglobal/arda-poc/x/oracle/keeper"
 	propertymodulekeeper "github.com/ardaglobal/arda-poc/x/property/keeper"
 	usdardamodulekeeper "github.com/ardaglobal/arda-poc/x/usdarda/keeper"
 
@@ -155,6 +157,8 @@ type App struct {
 	PropertyKeeper propertymodulekeeper.Keeper
 	UsdardaKeeper  usdardamodulekeeper.Keeper
 	MortgageKeeper mortgagemodulekeeper.Keeper
+	LienKeeper     lienmodulekeeper.Keeper
+	OracleKeeper   oraclemodulekeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -265,6 +269,8 @@ func New(
 		&app.PropertyKeeper,
 		&app.UsdardaKeeper,
 		&app.MortgageKeeper,
+		&app.LienKeeper,
+		&app.OracleKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDefinition
 	); err != nil {
 		panic(err)
@@ -280,6 +286,9 @@ func New(
 	defaultAnteHandler := app.App.AnteHandler()
 	app.App.SetAnteHandler(GaslessAnteHandler(defaultAnteHandler))
 
+	// collect validator price observations through vote extensions
+	app.registerOracleHandlers(appOpts)
+
 	// register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
 		return nil, err
@@ -305,13 +314,25 @@ func New(
 	// This is necessary for manually registered modules that do not support app wiring.
 	// Manually set the module version map as shown below.
 	// The upgrade module will automatically handle de-duplication of the module version map.
+	// Vote extensions are enabled from the first block for the oracle.
 	app.SetInitChainer(func(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 		if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()); err != nil {
 			return nil, err
 		}
-		return app.App.InitChainer(ctx, req)
+		res, err := app.App.InitChainer(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if res.ConsensusParams, err = app.enableVoteExtensions(ctx, max(req.InitialHeight, 1)); err != nil {
+			return nil, err
+		}
+		return res, nil
 	})
 
+	if err := app.setupUpgradeHandlers(); err != nil {
+		return nil, err
+	}
+
 	if err := app.Load(loadLatest); err != nil {
 		return nil, err
 	}
@@ -39,10 +39,16 @@ import (
 	"cosmossdk.io/x/feegrant"
 	"cosmossdk.io/x/nft"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+	lienmodulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/lien/module"
 	mortgagemodulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/mortgage/module"
+	oraclemodulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/oracle/module"
 	usdardamodulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/usdarda/module"
+	_ "github.com/ardaglobal/arda-poc/x/lien/module" // import for side-effects
+	lienmoduletypes "github.com/ardaglobal/arda-poc/x/lien/types"
 	_ "github.com/ardaglobal/arda-poc/x/mortgage/module" // import for side-effects
 	mortgagemoduletypes "github.com/ardaglobal/arda-poc/x/mortgage/types"
+	_ "github.com/ardaglobal/arda-poc/x/oracle/module" // import for side-effects
+	oraclemoduletypes "github.com/ardaglobal/arda-poc/x/oracle/types"
 	_ "github.com/ardaglobal/arda-poc/x/usdarda/module" // import for side-effects
 	usdardamoduletypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -107,6 +113,8 @@ var (
 		propertymoduletypes.ModuleName,
 		usdardamoduletypes.ModuleName,
 		mortgagemoduletypes.ModuleName,
+		lienmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	}
 
@@ -135,6 +143,8 @@ var (
 		propertymoduletypes.ModuleName,
 		usdardamoduletypes.ModuleName,
 		mortgagemoduletypes.ModuleName,
+		lienmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -157,6 +167,8 @@ var (
 		propertymoduletypes.ModuleName,
 		usdardamoduletypes.ModuleName,
 		mortgagemoduletypes.ModuleName,
+		lienmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	}
 
@@ -177,8 +189,9 @@ var (
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: ibcfeetypes.ModuleName},
 		{Account: icatypes.ModuleName},
-		{Account: propertymoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
+		{Account: propertymoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: usdardamoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: usdardamoduletypes.VaultAccountName},
 		{Account: mortgagemoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
@@ -191,6 +204,8 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		// usdarda enters the savings vault only through deposits, repayments and yield
+		usdardamoduletypes.VaultAccountName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -331,6 +346,14 @@ var (
 				Name:   mortgagemoduletypes.ModuleName,
 				Config: appconfig.WrapAny(&mortgagemodulev1.Module{}),
 			},
+			{
+				Name:   lienmoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&lienmodulev1.Module{}),
+			},
+			{
+				Name:   oraclemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&oraclemodulev1.Module{}),
+			},
 			// this line is used by starport scaffolding # stargate/app/moduleConfig
 		},
 	})
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	oracleabci "github.com/ardaglobal/arda-poc/x/oracle/abci"
+)
+
+// FlagOraclePriceFile is the app.toml option naming the JSON file a validator
+// reads the price observations it votes with from. Validators without it vote
+// with no observations.
+const FlagOraclePriceFile = "oracle.price_file"
+
+// registerOracleHandlers sets the ABCI handlers through which validators
+// submit price observations in their vote extensions and the chain aggregates
+// them into x/oracle before each block.
+func (app *App) registerOracleHandlers(appOpts servertypes.AppOptions) {
+	var source oracleabci.PriceSource
+	if path := cast.ToString(appOpts.Get(FlagOraclePriceFile)); path != "" {
+		source = oracleabci.FilePriceSource(path)
+	}
+	voteExtHandler := oracleabci.NewVoteExtHandler(app.Logger(), source)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVote())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtension())
+
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(app.Mempool(), app.App.BaseApp)
+	proposalHandler := oracleabci.NewProposalHandler(
+		app.Logger(),
+		app.StakingKeeper,
+		defaultProposalHandler.PrepareProposalHandler(),
+		defaultProposalHandler.ProcessProposalHandler(),
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposal())
+	app.SetProcessProposal(proposalHandler.ProcessProposal())
+
+	app.SetPreBlocker(oracleabci.NewPreBlocker(app.OracleKeeper, app.App.PreBlocker))
+}
+
+// enableVoteExtensions has validators extend their votes from height on, as
+// the oracle needs, and returns the resulting consensus params. Vote
+// extensions already enabled are left as they are.
+func (app *App) enableVoteExtensions(ctx sdk.Context, height int64) (*cmtproto.ConsensusParams, error) {
+	params, err := app.ConsensusParamsKeeper.ParamsStore.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Abci == nil {
+		params.Abci = &cmtproto.ABCIParams{}
+	}
+	if params.Abci.VoteExtensionsEnableHeight > 0 {
+		return &params, nil
+	}
+	params.Abci.VoteExtensionsEnableHeight = height
+	if err := app.ConsensusParamsKeeper.ParamsStore.Set(ctx, params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
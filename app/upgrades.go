@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	oracletypes "github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// Upgrade defines a named software upgrade. Its handler runs the in-place store
+// migrations registered by the modules whose consensus version was bumped.
+type Upgrade struct {
+	// Name is the upgrade name used in the governance upgrade plan.
+	Name string
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+	// Handler optionally runs before the migrations, for changes outside the
+	// modules' state such as consensus params.
+	Handler func(ctx sdk.Context, app *App) error
+}
+
+// Upgrades lists all upgrades supported by the application, oldest first.
+var Upgrades = []Upgrade{
+	// represents properties as x/nft title NFTs
+	{Name: "v0.2.0"},
+	// moves share and marker tokens to safe denoms and registers denom metadata
+	{Name: "v0.3.0"},
+	// adds the lien registry and places liens for existing mortgages and mints
+	{
+		Name:          "v0.4.0",
+		StoreUpgrades: storetypes.StoreUpgrades{Added: []string{lientypes.ModuleName}},
+	},
+	// adds shareholder governance params for property proposals
+	{Name: "v0.5.0"},
+	// replaces free-form mortgage rates and terms with amortization schedules
+	{Name: "v0.6.0"},
+	// tracks mortgage due dates, delinquency and default
+	{Name: "v0.7.0"},
+	// escrows mortgage collateral shares and adds foreclosure
+	{Name: "v0.8.0"},
+	// replaces mortgage updates with refinance proposals and keeps terms history
+	{Name: "v0.9.0"},
+	// adds home equity loans limited by a maximum loan-to-value
+	{Name: "v0.10.0"},
+	// issues transferable loan notes for the lender positions of funded mortgages
+	{Name: "v0.11.0"},
+	// indexes mortgages by lender, lendee, collateral and status
+	{Name: "v0.12.0"},
+	// pulls mortgage installments through authz grants and charges late fees once
+	{Name: "v0.13.0"},
+	// stores usdarda mint info in protobuf and exports it in genesis
+	{Name: "v0.14.0"},
+	// adds usdarda collateral ratios, stability fees and liquidations
+	{Name: "v0.15.0"},
+	// adds usdarda proof-of-reserve attestations and the min reserve coverage
+	{Name: "v0.16.0"},
+	// adds the x/oracle valuation oracle and enables the vote extensions it
+	// collects validator price observations through
+	{
+		Name:          "v0.17.0",
+		StoreUpgrades: storetypes.StoreUpgrades{Added: []string{oracletypes.ModuleName}},
+		Handler: func(ctx sdk.Context, app *App) error {
+			_, err := app.enableVoteExtensions(ctx, ctx.BlockHeight()+1)
+			return err
+		},
+	},
+	// adds the usdarda savings vault and the share of mortgage interest paid
+	// into it
+	{Name: "v0.18.0"},
+	// stores property denom aliases in protobuf and exports them in genesis,
+	// enforces liens on bank sends of property shares, scopes mortgage liens
+	// to the lendee's shares, returns collateral escrowed for mortgages the
+	// lendee never requested, bounds mortgage purchase prices by the value of
+	// the shares bought, queues the usdarda stability fee reviews and
+	// checkpoints the voting weights on open property proposals and keeps
+	// running totals of the principal outstanding per lender and property
+	{Name: "v0.19.0"},
+}
+
+// setupUpgradeHandlers registers the upgrade handlers and, when the node restarts
+// at an upgrade height, the store loader applying that upgrade's store changes.
+func (app *App) setupUpgradeHandlers() error {
+	for _, upgrade := range Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			upgrade.Name,
+			func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+				if upgrade.Handler != nil {
+					if err := upgrade.Handler(sdk.UnwrapSDKContext(ctx), app); err != nil {
+						return nil, err
+					}
+				}
+				return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			},
+		)
+	}
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		return fmt.Errorf("failed to read upgrade info from disk: %w", err)
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return nil
+	}
+
+	for _, upgrade := range Upgrades {
+		if upgradeInfo.Name == upgrade.Name {
+			storeUpgrades := upgrade.StoreUpgrades
+			app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+		}
+	}
+
+	return nil
+}
@@ -23,8 +23,14 @@ func initCometBFTConfig() *cmtcfg.Config {
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
 	// The following code snippet is just for reference.
+	type OracleConfig struct {
+		PriceFile string `mapstructure:"price_file"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		Oracle OracleConfig `mapstructure:"oracle"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -50,7 +56,17 @@ func initAppConfig() (string, interface{}) {
 		Config: *srvCfg,
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                           Oracle Configuration                          ###
+###############################################################################
+
+[oracle]
+
+# JSON file of the region price index and property valuation observations the
+# validator extends its votes with. Leave empty to vote without observations.
+price_file = "{{ .Oracle.PriceFile }}"
+`
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -3,10 +3,8 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
-	"strings"
 	"time"
 
 	"cosmossdk.io/math"
@@ -30,6 +28,11 @@ type MortgageRequest struct {
 	Term         string    `json:"term"`
 	Status       string    `json:"status"` // e.g., "pending", "completed"
 	Timestamp    time.Time `json:"timestamp"`
+	// RequestTxHash is the hash of the MsgRequestMortgage that recorded the
+	// request on chain. Requests without one cannot be approved.
+	RequestTxHash string `json:"request_tx_hash,omitempty"`
+	// Equity marks a home equity loan against shares the lendee already owns.
+	Equity bool `json:"equity,omitempty"`
 
 	// Property purchase details
 	PropertyID string   `json:"property_id,omitempty"`
@@ -40,6 +43,19 @@ type MortgageRequest struct {
 	Price      uint64   `json:"price,omitempty"`
 }
 
+// purchase returns the property purchase the request finances, or nil if it
+// finances none.
+func (mr MortgageRequest) purchase() *mortgagetypes.MortgagePurchase {
+	if mr.Equity || mr.Index == "equity" || mr.PropertyID == "" || len(mr.FromOwners) == 0 || len(mr.FromShares) == 0 {
+		return nil
+	}
+	return &mortgagetypes.MortgagePurchase{
+		FromOwners: mr.FromOwners,
+		FromShares: mr.FromShares,
+		Price:      mr.Price,
+	}
+}
+
 // MortgageRequestPayload is used for both requesting and creating a mortgage, including property purchase details.
 type MortgageRequestPayload struct {
 	Lender       string `json:"lender"`
@@ -65,6 +81,12 @@ type RepayMortgageRequest struct {
 	Amount     uint64 `json:"amount"`
 }
 
+// AcceptMortgagePurchaseRequest defines the request body for accepting the
+// purchase a mortgage request finances.
+type AcceptMortgagePurchaseRequest struct {
+	MortgageID string `json:"mortgage_id"`
+}
+
 // CreateMortgageByIDRequest defines the request body for creating a mortgage by ID.
 type CreateMortgageByIDRequest struct {
 	ID string `json:"id"`
@@ -72,7 +94,7 @@ type CreateMortgageByIDRequest struct {
 
 // createMortgageHandler handles the creation of a mortgage, approving a pending request.
 // @Summary Create a mortgage (lender)
-// @Description Submits a transaction to create a new mortgage, effectively approving a pending request. This must be called by the **lender**, who must be logged in. The sidecar will use the logged-in user's account to sign the transaction, funding the mortgage from their account. The request body should only contain the ID of a pending mortgage request.
+// @Description Submits a transaction to create a new mortgage, effectively approving a pending request. This must be called by the **lender**, who must be logged in. The sidecar will use the logged-in user's account to sign the transaction, funding the mortgage from their account. If the request finances a property purchase, the sellers are paid and the shares are transferred to the lendee and escrowed in the same transaction. The request body should only contain the ID of a pending mortgage request.
 // @Accept json
 // @Produce json
 // @Param request body CreateMortgageByIDRequest true "mortgage request ID"
@@ -119,18 +141,19 @@ func (s *Server) createMortgageHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The chain only funds mortgages the lendee requested on chain
+	if mr.RequestTxHash == "" {
+		http.Error(w, "Mortgage request was not recorded on chain; the lendee must request it again", http.StatusBadRequest)
+		return
+	}
+
 	fromName := s.loggedInUser
 	msgBuilder := func(fromAddr string) sdk.Msg {
-		return mortgagetypes.NewMsgCreateMortgage(
-			fromAddr, // creator
-			mr.Index,
-			fromAddr, // lender is the creator
-			mr.LendeeAddr,
-			mr.Collateral,
-			mr.Amount,
-			mr.InterestRate,
-			mr.Term,
-		)
+		// Purchases are funded, paid and escrowed in a single transaction
+		if mr.purchase() != nil {
+			return mortgagetypes.NewMsgPurchaseWithMortgage(fromAddr, mr.Index)
+		}
+		return mortgagetypes.NewMsgApproveMortgage(fromAddr, mr.Index)
 	}
 
 	txHash, err := s.buildSignAndBroadcastInternal(r.Context(), fromName, "create_mortgage", msgBuilder)
@@ -142,20 +165,8 @@ func (s *Server) createMortgageHandler(w http.ResponseWriter, r *http.Request) {
 
 	// After successfully broadcasting, update the original request's status
 	mr.Status = "completed"
-	// After mortgage approval, immediately send transfer shares request if property purchase details are present
-	if mr.Index != "equity" {
-		if mr.PropertyID != "" && len(mr.FromOwners) > 0 && len(mr.FromShares) > 0 && len(mr.ToOwners) > 0 && len(mr.ToShares) > 0 {
-			transferReq := TransferSharesRequest{
-				PropertyID: mr.PropertyID,
-				FromOwners: mr.FromOwners,
-				FromShares: mr.FromShares,
-				ToOwners:   mr.ToOwners,
-				ToShares:   mr.ToShares,
-			}
-			transferReqBody, _ := json.Marshal(transferReq)
-			r2 := &http.Request{Body: io.NopCloser(strings.NewReader(string(transferReqBody))), Method: http.MethodPost}
-			s.transferSharesHandler(w, r2)
-		}
+	if mr.purchase() != nil {
+		s.updateForSalePropertiesOnTransfer(mr.PropertyID, mr.FromOwners, mr.FromShares)
 	}
 	if err := s.saveMortgageRequestsToFile(); err != nil {
 		zlog.Error().Err(err).Msgf("failed to update status for mortgage request id %s", mr.ID)
@@ -165,6 +176,41 @@ func (s *Server) createMortgageHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(map[string]string{"tx_hash": txHash})
 }
 
+// acceptMortgagePurchaseHandler handles a seller accepting the purchase of its shares.
+// @Summary Accept a mortgage purchase (seller)
+// @Description Submits a transaction agreeing to sell the logged-in user's shares at the price of a mortgage request that finances their purchase. This must be called by every **seller** before the lender can fund the request.
+// @Accept json
+// @Produce json
+// @Param request body AcceptMortgagePurchaseRequest true "mortgage request index"
+// @Success 200 {object} map[string]string{tx_hash=string}
+// @Router /bank/mortgage/accept-purchase [post]
+func (s *Server) acceptMortgagePurchaseHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if s.loggedInUser == "" {
+		http.Error(w, "No user is logged in. The seller must be logged in to accept a purchase.", http.StatusUnauthorized)
+		return
+	}
+
+	var req AcceptMortgagePurchaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	zlog.Info().Str("handler", "acceptMortgagePurchaseHandler").Interface("request", req).Msg("received request")
+
+	fromName := s.loggedInUser
+	msgBuilder := func(fromAddr string) sdk.Msg {
+		return mortgagetypes.NewMsgAcceptPurchase(fromAddr, req.MortgageID)
+	}
+
+	s.buildSignAndBroadcast(w, r, fromName, "accept_mortgage_purchase", msgBuilder)
+}
+
 // repayMortgageHandler handles the repayment of a mortgage.
 // @Summary Repay a mortgage (lendee)
 // @Description Submits a transaction to repay a portion of an outstanding mortgage. This must be called by the **lendee**, who must be logged in.
@@ -257,7 +303,7 @@ func (s *Server) requestFundsHandler(w http.ResponseWriter, r *http.Request) {
 
 // requestMortgageHandler allows a user to request a mortgage from a lender.
 // @Summary Request a mortgage (lendee)
-// @Description Allows a logged-in user (the lendee) to request a mortgage from a specified lender. This request is stored by the sidecar and does not submit a transaction. It creates a pending request that the lender can later approve.
+// @Description Allows a logged-in user (the lendee) to request a mortgage from a specified lender. The request is recorded on chain with a MsgRequestMortgage signed by the lendee, and the property purchase details are kept by the sidecar. It creates a pending request that the lender can later approve.
 // @Accept json
 // @Produce json
 // @Param request body MortgageRequestPayload true "mortgage request (with property purchase details)"
@@ -293,6 +339,12 @@ func (s *Server) requestMortgageHandler(w http.ResponseWriter, r *http.Request)
 	// Get the requester's (lendee's) address
 	requesterData := s.users[s.loggedInUser]
 
+	rate, termMonths, err := parseMortgageTerms(req.InterestRate, req.Term)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	newReq := MortgageRequest{
 		ID:           uuid.New().String(),
 		Requester:    s.loggedInUser,
@@ -312,6 +364,35 @@ func (s *Server) requestMortgageHandler(w http.ResponseWriter, r *http.Request)
 		ToShares:     req.ToShares,
 		Price:        req.Price,
 	}
+	// A financed purchase buys shares of the property that then secure the mortgage
+	purchase := newReq.purchase()
+	if purchase != nil {
+		newReq.Collateral = req.PropertyID
+	}
+
+	// Record the request on chain; nothing moves until the lender approves it
+	lenderAddr := s.users[req.Lender].Address
+	txHash, err := s.buildSignAndBroadcastInternal(r.Context(), s.loggedInUser, "request_mortgage", func(fromAddr string) sdk.Msg {
+		return mortgagetypes.NewMsgRequestMortgage(
+			fromAddr, // lendee
+			req.Index,
+			lenderAddr,
+			newReq.Collateral,
+			req.Amount,
+			rate,
+			termMonths,
+			mortgagetypes.MONTHLY,
+			mortgagetypes.ANNUITY,
+			purchase,
+		)
+	})
+	if err != nil {
+		zlog.Error().Err(err).Msg("failed to build sign and broadcast")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	newReq.RequestTxHash = txHash
 
 	s.mortgageRequests = append(s.mortgageRequests, newReq)
 	if err := s.saveMortgageRequestsToFile(); err != nil {
@@ -358,7 +439,7 @@ func (s *Server) getMortgageRequestsHandler(w http.ResponseWriter, r *http.Reque
 
 // requestEquityMortgageHandler allows a user to request a home equity mortgage against a property they own, routed to a lender for approval.
 // @Summary Request a home equity mortgage (pending lender approval)
-// @Description Allows a user to request a home equity mortgage against a property they own. The request is routed to the specified lender for approval. The index is set to 'equity'.
+// @Description Allows a user to request a home equity mortgage against a property they own. The request is recorded on chain and routed to the specified lender for approval. The amount, together with the debt already secured by liens on the user's shares, may not exceed the maximum loan-to-value of their current value. Without an index, a unique 'equity-' index is generated.
 // @Accept json
 // @Produce json
 // @Param request body MortgageRequestPayload true "equity mortgage request"
@@ -391,24 +472,59 @@ func (s *Server) requestEquityMortgageHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 	requesterData := s.users[s.loggedInUser]
+
+	rate, termMonths, err := parseMortgageTerms(req.InterestRate, req.Term)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := uuid.New().String()
+	index := req.Index
+	if index == "" {
+		index = "equity-" + id
+	}
+	collateral := req.Collateral
+	if collateral == "" {
+		collateral = req.PropertyID
+	}
+
+	// Record the request on chain; the chain rejects loans above the maximum loan-to-value
+	lenderAddr := s.users[req.Lender].Address
+	txHash, err := s.buildSignAndBroadcastInternal(r.Context(), s.loggedInUser, "request_equity_loan", func(fromAddr string) sdk.Msg {
+		return mortgagetypes.NewMsgRequestEquityLoan(
+			fromAddr, // lendee
+			index,
+			lenderAddr,
+			collateral,
+			req.Amount,
+			rate,
+			termMonths,
+			mortgagetypes.MONTHLY,
+			mortgagetypes.ANNUITY,
+		)
+	})
+	if err != nil {
+		zlog.Error().Err(err).Msg("failed to build sign and broadcast")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	newReq := MortgageRequest{
-		ID:           uuid.New().String(),
-		Requester:    s.loggedInUser,
-		Lender:       req.Lender,
-		LendeeAddr:   requesterData.Address,
-		Index:        "equity",
-		Collateral:   req.Collateral,
-		Amount:       req.Amount,
-		InterestRate: req.InterestRate,
-		Term:         req.Term,
-		Status:       "pending",
-		Timestamp:    time.Now(),
-		PropertyID:   req.PropertyID,
-		FromOwners:   req.FromOwners,
-		FromShares:   req.FromShares,
-		ToOwners:     req.ToOwners,
-		ToShares:     req.ToShares,
-		Price:        req.Price,
+		ID:            id,
+		Requester:     s.loggedInUser,
+		Lender:        req.Lender,
+		LendeeAddr:    requesterData.Address,
+		Index:         index,
+		Collateral:    collateral,
+		Amount:        req.Amount,
+		InterestRate:  req.InterestRate,
+		Term:          req.Term,
+		Status:        "pending",
+		Timestamp:     time.Now(),
+		PropertyID:    req.PropertyID,
+		RequestTxHash: txHash,
+		Equity:        true,
 	}
 	s.mortgageRequests = append(s.mortgageRequests, newReq)
 	if err := s.saveMortgageRequestsToFile(); err != nil {
@@ -421,6 +537,21 @@ func (s *Server) requestEquityMortgageHandler(w http.ResponseWriter, r *http.Req
 	json.NewEncoder(w).Encode(newReq)
 }
 
+// parseMortgageTerms converts the free-form interest rate and term of a request,
+// such as "5%" and "30y", into the decimal rate and months used on chain.
+// Requests are paid in monthly annuity installments.
+func parseMortgageTerms(interestRate, term string) (string, uint32, error) {
+	rate, err := mortgagetypes.ParseInterestRate(interestRate)
+	if err != nil {
+		return "", 0, err
+	}
+	termMonths, err := mortgagetypes.ParseTermMonths(term)
+	if err != nil {
+		return "", 0, err
+	}
+	return rate.String(), termMonths, nil
+}
+
 func (s *Server) saveMortgageRequestsToFile() error {
 	data, err := json.MarshalIndent(s.mortgageRequests, "", "  ")
 	if err != nil {
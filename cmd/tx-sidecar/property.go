@@ -20,6 +20,8 @@ type RegisterPropertyRequest struct {
 	Value   uint64   `json:"value"`
 	Owners  []string `json:"owners"`
 	Shares  []uint64 `json:"shares"`
+	// MetadataURI optionally points to the title metadata carried by the property NFT.
+	MetadataURI string `json:"metadata_uri,omitempty"`
 }
 
 // TransferSharesRequest defines the request body for transferring property shares.
@@ -117,7 +119,7 @@ func (s *Server) registerPropertyHandler(w http.ResponseWriter, r *http.Request)
 
 	fromName := "ERES" // In a real app, this might come from the request or config
 	msgBuilder := func(fromAddr string) sdk.Msg {
-		return propertytypes.NewMsgRegisterProperty(
+		msg := propertytypes.NewMsgRegisterProperty(
 			fromAddr,
 			req.Address,
 			req.Region,
@@ -125,6 +127,8 @@ func (s *Server) registerPropertyHandler(w http.ResponseWriter, r *http.Request)
 			req.Owners,
 			req.Shares,
 		)
+		msg.MetadataUri = req.MetadataURI
+		return msg
 	}
 
 	s.buildSignAndBroadcast(w, r, fromName, "register_property", msgBuilder)
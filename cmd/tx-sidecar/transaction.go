@@ -15,6 +15,8 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	zlog "github.com/rs/zerolog/log"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
 )
 
 // TransactionEvent represents a single event in the transaction lifecycle
@@ -366,14 +368,14 @@ func (s *Server) getTransactionHandler(w http.ResponseWriter, r *http.Request) {
 		response["txhash"] = getTxRes.TxResponse.TxHash
 		response["timestamp"] = getTxRes.TxResponse.Timestamp
 
-		// Filter for and include only the 'submission' event, and stringify it.
-		var submissionEvents sdk.StringEvents
+		// Filter for and include only the typed events of the arda modules, and stringify them.
+		var ardaEvents sdk.StringEvents
 		for _, event := range getTxRes.TxResponse.Events {
-			if event.Type == "submission" {
-				submissionEvents = append(submissionEvents, sdk.StringifyEvent(event))
+			if events.IsArda(event.Type) {
+				ardaEvents = append(ardaEvents, sdk.StringifyEvent(event))
 			}
 		}
-		response["events"] = submissionEvents
+		response["events"] = ardaEvents
 
 		// Decode the transaction from the response to access its messages.
 		sdkTx, err := s.clientCtx.TxConfig.TxDecoder()(getTxRes.TxResponse.Tx.Value)
@@ -0,0 +1,94 @@
+// Package events parses the typed events emitted by the arda modules, for
+// indexers and the sidecar.
+package events
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	// The event types must be registered to be parsed
+	_ "github.com/ardaglobal/arda-poc/x/arda/types"
+	_ "github.com/ardaglobal/arda-poc/x/lien/types"
+	_ "github.com/ardaglobal/arda-poc/x/mortgage/types"
+	_ "github.com/ardaglobal/arda-poc/x/oracle/types"
+	_ "github.com/ardaglobal/arda-poc/x/property/types"
+	_ "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// Prefix is the proto package prefix of the arda module events.
+const Prefix = "ardapoc."
+
+// Type returns the event type a typed event is emitted as, its proto name.
+func Type(event proto.Message) string {
+	return proto.MessageName(event)
+}
+
+// IsArda reports whether an event type is a typed event of an arda module.
+func IsArda(eventType string) bool {
+	return strings.HasPrefix(eventType, Prefix)
+}
+
+// Parse returns the typed event an event was emitted from.
+func Parse(event abci.Event) (proto.Message, error) {
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s event: %w", event.Type, err)
+	}
+	return msg, nil
+}
+
+// ParseAll returns the typed events of the arda modules among events, in the
+// order they were emitted. Other events are skipped.
+func ParseAll(events []abci.Event) ([]proto.Message, error) {
+	var msgs []proto.Message
+	for _, event := range events {
+		if !IsArda(event.Type) {
+			continue
+		}
+		msg, err := Parse(event)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// Filter returns the events of type T among events, in the order they were
+// emitted.
+func Filter[T proto.Message](events []abci.Event) ([]T, error) {
+	var zero T
+	eventType := Type(zero)
+
+	var found []T
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		msg, err := Parse(event)
+		if err != nil {
+			return nil, err
+		}
+		typed, ok := msg.(T)
+		if !ok {
+			return nil, fmt.Errorf("%s event parsed as %T", eventType, msg)
+		}
+		found = append(found, typed)
+	}
+	return found, nil
+}
+
+// Find returns the first event of type T among events and whether there is
+// one.
+func Find[T proto.Message](events []abci.Event) (T, bool, error) {
+	var zero T
+	found, err := Filter[T](events)
+	if err != nil || len(found) == 0 {
+		return zero, false, err
+	}
+	return found[0], true, nil
+}
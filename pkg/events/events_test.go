@@ -0,0 +1,62 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	ardatypes "github.com/ardaglobal/arda-poc/x/arda/types"
+	mortgagetypes "github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func emitted(t *testing.T, msgs ...proto.Message) []abci.Event {
+	t.Helper()
+	em := sdk.NewEventManager()
+	for _, msg := range msgs {
+		require.NoError(t, em.EmitTypedEvent(msg))
+	}
+	// Events of a transaction carry the index of the message that emitted them
+	em.EmitEvent(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, "bank")))
+	evs := em.ABCIEvents()
+	for i := range evs {
+		evs[i].Attributes = append(evs[i].Attributes, abci.EventAttribute{Key: "msg_index", Value: "0"})
+	}
+	return evs
+}
+
+func TestParseEvents(t *testing.T) {
+	dueDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	registered := &propertytypes.EventPropertyRegistered{PropertyId: "p1", Region: "dubai", Value: 1000, Owners: []string{"alice"}, Shares: []uint64{100}}
+	delinquent := &mortgagetypes.EventMortgageDelinquent{Index: "m1", DueDate: dueDate, Overdue: 10, LateFee: 1}
+	evs := emitted(t, registered, delinquent, &propertytypes.EventPropertyRegistered{PropertyId: "p2"})
+
+	require.Equal(t, "ardapoc.property.EventPropertyRegistered", events.Type(registered))
+	require.True(t, events.IsArda(evs[1].Type))
+	require.False(t, events.IsArda(sdk.EventTypeMessage))
+
+	msgs, err := events.ParseAll(evs)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+	require.Equal(t, registered, msgs[0])
+	require.Equal(t, delinquent, msgs[1])
+
+	properties, err := events.Filter[*propertytypes.EventPropertyRegistered](evs)
+	require.NoError(t, err)
+	require.Len(t, properties, 2)
+	require.Equal(t, "p2", properties[1].PropertyId)
+
+	found, ok, err := events.Find[*mortgagetypes.EventMortgageDelinquent](evs)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, dueDate, found.DueDate)
+
+	_, ok, err = events.Find[*ardatypes.EventHashSubmitted](evs)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	ardatypes "github.com/ardaglobal/arda-poc/x/arda/types"
 )
 
 func GlobalNode() {
@@ -21,8 +24,8 @@ func GlobalNode() {
 	}
 	defer c.Stop()
 
-	// Build the query: listen for Tx events where submission.valid = 'true'
-	query, err := query.New("tm.event='Tx' AND submission.valid='true'")
+	// Build the query: listen for Tx events with a valid hash submission
+	query, err := query.New(fmt.Sprintf("tm.event='Tx' AND %s.valid='true'", events.Type(&ardatypes.EventHashSubmitted{})))
 	if err != nil {
 		panic(err)
 	}
@@ -44,23 +47,14 @@ func GlobalNode() {
 				continue
 			}
 			// Each txResult contains all events; we filter our module's event
-			for _, ev := range txEvent.Result.Events {
-				if ev.Type == "submission" {
-					// Extract attributes
-					var region, hash string
-					var validAttr string
-					for _, attr := range ev.Attributes {
-						if string(attr.Key) == "region" {
-							region = string(attr.Value)
-						} else if string(attr.Key) == "hash" {
-							hash = string(attr.Value)
-						} else if string(attr.Key) == "valid" {
-							validAttr = string(attr.Value)
-						}
-					}
-					fmt.Printf("Indexed verified hash: %s (region: %s, valid: %s)\n", hash, region, validAttr)
-					// Here, we could store this information in a database or memory structure.
-				}
+			submissions, err := events.Filter[*ardatypes.EventHashSubmitted](txEvent.Result.Events)
+			if err != nil {
+				fmt.Println("Failed to parse submission events:", err)
+				continue
+			}
+			for _, submission := range submissions {
+				fmt.Printf("Indexed verified hash: %s (region: %s, valid: %t)\n", submission.Hash, submission.Region, submission.Valid)
+				// Here, we could store this information in a database or memory structure.
 			}
 		case <-ctx.Done():
 			return
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	mortgagetypes "github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	usdardatypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// AccountKeeperMock is an account keeper that knows only the accounts of the
+// given modules.
+type AccountKeeperMock struct {
+	modules map[string]string
+}
+
+// NewAccountKeeperMock returns an AccountKeeperMock with the accounts of the
+// named modules.
+func NewAccountKeeperMock(modules ...string) AccountKeeperMock {
+	m := AccountKeeperMock{modules: make(map[string]string)}
+	for _, name := range modules {
+		m.modules[authtypes.NewModuleAddress(name).String()] = name
+	}
+	return m
+}
+
+func (m AccountKeeperMock) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	name, ok := m.modules[addr.String()]
+	if !ok {
+		return nil
+	}
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+// moduleAccounts returns an AccountKeeperMock with the accounts of the modules
+// that hold share tokens and usdarda.
+func moduleAccounts() AccountKeeperMock {
+	return NewAccountKeeperMock(
+		propertytypes.ModuleName,
+		usdardatypes.ModuleName,
+		usdardatypes.VaultAccountName,
+		mortgagetypes.ModuleName,
+	)
+}
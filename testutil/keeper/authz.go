@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// AuthzKeeperMock is an in-memory authz keeper holding send authorizations
+// granted to a single grantee. Dispatched sends move funds in its bank keeper.
+type AuthzKeeperMock struct {
+	Grants     map[string]*banktypes.SendAuthorization
+	bankKeeper *LedgerBankKeeper
+}
+
+// NewAuthzKeeperMock returns an AuthzKeeperMock without grants.
+func NewAuthzKeeperMock(bk *LedgerBankKeeper) *AuthzKeeperMock {
+	return &AuthzKeeperMock{
+		Grants:     make(map[string]*banktypes.SendAuthorization),
+		bankKeeper: bk,
+	}
+}
+
+// Grant lets the grantee send up to spendLimit on behalf of granter.
+func (m *AuthzKeeperMock) Grant(granter sdk.AccAddress, spendLimit sdk.Coins) {
+	m.Grants[granter.String()] = banktypes.NewSendAuthorization(spendLimit, nil)
+}
+
+func (m *AuthzKeeperMock) GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	grant, ok := m.Grants[granter.String()]
+	if !ok || msgType != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+		return nil, nil
+	}
+	return grant, nil
+}
+
+func (m *AuthzKeeperMock) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	for _, msg := range msgs {
+		send, ok := msg.(*banktypes.MsgSend)
+		if !ok {
+			return nil, fmt.Errorf("unsupported message %s", sdk.MsgTypeURL(msg))
+		}
+		grant, ok := m.Grants[send.FromAddress]
+		if !ok {
+			return nil, authz.ErrNoAuthorizationFound
+		}
+		resp, err := grant.Accept(ctx, send)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.bankKeeper.send(ctx, sdk.MustAccAddressFromBech32(send.FromAddress), sdk.MustAccAddressFromBech32(send.ToAddress), send.Amount); err != nil {
+			return nil, err
+		}
+		switch {
+		case resp.Delete:
+			delete(m.Grants, send.FromAddress)
+		case resp.Updated != nil:
+			m.Grants[send.FromAddress] = resp.Updated.(*banktypes.SendAuthorization)
+		}
+	}
+	return nil, nil
+}
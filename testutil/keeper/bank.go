@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// LedgerBankKeeper is an in-memory bank keeper for tests that need to check how
+// funds move. Module accounts are keyed by their module address.
+type LedgerBankKeeper struct {
+	Balances    map[string]sdk.Coins
+	Metadata    map[string]banktypes.Metadata
+	SendEnabled map[string]bool
+	// Restriction, if set, is applied to every transfer like a bank send
+	// restriction
+	Restriction banktypes.SendRestrictionFn
+}
+
+// NewLedgerBankKeeper returns an empty LedgerBankKeeper.
+func NewLedgerBankKeeper() *LedgerBankKeeper {
+	return &LedgerBankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Metadata:    make(map[string]banktypes.Metadata),
+		SendEnabled: make(map[string]bool),
+	}
+}
+
+// Fund credits amt to addr.
+func (b *LedgerBankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.Balances[addr.String()] = b.Balances[addr.String()].Add(amt...)
+}
+
+func (b *LedgerBankKeeper) send(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if b.Restriction != nil {
+		var err error
+		if to, err = b.Restriction(ctx, from, to, amt); err != nil {
+			return err
+		}
+	}
+	balance, ok := b.Balances[from.String()].SafeSub(amt...)
+	if ok {
+		return fmt.Errorf("%s has insufficient funds for %s", from, amt)
+	}
+	b.Balances[from.String()] = balance
+	b.Fund(to, amt)
+	return nil
+}
+
+func (b *LedgerBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balances[addr.String()]
+}
+func (b *LedgerBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.Balances[addr.String()].AmountOf(denom))
+}
+func (b *LedgerBankKeeper) SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(ctx, from, to, amt)
+}
+func (b *LedgerBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, sender string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(ctx, authtypes.NewModuleAddress(sender), recipient, amt)
+}
+func (b *LedgerBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, sender sdk.AccAddress, recipient string, amt sdk.Coins) error {
+	return b.send(ctx, sender, authtypes.NewModuleAddress(recipient), amt)
+}
+func (b *LedgerBankKeeper) MintCoins(ctx context.Context, module string, amt sdk.Coins) error {
+	b.Fund(authtypes.NewModuleAddress(module), amt)
+	return nil
+}
+func (b *LedgerBankKeeper) BurnCoins(ctx context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module)
+	balance, ok := b.Balances[addr.String()].SafeSub(amt...)
+	if ok {
+		return fmt.Errorf("module %s has insufficient funds to burn %s", module, amt)
+	}
+	b.Balances[addr.String()] = balance
+	return nil
+}
+func (b *LedgerBankKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+	for addr, coins := range b.Balances {
+		for _, coin := range coins {
+			if cb(sdk.MustAccAddressFromBech32(addr), coin) {
+				return
+			}
+		}
+	}
+}
+func (b *LedgerBankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	supply := sdk.NewInt64Coin(denom, 0)
+	for _, coins := range b.Balances {
+		supply = supply.AddAmount(coins.AmountOf(denom))
+	}
+	return supply
+}
+func (b *LedgerBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := b.Metadata[denom]
+	return metadata, found
+}
+func (b *LedgerBankKeeper) SetDenomMetaData(ctx context.Context, metadata banktypes.Metadata) {
+	b.Metadata[metadata.Base] = metadata
+}
+func (b *LedgerBankKeeper) SetSendEnabled(ctx context.Context, denom string, value bool) {
+	b.SendEnabled[denom] = value
+}
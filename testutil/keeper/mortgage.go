@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
@@ -9,37 +10,103 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
 	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertykeeper "github.com/ardaglobal/arda-poc/x/property/keeper"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	usdardakeeper "github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	usdardatypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
 )
 
 func MortgageKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	f := NewMortgageFixture(t)
+	return f.Keeper, f.Ctx
+}
+
+// MortgageKeeperWithBank returns a mortgage keeper that moves funds through an
+// in-memory bank keeper.
+func MortgageKeeperWithBank(t testing.TB) (keeper.Keeper, sdk.Context, *LedgerBankKeeper) {
+	f := NewMortgageFixture(t)
+	return f.Keeper, f.Ctx, f.BankKeeper
+}
+
+// MortgageFixture is a mortgage keeper together with the dependencies it was
+// built with.
+type MortgageFixture struct {
+	Keeper         keeper.Keeper
+	Ctx            sdk.Context
+	BankKeeper     *LedgerBankKeeper
+	PropertyKeeper propertykeeper.Keeper
+	AuthzKeeper    *AuthzKeeperMock
+	UsdardaKeeper  usdardakeeper.Keeper
+}
+
+func NewMortgageFixture(t testing.TB) MortgageFixture {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	propertyStoreKey := storetypes.NewKVStoreKey(propertytypes.StoreKey)
+	usdardaStoreKey := storetypes.NewKVStoreKey(usdardatypes.StoreKey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(propertyStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(usdardaStoreKey, storetypes.StoreTypeIAVL, db)
+	lk := mountLienKeeper(stateStore, db, cdc)
+	ok := mountOracleKeeper(stateStore, db, cdc)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(registry)
-	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-
+	bk := NewLedgerBankKeeper()
+	pk := propertykeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(propertyStoreKey),
+		log.NewNopLogger(),
+		moduleAccounts(),
+		bk,
+		NewNFTKeeperMock(),
+		lk,
+		ok,
+		baseapp.NewMsgServiceRouter(),
+		authority.String(),
+	)
+	ak := NewAuthzKeeperMock(bk)
+	uk := usdardakeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(usdardaStoreKey),
+		log.NewNopLogger(),
+		moduleAccounts(),
+		bk,
+		lk,
+		pk,
+		authority.String(),
+	)
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
-		nil,
+		bk,
+		lk,
+		pk,
+		ak,
+		uk,
 	)
+	// The send restrictions are chained the way the app chains them
+	bk.Restriction = banktypes.ComposeSendRestrictions(pk.SendRestriction, uk.SendRestriction)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
@@ -47,6 +114,79 @@ func MortgageKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
 		panic(err)
 	}
+	if err := uk.SetParams(ctx, usdardatypes.DefaultParams()); err != nil {
+		panic(err)
+	}
+
+	return MortgageFixture{
+		Keeper:         k,
+		Ctx:            ctx,
+		BankKeeper:     bk,
+		PropertyKeeper: pk,
+		AuthzKeeper:    ak,
+		UsdardaKeeper:  uk,
+	}
+}
+
+// RegisterCollateral records a property owned by owner and credits owner with
+// its share tokens.
+func (f MortgageFixture) RegisterCollateral(id string, owner string, shares uint64) {
+	f.PropertyKeeper.SetProperty(f.Ctx, propertytypes.Property{
+		Index:  id,
+		Owners: []string{owner},
+		Shares: []uint64{shares},
+	})
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin(propertytypes.PropertyShareDenom(id), int64(shares))))
+}
+
+// FundMortgage originates a mortgage the way the chain does: it registers 100
+// shares of the collateral to the lendee, credits the lender with the amount,
+// and has the lendee request the mortgage and the lender approve it.
+func (f MortgageFixture) FundMortgage(t testing.TB, ctx sdk.Context, msg *types.MsgRequestMortgage) types.Mortgage {
+	f.RegisterCollateral(msg.Collateral, msg.Lendee, 100)
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(msg.Lender), sdk.NewCoins(sdk.NewInt64Coin(usdardatypes.USDArdaDenom, int64(msg.Amount))))
 
-	return k, ctx
+	srv := keeper.NewMsgServerImpl(f.Keeper)
+	_, err := srv.RequestMortgage(ctx, msg)
+	require.NoError(t, err)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(msg.Lender, msg.Index))
+	require.NoError(t, err)
+
+	mortgage, found := f.Keeper.GetMortgage(ctx, msg.Index)
+	require.True(t, found)
+	return mortgage
+}
+
+// MortgageStart is when the mortgage of a FundedMortgageFixture is funded.
+var MortgageStart = time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+
+// FundedMortgageFixture is a MortgageFixture in which the lender funded
+// mortgage "m1" of the lendee against the 100 shares they own of "addr 1" at
+// MortgageStart.
+type FundedMortgageFixture struct {
+	MortgageFixture
+	Srv      types.MsgServer
+	Lender   string
+	Lendee   string
+	Mortgage types.Mortgage
+}
+
+// NewFundedMortgageFixture funds a monthly annuity mortgage of amount at
+// interestRate over termMonths. Options may change the request before it is
+// sent.
+func NewFundedMortgageFixture(t testing.TB, amount uint64, interestRate string, termMonths uint32, options ...func(*types.MsgRequestMortgage)) FundedMortgageFixture {
+	f := FundedMortgageFixture{
+		MortgageFixture: NewMortgageFixture(t),
+		Lender:          sample.AccAddress(),
+		Lendee:          sample.AccAddress(),
+	}
+	f.Ctx = f.Ctx.WithBlockTime(MortgageStart)
+	f.Srv = keeper.NewMsgServerImpl(f.Keeper)
+
+	msg := types.NewMsgRequestMortgage(f.Lendee, "m1", f.Lender, "addr 1", amount, interestRate, termMonths, types.MONTHLY, types.ANNUITY, nil)
+	for _, option := range options {
+		option(msg)
+	}
+	f.Mortgage = f.FundMortgage(t, f.Ctx, msg)
+	return f
 }
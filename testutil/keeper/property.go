@@ -2,44 +2,108 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/tx/signing"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	lienkeeper "github.com/ardaglobal/arda-poc/x/lien/keeper"
+	oraclekeeper "github.com/ardaglobal/arda-poc/x/oracle/keeper"
 	"github.com/ardaglobal/arda-poc/x/property/keeper"
 	"github.com/ardaglobal/arda-poc/x/property/types"
 )
 
 func PropertyKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	f := NewPropertyFixture(t)
+	return f.Keeper, f.Ctx
+}
+
+// PropertyKeeperWithNFT returns a property keeper together with the in-memory
+// x/nft keeper it mints title NFTs into.
+func PropertyKeeperWithNFT(t testing.TB) (keeper.Keeper, sdk.Context, *NFTKeeperMock) {
+	f := NewPropertyFixture(t)
+	return f.Keeper, f.Ctx, f.NFTKeeper
+}
+
+// PropertyKeeperWithLiens returns a property keeper together with the lien
+// keeper it checks for encumbrances.
+func PropertyKeeperWithLiens(t testing.TB) (keeper.Keeper, sdk.Context, lienkeeper.Keeper) {
+	f := NewPropertyFixture(t)
+	return f.Keeper, f.Ctx, f.LienKeeper
+}
+
+// PropertyFixture is a property keeper together with the dependencies it was
+// built with.
+type PropertyFixture struct {
+	Keeper     keeper.Keeper
+	Ctx        sdk.Context
+	BankKeeper BankKeeperMock
+	NFTKeeper  *NFTKeeperMock
+	LienKeeper lienkeeper.Keeper
+	// OracleKeeper holds the region indexes and valuations the property
+	// values are read from
+	OracleKeeper oraclekeeper.Keeper
+	// Router executes the messages of passed proposals. No msg server is
+	// registered on it, so tests register the handlers they need.
+	Router *baseapp.MsgServiceRouter
+}
+
+func NewPropertyFixture(t testing.TB) PropertyFixture {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	require.NoError(t, err)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	lk := mountLienKeeper(stateStore, db, cdc)
+	ok := mountOracleKeeper(stateStore, db, cdc)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(registry)
-	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
 
-	bk := BankKeeperMock{}
+	bk := BankKeeperMock{Balances: map[string]sdk.Coins{}}
+	nk := NewNFTKeeperMock()
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
+		moduleAccounts(),
 		bk,
+		nk,
+		lk,
+		ok,
+		router,
 		authority.String(),
 	)
 
@@ -50,16 +114,38 @@ func PropertyKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return PropertyFixture{
+		Keeper:       k,
+		Ctx:          ctx,
+		BankKeeper:   bk,
+		NFTKeeper:    nk,
+		LienKeeper:   lk,
+		OracleKeeper: ok,
+		Router:       router,
+	}
 }
 
 // BankKeeperMock implements types.BankKeeper for tests.
 // TODO: how can we remove this mock? What is the right way to handle this?
-type BankKeeperMock struct{}
+type BankKeeperMock struct {
+	// Balances optionally seeds the balances returned by GetBalance, keyed by
+	// bech32 address.
+	Balances map[string]sdk.Coins
+}
 
 func (BankKeeperMock) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
 	return sdk.Coins{}
 }
+func (m BankKeeperMock) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.Balances[addr.String()].AmountOf(denom))
+}
+func (m BankKeeperMock) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	supply := sdk.NewCoin(denom, math.ZeroInt())
+	for _, balance := range m.Balances {
+		supply.Amount = supply.Amount.Add(balance.AmountOf(denom))
+	}
+	return supply
+}
 func (BankKeeperMock) MintCoins(ctx context.Context, module string, amt sdk.Coins) error { return nil }
 func (BankKeeperMock) BurnCoins(ctx context.Context, module string, amt sdk.Coins) error { return nil }
 func (BankKeeperMock) SendCoinsFromModuleToAccount(ctx context.Context, sender string, recipient sdk.AccAddress, amt sdk.Coins) error {
@@ -71,3 +157,74 @@ func (BankKeeperMock) SendCoinsFromAccountToModule(ctx context.Context, sender s
 func (BankKeeperMock) SendCoins(ctx context.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) error {
 	return nil
 }
+func (BankKeeperMock) IterateAllBalances(ctx context.Context, cb func(sdk.AccAddress, sdk.Coin) bool) {
+}
+func (BankKeeperMock) GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+	return banktypes.Metadata{}, false
+}
+func (BankKeeperMock) SetDenomMetaData(ctx context.Context, metadata banktypes.Metadata) {}
+
+// NFTKeeperMock is an in-memory implementation of types.NFTKeeper for tests.
+type NFTKeeperMock struct {
+	Classes map[string]nft.Class
+	NFTs    map[string]nft.NFT
+	Owners  map[string]sdk.AccAddress
+}
+
+// NewNFTKeeperMock returns an empty NFTKeeperMock.
+func NewNFTKeeperMock() *NFTKeeperMock {
+	return &NFTKeeperMock{
+		Classes: map[string]nft.Class{},
+		NFTs:    map[string]nft.NFT{},
+		Owners:  map[string]sdk.AccAddress{},
+	}
+}
+
+func nftKey(classID, nftID string) string { return classID + "/" + nftID }
+
+func (m *NFTKeeperMock) SaveClass(ctx context.Context, class nft.Class) error {
+	if _, ok := m.Classes[class.Id]; ok {
+		return fmt.Errorf("class %s already exists", class.Id)
+	}
+	m.Classes[class.Id] = class
+	return nil
+}
+
+func (m *NFTKeeperMock) HasClass(ctx context.Context, classID string) bool {
+	_, ok := m.Classes[classID]
+	return ok
+}
+
+func (m *NFTKeeperMock) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if !m.HasClass(ctx, token.ClassId) {
+		return fmt.Errorf("class %s not found", token.ClassId)
+	}
+	if m.HasNFT(ctx, token.ClassId, token.Id) {
+		return fmt.Errorf("nft %s already exists", token.Id)
+	}
+	m.NFTs[nftKey(token.ClassId, token.Id)] = token
+	m.Owners[nftKey(token.ClassId, token.Id)] = receiver
+	return nil
+}
+
+func (m *NFTKeeperMock) Update(ctx context.Context, token nft.NFT) error {
+	if !m.HasNFT(ctx, token.ClassId, token.Id) {
+		return fmt.Errorf("nft %s not found", token.Id)
+	}
+	m.NFTs[nftKey(token.ClassId, token.Id)] = token
+	return nil
+}
+
+func (m *NFTKeeperMock) GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool) {
+	token, ok := m.NFTs[nftKey(classID, nftID)]
+	return token, ok
+}
+
+func (m *NFTKeeperMock) HasNFT(ctx context.Context, classID, nftID string) bool {
+	_, ok := m.NFTs[nftKey(classID, nftID)]
+	return ok
+}
+
+func (m *NFTKeeperMock) GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress {
+	return m.Owners[nftKey(classID, nftID)]
+}
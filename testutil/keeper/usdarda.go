@@ -3,44 +3,92 @@ package keeper
 import (
 	"testing"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	lienkeeper "github.com/ardaglobal/arda-poc/x/lien/keeper"
+	oraclekeeper "github.com/ardaglobal/arda-poc/x/oracle/keeper"
+	propertykeeper "github.com/ardaglobal/arda-poc/x/property/keeper"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
 	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
 	"github.com/ardaglobal/arda-poc/x/usdarda/types"
 )
 
 func UsdardaKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	f := NewUsdardaFixture(t)
+	return f.Keeper, f.Ctx
+}
+
+// UsdardaFixture is a usdarda keeper together with the dependencies it was
+// built with.
+type UsdardaFixture struct {
+	Keeper         keeper.Keeper
+	Ctx            sdk.Context
+	BankKeeper     *LedgerBankKeeper
+	PropertyKeeper propertykeeper.Keeper
+	LienKeeper     lienkeeper.Keeper
+	OracleKeeper   oraclekeeper.Keeper
+	// StoreService opens the usdarda store, e.g. to write state of earlier
+	// versions
+	StoreService corestore.KVStoreService
+}
+
+func NewUsdardaFixture(t testing.TB) UsdardaFixture {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	propertyStoreKey := storetypes.NewKVStoreKey(propertytypes.StoreKey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(propertyStoreKey, storetypes.StoreTypeIAVL, db)
+	lk := mountLienKeeper(stateStore, db, cdc)
+	ok := mountOracleKeeper(stateStore, db, cdc)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(registry)
-	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-
-	bk := BankKeeperMock{}
+	bk := NewLedgerBankKeeper()
+	pk := propertykeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(propertyStoreKey),
+		log.NewNopLogger(),
+		moduleAccounts(),
+		bk,
+		NewNFTKeeperMock(),
+		lk,
+		ok,
+		baseapp.NewMsgServiceRouter(),
+		authority.String(),
+	)
+	storeService := runtime.NewKVStoreService(storeKey)
 	k := keeper.NewKeeper(
 		cdc,
-		runtime.NewKVStoreService(storeKey),
+		storeService,
 		log.NewNopLogger(),
+		moduleAccounts(),
 		bk,
+		lk,
+		pk,
 		authority.String(),
 	)
+	// The send restrictions are chained the way the app chains them
+	bk.Restriction = banktypes.ComposeSendRestrictions(pk.SendRestriction, k.SendRestriction)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
@@ -49,5 +97,13 @@ func UsdardaKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return UsdardaFixture{
+		Keeper:         k,
+		Ctx:            ctx,
+		BankKeeper:     bk,
+		PropertyKeeper: pk,
+		LienKeeper:     lk,
+		OracleKeeper:   ok,
+		StoreService:   storeService,
+	}
 }
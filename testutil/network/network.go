@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/stretchr/testify/require"
 
@@ -56,6 +60,25 @@ func DefaultConfig() network.Config {
 	if cfg.GRPCAddress == "" {
 		cfg.GRPCAddress = fmt.Sprintf("0.0.0.0:%s", ports[2])
 	}
+	// Build every validator with app.New, so that the network runs the ABCI
+	// handlers the app sets beyond its modules, e.g. the oracle vote extensions.
+	// The validator's viper holds its app options.
+	cfg.AppConstructor = func(val network.ValidatorI) servertypes.Application {
+		a, err := app.New(
+			val.GetCtx().Logger,
+			dbm.NewMemDB(),
+			nil,
+			true,
+			val.GetCtx().Viper,
+			baseapp.SetPruning(pruningtypes.NewPruningOptionsFromString(val.GetAppConfig().Pruning)),
+			baseapp.SetMinGasPrices(val.GetAppConfig().MinGasPrices),
+			baseapp.SetChainID(cfg.ChainID),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return a
+	}
 	return cfg
 }
 
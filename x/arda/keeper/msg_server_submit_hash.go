@@ -62,12 +62,14 @@ func (k Keeper) SubmitHash(goCtx context.Context, msg *types.MsgSubmitHash) (*ty
 	id := k.AppendSubmission(ctx, submission)
 
 	// 7. Emit an event with details
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent("submission",
-			sdk.NewAttribute("region", msg.Region),
-			sdk.NewAttribute("hash", msg.Hash),
-			sdk.NewAttribute("valid", fmt.Sprintf("%t", valid)),
-		),
-	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventHashSubmitted{
+		Id:      id,
+		Creator: msg.Creator,
+		Region:  msg.Region,
+		Hash:    msg.Hash,
+		Valid:   valid,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgSubmitHashResponse{Id: id}, nil
 }
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+)
+
+// GetLienCount get the total number of lien
+func (k Keeper) GetLienCount(ctx context.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.LienCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLienCount set the total number of lien
+func (k Keeper) SetLienCount(ctx context.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.LienCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendLien appends a lien in the store with a new id and update the count
+func (k Keeper) AppendLien(ctx context.Context, lien types.Lien) uint64 {
+	// Create the lien
+	count := k.GetLienCount(ctx)
+
+	// Set the ID of the appended value
+	lien.Id = count
+
+	k.SetLien(ctx, lien)
+
+	// Update lien count
+	k.SetLienCount(ctx, count+1)
+
+	return count
+}
+
+// SetLien set a specific lien in the store and index it by property
+func (k Keeper) SetLien(ctx context.Context, lien types.Lien) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LienKeyPrefix))
+	b := k.cdc.MustMarshal(&lien)
+	store.Set(types.LienKey(lien.Id), b)
+
+	indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PropertyLienKeyPrefix))
+	indexStore.Set(types.PropertyLienKey(lien.PropertyId, lien.Id), []byte{})
+}
+
+// GetLien returns a lien from its id
+func (k Keeper) GetLien(ctx context.Context, id uint64) (val types.Lien, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LienKeyPrefix))
+	b := store.Get(types.LienKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveLien removes a lien from the store
+func (k Keeper) RemoveLien(ctx context.Context, id uint64) {
+	lien, found := k.GetLien(ctx, id)
+	if !found {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LienKeyPrefix))
+	store.Delete(types.LienKey(id))
+
+	indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PropertyLienKeyPrefix))
+	indexStore.Delete(types.PropertyLienKey(lien.PropertyId, id))
+}
+
+// GetAllLien returns all lien
+func (k Keeper) GetAllLien(ctx context.Context) (list []types.Lien) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.LienKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Lien
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPropertyLiens returns the liens on a property, senior lien first.
+func (k Keeper) GetPropertyLiens(ctx context.Context, propertyId string) []types.Lien {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PropertyLienKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(indexStore, types.PropertyLienPrefix(propertyId))
+
+	defer iterator.Close()
+
+	liens := []types.Lien{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		if lien, found := k.GetLien(ctx, id); found {
+			liens = append(liens, lien)
+		}
+	}
+
+	sort.Slice(liens, func(i, j int) bool { return liens[i].Priority < liens[j].Priority })
+	return liens
+}
+
+// PlaceLien records a lien on lien.PropertyId and returns it with its id and
+// priority set. The lien ranks behind every lien already on the property.
+func (k Keeper) PlaceLien(ctx context.Context, lien types.Lien) (types.Lien, error) {
+	if lien.PropertyId == "" || lien.Module == "" || lien.Holder == "" {
+		return lien, errorsmod.Wrap(types.ErrInvalidLien, "a lien needs a property, a module and a holder")
+	}
+
+	lien.Priority = uint64(len(k.GetPropertyLiens(ctx, lien.PropertyId))) + 1
+	lien.Id = k.AppendLien(ctx, lien)
+	return lien, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLienPlaced{Lien: lien})
+}
+
+// ReleaseLien removes a lien placed by module. The liens ranking behind it on
+// the same property move up one position.
+func (k Keeper) ReleaseLien(ctx context.Context, module string, id uint64) error {
+	lien, found := k.GetLien(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLienNotFound, "lien %d", id)
+	}
+	if lien.Module != module {
+		return errorsmod.Wrapf(types.ErrNotLienModule, "lien %d was placed by %s", id, lien.Module)
+	}
+
+	k.RemoveLien(ctx, id)
+
+	for _, junior := range k.GetPropertyLiens(ctx, lien.PropertyId) {
+		if junior.Priority > lien.Priority {
+			junior.Priority--
+			k.SetLien(ctx, junior)
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLienReleased{
+		Id:         lien.Id,
+		PropertyId: lien.PropertyId,
+		Module:     lien.Module,
+		Holder:     lien.Holder,
+	})
+}
+
+// UpdateLienAmount changes the amount secured by a lien placed by module.
+// Setting the amount it already secures changes nothing.
+func (k Keeper) UpdateLienAmount(ctx context.Context, module string, id uint64, amount uint64) error {
+	lien, found := k.GetLien(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLienNotFound, "lien %d", id)
+	}
+	if lien.Module != module {
+		return errorsmod.Wrapf(types.ErrNotLienModule, "lien %d was placed by %s", id, lien.Module)
+	}
+
+	if lien.Amount == amount {
+		return nil
+	}
+
+	lien.Amount = amount
+	k.SetLien(ctx, lien)
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLienAmountUpdated{
+		Id:         lien.Id,
+		PropertyId: lien.PropertyId,
+		Amount:     amount,
+	})
+}
+
+// AssignLien changes the holder of a lien placed by module, e.g. when the claim
+// it secures is sold.
+func (k Keeper) AssignLien(ctx context.Context, module string, id uint64, holder string) error {
+	lien, found := k.GetLien(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLienNotFound, "lien %d", id)
+	}
+	if lien.Module != module {
+		return errorsmod.Wrapf(types.ErrNotLienModule, "lien %d was placed by %s", id, lien.Module)
+	}
+
+	lien.Holder = holder
+	k.SetLien(ctx, lien)
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLienAssigned{
+		Id:         lien.Id,
+		PropertyId: lien.PropertyId,
+		Holder:     holder,
+	})
+}
+
+// SetLienOwner records the owner whose shares a lien placed by module
+// encumbers. It is used to scope liens placed before their owner was recorded.
+func (k Keeper) SetLienOwner(ctx context.Context, module string, id uint64, owner string) error {
+	lien, found := k.GetLien(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(types.ErrLienNotFound, "lien %d", id)
+	}
+	if lien.Module != module {
+		return errorsmod.Wrapf(types.ErrNotLienModule, "lien %d was placed by %s", id, lien.Module)
+	}
+
+	lien.Owner = owner
+	k.SetLien(ctx, lien)
+	return nil
+}
+
+// OwnerLiens returns the liens encumbering the shares owner holds of a
+// property, senior lien first. Liens without an owner encumber every owner.
+func (k Keeper) OwnerLiens(ctx context.Context, propertyId string, owner string) []types.Lien {
+	liens := []types.Lien{}
+	for _, lien := range k.GetPropertyLiens(ctx, propertyId) {
+		if lien.Owner == "" || lien.Owner == owner {
+			liens = append(liens, lien)
+		}
+	}
+	return liens
+}
+
+// AssertUnencumbered returns an error if the shares owner holds of a property
+// are under a lien.
+func (k Keeper) AssertUnencumbered(ctx context.Context, propertyId string, owner string) error {
+	liens := k.OwnerLiens(ctx, propertyId, owner)
+	if len(liens) > 0 {
+		return errorsmod.Wrapf(types.ErrPropertyEncumbered, "shares of %s held by %s have %d lien(s); senior lien held by %s", propertyId, owner, len(liens), liens[0].Holder)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/nullify"
+	"github.com/ardaglobal/arda-poc/x/lien/keeper"
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNLien(keeper keeper.Keeper, ctx context.Context, n int) []types.Lien {
+	items := make([]types.Lien, n)
+	for i := range items {
+		items[i].PropertyId = "property"
+		items[i].Id = keeper.AppendLien(ctx, items[i])
+	}
+	return items
+}
+
+func TestLienGet(t *testing.T) {
+	keeper, ctx := keepertest.LienKeeper(t)
+	items := createNLien(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetLien(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+}
+
+func TestLienRemove(t *testing.T) {
+	keeper, ctx := keepertest.LienKeeper(t)
+	items := createNLien(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveLien(ctx, item.Id)
+		_, found := keeper.GetLien(ctx, item.Id)
+		require.False(t, found)
+	}
+	require.Empty(t, keeper.GetPropertyLiens(ctx, "property"))
+}
+
+func TestLienGetAll(t *testing.T) {
+	keeper, ctx := keepertest.LienKeeper(t)
+	items := createNLien(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllLien(ctx)),
+	)
+}
+
+func TestLienCount(t *testing.T) {
+	keeper, ctx := keepertest.LienKeeper(t)
+	items := createNLien(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetLienCount(ctx))
+}
+
+func placeLien(t *testing.T, k keeper.Keeper, ctx context.Context, lien types.Lien) types.Lien {
+	t.Helper()
+	placed, err := k.PlaceLien(ctx, lien)
+	require.NoError(t, err)
+	return placed
+}
+
+func TestPlaceAndReleaseLien(t *testing.T) {
+	k, ctx := keepertest.LienKeeper(t)
+
+	first := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "mortgage", Holder: "lender", Reference: "m1"})
+	second := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "usdarda", Holder: "usdarda", Reference: "addr 1"})
+	third := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "mortgage", Holder: "lender", Reference: "m2"})
+	other := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 2", Module: "mortgage", Holder: "lender", Reference: "m3"})
+	require.Equal(t, uint64(1), first.Priority)
+	require.Equal(t, uint64(2), second.Priority)
+	require.Equal(t, uint64(3), third.Priority)
+	require.Equal(t, uint64(1), other.Priority)
+
+	liens := k.GetPropertyLiens(ctx, "addr 1")
+	require.Len(t, liens, 3)
+	require.Equal(t, []uint64{first.Id, second.Id, third.Id}, []uint64{liens[0].Id, liens[1].Id, liens[2].Id})
+
+	// Only the module that placed a lien may change or release it
+	require.ErrorIs(t, k.ReleaseLien(ctx, "usdarda", first.Id), types.ErrNotLienModule)
+	require.ErrorIs(t, k.UpdateLienAmount(ctx, "usdarda", first.Id, 10), types.ErrNotLienModule)
+	require.ErrorIs(t, k.AssignLien(ctx, "usdarda", first.Id, "buyer"), types.ErrNotLienModule)
+	require.ErrorIs(t, k.ReleaseLien(ctx, "mortgage", 100), types.ErrLienNotFound)
+
+	require.NoError(t, k.UpdateLienAmount(ctx, "usdarda", second.Id, 10))
+	require.NoError(t, k.ReleaseLien(ctx, "mortgage", first.Id))
+
+	// Junior liens move up once the senior lien is released
+	liens = k.GetPropertyLiens(ctx, "addr 1")
+	require.Len(t, liens, 2)
+	require.Equal(t, second.Id, liens[0].Id)
+	require.Equal(t, uint64(1), liens[0].Priority)
+	require.Equal(t, uint64(10), liens[0].Amount)
+	require.Equal(t, third.Id, liens[1].Id)
+	require.Equal(t, uint64(2), liens[1].Priority)
+
+	require.NoError(t, k.AssignLien(ctx, "mortgage", third.Id, "buyer"))
+	assigned, _ := k.GetLien(ctx, third.Id)
+	require.Equal(t, "buyer", assigned.Holder)
+
+	// A new lien ranks behind the remaining ones
+	fourth := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "mortgage", Holder: "lender", Reference: "m4"})
+	require.Equal(t, uint64(3), fourth.Priority)
+
+	// A lien needs a holder to be satisfied in favour of
+	_, err := k.PlaceLien(ctx, types.Lien{PropertyId: "addr 1", Module: "mortgage"})
+	require.ErrorIs(t, err, types.ErrInvalidLien)
+}
+
+func TestAssertUnencumbered(t *testing.T) {
+	k, ctx := keepertest.LienKeeper(t)
+
+	require.NoError(t, k.AssertUnencumbered(ctx, "addr 1", "alice"))
+
+	// A lien with an owner only encumbers that owner's shares
+	lien := placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "mortgage", Holder: "lender", Owner: "alice"})
+	require.ErrorIs(t, k.AssertUnencumbered(ctx, "addr 1", "alice"), types.ErrPropertyEncumbered)
+	require.NoError(t, k.AssertUnencumbered(ctx, "addr 1", "bob"))
+	require.NoError(t, k.AssertUnencumbered(ctx, "addr 2", "alice"))
+
+	require.NoError(t, k.ReleaseLien(ctx, "mortgage", lien.Id))
+	require.NoError(t, k.AssertUnencumbered(ctx, "addr 1", "alice"))
+
+	// A lien without an owner encumbers every owner until one is recorded
+	lien = placeLien(t, k, ctx, types.Lien{PropertyId: "addr 1", Module: "usdarda", Holder: "usdarda"})
+	require.ErrorIs(t, k.AssertUnencumbered(ctx, "addr 1", "bob"), types.ErrPropertyEncumbered)
+
+	require.ErrorIs(t, k.SetLienOwner(ctx, "mortgage", lien.Id, "alice"), types.ErrNotLienModule)
+	require.NoError(t, k.SetLienOwner(ctx, "usdarda", lien.Id, "alice"))
+	require.NoError(t, k.AssertUnencumbered(ctx, "addr 1", "bob"))
+	require.Len(t, k.OwnerLiens(ctx, "addr 1", "alice"), 1)
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+)
+
+func (k Keeper) LienAll(ctx context.Context, req *types.QueryAllLienRequest) (*types.QueryAllLienResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var liens []types.Lien
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	lienStore := prefix.NewStore(store, types.KeyPrefix(types.LienKeyPrefix))
+
+	pageRes, err := query.Paginate(lienStore, req.Pagination, func(key []byte, value []byte) error {
+		var lien types.Lien
+		if err := k.cdc.Unmarshal(value, &lien); err != nil {
+			return err
+		}
+
+		liens = append(liens, lien)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllLienResponse{Lien: liens, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Lien(ctx context.Context, req *types.QueryGetLienRequest) (*types.QueryGetLienResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	lien, found := k.GetLien(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetLienResponse{Lien: lien}, nil
+}
+
+func (k Keeper) PropertyLiens(ctx context.Context, req *types.QueryPropertyLiensRequest) (*types.QueryPropertyLiensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryPropertyLiensResponse{Liens: k.GetPropertyLiens(ctx, req.PropertyId)}, nil
+}
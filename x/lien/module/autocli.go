@@ -0,0 +1,52 @@
+package lien
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/lien"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "LienAll",
+					Use:       "list-lien",
+					Short:     "List all Lien",
+				},
+				{
+					RpcMethod:      "Lien",
+					Use:            "show-lien [id]",
+					Short:          "Shows a Lien",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "PropertyLiens",
+					Use:            "property-liens [property-id]",
+					Short:          "List the liens on a property, senior lien first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package lien
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/lien/keeper"
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the lien
+	for _, elem := range genState.LienList {
+		k.SetLien(ctx, elem)
+	}
+
+	// Set lien count
+	k.SetLienCount(ctx, genState.LienCount)
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.LienList = k.GetAllLien(ctx)
+	genesis.LienCount = k.GetLienCount(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,40 @@
+package lien_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/nullify"
+	lien "github.com/ardaglobal/arda-poc/x/lien/module"
+	"github.com/ardaglobal/arda-poc/x/lien/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		LienList: []types.Lien{
+			{
+				Id: 0,
+			},
+			{
+				Id: 1,
+			},
+		},
+		LienCount: 2,
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.LienKeeper(t)
+	lien.InitGenesis(ctx, k, genesisState)
+	got := lien.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.ElementsMatch(t, genesisState.LienList, got.LienList)
+	require.Equal(t, genesisState.LienCount, got.LienCount)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/lien module sentinel errors
+var (
+	ErrInvalidSigner      = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample             = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrLienNotFound       = sdkerrors.Register(ModuleName, 1102, "lien not found")
+	ErrNotLienModule      = sdkerrors.Register(ModuleName, 1103, "lien was placed by another module")
+	ErrPropertyEncumbered = sdkerrors.Register(ModuleName, 1104, "property is encumbered by liens")
+	ErrInvalidLien        = sdkerrors.Register(ModuleName, 1105, "invalid lien")
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		LienList: []Lien{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// Check for duplicated ID in lien
+	lienIdMap := make(map[uint64]bool)
+	lienCount := gs.GetLienCount()
+	for _, elem := range gs.LienList {
+		if _, ok := lienIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for lien")
+		}
+		if elem.Id >= lienCount {
+			return fmt.Errorf("lien id should be lower or equal than the last id")
+		}
+		lienIdMap[elem.Id] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+const (
+	// LienKeyPrefix is the prefix to retrieve all Lien
+	LienKeyPrefix = "Lien/value/"
+
+	// LienCountKey is the key of the Lien counter
+	LienCountKey = "Lien/count/"
+
+	// PropertyLienKeyPrefix is the prefix indexing liens by the property they encumber
+	PropertyLienKeyPrefix = "Lien/property/"
+)
+
+// LienKey returns the store key to retrieve a Lien from its id
+func LienKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+// PropertyLienPrefix returns the index prefix of the liens on a property.
+// Property IDs are free-form, so they are hashed to a fixed length.
+func PropertyLienPrefix(propertyId string) []byte {
+	h := sha256.Sum256([]byte(propertyId))
+	return h[:]
+}
+
+// PropertyLienKey returns the index key of a lien on a property
+func PropertyLienKey(propertyId string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(PropertyLienPrefix(propertyId), id)
+}
@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "lien"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_lien"
+)
+
+var (
+	ParamsKey = []byte("p_lien")
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
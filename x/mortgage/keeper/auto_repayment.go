@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// enableAutoRepayment schedules the automatic repayment of an active mortgage
+// at the due date of its oldest unpaid installment. The lendee must have
+// granted the module account a SendAuthorization.
+func (k Keeper) enableAutoRepayment(ctx context.Context, mortgage *types.Mortgage) error {
+	if !mortgage.IsActive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s is %s", mortgage.Index, mortgage.Status)
+	}
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+	grant, _ := k.authzKeeper.GetAuthorization(ctx, authtypes.NewModuleAddress(types.ModuleName), lendee, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	if _, ok := grant.(*banktypes.SendAuthorization); !ok {
+		return errorsmod.Wrapf(types.ErrNoRepaymentGrant, "grant %s a %s authorization first", authtypes.NewModuleAddress(types.ModuleName), sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	}
+
+	k.disableAutoRepayment(ctx, mortgage)
+	mortgage.AutoRepayment = &types.AutoRepayment{}
+	k.queueAutoRepayment(ctx, mortgage, mortgage.NextDueDate)
+	return nil
+}
+
+// disableAutoRepayment cancels the automatic repayment of a mortgage, if any.
+func (k Keeper) disableAutoRepayment(ctx context.Context, mortgage *types.Mortgage) {
+	if mortgage.AutoRepayment == nil {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageAutoRepaymentKeyPrefix))
+	store.Delete(types.MortgageReviewKey(mortgage.AutoRepayment.NextPull, mortgage.Index))
+	mortgage.AutoRepayment = nil
+}
+
+// queueAutoRepayment schedules the next pull of a mortgage's automatic
+// repayment.
+func (k Keeper) queueAutoRepayment(ctx context.Context, mortgage *types.Mortgage, pullTime time.Time) {
+	mortgage.AutoRepayment.NextPull = pullTime
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageAutoRepaymentKeyPrefix))
+	store.Set(types.MortgageReviewKey(pullTime, mortgage.Index), []byte{})
+}
+
+// pullRepayment collects what is due on a mortgage from the lendee through its
+// SendAuthorization and books it as a repayment. If the lendee's funds or grant
+// fall short nothing is collected, the failure is recorded and the mortgage is
+// delinquent. The next pull is scheduled at the next due date.
+func (k Keeper) pullRepayment(ctx context.Context, mortgage *types.Mortgage) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	auto := mortgage.AutoRepayment
+	if !mortgage.IsActive() {
+		mortgage.AutoRepayment = nil
+		return nil
+	}
+
+	if err := k.accrueInterest(ctx, mortgage); err != nil {
+		return err
+	}
+	schedule, err := mortgage.AmortizationSchedule()
+	if err != nil {
+		mortgage.AutoRepayment = nil
+		return nil
+	}
+	amount := amountDue(*mortgage, schedule, sdkCtx.BlockTime())
+
+	auto.LastAttempt = sdkCtx.BlockTime()
+	auto.LastAmount = amount
+	if amount > 0 {
+		if err := k.collectRepayment(ctx, mortgage, amount); err != nil {
+			auto.Failures++
+			auto.LastError = err.Error()
+			if mortgage.Status == types.APPROVED {
+				mortgage.Status = types.DELINQUENT
+				if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMortgageDelinquent{
+					Index:   mortgage.Index,
+					Lender:  mortgage.Lender,
+					Lendee:  mortgage.Lendee,
+					DueDate: mortgage.NextDueDate,
+					Overdue: amount,
+				}); err != nil {
+					return err
+				}
+			}
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventAutoRepaymentFailed{
+				Index:  mortgage.Index,
+				Lendee: mortgage.Lendee,
+				Amount: amount,
+				Error:  auto.LastError,
+			}); err != nil {
+				return err
+			}
+		} else {
+			auto.Successes++
+			auto.LastError = ""
+			if err := k.emitRepaid(ctx, *mortgage, amount, 0, true); err != nil {
+				return err
+			}
+			if err := k.applyRepayment(ctx, mortgage, amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	if mortgage.AutoRepayment == nil {
+		// Repaid in full
+		return nil
+	}
+	for _, installment := range schedule {
+		if installment.DueDate.After(sdkCtx.BlockTime()) {
+			k.queueAutoRepayment(ctx, mortgage, installment.DueDate)
+			return nil
+		}
+	}
+	// Past the last installment a failed pull is not retried
+	mortgage.AutoRepayment.NextPull = time.Time{}
+	return nil
+}
+
+// collectRepayment pays amount from the lendee to the lenders through the
+// module's authorization, and the lenders' vault interest share into the
+// savings vault. Either every lender is paid or none is.
+func (k Keeper) collectRepayment(ctx context.Context, mortgage *types.Mortgage, amount uint64) error {
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+
+	attempt := *mortgage
+	attempt.Syndicate = slices.Clone(mortgage.Syndicate)
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	grantee := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.payLenders(cacheCtx, &attempt, amount, func(lender sdk.AccAddress, amount uint64) error {
+		_, err := k.authzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{banktypes.NewMsgSend(lendee, lender, usdardaCoins(amount))})
+		return err
+	}); err != nil {
+		return err
+	}
+	_, interest, _ := allocateRepayment(attempt, amount)
+	if err := k.payVaultInterest(cacheCtx, &attempt, interest); err != nil {
+		return err
+	}
+	write()
+
+	mortgage.Syndicate = attempt.Syndicate
+	return nil
+}
+
+// amountDue returns what the lendee owes at t under the schedule: the unpaid
+// part of the installments that fell due and the late fees, capped at the debt.
+func amountDue(mortgage types.Mortgage, schedule []types.Installment, t time.Time) uint64 {
+	var owed uint64
+	for _, installment := range schedule {
+		if installment.DueDate.After(t) {
+			break
+		}
+		owed += installment.Payment
+	}
+	due := owed - min(owed, mortgage.AmountPaid) + mortgage.LateFeesDue
+	return min(due, mortgage.OutstandingAmount+mortgage.InterestDue+mortgage.LateFeesDue)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestAutoRepayment(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	start, params := keepertest.MortgageStart, k.GetParams(ctx)
+
+	// The module needs a send authorization of the lendee
+	_, err := srv.EnableAutoRepayment(ctx, types.NewMsgEnableAutoRepayment(lendee, "m1"))
+	require.ErrorIs(t, err, types.ErrNoRepaymentGrant)
+	f.AuthzKeeper.Grant(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 150)))
+	_, err = srv.EnableAutoRepayment(ctx, types.NewMsgEnableAutoRepayment(lender, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.EnableAutoRepayment(ctx, types.NewMsgEnableAutoRepayment(lendee, "m1"))
+	require.NoError(t, err)
+
+	firstDue := start.AddDate(0, 1, 0)
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, firstDue, mortgage.AutoRepayment.NextPull)
+
+	// Nothing is pulled before the installment falls due
+	ctx = ctx.WithBlockTime(firstDue.Add(-time.Second))
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Equal(t, int64(0), bk.Balances[lender].AmountOf("usdarda").Int64())
+
+	ctx = ctx.WithBlockTime(firstDue).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, uint64(100), mortgage.AmountPaid)
+	require.Equal(t, uint32(1), mortgage.AutoRepayment.Successes)
+	require.Equal(t, uint64(100), mortgage.AutoRepayment.LastAmount)
+	require.Equal(t, start.AddDate(0, 2, 0), mortgage.AutoRepayment.NextPull)
+	require.Equal(t, int64(100), bk.Balances[lender].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(1100), bk.Balances[lendee].AmountOf("usdarda").Int64())
+	require.True(t, hasEvent(ctx, &types.EventMortgageRepaid{}))
+
+	// The grant only covers 50 of the second installment: the pull fails and
+	// the mortgage is delinquent
+	secondDue := start.AddDate(0, 2, 0)
+	ctx = ctx.WithBlockTime(secondDue).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.DELINQUENT, mortgage.Status)
+	require.Equal(t, uint64(100), mortgage.AmountPaid)
+	require.Equal(t, uint32(1), mortgage.AutoRepayment.Failures)
+	require.NotEmpty(t, mortgage.AutoRepayment.LastError)
+	require.Zero(t, mortgage.LateFeesDue)
+	require.Equal(t, start.AddDate(0, 3, 0), mortgage.AutoRepayment.NextPull)
+	require.Equal(t, int64(1100), bk.Balances[lendee].AmountOf("usdarda").Int64())
+	require.True(t, hasEvent(ctx, &types.EventAutoRepaymentFailed{}))
+	require.True(t, hasEvent(ctx, &types.EventMortgageDelinquent{}))
+
+	// The late fee is charged once the grace period ended
+	ctx = ctx.WithBlockTime(secondDue.Add(params.GracePeriod))
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.DELINQUENT, mortgage.Status)
+	require.Equal(t, uint64(5), mortgage.LateFeesDue)
+
+	// A renewed grant pays the arrears and the late fee at the next due date
+	f.AuthzKeeper.Grant(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+	ctx = ctx.WithBlockTime(start.AddDate(0, 3, 0))
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.APPROVED, mortgage.Status)
+	require.Equal(t, uint64(300), mortgage.AmountPaid)
+	require.Zero(t, mortgage.LateFeesDue)
+	require.False(t, mortgage.LateFeeCharged)
+	require.Equal(t, uint64(205), mortgage.AutoRepayment.LastAmount)
+
+	// Disabling stops the pulls
+	_, err = srv.DisableAutoRepayment(ctx, types.NewMsgDisableAutoRepayment(lendee, "m1"))
+	require.NoError(t, err)
+	_, err = srv.DisableAutoRepayment(ctx, types.NewMsgDisableAutoRepayment(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	ctx = ctx.WithBlockTime(start.AddDate(0, 4, 0))
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Nil(t, mortgage.AutoRepayment)
+	require.Equal(t, uint64(300), mortgage.AmountPaid)
+}
+
+func TestMigrate10to11(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Status: types.DELINQUENT, LateFeesDue: 5})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m2", Status: types.APPROVED})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate10to11(ctx))
+	delinquent, _ := k.GetMortgage(ctx, "m1")
+	require.True(t, delinquent.LateFeeCharged)
+	current, _ := k.GetMortgage(ctx, "m2")
+	require.False(t, current.LateFeeCharged)
+}
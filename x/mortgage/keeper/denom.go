@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// SetMarkerDenomMetadata registers the bank metadata of a mortgage's marker denom
+// so wallets can display it. Aliases already recorded on the metadata are kept
+// and the given aliases are added to them.
+func (k Keeper) SetMarkerDenomMetadata(ctx context.Context, mortgage types.Mortgage, aliases ...string) {
+	denom := types.MortgageMarkerDenom(mortgage.Index)
+	if existing, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found && len(existing.DenomUnits) > 0 {
+		aliases = append(existing.DenomUnits[0].Aliases, aliases...)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("Marks mortgage %s secured by %s; burned once the mortgage is repaid", mortgage.Index, mortgage.Collateral),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: aliases},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    fmt.Sprintf("Mortgage %s", mortgage.Index),
+		Symbol:  "ARDAMTG",
+	})
+}
+
+// SetDenomAlias records that a legacy marker denom was replaced by denom.
+func (k Keeper) SetDenomAlias(ctx context.Context, alias types.DenomAlias) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAliasKeyPrefix))
+	b := k.cdc.MustMarshal(&alias)
+	store.Set([]byte(alias.Alias), b)
+}
+
+// GetDenomAlias returns the alias record of a legacy marker denom.
+func (k Keeper) GetDenomAlias(ctx context.Context, alias string) (val types.DenomAlias, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAliasKeyPrefix))
+
+	b := store.Get([]byte(alias))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDenomAlias returns all recorded denom aliases
+func (k Keeper) GetAllDenomAlias(ctx context.Context) (list []types.DenomAlias) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.DenomAliasKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DenomAlias
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// holding is an account's balance of a legacy denom awaiting migration.
+type holding struct {
+	addr   sdk.AccAddress
+	amount math.Int
+}
+
+// holdingsByDenom collects the positive balances of the given denoms in a
+// single pass over all balances, grouped by denom. Balances cannot change while
+// iterating them, so migrations collect the holders first.
+func (k Keeper) holdingsByDenom(ctx context.Context, denoms map[string]bool) map[string][]holding {
+	holdings := make(map[string][]holding)
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if denoms[coin.Denom] && coin.Amount.IsPositive() {
+			holdings[coin.Denom] = append(holdings[coin.Denom], holding{addr: addr, amount: coin.Amount})
+		}
+		return false
+	})
+	return holdings
+}
+
+// migrateMarkerDenom swaps the holdings of a mortgage's legacy marker denom for
+// its current marker denom and records the alias.
+func (k Keeper) migrateMarkerDenom(ctx context.Context, mortgage types.Mortgage, holdings []holding) error {
+	legacy := types.LegacyMortgageMarkerDenom(mortgage.Collateral, mortgage.Index)
+	denom := types.MortgageMarkerDenom(mortgage.Index)
+
+	for _, h := range holdings {
+		legacyCoins := sdk.NewCoins(sdk.NewCoin(legacy, h.amount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, h.addr, types.ModuleName, legacyCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to collect %s from %s", legacy, h.addr)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, legacyCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn %s", legacy)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, h.amount))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to mint %s", denom)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, h.addr, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to send %s to %s", denom, h.addr)
+		}
+	}
+
+	k.SetDenomAlias(ctx, types.DenomAlias{Alias: legacy, Denom: denom})
+	k.SetMarkerDenomMetadata(ctx, mortgage, legacy)
+	return nil
+}
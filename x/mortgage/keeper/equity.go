@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// equityLimit returns how much lendee may borrow against their shares of a
+// property: the maximum loan-to-value share of the current value of the shares,
+// less the debt already secured by liens on them. Liens of the lendee's own
+// mortgages count with what is still owed on them; liens placed by other
+// modules count with their full amount. Mortgages of other owners are secured
+// by their own shares and do not count.
+func (k Keeper) equityLimit(ctx context.Context, collateral string, lendee string) (uint64, error) {
+	property, found := k.propertyKeeper.GetProperty(sdk.UnwrapSDKContext(ctx), collateral)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrCollateralNotOwned, "property %s not found", collateral)
+	}
+
+	var shares uint64
+	for i, owner := range property.Owners {
+		if owner == lendee && i < len(property.Shares) {
+			shares = property.Shares[i]
+		}
+	}
+	if shares == 0 {
+		return 0, errorsmod.Wrapf(types.ErrCollateralNotOwned, "%s owns no shares of %s", lendee, collateral)
+	}
+
+	// Shares are percentages of the property
+	value := math.NewIntFromUint64(k.propertyKeeper.CurrentValue(ctx, property)).MulRaw(int64(shares)).QuoRaw(100)
+	ltv, err := math.LegacyNewDecFromStr(k.GetParams(ctx).MaxLoanToValue)
+	if err != nil {
+		return 0, err
+	}
+	limit := ltv.MulInt(value).TruncateInt()
+
+	for _, lien := range k.lienKeeper.GetPropertyLiens(ctx, collateral) {
+		secured := math.NewIntFromUint64(lien.Amount)
+		if lien.Module == types.ModuleName {
+			mortgage, found := k.GetMortgage(ctx, lien.Reference)
+			if found && mortgage.Lendee != lendee {
+				continue
+			}
+			if found {
+				secured = math.NewIntFromUint64(mortgage.OutstandingAmount + mortgage.InterestDue + mortgage.LateFeesDue)
+			}
+		}
+		limit = limit.Sub(secured)
+	}
+
+	if !limit.IsPositive() {
+		return 0, nil
+	}
+	return limit.Uint64(), nil
+}
+
+// assertWithinLoanToValue returns an error if an equity loan asks for more than
+// can be borrowed against the lendee's shares of its collateral.
+func (k Keeper) assertWithinLoanToValue(ctx context.Context, mortgage types.Mortgage) error {
+	limit, err := k.equityLimit(ctx, mortgage.Collateral, mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+	if mortgage.Amount > limit {
+		return errorsmod.Wrapf(types.ErrLoanToValueExceeded, "amount %d exceeds the %d that can be borrowed against %s", mortgage.Amount, limit, mortgage.Collateral)
+	}
+	return nil
+}
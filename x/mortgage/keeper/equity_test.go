@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestEquityLoan(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	lender, lendee, coOwner := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	// The lendee's half of a property worth 1000 supports 400 of debt at the
+	// default loan-to-value of 0.8
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{
+		Index:  "p1",
+		Value:  1000,
+		Owners: []string{lendee, coOwner},
+		Shares: []uint64{50, 50},
+	})
+	bk.Fund(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin(propertytypes.PropertyShareDenom("p1"), 50)))
+	bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+
+	_, err := srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(coOwner, "e0", lender, "p1", 100, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e1", lender, "p1", 401, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrLoanToValueExceeded)
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e1", lender, "p1", 300, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e2", lender, "p1", 100, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "e1"))
+	require.NoError(t, err)
+	first, _ := k.GetMortgage(ctx, "e1")
+	require.True(t, first.Equity)
+	require.Equal(t, types.APPROVED, first.Status)
+	require.Equal(t, uint64(50), first.EscrowedShares)
+
+	// The funded loan leaves 100 to borrow; the co-owner's loan, secured by
+	// their own shares, does not count against the lendee
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "e0"))
+	require.NoError(t, err)
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e3", lender, "p1", 101, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrLoanToValueExceeded)
+
+	// A junior loan is secured by its lien; the shares stay in the senior escrow
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "e2"))
+	require.NoError(t, err)
+	second, _ := k.GetMortgage(ctx, "e2")
+	require.Equal(t, types.APPROVED, second.Status)
+	require.Zero(t, second.EscrowedShares)
+	require.Equal(t, int64(400), bk.Balances[lendee].AmountOf("usdarda").Int64())
+
+	// A lower valuation leaves nothing to borrow
+	property, _ := f.PropertyKeeper.GetProperty(ctx, "p1")
+	property.Value = 800
+	f.PropertyKeeper.SetProperty(ctx, property)
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e3", lender, "p1", 1, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrLoanToValueExceeded)
+
+	// Equity loans need shares of the collateral
+	_, err = srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lender, "e4", lendee, "p1", 1, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrCollateralNotOwned)
+}
+
+func TestEquityLoanRecheckedOnApproval(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{Index: "p1", Value: 1000, Owners: []string{lendee}, Shares: []uint64{100}})
+	bk.Fund(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin(propertytypes.PropertyShareDenom("p1"), 100)))
+	bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+
+	_, err := srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e1", lender, "p1", 800, "0.05", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.MaxLoanToValue = "0.5"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "e1"))
+	require.ErrorIs(t, err, types.ErrLoanToValueExceeded)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	params := k.GetParams(ctx)
+	params.MaxLoanToValue = ""
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate7to8(ctx))
+	require.Equal(t, types.DefaultMaxLoanToValue, k.GetParams(ctx).MaxLoanToValue)
+}
+
+func TestEquityLoanBehindUsdardaLien(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+	shareDenom := propertytypes.PropertyShareDenom("p1")
+
+	property := propertytypes.Property{
+		Index:  "p1",
+		Value:  1000,
+		Owners: []string{lendee},
+		Shares: []uint64{100},
+	}
+	f.PropertyKeeper.SetProperty(ctx, property)
+	bk.Fund(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100)))
+
+	// The usdarda lien encumbers every holder of the shares, yet they still
+	// move into escrow behind it and back out once the loan is repaid
+	require.NoError(t, f.UsdardaKeeper.Mint(ctx, property, 200))
+	bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 300)))
+	_, err := srv.RequestEquityLoan(ctx, types.NewMsgRequestEquityLoan(lendee, "e1", lender, "p1", 300, "0", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "e1"))
+	require.NoError(t, err)
+	mortgage, _ := k.GetMortgage(ctx, "e1")
+	require.Equal(t, uint64(100), mortgage.EscrowedShares)
+	require.Zero(t, bk.Balances[lendee].AmountOf(shareDenom).Int64())
+
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "e1", 300))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "e1")
+	require.Equal(t, types.PAID, mortgage.Status)
+	require.Equal(t, int64(100), bk.Balances[lendee].AmountOf(shareDenom).Int64())
+}
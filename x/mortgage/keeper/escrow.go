@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// escrowCollateral moves the lendee's share tokens of the collateral into the
+// module account, where they stay while the mortgage is funded. Shares already
+// escrowed for a senior mortgage stay there; an equity loan ranking behind it
+// is secured by its lien only. Only mortgages the lendee requested escrow its
+// shares.
+func (k Keeper) escrowCollateral(ctx context.Context, mortgage *types.Mortgage) error {
+	if !mortgage.RequestedByLendee() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s did not request mortgage %s", mortgage.Lendee, mortgage.Index)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	property, found := k.propertyKeeper.GetProperty(sdkCtx, mortgage.Collateral)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCollateralNotOwned, "property %s not found", mortgage.Collateral)
+	}
+
+	var shares uint64
+	for i, owner := range property.Owners {
+		if owner == mortgage.Lendee && i < len(property.Shares) {
+			shares = property.Shares[i]
+		}
+	}
+	if shares == 0 {
+		return errorsmod.Wrapf(types.ErrCollateralNotOwned, "%s owns no shares of %s", mortgage.Lendee, mortgage.Collateral)
+	}
+
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+	if mortgage.Equity {
+		held := k.bankKeeper.SpendableCoins(ctx, lendee).AmountOf(propertytypes.PropertyShareDenom(mortgage.Collateral))
+		shares = min(shares, held.Uint64())
+		if shares == 0 {
+			return nil
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lendee, types.ModuleName, collateralCoins(mortgage.Collateral, shares)); err != nil {
+		return errorsmod.Wrap(err, "failed to escrow collateral shares")
+	}
+
+	mortgage.EscrowedShares = shares
+	return nil
+}
+
+// releaseCollateral returns the escrowed share tokens to the lendee.
+func (k Keeper) releaseCollateral(ctx context.Context, mortgage *types.Mortgage) error {
+	if mortgage.EscrowedShares == 0 {
+		return nil
+	}
+
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lendee, collateralCoins(mortgage.Collateral, mortgage.EscrowedShares)); err != nil {
+		return errorsmod.Wrap(err, "failed to release collateral shares")
+	}
+
+	mortgage.EscrowedShares = 0
+	return nil
+}
+
+// transferCollateral hands the escrowed share tokens to a new owner and records
+// the change of ownership on the property.
+func (k Keeper) transferCollateral(ctx context.Context, mortgage *types.Mortgage, to string) error {
+	if mortgage.EscrowedShares == 0 {
+		return nil
+	}
+	if err := k.handOverShares(ctx, *mortgage, to, mortgage.EscrowedShares); err != nil {
+		return err
+	}
+
+	mortgage.EscrowedShares = 0
+	return nil
+}
+
+// divideCollateral hands the escrowed share tokens of a syndicated mortgage to
+// the members of its syndicate in proportion to their contributions.
+func (k Keeper) divideCollateral(ctx context.Context, mortgage *types.Mortgage) error {
+	for i, shares := range mortgage.SyndicateShares(mortgage.EscrowedShares) {
+		if shares == 0 {
+			continue
+		}
+		if err := k.handOverShares(ctx, *mortgage, mortgage.Syndicate[i].Lender, shares); err != nil {
+			return err
+		}
+	}
+
+	mortgage.EscrowedShares = 0
+	return nil
+}
+
+// handOverShares sends escrowed share tokens of the collateral to a new owner
+// and records the change of ownership on the property.
+func (k Keeper) handOverShares(ctx context.Context, mortgage types.Mortgage, to string, shares uint64) error {
+	recipient, err := sdk.AccAddressFromBech32(to)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, collateralCoins(mortgage.Collateral, shares)); err != nil {
+		return errorsmod.Wrap(err, "failed to transfer collateral shares")
+	}
+	return k.propertyKeeper.ReassignShares(sdk.UnwrapSDKContext(ctx), mortgage.Collateral, mortgage.Lendee, to, shares)
+}
+
+func collateralCoins(propertyId string, shares uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(propertytypes.PropertyShareDenom(propertyId), math.NewIntFromUint64(shares)))
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// startAuction puts the escrowed collateral of a defaulted mortgage up for
+// auction. The auction is settled by the EndBlocker once its duration passed.
+func (k Keeper) startAuction(ctx context.Context, mortgage *types.Mortgage) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	end := sdkCtx.BlockTime().Add(k.GetParams(ctx).AuctionDuration)
+
+	mortgage.Status = types.FORECLOSING
+	mortgage.Auction = &types.ForeclosureAuction{EndTime: end}
+	k.queueReview(ctx, mortgage, end)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventForeclosureStarted{
+		Index:   mortgage.Index,
+		Lender:  mortgage.Lender,
+		EndTime: end,
+		Shares:  mortgage.EscrowedShares,
+	})
+}
+
+// placeBid makes bidder the highest bidder of a foreclosure auction. The bid is
+// held by the module account and the bid it outbids is refunded.
+func (k Keeper) placeBid(ctx context.Context, mortgage *types.Mortgage, bidder string, amount uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	auction := mortgage.Auction
+	if mortgage.Status != types.FORECLOSING || auction == nil || !sdkCtx.BlockTime().Before(auction.EndTime) {
+		return errorsmod.Wrapf(types.ErrAuctionClosed, "mortgage %s", mortgage.Index)
+	}
+	if amount <= auction.HighestBid {
+		return errorsmod.Wrapf(types.ErrBidTooLow, "highest bid is %d", auction.HighestBid)
+	}
+
+	bidderAddr, err := sdk.AccAddressFromBech32(bidder)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidderAddr, types.ModuleName, usdardaCoins(amount)); err != nil {
+		return errorsmod.Wrap(err, "failed to escrow bid")
+	}
+	if auction.HighestBidder != "" {
+		if err := k.payFromModule(ctx, auction.HighestBidder, auction.HighestBid); err != nil {
+			return errorsmod.Wrap(err, "failed to refund outbid bidder")
+		}
+	}
+
+	auction.HighestBidder = bidder
+	auction.HighestBid = amount
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventForeclosureBid{
+		Index:  mortgage.Index,
+		Bidder: bidder,
+		Bid:    amount,
+	})
+}
+
+// settleForeclosure hands the escrowed collateral of a defaulted mortgage over
+// and closes it. Without a winning bid the lender takes the shares, or the
+// members of the syndicate divide them. Otherwise the winning bid pays off the
+// debt owed to the lenders, the surplus goes to the lendee and the shares go to
+// the highest bidder. A shortfall is not recovered.
+func (k Keeper) settleForeclosure(ctx context.Context, mortgage *types.Mortgage) error {
+	buyer, bid, surplus := mortgage.Lender, uint64(0), uint64(0)
+	if auction := mortgage.Auction; auction != nil && auction.HighestBidder != "" {
+		if err := k.accrueInterest(ctx, mortgage); err != nil {
+			return err
+		}
+		buyer, bid = auction.HighestBidder, auction.HighestBid
+
+		debt := mortgage.OutstandingAmount + mortgage.InterestDue + mortgage.LateFeesDue
+		proceeds := min(bid, debt)
+		surplus = bid - proceeds
+		if err := k.payLenders(ctx, mortgage, proceeds, func(lender sdk.AccAddress, amount uint64) error {
+			return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lender, usdardaCoins(amount))
+		}); err != nil {
+			return errorsmod.Wrap(err, "failed to pay auction proceeds to lender")
+		}
+		if err := k.payFromModule(ctx, mortgage.Lendee, surplus); err != nil {
+			return errorsmod.Wrap(err, "failed to pay auction surplus to lendee")
+		}
+	}
+
+	shares := mortgage.EscrowedShares
+	if bid == 0 && mortgage.IsSyndicated() {
+		if err := k.divideCollateral(ctx, mortgage); err != nil {
+			return err
+		}
+	} else if err := k.transferCollateral(ctx, mortgage, buyer); err != nil {
+		return err
+	}
+	if err := k.releaseCollateralLien(ctx, *mortgage); err != nil {
+		return errorsmod.Wrap(err, "failed to release collateral lien")
+	}
+	if err := k.retireMarker(ctx, *mortgage); err != nil {
+		return err
+	}
+	if err := k.retireNote(ctx, *mortgage); err != nil {
+		return err
+	}
+
+	mortgage.Status = types.FORECLOSED
+	k.dequeueReview(ctx, mortgage)
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMortgageForeclosed{
+		Index:   mortgage.Index,
+		Buyer:   buyer,
+		Shares:  shares,
+		Bid:     bid,
+		Surplus: surplus,
+	})
+}
+
+// retireMarker burns the marker token of a foreclosed mortgage if the lendee
+// still holds it. A lendee who gave the marker away cannot block foreclosure.
+func (k Keeper) retireMarker(ctx context.Context, mortgage types.Mortgage) error {
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return err
+	}
+
+	markerDenom := types.MortgageMarkerDenom(mortgage.Index)
+	if k.bankKeeper.SpendableCoins(ctx, lendee).AmountOf(markerDenom).IsZero() {
+		return nil
+	}
+	markerCoin := sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 1))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lendee, types.ModuleName, markerCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to send marker token from lendee to module")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, markerCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to burn marker token")
+	}
+	return nil
+}
+
+// payFromModule sends usdarda held by the module account to addr.
+func (k Keeper) payFromModule(ctx context.Context, addr string, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, usdardaCoins(amount))
+}
+
+func usdardaCoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(usdtypes.USDArdaDenom, math.NewIntFromUint64(amount)))
+}
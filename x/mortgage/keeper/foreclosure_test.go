@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// defaultedMortgage lets the funded mortgage m1 of 1200 over 12 months
+// default.
+func defaultedMortgage(t *testing.T) keepertest.FundedMortgageFixture {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+
+	// A performing mortgage cannot be foreclosed
+	_, err := f.Srv.Foreclose(f.Ctx, types.NewMsgForeclose(f.Lender, "m1", false))
+	require.ErrorIs(t, err, types.ErrMortgageNotDefaulted)
+
+	f.Ctx = f.Ctx.WithBlockTime(keepertest.MortgageStart.AddDate(0, 1, 0).Add(f.Keeper.GetParams(f.Ctx).DefaultPeriod))
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx))
+	mortgage, _ := f.Keeper.GetMortgage(f.Ctx, "m1")
+	require.Equal(t, types.DEFAULTED, mortgage.Status)
+	return f
+}
+
+func TestForecloseToLender(t *testing.T) {
+	f := defaultedMortgage(t)
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	shareDenom := propertytypes.PropertyShareDenom("addr 1")
+
+	_, err := srv.Foreclose(ctx, types.NewMsgForeclose(lendee, "m1", false))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.Foreclose(ctx, types.NewMsgForeclose(lender, "missing", false))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.Foreclose(ctx, types.NewMsgForeclose(lender, "m1", false))
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, &types.EventMortgageForeclosed{}))
+
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.FORECLOSED, mortgage.Status)
+	require.Zero(t, mortgage.EscrowedShares)
+	require.Equal(t, int64(100), bk.Balances[lender].AmountOf(shareDenom).Int64())
+	require.True(t, bk.Balances[lendee].AmountOf(types.MortgageMarkerDenom("m1")).IsZero())
+
+	property, _ := f.PropertyKeeper.GetProperty(ctx, "addr 1")
+	require.Equal(t, []string{lender}, property.Owners)
+	require.Equal(t, []uint64{100}, property.Shares)
+	require.Len(t, property.Transfers, 1)
+
+	// The lien is released and the mortgage cannot be foreclosed twice
+	_, err = srv.Foreclose(ctx, types.NewMsgForeclose(lender, "m1", false))
+	require.ErrorIs(t, err, types.ErrMortgageNotDefaulted)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "m1"))
+	require.ErrorIs(t, err, types.ErrMortgageNotRequested)
+}
+
+func TestForecloseByAuction(t *testing.T) {
+	f := defaultedMortgage(t)
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	shareDenom := propertytypes.PropertyShareDenom("addr 1")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	bk.Fund(sdk.MustAccAddressFromBech32(alice), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 2000)))
+	bk.Fund(sdk.MustAccAddressFromBech32(bob), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 2000)))
+
+	_, err := srv.BidForeclosure(ctx, types.NewMsgBidForeclosure(alice, "m1", 1000))
+	require.ErrorIs(t, err, types.ErrAuctionClosed)
+
+	_, err = srv.Foreclose(ctx, types.NewMsgForeclose(lender, "m1", true))
+	require.NoError(t, err)
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.FORECLOSING, mortgage.Status)
+	end := ctx.BlockTime().Add(k.GetParams(ctx).AuctionDuration)
+	require.Equal(t, end, mortgage.Auction.EndTime)
+
+	// A mortgage being foreclosed cannot be deleted
+	_, err = srv.DeleteMortgage(ctx, types.NewMsgDeleteMortgage(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.BidForeclosure(ctx, types.NewMsgBidForeclosure(alice, "m1", 1000))
+	require.NoError(t, err)
+	_, err = srv.BidForeclosure(ctx, types.NewMsgBidForeclosure(bob, "m1", 1000))
+	require.ErrorIs(t, err, types.ErrBidTooLow)
+	_, err = srv.BidForeclosure(ctx, types.NewMsgBidForeclosure(bob, "m1", 1500))
+	require.NoError(t, err)
+
+	// The outbid bidder is refunded
+	require.Equal(t, int64(2000), bk.Balances[alice].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(1500), bk.Balances[moduleAddr].AmountOf("usdarda").Int64())
+
+	// Nothing settles before the auction ends
+	ctx = ctx.WithBlockTime(end.Add(-time.Second))
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.FORECLOSING, mortgage.Status)
+
+	ctx = ctx.WithBlockTime(end)
+	_, err = srv.BidForeclosure(ctx, types.NewMsgBidForeclosure(alice, "m1", 1600))
+	require.ErrorIs(t, err, types.ErrAuctionClosed)
+
+	// A settlement the module cannot pay for is retried later
+	held := bk.Balances[moduleAddr]
+	bk.Balances[moduleAddr] = sdk.NewCoins(sdk.NewCoin(shareDenom, held.AmountOf(shareDenom)))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.FORECLOSING, mortgage.Status)
+	require.Equal(t, end.Add(time.Hour), mortgage.ReviewTime)
+	require.True(t, hasEvent(ctx, &types.EventForeclosureSettlementFailed{}))
+	require.False(t, hasEvent(ctx, &types.EventMortgageForeclosed{}))
+
+	bk.Balances[moduleAddr] = held
+	ctx = ctx.WithBlockTime(mortgage.ReviewTime)
+	require.NoError(t, k.EndBlocker(ctx))
+
+	// The bid pays off the 1200 owed and the surplus goes to the lendee
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.FORECLOSED, mortgage.Status)
+	require.Equal(t, int64(1200), bk.Balances[lender].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(1200+300), bk.Balances[lendee].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(500), bk.Balances[bob].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(100), bk.Balances[bob].AmountOf(shareDenom).Int64())
+	require.True(t, bk.Balances[moduleAddr].IsZero())
+
+	property, _ := f.PropertyKeeper.GetProperty(ctx, "addr 1")
+	require.Equal(t, []string{bob}, property.Owners)
+}
+
+func TestRepaidMortgageReleasesEscrow(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+	require.Equal(t, uint64(100), f.Mortgage.EscrowedShares)
+	_, err := f.Srv.RepayMortgage(f.Ctx, types.NewMsgRepayMortgage(f.Lendee, "m1", 1200))
+	require.NoError(t, err)
+
+	mortgage, _ := f.Keeper.GetMortgage(f.Ctx, "m1")
+	require.Zero(t, mortgage.EscrowedShares)
+	require.Equal(t, int64(100), f.BankKeeper.Balances[f.Lendee].AmountOf(propertytypes.PropertyShareDenom("addr 1")).Int64())
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	lendee := sample.AccAddress()
+	f.RegisterCollateral("addr 1", lendee, 60)
+	params := k.GetParams(ctx)
+	params.AuctionDuration = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetMortgage(ctx, types.Mortgage{Creator: lendee, Index: "funded", Lendee: lendee, Collateral: "addr 1", Status: types.DELINQUENT})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate5to6(ctx))
+
+	// Collateral funded before escrow existed stays with the lendee
+	require.Equal(t, types.DefaultAuctionDuration, k.GetParams(ctx).AuctionDuration)
+	funded, _ := k.GetMortgage(ctx, "funded")
+	require.Zero(t, funded.EscrowedShares)
+	require.Equal(t, int64(60), bk.Balances[lendee].AmountOf(propertytypes.PropertyShareDenom("addr 1")).Int64())
+}
+
+func TestMigrate13to14(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+	shareDenom := propertytypes.PropertyShareDenom("addr 1")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	bk.Fund(moduleAddr, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100)))
+
+	k.SetMortgage(ctx, types.Mortgage{Creator: lender, Index: "created", Lender: lender, Lendee: lendee, Collateral: "addr 1", Status: types.APPROVED, EscrowedShares: 60})
+	k.SetMortgage(ctx, types.Mortgage{Creator: lendee, Index: "requested", Lender: lender, Lendee: lendee, Collateral: "addr 1", Status: types.APPROVED, EscrowedShares: 40})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate13to14(ctx))
+
+	// Only the shares of the mortgage the lendee requested stay in escrow
+	created, _ := k.GetMortgage(ctx, "created")
+	require.Zero(t, created.EscrowedShares)
+	requested, _ := k.GetMortgage(ctx, "requested")
+	require.Equal(t, uint64(40), requested.EscrowedShares)
+	require.Equal(t, int64(60), bk.Balances[lendee].AmountOf(shareDenom).Int64())
+	require.Equal(t, int64(40), bk.Balances[moduleAddr.String()].AmountOf(shareDenom).Int64())
+}
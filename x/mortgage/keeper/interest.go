@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// accrueInterest adds the interest of every installment that fell due since the
+// last accrual to the interest due on the mortgage. Interest accrues on the
+// principal outstanding when the installment falls due.
+func (k Keeper) accrueInterest(ctx context.Context, mortgage *types.Mortgage) error {
+	periodRate, err := mortgage.PeriodRate()
+	if err != nil {
+		return err
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	for !mortgage.InstallmentDueDate(mortgage.PeriodsAccrued + 1).After(now) {
+		mortgage.PeriodsAccrued++
+		mortgage.InterestDue += types.PeriodInterest(mortgage.OutstandingAmount, periodRate)
+	}
+	return nil
+}
+
+// payVaultInterest pays the vault interest share of the interest in a
+// repayment into the usdarda savings vault, out of what the lenders received
+// and from each lender in proportion to its part. Nothing is paid while the
+// vault has no depositors.
+func (k Keeper) payVaultInterest(ctx context.Context, mortgage *types.Mortgage, interest uint64) error {
+	share, err := math.LegacyNewDecFromStr(k.GetParams(ctx).VaultInterestShare)
+	if err != nil {
+		return err
+	}
+	amount := share.MulInt(math.NewIntFromUint64(interest)).TruncateInt().Uint64()
+	if amount == 0 {
+		return nil
+	}
+
+	if !mortgage.IsSyndicated() {
+		lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		_, err = k.usdardaKeeper.AddVaultYield(ctx, lender, amount)
+		return err
+	}
+	for i, part := range mortgage.SyndicateShares(amount) {
+		member := &mortgage.Syndicate[i]
+		lender, err := sdk.AccAddressFromBech32(member.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		paid, err := k.usdardaKeeper.AddVaultYield(ctx, lender, part)
+		if err != nil {
+			return err
+		}
+		if !paid {
+			return nil
+		}
+		member.Received -= part
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestRepayMortgageInterestFirst(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0.12", 12)
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	start := keepertest.MortgageStart
+	bk.Fund(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 200)))
+
+	schedule, err := k.AmortizationSchedule(ctx, &types.QueryAmortizationScheduleRequest{Index: "m1"})
+	require.NoError(t, err)
+	require.Len(t, schedule.Installments, 12)
+
+	// Paying before the first installment falls due only reduces the principal
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 200))
+	require.NoError(t, err)
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, uint64(1000), mortgage.OutstandingAmount)
+	require.Zero(t, mortgage.InterestDue)
+
+	// Two installments later, 1% a month has accrued on the remaining 1000
+	ctx = ctx.WithBlockTime(start.AddDate(0, 2, 1))
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 15))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, uint32(2), mortgage.PeriodsAccrued)
+	require.Equal(t, uint64(5), mortgage.InterestDue)
+	require.Equal(t, uint64(1000), mortgage.OutstandingAmount)
+
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 1006))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	bk.Fund(sdk.MustAccAddressFromBech32(lendee), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 1005))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.PAID, mortgage.Status)
+	require.Equal(t, int64(1220), bk.Balances[lender].AmountOf("usdarda").Int64())
+}
+
+func TestRepayMortgageVaultInterest(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 12000, "0.12", 12)
+	ctx, bk, lender := f.Ctx, f.BankKeeper, f.Lender
+	saver := sample.AccAddress()
+	bk.Fund(sdk.MustAccAddressFromBech32(saver), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 100)))
+	_, err := f.UsdardaKeeper.DepositToVault(ctx, sdk.MustAccAddressFromBech32(saver), 100)
+	require.NoError(t, err)
+
+	// A tenth of the interest goes from the lender to the savings vault
+	ctx = ctx.WithBlockTime(keepertest.MortgageStart.AddDate(0, 1, 1))
+	_, err = f.Srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(f.Lendee, "m1", 120))
+	require.NoError(t, err)
+	require.Equal(t, int64(108), bk.Balances[lender].AmountOf("usdarda").Int64())
+	vault := f.UsdardaKeeper.GetVault(ctx)
+	require.Equal(t, uint64(112), vault.Liquid)
+	require.Equal(t, uint64(12), vault.Yield)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	k.SetMortgage(ctx, types.Mortgage{Index: "parsed", Status: types.APPROVED, LegacyInterestRate: "4.5%", LegacyTerm: "30 years"})
+	k.SetMortgage(ctx, types.Mortgage{Index: "unparsed", Status: types.REQUESTED, LegacyInterestRate: "prime + 1", LegacyTerm: "until sold"})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+
+	parsed, _ := k.GetMortgage(ctx, "parsed")
+	require.Equal(t, "0.045000000000000000", parsed.InterestRate)
+	require.Equal(t, uint32(360), parsed.TermMonths)
+	require.Empty(t, parsed.LegacyInterestRate)
+	require.Empty(t, parsed.LegacyTerm)
+	require.Equal(t, now, parsed.StartDate)
+
+	unparsed, _ := k.GetMortgage(ctx, "unparsed")
+	require.Empty(t, unparsed.InterestRate)
+	require.Zero(t, unparsed.TermMonths)
+	require.Equal(t, "prime + 1", unparsed.LegacyInterestRate)
+	require.Equal(t, "until sold", unparsed.LegacyTerm)
+	require.True(t, unparsed.StartDate.IsZero())
+}
+
+func TestMigrate11to12(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	params := k.GetParams(ctx)
+	params.VaultInterestShare = ""
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate11to12(ctx))
+	require.Equal(t, types.DefaultVaultInterestShare, k.GetParams(ctx).VaultInterestShare)
+}
@@ -21,7 +21,11 @@ type (
 		// should be the x/gov module account.
 		authority string
 
-		bankKeeper types.BankKeeper
+		bankKeeper     types.BankKeeper
+		lienKeeper     types.LienKeeper
+		propertyKeeper types.PropertyKeeper
+		authzKeeper    types.AuthzKeeper
+		usdardaKeeper  types.UsdardaKeeper
 	}
 )
 
@@ -32,6 +36,10 @@ func NewKeeper(
 	authority string,
 
 	bankKeeper types.BankKeeper,
+	lienKeeper types.LienKeeper,
+	propertyKeeper types.PropertyKeeper,
+	authzKeeper types.AuthzKeeper,
+	usdardaKeeper types.UsdardaKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -43,7 +51,11 @@ func NewKeeper(
 		authority:    authority,
 		logger:       logger,
 
-		bankKeeper: bankKeeper,
+		bankKeeper:     bankKeeper,
+		lienKeeper:     lienKeeper,
+		propertyKeeper: propertyKeeper,
+		authzKeeper:    authzKeeper,
+		usdardaKeeper:  usdardaKeeper,
 	}
 }
 
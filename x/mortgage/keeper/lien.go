@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// assertCollateralFree returns an error if the collateral already has liens. A
+// mortgage must be the senior lien on its collateral.
+func (k Keeper) assertCollateralFree(ctx context.Context, collateral string) error {
+	if liens := k.lienKeeper.GetPropertyLiens(ctx, collateral); len(liens) > 0 {
+		return errorsmod.Wrapf(types.ErrCollateralEncumbered, "%s has %d lien(s)", collateral, len(liens))
+	}
+	return nil
+}
+
+// placeCollateralLien records the lien of a mortgage on its collateral in favour
+// of the lender. The lien encumbers the lendee's shares only.
+func (k Keeper) placeCollateralLien(ctx context.Context, mortgage types.Mortgage) error {
+	_, err := k.lienKeeper.PlaceLien(ctx, lientypes.Lien{
+		PropertyId: mortgage.Collateral,
+		Module:     types.ModuleName,
+		Holder:     mortgage.Lender,
+		Amount:     mortgage.OutstandingAmount,
+		Reference:  mortgage.Index,
+		Owner:      mortgage.Lendee,
+	})
+	return err
+}
+
+// collateralLien returns the lien securing a mortgage.
+func (k Keeper) collateralLien(ctx context.Context, mortgage types.Mortgage) (lientypes.Lien, bool) {
+	for _, lien := range k.lienKeeper.GetPropertyLiens(ctx, mortgage.Collateral) {
+		if lien.Module == types.ModuleName && lien.Reference == mortgage.Index {
+			return lien, true
+		}
+	}
+	return lientypes.Lien{}, false
+}
+
+// releaseCollateralLien releases the lien securing a mortgage, if any.
+func (k Keeper) releaseCollateralLien(ctx context.Context, mortgage types.Mortgage) error {
+	lien, found := k.collateralLien(ctx, mortgage)
+	if !found {
+		return nil
+	}
+	return k.lienKeeper.ReleaseLien(ctx, types.ModuleName, lien.Id)
+}
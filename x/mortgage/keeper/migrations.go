@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves marker balances from the legacy "mortgage/<collateral>/<index>"
+// denoms to their current denoms, registers the denom metadata and records the
+// legacy denoms as aliases.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	mortgages := m.keeper.GetAllMortgage(ctx)
+
+	legacy := make(map[string]bool, len(mortgages))
+	for _, mortgage := range mortgages {
+		legacy[types.LegacyMortgageMarkerDenom(mortgage.Collateral, mortgage.Index)] = true
+	}
+	holdings := m.keeper.holdingsByDenom(ctx, legacy)
+
+	for _, mortgage := range mortgages {
+		denom := types.LegacyMortgageMarkerDenom(mortgage.Collateral, mortgage.Index)
+		if err := m.keeper.migrateMarkerDenom(ctx, mortgage, holdings[denom]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 records the liens of active mortgages created before liens were
+// tracked in x/lien.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if mortgage.Status != types.APPROVED {
+			continue
+		}
+		if _, found := m.keeper.collateralLien(ctx, mortgage); found {
+			continue
+		}
+		if err := m.keeper.placeCollateralLien(ctx, mortgage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 replaces the free-form interest rate and term of existing
+// mortgages with structured terms. Values that cannot be parsed are kept in the
+// legacy fields and leave the mortgage without a rate or term. Active mortgages
+// start their schedule at the upgrade.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if rate, err := types.ParseInterestRate(mortgage.LegacyInterestRate); err == nil {
+			mortgage.InterestRate = rate.String()
+			mortgage.LegacyInterestRate = ""
+		} else {
+			m.keeper.Logger().Info("cannot parse mortgage interest rate", "index", mortgage.Index, "error", err)
+		}
+
+		if term, err := types.ParseTermMonths(mortgage.LegacyTerm); err == nil {
+			mortgage.TermMonths = term
+			mortgage.LegacyTerm = ""
+		} else {
+			m.keeper.Logger().Info("cannot parse mortgage term", "index", mortgage.Index, "error", err)
+		}
+
+		mortgage.PaymentFrequency = types.MONTHLY
+		mortgage.Amortization = types.ANNUITY
+		if mortgage.Status == types.APPROVED {
+			mortgage.StartDate = ctx.BlockTime()
+		}
+
+		m.keeper.SetMortgage(ctx, mortgage)
+	}
+	return nil
+}
+
+// Migrate4to5 sets the late payment params and starts tracking the installments
+// of active mortgages. Principal repaid before the upgrade counts towards their
+// schedule.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := m.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if mortgage.Status != types.APPROVED {
+			continue
+		}
+		mortgage.AmountPaid = mortgage.Amount - mortgage.OutstandingAmount
+		if err := m.keeper.reviewPayments(ctx, &mortgage); err != nil {
+			return err
+		}
+		m.keeper.SetMortgage(ctx, mortgage)
+	}
+	return nil
+}
+
+// Migrate5to6 sets the foreclosure auction duration. The collateral of
+// mortgages funded before escrow existed stays with the lendee under the
+// mortgage lien; the lendee never agreed to escrow it.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.AuctionDuration = types.DefaultAuctionDuration
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate6to7 records the terms funded mortgages are on as the first version of
+// their terms history.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if mortgage.IsUnfunded() || len(mortgage.TermsHistory) > 0 {
+			continue
+		}
+		recordTerms(&mortgage)
+		m.keeper.SetMortgage(ctx, mortgage)
+	}
+	return nil
+}
+
+// Migrate7to8 sets the maximum loan-to-value of equity loans.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxLoanToValue = types.DefaultMaxLoanToValue
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate8to9 issues loan notes to the lenders of funded mortgages.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if !mortgage.IsFunded() {
+			continue
+		}
+		if err := m.keeper.issueNote(ctx, mortgage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate9to10 builds the lender, lendee, collateral and status indexes of the
+// existing mortgages.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		m.keeper.setMortgageIndexes(ctx, nil, mortgage)
+	}
+	return nil
+}
+
+// Migrate10to11 records that delinquent mortgages were charged their late fee,
+// which used to be charged on every review past the grace period.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if mortgage.Status != types.DELINQUENT {
+			continue
+		}
+		mortgage.LateFeeCharged = true
+		m.keeper.SetMortgage(ctx, mortgage)
+	}
+	return nil
+}
+
+// Migrate11to12 sets the share of mortgage interest paid into the usdarda
+// savings vault.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.VaultInterestShare = types.DefaultVaultInterestShare
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate12to13 scopes the liens of existing mortgages to the lendee's shares,
+// so they no longer block the other owners of the collateral.
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		lien, found := m.keeper.collateralLien(ctx, mortgage)
+		if !found {
+			continue
+		}
+		if err := m.keeper.lienKeeper.SetLienOwner(ctx, types.ModuleName, lien.Id, mortgage.Lendee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate13to14 returns to their lendees the collateral shares escrowed for
+// mortgages the lender created without the lendee's request. The mortgage
+// lien stays in place.
+func (m Migrator) Migrate13to14(ctx sdk.Context) error {
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		if mortgage.RequestedByLendee() || mortgage.EscrowedShares == 0 {
+			continue
+		}
+		if err := m.keeper.releaseCollateral(ctx, &mortgage); err != nil {
+			return err
+		}
+		m.keeper.SetMortgage(ctx, mortgage)
+	}
+	return nil
+}
+
+// Migrate14to15 sets how far the price of a purchase financed by a mortgage may
+// be from the value of the shares bought.
+func (m Migrator) Migrate14to15(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MaxPriceDeviation = types.DefaultMaxPriceDeviation
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate15to16 keeps running totals of the principal outstanding per lender
+// and per property. Earlier migrations of the same upgrade may already have
+// adjusted totals that did not exist yet, so they are cleared and rebuilt from
+// the funded mortgages.
+func (m Migrator) Migrate15to16(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	for _, keyPrefix := range []string{types.LenderOutstandingKeyPrefix, types.PropertyOutstandingKeyPrefix} {
+		totalStore := prefix.NewStore(store, types.KeyPrefix(keyPrefix))
+		iterator := storetypes.KVStorePrefixIterator(totalStore, []byte{})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			totalStore.Delete(key)
+		}
+	}
+
+	for _, mortgage := range m.keeper.GetAllMortgage(ctx) {
+		m.keeper.addOutstanding(ctx, mortgage, true)
+	}
+	return nil
+}
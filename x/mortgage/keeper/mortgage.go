@@ -9,8 +9,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 )
 
-// SetMortgage set a specific mortgage in the store from its index
+// SetMortgage set a specific mortgage in the store from its index and keeps
+// the lender, lendee, collateral and status indexes and the outstanding totals
+// up to date
 func (k Keeper) SetMortgage(ctx context.Context, mortgage types.Mortgage) {
+	var old *types.Mortgage
+	if existing, found := k.GetMortgage(ctx, mortgage.Index); found {
+		old = &existing
+	}
+	k.setMortgageIndexes(ctx, old, mortgage)
+	k.setOutstandingTotals(ctx, old, mortgage)
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageKeyPrefix))
 	b := k.cdc.MustMarshal(&mortgage)
@@ -45,6 +54,11 @@ func (k Keeper) RemoveMortgage(
 	index string,
 
 ) {
+	if mortgage, found := k.GetMortgage(ctx, index); found {
+		k.removeMortgageIndexes(ctx, mortgage)
+		k.addOutstanding(ctx, mortgage, false)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageKeyPrefix))
 	store.Delete(types.MortgageKey(
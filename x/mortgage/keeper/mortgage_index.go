@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// mortgageIndexEntries returns the keys of a mortgage in the lender, lendee,
+// collateral and status indexes. Each key is the prefix of the entries for the
+// indexed value followed by the mortgage index.
+func mortgageIndexEntries(mortgage types.Mortgage) [][]byte {
+	entry := func(keyPrefix string, valuePrefix []byte) []byte {
+		key := append(types.KeyPrefix(keyPrefix), valuePrefix...)
+		return append(key, []byte(mortgage.Index)...)
+	}
+
+	entries := [][]byte{
+		entry(types.MortgageLenderKeyPrefix, types.MortgageIndexPrefix(mortgage.Lender)),
+		entry(types.MortgageLendeeKeyPrefix, types.MortgageIndexPrefix(mortgage.Lendee)),
+		entry(types.MortgageCollateralKeyPrefix, types.MortgageIndexPrefix(mortgage.Collateral)),
+		entry(types.MortgageStatusKeyPrefix, types.MortgageStatusPrefix(mortgage.Status)),
+	}
+	for _, member := range mortgage.Syndicate {
+		if member.Lender != mortgage.Lender {
+			entries = append(entries, entry(types.MortgageLenderKeyPrefix, types.MortgageIndexPrefix(member.Lender)))
+		}
+	}
+	return entries
+}
+
+// setMortgageIndexes replaces the index entries of old, if any, with those of
+// mortgage.
+func (k Keeper) setMortgageIndexes(ctx context.Context, old *types.Mortgage, mortgage types.Mortgage) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if old != nil {
+		for _, key := range mortgageIndexEntries(*old) {
+			store.Delete(key)
+		}
+	}
+	for _, key := range mortgageIndexEntries(mortgage) {
+		store.Set(key, []byte{})
+	}
+}
+
+// removeMortgageIndexes deletes the index entries of a mortgage.
+func (k Keeper) removeMortgageIndexes(ctx context.Context, mortgage types.Mortgage) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, key := range mortgageIndexEntries(mortgage) {
+		store.Delete(key)
+	}
+}
+
+// isOutstanding reports whether a mortgage is funded and not settled, so that
+// it counts towards the outstanding totals.
+func isOutstanding(mortgage types.Mortgage) bool {
+	switch mortgage.Status {
+	case types.APPROVED, types.DELINQUENT, types.DEFAULTED, types.FORECLOSING:
+		return true
+	}
+	return false
+}
+
+// setOutstandingTotals replaces what old, if any, added to the outstanding
+// totals of its lenders and collateral with what mortgage adds.
+func (k Keeper) setOutstandingTotals(ctx context.Context, old *types.Mortgage, mortgage types.Mortgage) {
+	if old != nil {
+		k.addOutstanding(ctx, *old, false)
+	}
+	k.addOutstanding(ctx, mortgage, true)
+}
+
+// addOutstanding adds the principal owed on a funded mortgage to the
+// outstanding totals of its lenders and its collateral, or subtracts it.
+// Totals without mortgages are deleted.
+func (k Keeper) addOutstanding(ctx context.Context, mortgage types.Mortgage, add bool) {
+	if !isOutstanding(mortgage) {
+		return
+	}
+	adjust := func(outstanding *uint64, mortgages *uint32, amount uint64) {
+		if add {
+			*outstanding += amount
+			*mortgages++
+		} else {
+			*outstanding -= amount
+			*mortgages--
+		}
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	lenderStore := prefix.NewStore(store, types.KeyPrefix(types.LenderOutstandingKeyPrefix))
+	for _, position := range lenderPositions(mortgage) {
+		total := types.LenderOutstanding{Lender: position.Lender}
+		if bz := lenderStore.Get(types.OutstandingKey(position.Lender)); bz != nil {
+			k.cdc.MustUnmarshal(bz, &total)
+		}
+		adjust(&total.Outstanding, &total.Mortgages, position.Outstanding)
+		if total.Mortgages == 0 {
+			lenderStore.Delete(types.OutstandingKey(position.Lender))
+			continue
+		}
+		lenderStore.Set(types.OutstandingKey(position.Lender), k.cdc.MustMarshal(&total))
+	}
+
+	propertyStore := prefix.NewStore(store, types.KeyPrefix(types.PropertyOutstandingKeyPrefix))
+	total := types.PropertyOutstanding{Collateral: mortgage.Collateral}
+	if bz := propertyStore.Get(types.OutstandingKey(mortgage.Collateral)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &total)
+	}
+	adjust(&total.Outstanding, &total.Mortgages, mortgage.OutstandingAmount)
+	if total.Mortgages == 0 {
+		propertyStore.Delete(types.OutstandingKey(mortgage.Collateral))
+		return
+	}
+	propertyStore.Set(types.OutstandingKey(mortgage.Collateral), k.cdc.MustMarshal(&total))
+}
+
+// paginateIndex pages through the mortgages with entries under indexPrefix,
+// keeping those that match.
+func (k Keeper) paginateIndex(ctx context.Context, indexPrefix []byte, pagination *query.PageRequest, match func(types.Mortgage) bool) ([]types.Mortgage, *query.PageResponse, error) {
+	var mortgages []types.Mortgage
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		mortgage, found := k.GetMortgage(ctx, string(key))
+		if !found || !match(mortgage) {
+			return false, nil
+		}
+		if accumulate {
+			mortgages = append(mortgages, mortgage)
+		}
+		return true, nil
+	})
+	return mortgages, pageRes, err
+}
+
+// lenderIndexPrefix returns the prefix of the entries of a lender in the
+// lender index.
+func lenderIndexPrefix(lender string) []byte {
+	return append(types.KeyPrefix(types.MortgageLenderKeyPrefix), types.MortgageIndexPrefix(lender)...)
+}
+
+// lendeeIndexPrefix returns the prefix of the entries of a lendee in the
+// lendee index.
+func lendeeIndexPrefix(lendee string) []byte {
+	return append(types.KeyPrefix(types.MortgageLendeeKeyPrefix), types.MortgageIndexPrefix(lendee)...)
+}
+
+// statusIndexPrefix returns the prefix of the entries of a status in the
+// status index.
+func statusIndexPrefix(status types.MortgageStatus) []byte {
+	return append(types.KeyPrefix(types.MortgageStatusKeyPrefix), types.MortgageStatusPrefix(status)...)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) EnableAutoRepayment(goCtx context.Context, msg *types.MsgEnableAutoRepayment) (*types.MsgEnableAutoRepaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lendee != mortgage.Lendee {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "auto repayment can only be set up by the lendee")
+	}
+
+	if err := k.enableAutoRepayment(ctx, &mortgage); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAutoRepaymentEnabled{
+		Index:  mortgage.Index,
+		Lendee: mortgage.Lendee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnableAutoRepaymentResponse{}, nil
+}
+
+func (k msgServer) DisableAutoRepayment(goCtx context.Context, msg *types.MsgDisableAutoRepayment) (*types.MsgDisableAutoRepaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lendee != mortgage.Lendee {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "auto repayment can only be cancelled by the lendee")
+	}
+	if mortgage.AutoRepayment == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s has no auto repayment", msg.Index)
+	}
+
+	k.disableAutoRepayment(ctx, &mortgage)
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAutoRepaymentDisabled{
+		Index:  mortgage.Index,
+		Lendee: mortgage.Lendee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisableAutoRepaymentResponse{}, nil
+}
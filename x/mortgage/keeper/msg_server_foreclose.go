@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) Foreclose(goCtx context.Context, msg *types.MsgForeclose) (*types.MsgForecloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lender != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mortgage can only be foreclosed by its lender")
+	}
+	if mortgage.Status != types.DEFAULTED {
+		return nil, errorsmod.Wrapf(types.ErrMortgageNotDefaulted, "mortgage %s is %s", msg.Index, mortgage.Status)
+	}
+
+	if msg.Auction {
+		if err := k.startAuction(ctx, &mortgage); err != nil {
+			return nil, err
+		}
+	} else if err := k.settleForeclosure(ctx, &mortgage); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	return &types.MsgForecloseResponse{}, nil
+}
+
+func (k msgServer) BidForeclosure(goCtx context.Context, msg *types.MsgBidForeclosure) (*types.MsgBidForeclosureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if err := k.placeBid(ctx, &mortgage, msg.Bidder, msg.Amount); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	return &types.MsgBidForeclosureResponse{}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) TransferLoanNote(goCtx context.Context, msg *types.MsgTransferLoanNote) (*types.MsgTransferLoanNoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Holder != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "loan note can only be transferred by its holder")
+	}
+
+	if err := k.transferNote(ctx, &mortgage, msg.Recipient); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	return &types.MsgTransferLoanNoteResponse{}, nil
+}
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) RequestMortgage(goCtx context.Context, msg *types.MsgRequestMortgage) (*types.MsgRequestMortgageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, isFound := k.GetMortgage(ctx, msg.Index); isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+	}
+
+	// Members of the syndicate commit themselves with MsgJoinSyndicate
+	var syndicate []types.SyndicateLender
+	for _, member := range msg.Syndicate {
+		syndicate = append(syndicate, types.SyndicateLender{Lender: member.Lender, Contribution: member.Contribution})
+	}
+
+	// Nothing is owed and no funds move until the lender approves the request
+	k.SetMortgage(ctx, types.Mortgage{
+		Creator:          msg.Lendee,
+		Index:            msg.Index,
+		Lender:           msg.Lender,
+		Lendee:           msg.Lendee,
+		Collateral:       msg.Collateral,
+		Amount:           msg.Amount,
+		InterestRate:     msg.InterestRate,
+		TermMonths:       msg.TermMonths,
+		PaymentFrequency: msg.PaymentFrequency,
+		Amortization:     msg.Amortization,
+		Purchase:         msg.Purchase,
+		Syndicate:        syndicate,
+		Prepayment:       msg.Prepayment,
+		Status:           types.REQUESTED,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMortgageRequested{
+		Index:      msg.Index,
+		Lender:     msg.Lender,
+		Lendee:     msg.Lendee,
+		Collateral: msg.Collateral,
+		Amount:     msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestMortgageResponse{}, nil
+}
+
+func (k msgServer) RequestEquityLoan(goCtx context.Context, msg *types.MsgRequestEquityLoan) (*types.MsgRequestEquityLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, isFound := k.GetMortgage(ctx, msg.Index); isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+	}
+
+	mortgage := types.Mortgage{
+		Creator:          msg.Lendee,
+		Index:            msg.Index,
+		Lender:           msg.Lender,
+		Lendee:           msg.Lendee,
+		Collateral:       msg.Collateral,
+		Amount:           msg.Amount,
+		InterestRate:     msg.InterestRate,
+		TermMonths:       msg.TermMonths,
+		PaymentFrequency: msg.PaymentFrequency,
+		Amortization:     msg.Amortization,
+		Prepayment:       msg.Prepayment,
+		Equity:           true,
+		Status:           types.REQUESTED,
+	}
+	// The limit is checked again on approval, when the value or liens may have changed
+	if err := k.assertWithinLoanToValue(ctx, mortgage); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMortgageRequested{
+		Index:      mortgage.Index,
+		Lender:     mortgage.Lender,
+		Lendee:     mortgage.Lendee,
+		Collateral: mortgage.Collateral,
+		Amount:     mortgage.Amount,
+		Equity:     true,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestEquityLoanResponse{}, nil
+}
+
+func (k msgServer) ApproveMortgage(goCtx context.Context, msg *types.MsgApproveMortgage) (*types.MsgApproveMortgageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Lender != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mortgage request can only be approved by its lender")
+	}
+	if mortgage.Purchase != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mortgage request finances a purchase; fund it with MsgPurchaseWithMortgage")
+	}
+
+	// Every member of a syndicate pays its contribution or none does
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.originateMortgage(cacheCtx, mortgage); err != nil {
+		return nil, err
+	}
+	write()
+
+	return &types.MsgApproveMortgageResponse{}, nil
+}
+
+func (k msgServer) PurchaseWithMortgage(goCtx context.Context, msg *types.MsgPurchaseWithMortgage) (*types.MsgPurchaseWithMortgageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Lender != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mortgage request can only be approved by its lender")
+	}
+	if mortgage.Purchase == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mortgage request finances no purchase; approve it with MsgApproveMortgage")
+	}
+
+	// Funding, the purchase and the escrow only take effect together
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.originateMortgage(cacheCtx, mortgage); err != nil {
+		return nil, err
+	}
+	write()
+
+	return &types.MsgPurchaseWithMortgageResponse{}, nil
+}
+
+func (k msgServer) AcceptPurchase(goCtx context.Context, msg *types.MsgAcceptPurchase) (*types.MsgAcceptPurchaseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+	purchase := mortgage.Purchase
+	if purchase == nil || !slices.Contains(purchase.FromOwners, msg.Seller) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s sells no shares in mortgage request %s", msg.Seller, msg.Index)
+	}
+	if !slices.Contains(purchase.AcceptedBy, msg.Seller) {
+		purchase.AcceptedBy = append(purchase.AcceptedBy, msg.Seller)
+		k.SetMortgage(ctx, mortgage)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPurchaseAccepted{
+		Index:  mortgage.Index,
+		Seller: msg.Seller,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptPurchaseResponse{}, nil
+}
+
+func (k msgServer) RejectMortgage(goCtx context.Context, msg *types.MsgRejectMortgage) (*types.MsgRejectMortgageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Lender != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mortgage request can only be rejected by its lender")
+	}
+
+	mortgage.Status = types.REJECTED
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMortgageRejected{
+		Index:  mortgage.Index,
+		Lender: mortgage.Lender,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectMortgageResponse{}, nil
+}
+
+func (k msgServer) CancelMortgageRequest(goCtx context.Context, msg *types.MsgCancelMortgageRequest) (*types.MsgCancelMortgageRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Lendee != mortgage.Lendee {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mortgage request can only be cancelled by its lendee")
+	}
+
+	mortgage.Status = types.CANCELLED
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMortgageRequestCancelled{
+		Index:  mortgage.Index,
+		Lendee: mortgage.Lendee,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMortgageRequestResponse{}, nil
+}
+
+// pendingRequest returns the mortgage with the given index if it is still
+// awaiting the lender's decision.
+func (k Keeper) pendingRequest(ctx context.Context, index string) (types.Mortgage, error) {
+	mortgage, isFound := k.GetMortgage(ctx, index)
+	if !isFound {
+		return mortgage, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if mortgage.Status != types.REQUESTED {
+		return mortgage, errorsmod.Wrapf(types.ErrMortgageNotRequested, "mortgage %s is %s", index, mortgage.Status)
+	}
+	return mortgage, nil
+}
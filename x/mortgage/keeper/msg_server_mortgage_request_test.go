@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestMortgageRequestApprove(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+	f.RegisterCollateral("addr 1", lendee, 100)
+	bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+
+	_, err := srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m1", lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+
+	_, err = srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m1", lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Nothing moves while the request is pending
+	mortgage, found := k.GetMortgage(ctx, "m1")
+	require.True(t, found)
+	require.Equal(t, types.REQUESTED, mortgage.Status)
+	require.Zero(t, mortgage.OutstandingAmount)
+	require.True(t, bk.Balances[lendee].AmountOf("usdarda").IsZero())
+
+	resp, err := k.MortgageRequestsByLender(ctx, &types.QueryMortgageRequestsByLenderRequest{Lender: lender})
+	require.NoError(t, err)
+	require.Len(t, resp.Mortgage, 1)
+	resp, err = k.MortgageRequestsByLendee(ctx, &types.QueryMortgageRequestsByLendeeRequest{Lendee: lendee})
+	require.NoError(t, err)
+	require.Len(t, resp.Mortgage, 1)
+	resp, err = k.MortgageRequestsByLendee(ctx, &types.QueryMortgageRequestsByLendeeRequest{Lendee: lender})
+	require.NoError(t, err)
+	require.Empty(t, resp.Mortgage)
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "m1"))
+	require.NoError(t, err)
+
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.APPROVED, mortgage.Status)
+	require.Equal(t, uint64(600), mortgage.OutstandingAmount)
+	require.Equal(t, int64(600), bk.Balances[lendee].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(400), bk.Balances[lender].AmountOf("usdarda").Int64())
+	require.Equal(t, int64(1), bk.Balances[lendee].AmountOf(types.MortgageMarkerDenom("m1")).Int64())
+
+	// The lendee's shares of the collateral are held in escrow
+	shareDenom := propertytypes.PropertyShareDenom("addr 1")
+	require.Equal(t, uint64(100), mortgage.EscrowedShares)
+	require.True(t, bk.Balances[lendee].AmountOf(shareDenom).IsZero())
+	require.Equal(t, int64(100), bk.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].AmountOf(shareDenom).Int64())
+
+	// An approved mortgage is no longer pending
+	resp, err = k.MortgageRequestsByLender(ctx, &types.QueryMortgageRequestsByLenderRequest{Lender: lender})
+	require.NoError(t, err)
+	require.Empty(t, resp.Mortgage)
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "m1"))
+	require.ErrorIs(t, err, types.ErrMortgageNotRequested)
+	_, err = srv.CancelMortgageRequest(ctx, types.NewMsgCancelMortgageRequest(lendee, "m1"))
+	require.ErrorIs(t, err, types.ErrMortgageNotRequested)
+
+	// The lendee cannot drop an approved mortgage to free the collateral
+	_, err = srv.DeleteMortgage(ctx, types.NewMsgDeleteMortgage(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// The collateral now secures m1 and cannot back another mortgage
+	_, err = srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m2", lender, "addr 1", 100, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "m2"))
+	require.ErrorIs(t, err, types.ErrCollateralEncumbered)
+}
+
+func TestMortgageRequestRejectAndCancel(t *testing.T) {
+	k, ctx, bk := keepertest.MortgageKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(k)
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+	bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+
+	_, err := srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m1", lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+	_, err = srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m2", lender, "addr 2", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+
+	_, err = srv.RejectMortgage(ctx, types.NewMsgRejectMortgage(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RejectMortgage(ctx, types.NewMsgRejectMortgage(lender, "m1"))
+	require.NoError(t, err)
+
+	_, err = srv.CancelMortgageRequest(ctx, types.NewMsgCancelMortgageRequest(lender, "m2"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CancelMortgageRequest(ctx, types.NewMsgCancelMortgageRequest(lendee, "m2"))
+	require.NoError(t, err)
+
+	m1, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.REJECTED, m1.Status)
+	m2, _ := k.GetMortgage(ctx, "m2")
+	require.Equal(t, types.CANCELLED, m2.Status)
+
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "m1"))
+	require.ErrorIs(t, err, types.ErrMortgageNotRequested)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, "missing"))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	resp, err := k.MortgageRequestsByLender(ctx, &types.QueryMortgageRequestsByLenderRequest{Lender: lender})
+	require.NoError(t, err)
+	require.Empty(t, resp.Mortgage)
+	require.Equal(t, int64(1000), bk.Balances[lender].AmountOf("usdarda").Int64())
+}
@@ -4,10 +4,12 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
 	"github.com/ardaglobal/arda-poc/x/mortgage/types"
 )
@@ -15,80 +17,8 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
-func TestMortgageMsgServerCreate(t *testing.T) {
-	k, ctx := keepertest.MortgageKeeper(t)
-	srv := keeper.NewMsgServerImpl(k)
-	creator := "A"
-	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateMortgage{Creator: creator,
-			Index: strconv.Itoa(i),
-		}
-		_, err := srv.CreateMortgage(ctx, expected)
-		require.NoError(t, err)
-		rst, found := k.GetMortgage(ctx,
-			expected.Index,
-		)
-		require.True(t, found)
-		require.Equal(t, expected.Creator, rst.Creator)
-	}
-}
-
-func TestMortgageMsgServerUpdate(t *testing.T) {
-	creator := "A"
-
-	tests := []struct {
-		desc    string
-		request *types.MsgUpdateMortgage
-		err     error
-	}{
-		{
-			desc: "Completed",
-			request: &types.MsgUpdateMortgage{Creator: creator,
-				Index: strconv.Itoa(0),
-			},
-		},
-		{
-			desc: "Unauthorized",
-			request: &types.MsgUpdateMortgage{Creator: "B",
-				Index: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc: "KeyNotFound",
-			request: &types.MsgUpdateMortgage{Creator: creator,
-				Index: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
-		},
-	}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			k, ctx := keepertest.MortgageKeeper(t)
-			srv := keeper.NewMsgServerImpl(k)
-			expected := &types.MsgCreateMortgage{Creator: creator,
-				Index: strconv.Itoa(0),
-			}
-			_, err := srv.CreateMortgage(ctx, expected)
-			require.NoError(t, err)
-
-			_, err = srv.UpdateMortgage(ctx, tc.request)
-			if tc.err != nil {
-				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-				rst, found := k.GetMortgage(ctx,
-					expected.Index,
-				)
-				require.True(t, found)
-				require.Equal(t, expected.Creator, rst.Creator)
-			}
-		})
-	}
-}
-
 func TestMortgageMsgServerDelete(t *testing.T) {
-	creator := "A"
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
 
 	tests := []struct {
 		desc    string
@@ -96,35 +26,40 @@ func TestMortgageMsgServerDelete(t *testing.T) {
 		err     error
 	}{
 		{
-			desc: "Completed",
-			request: &types.MsgDeleteMortgage{Creator: creator,
-				Index: strconv.Itoa(0),
-			},
+			desc:    "Completed",
+			request: &types.MsgDeleteMortgage{Creator: lendee, Index: strconv.Itoa(0)},
 		},
 		{
-			desc: "Unauthorized",
-			request: &types.MsgDeleteMortgage{Creator: "B",
-				Index: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
+			desc:    "Unauthorized",
+			request: &types.MsgDeleteMortgage{Creator: lender, Index: strconv.Itoa(0)},
+			err:     sdkerrors.ErrUnauthorized,
 		},
 		{
-			desc: "KeyNotFound",
-			request: &types.MsgDeleteMortgage{Creator: creator,
-				Index: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
+			desc:    "KeyNotFound",
+			request: &types.MsgDeleteMortgage{Creator: lendee, Index: strconv.Itoa(100000)},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Funded",
+			request: &types.MsgDeleteMortgage{Creator: lendee, Index: strconv.Itoa(1)},
+			err:     sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			k, ctx := keepertest.MortgageKeeper(t)
+			f := keepertest.NewMortgageFixture(t)
+			k, ctx := f.Keeper, f.Ctx
 			srv := keeper.NewMsgServerImpl(k)
+			f.RegisterCollateral("addr 1", lendee, 100)
+			f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
 
-			_, err := srv.CreateMortgage(ctx, &types.MsgCreateMortgage{Creator: creator,
-				Index: strconv.Itoa(0),
-			})
+			_, err := srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, strconv.Itoa(0), lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+			require.NoError(t, err)
+			_, err = srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, strconv.Itoa(1), lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
 			require.NoError(t, err)
+			_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lender, strconv.Itoa(1)))
+			require.NoError(t, err)
+
 			_, err = srv.DeleteMortgage(ctx, tc.request)
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
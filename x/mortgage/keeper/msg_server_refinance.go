@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) ProposeRefinance(goCtx context.Context, msg *types.MsgProposeRefinance) (*types.MsgProposeRefinanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lender != mortgage.Lender {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "refinancing can only be proposed by the lender")
+	}
+
+	terms := types.MortgageTerms{
+		InterestRate:     msg.InterestRate,
+		TermMonths:       msg.TermMonths,
+		PaymentFrequency: msg.PaymentFrequency,
+		Amortization:     msg.Amortization,
+	}
+	if err := k.proposeRefinance(ctx, &mortgage, terms); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	return &types.MsgProposeRefinanceResponse{}, nil
+}
+
+func (k msgServer) AcceptRefinance(goCtx context.Context, msg *types.MsgAcceptRefinance) (*types.MsgAcceptRefinanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lendee != mortgage.Lendee {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "refinancing can only be accepted by the lendee")
+	}
+
+	terms := types.MortgageTerms{
+		InterestRate:     msg.InterestRate,
+		TermMonths:       msg.TermMonths,
+		PaymentFrequency: msg.PaymentFrequency,
+		Amortization:     msg.Amortization,
+	}
+	if err := k.acceptRefinance(ctx, &mortgage, terms); err != nil {
+		return nil, err
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	return &types.MsgAcceptRefinanceResponse{}, nil
+}
+
+func (k msgServer) RejectRefinance(goCtx context.Context, msg *types.MsgRejectRefinance) (*types.MsgRejectRefinanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, isFound := k.GetMortgage(ctx, msg.Index)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
+	}
+	if msg.Lendee != mortgage.Lendee {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "refinancing can only be rejected by the lendee")
+	}
+	if mortgage.RefinanceProposal == nil {
+		return nil, errorsmod.Wrapf(types.ErrNoRefinanceProposal, "mortgage %s", msg.Index)
+	}
+
+	version := mortgage.RefinanceProposal.Version
+	mortgage.RefinanceProposal = nil
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRefinanceRejected{
+		Index:   mortgage.Index,
+		Lendee:  mortgage.Lendee,
+		Version: version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectRefinanceResponse{}, nil
+}
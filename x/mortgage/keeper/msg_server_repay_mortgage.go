@@ -17,15 +17,10 @@ func (k msgServer) RepayMortgage(goCtx context.Context, msg *types.MsgRepayMortg
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "mortgage not found")
 	}
 
-	if mortgage.Status != types.APPROVED {
+	if !mortgage.IsActive() {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mortgage is not active")
 	}
 
-	lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
-	}
-
 	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
@@ -35,32 +30,112 @@ func (k msgServer) RepayMortgage(goCtx context.Context, msg *types.MsgRepayMortg
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "repayment can only be made by the lendee")
 	}
 
-	if msg.Amount > mortgage.OutstandingAmount {
+	if err := k.accrueInterest(ctx, &mortgage); err != nil {
+		return nil, err
+	}
+
+	if msg.Amount > mortgage.LateFeesDue+mortgage.InterestDue+mortgage.OutstandingAmount {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "repayment amount exceeds outstanding balance")
 	}
 
-	denom := "usdarda"
-	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, int64(msg.Amount)))
-	if err := k.bankKeeper.SendCoins(ctx, lendee, lender, coins); err != nil {
+	schedule, err := mortgage.AmortizationSchedule()
+	if err != nil {
+		return nil, err
+	}
+	penalty, err := k.chargePrepayment(ctx, &mortgage, prepaidPrincipal(mortgage, schedule, msg.Amount, ctx.BlockTime()))
+	if err != nil {
+		return nil, err
+	}
+	lateFees, interest, principal := allocateRepayment(mortgage, msg.Amount)
+
+	// The prepayment penalty is paid to the lenders on top of the repayment
+	if err := k.payLenders(ctx, &mortgage, msg.Amount+penalty, func(lender sdk.AccAddress, amount uint64) error {
+		return k.bankKeeper.SendCoins(ctx, lendee, lender, usdardaCoins(amount))
+	}); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to send funds from lendee to lender")
 	}
+	if err := k.payVaultInterest(ctx, &mortgage, interest); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to pay interest into the savings vault")
+	}
+	if err := k.emitRepaid(ctx, mortgage, msg.Amount, penalty, false); err != nil {
+		return nil, err
+	}
+	if err := k.applyRepayment(ctx, &mortgage, msg.Amount); err != nil {
+		return nil, err
+	}
 
-	mortgage.OutstandingAmount -= msg.Amount
+	k.SetMortgage(ctx, mortgage)
 
-	if mortgage.OutstandingAmount == 0 {
-		mortgage.Status = types.PAID
+	return &types.MsgRepayMortgageResponse{
+		LateFees:  lateFees,
+		Interest:  interest,
+		Principal: principal,
+		Penalty:   penalty,
+	}, nil
+}
 
-		markerDenom := types.MortgageMarkerDenom(mortgage.Collateral, mortgage.Index)
-		markerCoin := sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 1))
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lendee, types.ModuleName, markerCoin); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to send marker token from lendee to module")
-		}
-		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, markerCoin); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to burn marker token")
-		}
+// emitRepaid emits the event of a repayment of amount, plus penalty, before it
+// is applied to the mortgage.
+func (k Keeper) emitRepaid(ctx context.Context, mortgage types.Mortgage, amount uint64, penalty uint64, automatic bool) error {
+	lateFees, interest, principal := allocateRepayment(mortgage, amount)
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMortgageRepaid{
+		Index:       mortgage.Index,
+		Lender:      mortgage.Lender,
+		Lendee:      mortgage.Lendee,
+		LateFees:    lateFees,
+		Interest:    interest,
+		Principal:   principal,
+		Penalty:     penalty,
+		Outstanding: mortgage.OutstandingAmount - principal,
+		Automatic:   automatic,
+	})
+}
+
+// applyRepayment books a repayment the lenders were paid. Payments settle late
+// fees and then the interest due before they pay down the principal. Only the
+// part paid towards the schedule counts against it. A mortgage repaid in full
+// is closed: the marker and loan note are burned and the collateral released.
+func (k Keeper) applyRepayment(ctx context.Context, mortgage *types.Mortgage, amount uint64) error {
+	lateFees, interest, principal := allocateRepayment(*mortgage, amount)
+	mortgage.LateFeesDue -= lateFees
+	mortgage.InterestDue -= interest
+	mortgage.OutstandingAmount -= principal
+	mortgage.AmountPaid += interest + principal
+
+	if mortgage.OutstandingAmount != 0 || mortgage.InterestDue != 0 || mortgage.LateFeesDue != 0 {
+		return k.reviewPayments(ctx, mortgage)
 	}
 
-	k.SetMortgage(ctx, mortgage)
+	mortgage.Status = types.PAID
+	k.dequeueReview(ctx, mortgage)
+	k.disableAutoRepayment(ctx, mortgage)
+
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	markerDenom := types.MortgageMarkerDenom(mortgage.Index)
+	markerCoin := sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 1))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lendee, types.ModuleName, markerCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to send marker token from lendee to module")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, markerCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to burn marker token")
+	}
+	if err := k.retireNote(ctx, *mortgage); err != nil {
+		return err
+	}
+
+	if err := k.releaseCollateralLien(ctx, *mortgage); err != nil {
+		return errorsmod.Wrap(err, "failed to release collateral lien")
+	}
+	if err := k.releaseCollateral(ctx, mortgage); err != nil {
+		return err
+	}
 
-	return &types.MsgRepayMortgageResponse{}, nil
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMortgagePaidOff{
+		Index:  mortgage.Index,
+		Lender: mortgage.Lender,
+		Lendee: mortgage.Lendee,
+	})
 }
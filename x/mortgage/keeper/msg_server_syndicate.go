@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k msgServer) JoinSyndicate(goCtx context.Context, msg *types.MsgJoinSyndicate) (*types.MsgJoinSyndicateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mortgage, err := k.pendingRequest(ctx, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	joined, contribution := false, uint64(0)
+	for i := range mortgage.Syndicate {
+		if mortgage.Syndicate[i].Lender == msg.Lender {
+			mortgage.Syndicate[i].Committed = true
+			joined = true
+			contribution += mortgage.Syndicate[i].Contribution
+		}
+	}
+	if !joined {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not in the syndicate of mortgage %s", msg.Lender, msg.Index)
+	}
+	k.SetMortgage(ctx, mortgage)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSyndicateJoined{
+		Index:        mortgage.Index,
+		Lender:       msg.Lender,
+		Contribution: contribution,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinSyndicateResponse{}, nil
+}
@@ -18,6 +18,9 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{Authority: req.Authority, Params: req.Params}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// issueNote mints the loan note of a mortgage to its lender. Bank sends of the
+// note are disabled so it only changes hands through transferNote, which keeps
+// the lender of the mortgage in step with the holder of the note.
+func (k Keeper) issueNote(ctx context.Context, mortgage types.Mortgage) error {
+	lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
+	if err != nil {
+		return err
+	}
+
+	noteDenom := types.MortgageNoteDenom(mortgage.Index)
+	noteCoin := sdk.NewCoins(sdk.NewInt64Coin(noteDenom, 1))
+	k.setNoteDenomMetadata(ctx, mortgage)
+	k.bankKeeper.SetSendEnabled(ctx, noteDenom, false)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, noteCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to mint loan note")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lender, noteCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to send loan note to lender")
+	}
+	return nil
+}
+
+// transferNote assigns the loan note of a funded mortgage to a new lender, who
+// receives the repayments and foreclosure proceeds from then on. The new lender
+// also takes over the previous lender's part of a syndicate and leads it. A
+// refinance proposal of the previous lender is withdrawn.
+func (k Keeper) transferNote(ctx context.Context, mortgage *types.Mortgage, to string) error {
+	if !mortgage.IsFunded() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s is %s", mortgage.Index, mortgage.Status)
+	}
+	if mortgage.HasLender(to) || to == mortgage.Lendee {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "loan note cannot be transferred to a lender or the lendee")
+	}
+
+	holder, err := sdk.AccAddressFromBech32(mortgage.Lender)
+	if err != nil {
+		return err
+	}
+	recipient, err := sdk.AccAddressFromBech32(to)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	noteCoin := sdk.NewCoins(sdk.NewInt64Coin(types.MortgageNoteDenom(mortgage.Index), 1))
+	if err := k.bankKeeper.SendCoins(ctx, holder, recipient, noteCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to transfer loan note")
+	}
+
+	if lien, found := k.collateralLien(ctx, *mortgage); found {
+		if err := k.lienKeeper.AssignLien(ctx, types.ModuleName, lien.Id, to); err != nil {
+			return errorsmod.Wrap(err, "failed to assign collateral lien")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	from := mortgage.Lender
+	mortgage.NoteAssignments = append(mortgage.NoteAssignments, types.NoteAssignment{
+		From: from,
+		To:   to,
+		Time: sdkCtx.BlockTime(),
+	})
+	for i := range mortgage.Syndicate {
+		if mortgage.Syndicate[i].Lender == from {
+			mortgage.Syndicate[i].Lender = to
+		}
+	}
+	mortgage.Lender = to
+	mortgage.RefinanceProposal = nil
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventLoanNoteTransferred{
+		Index: mortgage.Index,
+		From:  from,
+		To:    to,
+	})
+}
+
+// retireNote burns the loan note of a settled mortgage. Mortgages funded before
+// loan notes existed have none to burn.
+func (k Keeper) retireNote(ctx context.Context, mortgage types.Mortgage) error {
+	lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
+	if err != nil {
+		return err
+	}
+
+	noteDenom := types.MortgageNoteDenom(mortgage.Index)
+	if k.bankKeeper.SpendableCoins(ctx, lender).AmountOf(noteDenom).IsZero() {
+		return nil
+	}
+	noteCoin := sdk.NewCoins(sdk.NewInt64Coin(noteDenom, 1))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, lender, types.ModuleName, noteCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to send loan note from lender to module")
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, noteCoin); err != nil {
+		return errorsmod.Wrap(err, "failed to burn loan note")
+	}
+	return nil
+}
+
+// setNoteDenomMetadata registers the bank metadata of a mortgage's loan note
+// denom so wallets can display it.
+func (k Keeper) setNoteDenomMetadata(ctx context.Context, mortgage types.Mortgage) {
+	denom := types.MortgageNoteDenom(mortgage.Index)
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("Lender's claim on mortgage %s secured by %s; burned once the mortgage is settled", mortgage.Index, mortgage.Collateral),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    fmt.Sprintf("Mortgage %s note", mortgage.Index),
+		Symbol:  "ARDANOTE",
+	})
+}
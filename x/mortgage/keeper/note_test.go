@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestTransferLoanNote(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1000, "0", 12)
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	start, buyer := keepertest.MortgageStart, sample.AccAddress()
+
+	noteDenom := types.MortgageNoteDenom("m1")
+	require.Equal(t, int64(1), bk.Balances[lender].AmountOf(noteDenom).Int64())
+	require.False(t, bk.SendEnabled[noteDenom])
+
+	_, err := srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(buyer, "m1", lendee))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(lender, "m1", lendee))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	ctx = ctx.WithBlockTime(start.AddDate(0, 0, 10)).WithEventManager(sdk.NewEventManager())
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(lender, "m1", buyer))
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, &types.EventLoanNoteTransferred{}))
+
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, buyer, mortgage.Lender)
+	require.Equal(t, []types.NoteAssignment{{From: lender, To: buyer, Time: start.AddDate(0, 0, 10)}}, mortgage.NoteAssignments)
+	require.True(t, bk.Balances[lender].AmountOf(noteDenom).IsZero())
+	require.Equal(t, int64(1), bk.Balances[buyer].AmountOf(noteDenom).Int64())
+
+	// The previous lender no longer controls the mortgage
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(lender, "m1", sample.AccAddress()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	holderMortgages, err := k.MortgagesByHolder(ctx, &types.QueryMortgagesByHolderRequest{Holder: buyer})
+	require.NoError(t, err)
+	require.Len(t, holderMortgages.Mortgage, 1)
+	holderMortgages, err = k.MortgagesByHolder(ctx, &types.QueryMortgagesByHolderRequest{Holder: lender})
+	require.NoError(t, err)
+	require.Empty(t, holderMortgages.Mortgage)
+
+	// Repayments go to the holder of the note, which is burned once the
+	// mortgage is repaid
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 1000))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), bk.Balances[buyer].AmountOf("usdarda").Int64())
+	require.True(t, bk.Balances[lender].AmountOf("usdarda").IsZero())
+	require.True(t, bk.Balances[buyer].AmountOf(noteDenom).IsZero())
+
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.PAID, mortgage.Status)
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(buyer, "m1", lender))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMigrate8to9(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	lender := sample.AccAddress()
+	f.Keeper.SetMortgage(f.Ctx, types.Mortgage{Index: "m1", Lender: lender, Status: types.APPROVED})
+	f.Keeper.SetMortgage(f.Ctx, types.Mortgage{Index: "m2", Lender: lender, Status: types.REQUESTED})
+
+	require.NoError(t, keeper.NewMigrator(f.Keeper).Migrate8to9(f.Ctx))
+	require.Equal(t, int64(1), f.BankKeeper.Balances[lender].AmountOf(types.MortgageNoteDenom("m1")).Int64())
+	require.True(t, f.BankKeeper.Balances[lender].AmountOf(types.MortgageNoteDenom("m2")).IsZero())
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// originateMortgage funds a mortgage: the lender, or every member of its
+// syndicate, pays the amount to the lendee,
+// the lendee buys the collateral shares if the mortgage finances a purchase
+// every seller accepted at a price close to their value,
+// the collateral gets the mortgage lien, the lendee's collateral shares go into
+// escrow, and the lendee receives the marker token and the lender the loan
+// note. The lien comes first so that the shares may move into escrow behind
+// other liens.
+// The mortgage is stored as approved with the full amount outstanding and its
+// installments falling due from the current block time.
+func (k Keeper) originateMortgage(ctx context.Context, mortgage types.Mortgage) (types.Mortgage, error) {
+	lendee, err := sdk.AccAddressFromBech32(mortgage.Lendee)
+	if err != nil {
+		return mortgage, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+
+	// An equity loan may rank behind other liens as long as it stays within the
+	// maximum loan-to-value
+	if mortgage.Equity {
+		if err := k.assertWithinLoanToValue(ctx, mortgage); err != nil {
+			return mortgage, err
+		}
+	} else if err := k.assertCollateralFree(ctx, mortgage.Collateral); err != nil {
+		return mortgage, err
+	}
+
+	if mortgage.Purchase != nil {
+		if err := k.assertPurchaseAgreed(ctx, mortgage); err != nil {
+			return mortgage, err
+		}
+	}
+
+	if err := k.fundMortgage(ctx, &mortgage, lendee); err != nil {
+		return mortgage, err
+	}
+
+	if purchase := mortgage.Purchase; purchase != nil {
+		if err := k.propertyKeeper.SellShares(sdk.UnwrapSDKContext(ctx), mortgage.Collateral, purchase.FromOwners, purchase.FromShares, mortgage.Lendee, purchase.Price); err != nil {
+			return mortgage, errorsmod.Wrap(err, "failed to buy collateral shares")
+		}
+	}
+	mortgage.OutstandingAmount = mortgage.Amount
+	if err := k.placeCollateralLien(ctx, mortgage); err != nil {
+		return mortgage, err
+	}
+	if err := k.escrowCollateral(ctx, &mortgage); err != nil {
+		return mortgage, err
+	}
+
+	markerDenom := types.MortgageMarkerDenom(mortgage.Index)
+	markerCoin := sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 1))
+	k.SetMarkerDenomMetadata(ctx, mortgage)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, markerCoin); err != nil {
+		return mortgage, errorsmod.Wrap(err, "failed to mint marker token")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lendee, markerCoin); err != nil {
+		return mortgage, errorsmod.Wrap(err, "failed to send marker token to lendee")
+	}
+	if err := k.issueNote(ctx, mortgage); err != nil {
+		return mortgage, err
+	}
+
+	mortgage.Status = types.APPROVED
+	mortgage.StartDate = sdk.UnwrapSDKContext(ctx).BlockTime()
+	mortgage.InterestDue = 0
+	mortgage.PeriodsAccrued = 0
+	mortgage.AmountPaid = 0
+	recordTerms(&mortgage)
+	if err := k.reviewPayments(ctx, &mortgage); err != nil {
+		return mortgage, err
+	}
+
+	k.SetMortgage(ctx, mortgage)
+
+	return mortgage, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMortgageFunded{
+		Index:      mortgage.Index,
+		Lender:     mortgage.Lender,
+		Lendee:     mortgage.Lendee,
+		Collateral: mortgage.Collateral,
+		Amount:     mortgage.Amount,
+	})
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// allocateRepayment splits a repayment into the late fees, interest and
+// principal it settles, in that order.
+func allocateRepayment(mortgage types.Mortgage, amount uint64) (lateFees, interest, principal uint64) {
+	lateFees = min(amount, mortgage.LateFeesDue)
+	interest = min(amount-lateFees, mortgage.InterestDue)
+	return lateFees, interest, amount - lateFees - interest
+}
+
+// prepaidPrincipal returns the principal a repayment pays ahead of the
+// schedule at t: the part of it beyond late fees and the installments due up to
+// the end of the current period.
+func prepaidPrincipal(mortgage types.Mortgage, schedule []types.Installment, amount uint64, t time.Time) uint64 {
+	var scheduled uint64
+	for _, installment := range schedule {
+		scheduled += installment.Payment
+		if installment.DueDate.After(t) {
+			break
+		}
+	}
+	lateFees, _, principal := allocateRepayment(mortgage, amount)
+	regular := scheduled - min(scheduled, mortgage.AmountPaid)
+	paid := amount - lateFees
+	return min(principal, paid-min(paid, regular))
+}
+
+// chargePrepayment checks a prepayment of principal against the prepayment
+// terms of a mortgage, counts it against the allowance of the loan year and
+// returns the penalty due on it.
+func (k Keeper) chargePrepayment(ctx context.Context, mortgage *types.Mortgage, prepaid uint64) (uint64, error) {
+	terms := mortgage.Prepayment
+	if terms == nil || prepaid == 0 {
+		return 0, nil
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	lockInEnd := mortgage.FundedAt().AddDate(0, int(terms.LockInMonths), 0)
+	if now.Before(lockInEnd) {
+		return 0, errorsmod.Wrapf(types.ErrPrepaymentRestricted, "principal cannot be prepaid before %s", lockInEnd.Format(time.RFC3339))
+	}
+
+	if year := mortgage.LoanYear(now); year != mortgage.PrepaymentYear {
+		mortgage.PrepaymentYear = year
+		mortgage.PrepaidThisYear = 0
+	}
+	if terms.MaxYearlyPrepayment > 0 && mortgage.PrepaidThisYear+prepaid > terms.MaxYearlyPrepayment {
+		return 0, errorsmod.Wrapf(types.ErrPrepaymentRestricted, "prepaying %d exceeds the %d left this loan year", prepaid, terms.MaxYearlyPrepayment-min(terms.MaxYearlyPrepayment, mortgage.PrepaidThisYear))
+	}
+	mortgage.PrepaidThisYear += prepaid
+
+	rate, err := math.LegacyNewDecFromStr(terms.PenaltyRate)
+	if err != nil {
+		return 0, err
+	}
+	return rate.MulInt(math.NewIntFromUint64(prepaid)).TruncateInt().Uint64(), nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestRepayMortgagePrepayment(t *testing.T) {
+	// Installments of 100 over two years; 2% penalty, no prepayment in the
+	// first three months and at most 300 prepaid per loan year
+	f := keepertest.NewFundedMortgageFixture(t, 2400, "0", 24, func(msg *types.MsgRequestMortgage) {
+		msg.Prepayment = &types.PrepaymentTerms{PenaltyRate: "0.02", LockInMonths: 3, MaxYearlyPrepayment: 300}
+	})
+	k, ctx, bk, srv, lender, lendee := f.Keeper, f.Ctx, f.BankKeeper, f.Srv, f.Lender, f.Lendee
+	start := keepertest.MortgageStart
+
+	// The installment due and the next one may be paid during the lock-in
+	ctx = ctx.WithBlockTime(start.AddDate(0, 1, 0))
+	res, err := srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 200))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRepayMortgageResponse{Principal: 200}, res)
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 1))
+	require.ErrorIs(t, err, types.ErrPrepaymentRestricted)
+
+	// After the lock-in, principal beyond the current period is charged 2%
+	ctx = ctx.WithBlockTime(start.AddDate(0, 3, 0))
+	res, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 400))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRepayMortgageResponse{Principal: 400, Penalty: 4}, res)
+	require.Equal(t, int64(604), bk.Balances[lender].AmountOf("usdarda").Int64())
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, uint64(1800), mortgage.OutstandingAmount)
+	require.Equal(t, uint64(200), mortgage.PrepaidThisYear)
+
+	// The yearly allowance has 100 left
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 101))
+	require.ErrorIs(t, err, types.ErrPrepaymentRestricted)
+
+	// The allowance renews in the second loan year
+	ctx = ctx.WithBlockTime(start.AddDate(1, 0, 0))
+	res, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 1000))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRepayMortgageResponse{Principal: 1000, Penalty: 6}, res)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, uint32(1), mortgage.PrepaymentYear)
+	require.Equal(t, uint64(300), mortgage.PrepaidThisYear)
+}
+
+func TestRepayMortgageWithoutPrepaymentTerms(t *testing.T) {
+	// Without terms the whole balance may be repaid at once, free of penalty
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+	res, err := f.Srv.RepayMortgage(f.Ctx, types.NewMsgRepayMortgage(f.Lendee, "m1", 1200))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgRepayMortgageResponse{Principal: 1200}, res)
+}
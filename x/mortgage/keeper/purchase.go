@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// assertPurchaseAgreed returns an error unless every seller accepted the
+// purchase a mortgage finances and its price is within the maximum price
+// deviation of the current value of the shares bought.
+func (k Keeper) assertPurchaseAgreed(ctx context.Context, mortgage types.Mortgage) error {
+	purchase := mortgage.Purchase
+	for _, seller := range purchase.FromOwners {
+		if !slices.Contains(purchase.AcceptedBy, seller) {
+			return errorsmod.Wrapf(types.ErrPurchaseNotAccepted, "seller %s has not accepted mortgage request %s", seller, mortgage.Index)
+		}
+	}
+
+	property, found := k.propertyKeeper.GetProperty(sdk.UnwrapSDKContext(ctx), mortgage.Collateral)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCollateralNotOwned, "property %s not found", mortgage.Collateral)
+	}
+	var shares uint64
+	for _, sold := range purchase.FromShares {
+		shares += sold
+	}
+
+	// Shares are percentages of the property
+	value := math.NewIntFromUint64(k.propertyKeeper.CurrentValue(ctx, property)).Mul(math.NewIntFromUint64(shares)).QuoRaw(100)
+	deviation, err := math.LegacyNewDecFromStr(k.GetParams(ctx).MaxPriceDeviation)
+	if err != nil {
+		return err
+	}
+	margin := deviation.MulInt(value).TruncateInt()
+	price := math.NewIntFromUint64(purchase.Price)
+	if price.LT(value.Sub(margin)) || price.GT(value.Add(margin)) {
+		return errorsmod.Wrapf(types.ErrPriceOutOfRange, "price %d is more than %s off the %s the shares are worth", purchase.Price, deviation, value)
+	}
+	return nil
+}
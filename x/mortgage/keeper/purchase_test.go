@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+type purchaseFixture struct {
+	keepertest.MortgageFixture
+	srv                  types.MsgServer
+	lender, buyer        string
+	sellerOne, sellerTwo string
+	purchase             *types.MortgagePurchase
+}
+
+// setupPurchase registers property p1 worth 2000 owned by two sellers and
+// records a request of buyer for a mortgage of 800 financing 50 shares bought
+// for 1000.
+func setupPurchase(t *testing.T, buyerFunds int64) purchaseFixture {
+	f := purchaseFixture{
+		MortgageFixture: keepertest.NewMortgageFixture(t),
+		lender:          sample.AccAddress(),
+		buyer:           sample.AccAddress(),
+		sellerOne:       sample.AccAddress(),
+		sellerTwo:       sample.AccAddress(),
+	}
+	f.srv = keeper.NewMsgServerImpl(f.Keeper)
+	f.PropertyKeeper.SetProperty(f.Ctx, propertytypes.Property{
+		Index:  "p1",
+		Owners: []string{f.sellerOne, f.sellerTwo},
+		Shares: []uint64{60, 40},
+		Value:  2000,
+	})
+	shareDenom := propertytypes.PropertyShareDenom("p1")
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(f.sellerOne), sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 60)))
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(f.sellerTwo), sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 40)))
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(f.lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+	f.BankKeeper.Fund(sdk.MustAccAddressFromBech32(f.buyer), sdk.NewCoins(sdk.NewInt64Coin("usdarda", buyerFunds)))
+
+	f.purchase = &types.MortgagePurchase{
+		FromOwners: []string{f.sellerOne, f.sellerTwo},
+		FromShares: []uint64{30, 20},
+		Price:      1000,
+	}
+	_, err := f.srv.RequestMortgage(f.Ctx, types.NewMsgRequestMortgage(f.buyer, "m1", f.lender, "p1", 800, "0.05", 360, types.MONTHLY, types.ANNUITY, f.purchase))
+	require.NoError(t, err)
+	return f
+}
+
+// accept has both sellers accept the purchase.
+func (f purchaseFixture) accept(t *testing.T) {
+	for _, seller := range []string{f.sellerOne, f.sellerTwo} {
+		_, err := f.srv.AcceptPurchase(f.Ctx, types.NewMsgAcceptPurchase(seller, "m1"))
+		require.NoError(t, err)
+	}
+}
+
+func (f purchaseFixture) usdarda(addr string) int64 {
+	return f.BankKeeper.Balances[addr].AmountOf("usdarda").Int64()
+}
+
+func TestPurchaseWithMortgage(t *testing.T) {
+	f := setupPurchase(t, 300)
+
+	_, err := f.srv.ApproveMortgage(f.Ctx, types.NewMsgApproveMortgage(f.lender, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.buyer, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Every seller has to accept the purchase
+	_, err = f.srv.AcceptPurchase(f.Ctx, types.NewMsgAcceptPurchase(f.buyer, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = f.srv.AcceptPurchase(f.Ctx, types.NewMsgAcceptPurchase(f.sellerOne, "m1"))
+	require.NoError(t, err)
+	_, err = f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.ErrorIs(t, err, types.ErrPurchaseNotAccepted)
+	_, err = f.srv.AcceptPurchase(f.Ctx, types.NewMsgAcceptPurchase(f.sellerTwo, "m1"))
+	require.NoError(t, err)
+
+	_, err = f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.NoError(t, err)
+
+	mortgage, found := f.Keeper.GetMortgage(f.Ctx, "m1")
+	require.True(t, found)
+	require.Equal(t, types.APPROVED, mortgage.Status)
+	require.Equal(t, uint64(800), mortgage.OutstandingAmount)
+	require.Equal(t, uint64(50), mortgage.EscrowedShares)
+	require.Equal(t, []string{f.sellerOne, f.sellerTwo}, mortgage.Purchase.AcceptedBy)
+
+	// The sellers are paid in proportion to their shares and the buyer pays
+	// what the mortgage does not cover
+	require.Equal(t, int64(200), f.usdarda(f.lender))
+	require.Equal(t, int64(600), f.usdarda(f.sellerOne))
+	require.Equal(t, int64(400), f.usdarda(f.sellerTwo))
+	require.Equal(t, int64(100), f.usdarda(f.buyer))
+
+	// The bought shares are in escrow
+	shareDenom := propertytypes.PropertyShareDenom("p1")
+	require.True(t, f.BankKeeper.Balances[f.buyer].AmountOf(shareDenom).IsZero())
+	require.Equal(t, int64(50), f.BankKeeper.Balances[authtypes.NewModuleAddress(types.ModuleName).String()].AmountOf(shareDenom).Int64())
+	require.Equal(t, int64(1), f.BankKeeper.Balances[f.buyer].AmountOf(types.MortgageMarkerDenom("m1")).Int64())
+
+	property, _ := f.PropertyKeeper.GetProperty(f.Ctx, "p1")
+	owners := f.PropertyKeeper.ConvertPropertyOwnersToMap(property)
+	require.Equal(t, map[string]uint64{f.sellerOne: 30, f.sellerTwo: 20, f.buyer: 50}, owners)
+	require.Len(t, property.Transfers, 1)
+
+	// A funded purchase cannot be funded again
+	_, err = f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.ErrorIs(t, err, types.ErrMortgageNotRequested)
+}
+
+func TestPurchaseWithMortgageReverts(t *testing.T) {
+	// The buyer cannot pay the 200 the mortgage does not cover
+	f := setupPurchase(t, 100)
+	f.accept(t)
+
+	_, err := f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.Error(t, err)
+
+	mortgage, _ := f.Keeper.GetMortgage(f.Ctx, "m1")
+	require.Equal(t, types.REQUESTED, mortgage.Status)
+	require.Zero(t, mortgage.EscrowedShares)
+
+	property, _ := f.PropertyKeeper.GetProperty(f.Ctx, "p1")
+	owners := f.PropertyKeeper.ConvertPropertyOwnersToMap(property)
+	require.Equal(t, map[string]uint64{f.sellerOne: 60, f.sellerTwo: 40}, owners)
+	require.Empty(t, property.Transfers)
+}
+
+func TestPurchasePriceOutOfRange(t *testing.T) {
+	f := setupPurchase(t, 300)
+	f.accept(t)
+
+	// The 50 shares are now worth 750: 1000 is more than 20% above that
+	property, _ := f.PropertyKeeper.GetProperty(f.Ctx, "p1")
+	property.Value = 1500
+	f.PropertyKeeper.SetProperty(f.Ctx, property)
+	_, err := f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.ErrorIs(t, err, types.ErrPriceOutOfRange)
+
+	// Worth 900 they may sell for 1000
+	property.Value = 1800
+	f.PropertyKeeper.SetProperty(f.Ctx, property)
+	_, err = f.srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(f.lender, "m1"))
+	require.NoError(t, err)
+}
+
+func TestApproveMortgageWithoutPurchase(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	srv := keeper.NewMsgServerImpl(f.Keeper)
+	lender, lendee := sample.AccAddress(), sample.AccAddress()
+	f.RegisterCollateral("addr 1", lendee, 100)
+
+	_, err := srv.RequestMortgage(f.Ctx, types.NewMsgRequestMortgage(lendee, "m1", lender, "addr 1", 600, "0.05", 360, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+	_, err = srv.PurchaseWithMortgage(f.Ctx, types.NewMsgPurchaseWithMortgage(lender, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMigrate14to15(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	params := k.GetParams(ctx)
+	params.MaxPriceDeviation = ""
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate14to15(ctx))
+	require.Equal(t, types.DefaultMaxPriceDeviation, k.GetParams(ctx).MaxPriceDeviation)
+}
@@ -53,3 +53,34 @@ func (k Keeper) Mortgage(ctx context.Context, req *types.QueryGetMortgageRequest
 
 	return &types.QueryGetMortgageResponse{Mortgage: val}, nil
 }
+
+func (k Keeper) DenomAlias(ctx context.Context, req *types.QueryDenomAliasRequest) (*types.QueryDenomAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetDenomAlias(ctx, req.Alias)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDenomAliasResponse{Denom: val.Denom}, nil
+}
+
+func (k Keeper) AmortizationSchedule(ctx context.Context, req *types.QueryAmortizationScheduleRequest) (*types.QueryAmortizationScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetMortgage(ctx, req.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	schedule, err := val.AmortizationSchedule()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryAmortizationScheduleResponse{Installments: schedule}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k Keeper) MortgagesByLender(ctx context.Context, req *types.QueryMortgagesByLenderRequest) (*types.QueryMortgagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.mortgagesByIndex(ctx, lenderIndexPrefix(req.Lender), req.Pagination)
+}
+
+func (k Keeper) MortgagesByLendee(ctx context.Context, req *types.QueryMortgagesByLendeeRequest) (*types.QueryMortgagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.mortgagesByIndex(ctx, lendeeIndexPrefix(req.Lendee), req.Pagination)
+}
+
+func (k Keeper) MortgagesByCollateral(ctx context.Context, req *types.QueryMortgagesByCollateralRequest) (*types.QueryMortgagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	indexPrefix := append(types.KeyPrefix(types.MortgageCollateralKeyPrefix), types.MortgageIndexPrefix(req.Collateral)...)
+	return k.mortgagesByIndex(ctx, indexPrefix, req.Pagination)
+}
+
+func (k Keeper) MortgagesByStatus(ctx context.Context, req *types.QueryMortgagesByStatusRequest) (*types.QueryMortgagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.mortgagesByIndex(ctx, statusIndexPrefix(req.Status), req.Pagination)
+}
+
+// mortgagesByIndex pages through all mortgages with entries under indexPrefix.
+func (k Keeper) mortgagesByIndex(ctx context.Context, indexPrefix []byte, pagination *query.PageRequest) (*types.QueryMortgagesResponse, error) {
+	mortgages, pageRes, err := k.paginateIndex(ctx, indexPrefix, pagination, func(types.Mortgage) bool { return true })
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMortgagesResponse{Mortgage: mortgages, Pagination: pageRes}, nil
+}
+
+// OutstandingTotals reads the running totals kept up to date as mortgages are
+// funded, repaid and settled.
+func (k Keeper) OutstandingTotals(ctx context.Context, req *types.QueryOutstandingTotalsRequest) (*types.QueryOutstandingTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res := &types.QueryOutstandingTotalsResponse{}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	lenderIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.LenderOutstandingKeyPrefix))
+	defer lenderIterator.Close()
+	for ; lenderIterator.Valid(); lenderIterator.Next() {
+		var total types.LenderOutstanding
+		k.cdc.MustUnmarshal(lenderIterator.Value(), &total)
+		res.Lenders = append(res.Lenders, total)
+	}
+
+	propertyIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefix(types.PropertyOutstandingKeyPrefix))
+	defer propertyIterator.Close()
+	for ; propertyIterator.Valid(); propertyIterator.Next() {
+		var total types.PropertyOutstanding
+		k.cdc.MustUnmarshal(propertyIterator.Value(), &total)
+		res.Properties = append(res.Properties, total)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func indexes(mortgages []types.Mortgage) []string {
+	var list []string
+	for _, mortgage := range mortgages {
+		list = append(list, mortgage.Index)
+	}
+	return list
+}
+
+func TestMortgageIndexQueries(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "bank", Lendee: "alice", Collateral: "p1", Status: types.APPROVED, OutstandingAmount: 500})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m2", Lender: "bank", Lendee: "bob", Collateral: "p2", Status: types.REQUESTED, Amount: 300})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m3", Lender: "other", Lendee: "alice", Collateral: "p1", Status: types.DELINQUENT, OutstandingAmount: 200, Amount: 300, Syndicate: []types.SyndicateLender{
+		{Lender: "other", Contribution: 200},
+		{Lender: "bank", Contribution: 100},
+	}})
+	// A lender whose address extends another's does not see its mortgages
+	k.SetMortgage(ctx, types.Mortgage{Index: "m4", Lender: "bankx", Lendee: "carol", Collateral: "p1 annex", Status: types.PAID})
+
+	byLender, err := k.MortgagesByLender(ctx, &types.QueryMortgagesByLenderRequest{Lender: "bank"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1", "m2", "m3"}, indexes(byLender.Mortgage))
+
+	byLendee, err := k.MortgagesByLendee(ctx, &types.QueryMortgagesByLendeeRequest{Lendee: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1", "m3"}, indexes(byLendee.Mortgage))
+
+	byCollateral, err := k.MortgagesByCollateral(ctx, &types.QueryMortgagesByCollateralRequest{Collateral: "p1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1", "m3"}, indexes(byCollateral.Mortgage))
+
+	byStatus, err := k.MortgagesByStatus(ctx, &types.QueryMortgagesByStatusRequest{Status: types.REQUESTED})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m2"}, indexes(byStatus.Mortgage))
+
+	// Updates move a mortgage between index entries
+	m2, _ := k.GetMortgage(ctx, "m2")
+	m2.Status = types.CANCELLED
+	k.SetMortgage(ctx, m2)
+	byStatus, err = k.MortgagesByStatus(ctx, &types.QueryMortgagesByStatusRequest{Status: types.REQUESTED})
+	require.NoError(t, err)
+	require.Empty(t, byStatus.Mortgage)
+	byStatus, err = k.MortgagesByStatus(ctx, &types.QueryMortgagesByStatusRequest{Status: types.CANCELLED})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m2"}, indexes(byStatus.Mortgage))
+
+	k.RemoveMortgage(ctx, "m1")
+	byLendee, err = k.MortgagesByLendee(ctx, &types.QueryMortgagesByLendeeRequest{Lendee: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m3"}, indexes(byLendee.Mortgage))
+
+	// Pages follow the index
+	page, err := k.MortgagesByLender(ctx, &types.QueryMortgagesByLenderRequest{Lender: "bank", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m2"}, indexes(page.Mortgage))
+	require.Equal(t, uint64(2), page.Pagination.Total)
+	page, err = k.MortgagesByLender(ctx, &types.QueryMortgagesByLenderRequest{Lender: "bank", Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m3"}, indexes(page.Mortgage))
+}
+
+func TestOutstandingTotals(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "bank", Collateral: "p1", Status: types.APPROVED, OutstandingAmount: 500})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m2", Lender: "bank", Collateral: "p2", Status: types.REQUESTED, Amount: 300})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m3", Lender: "other", Collateral: "p1", Status: types.DEFAULTED, OutstandingAmount: 200, Amount: 300, Syndicate: []types.SyndicateLender{
+		{Lender: "other", Contribution: 200},
+		{Lender: "bank", Contribution: 100},
+	}})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m4", Lender: "bank", Collateral: "p2", Status: types.PAID})
+
+	res, err := k.OutstandingTotals(ctx, &types.QueryOutstandingTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.LenderOutstanding{
+		{Lender: "bank", Outstanding: 567, Mortgages: 2},
+		{Lender: "other", Outstanding: 133, Mortgages: 1},
+	}, res.Lenders)
+	require.Equal(t, []types.PropertyOutstanding{
+		{Collateral: "p1", Outstanding: 700, Mortgages: 2},
+	}, res.Properties)
+
+	// Repaying and settling mortgages updates the totals
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "bank", Collateral: "p1", Status: types.APPROVED, OutstandingAmount: 100})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m3", Lender: "other", Collateral: "p1", Status: types.FORECLOSED, Amount: 300})
+	res, err = k.OutstandingTotals(ctx, &types.QueryOutstandingTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.LenderOutstanding{{Lender: "bank", Outstanding: 100, Mortgages: 1}}, res.Lenders)
+	require.Equal(t, []types.PropertyOutstanding{{Collateral: "p1", Outstanding: 100, Mortgages: 1}}, res.Properties)
+
+	k.RemoveMortgage(ctx, "m1")
+	res, err = k.OutstandingTotals(ctx, &types.QueryOutstandingTotalsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Lenders)
+	require.Empty(t, res.Properties)
+}
+
+func TestMigrate15to16(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "bank", Collateral: "p1", Status: types.APPROVED, OutstandingAmount: 500})
+	k.SetMortgage(ctx, types.Mortgage{Index: "m2", Lender: "bank", Collateral: "p2", Status: types.DELINQUENT, OutstandingAmount: 300})
+
+	// Rebuilding the totals does not count the mortgages twice
+	require.NoError(t, keeper.NewMigrator(k).Migrate15to16(ctx))
+	res, err := k.OutstandingTotals(ctx, &types.QueryOutstandingTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.LenderOutstanding{{Lender: "bank", Outstanding: 800, Mortgages: 2}}, res.Lenders)
+	require.Equal(t, []types.PropertyOutstanding{
+		{Collateral: "p1", Outstanding: 500, Mortgages: 1},
+		{Collateral: "p2", Outstanding: 300, Mortgages: 1},
+	}, res.Properties)
+}
+
+func TestMigrate9to10(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "bank", Lendee: "alice", Collateral: "p1", Status: types.APPROVED})
+
+	// Rebuilding the indexes of indexed mortgages leaves them as they were
+	require.NoError(t, keeper.NewMigrator(k).Migrate9to10(ctx))
+	res, err := k.MortgagesByCollateral(ctx, &types.QueryMortgagesByCollateralRequest{Collateral: "p1"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1"}, indexes(res.Mortgage))
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// MortgagesByHolder lists the funded mortgages holder lends to, as holder of the
+// loan note or as a member of the syndicate.
+func (k Keeper) MortgagesByHolder(ctx context.Context, req *types.QueryMortgagesByHolderRequest) (*types.QueryMortgagesByHolderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	mortgages, pageRes, err := k.paginateIndex(ctx, lenderIndexPrefix(req.Holder), req.Pagination, types.Mortgage.IsFunded)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMortgagesByHolderResponse{Mortgage: mortgages, Pagination: pageRes}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k Keeper) MortgageRequestsByLender(ctx context.Context, req *types.QueryMortgageRequestsByLenderRequest) (*types.QueryMortgageRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.mortgageRequests(ctx, lenderIndexPrefix(req.Lender), req.Pagination)
+}
+
+func (k Keeper) MortgageRequestsByLendee(ctx context.Context, req *types.QueryMortgageRequestsByLendeeRequest) (*types.QueryMortgageRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return k.mortgageRequests(ctx, lendeeIndexPrefix(req.Lendee), req.Pagination)
+}
+
+// mortgageRequests pages through the pending mortgage requests with entries
+// under indexPrefix.
+func (k Keeper) mortgageRequests(ctx context.Context, indexPrefix []byte, pagination *query.PageRequest) (*types.QueryMortgageRequestsResponse, error) {
+	mortgages, pageRes, err := k.paginateIndex(ctx, indexPrefix, pagination, func(mortgage types.Mortgage) bool {
+		return mortgage.Status == types.REQUESTED
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMortgageRequestsResponse{Mortgage: mortgages, Pagination: pageRes}, nil
+}
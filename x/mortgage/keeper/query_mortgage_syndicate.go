@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func (k Keeper) MortgageSyndicate(ctx context.Context, req *types.QueryMortgageSyndicateRequest) (*types.QueryMortgageSyndicateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	mortgage, found := k.GetMortgage(ctx, req.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMortgageSyndicateResponse{Lenders: lenderPositions(mortgage)}, nil
+}
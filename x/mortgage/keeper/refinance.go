@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// recordTerms appends the current terms of a mortgage to its terms history as
+// the next version.
+func recordTerms(mortgage *types.Mortgage) {
+	terms := mortgage.CurrentTerms()
+	terms.Version = uint32(len(mortgage.TermsHistory)) + 1
+	mortgage.TermsHistory = append(mortgage.TermsHistory, terms)
+}
+
+// proposeRefinance records new terms offered for an active mortgage, replacing
+// any proposal still pending.
+func (k Keeper) proposeRefinance(ctx context.Context, mortgage *types.Mortgage, terms types.MortgageTerms) error {
+	if !mortgage.IsActive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s is not active", mortgage.Index)
+	}
+
+	terms.Version = uint32(len(mortgage.TermsHistory)) + 1
+	mortgage.RefinanceProposal = &terms
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRefinanceProposed{
+		Index:   mortgage.Index,
+		Lender:  mortgage.Lender,
+		Version: terms.Version,
+	})
+}
+
+// acceptRefinance puts a mortgage on the proposed terms. Interest accrued under
+// the old terms is added to the balance and the new schedule amortizes that
+// balance from the current block time. Late fees due stay due.
+func (k Keeper) acceptRefinance(ctx context.Context, mortgage *types.Mortgage, terms types.MortgageTerms) error {
+	proposal := mortgage.RefinanceProposal
+	if proposal == nil {
+		return errorsmod.Wrapf(types.ErrNoRefinanceProposal, "mortgage %s", mortgage.Index)
+	}
+	if !mortgage.IsActive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s is not active", mortgage.Index)
+	}
+	if terms.InterestRate != proposal.InterestRate || terms.TermMonths != proposal.TermMonths ||
+		terms.PaymentFrequency != proposal.PaymentFrequency || terms.Amortization != proposal.Amortization {
+		return errorsmod.Wrapf(types.ErrRefinanceMismatch, "mortgage %s", mortgage.Index)
+	}
+
+	if err := k.accrueInterest(ctx, mortgage); err != nil {
+		return err
+	}
+	mortgage.OutstandingAmount += mortgage.InterestDue
+	mortgage.InterestDue = 0
+
+	mortgage.InterestRate = proposal.InterestRate
+	mortgage.TermMonths = proposal.TermMonths
+	mortgage.PaymentFrequency = proposal.PaymentFrequency
+	mortgage.Amortization = proposal.Amortization
+	mortgage.Principal = mortgage.OutstandingAmount
+	mortgage.StartDate = sdk.UnwrapSDKContext(ctx).BlockTime()
+	mortgage.PeriodsAccrued = 0
+	mortgage.AmountPaid = 0
+	mortgage.RefinanceProposal = nil
+	recordTerms(mortgage)
+	if err := k.reviewPayments(ctx, mortgage); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRefinanceAccepted{
+		Index:   mortgage.Index,
+		Lendee:  mortgage.Lendee,
+		Version: uint32(len(mortgage.TermsHistory)),
+	})
+}
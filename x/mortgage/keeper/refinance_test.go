@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestRefinance(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0.12", 12)
+	k, ctx, srv, lender, lendee, mortgage := f.Keeper, f.Ctx, f.Srv, f.Lender, f.Lendee, f.Mortgage
+	start := keepertest.MortgageStart
+	require.Len(t, mortgage.TermsHistory, 1)
+	require.Equal(t, uint32(1), mortgage.TermsHistory[0].Version)
+	require.Equal(t, uint64(1200), mortgage.TermsHistory[0].Principal)
+
+	_, err := srv.ProposeRefinance(ctx, types.NewMsgProposeRefinance(lendee, "m1", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AcceptRefinance(ctx, types.NewMsgAcceptRefinance(lendee, "m1", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrNoRefinanceProposal)
+
+	// A proposal changes nothing until the lendee accepts it
+	ctx = ctx.WithBlockTime(start.AddDate(0, 1, 1))
+	_, err = srv.ProposeRefinance(ctx, types.NewMsgProposeRefinance(lender, "m1", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, "0.12", mortgage.InterestRate)
+	require.Equal(t, uint32(2), mortgage.RefinanceProposal.Version)
+
+	_, err = srv.AcceptRefinance(ctx, types.NewMsgAcceptRefinance(lendee, "m1", "0.06", 36, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, types.ErrRefinanceMismatch)
+	_, err = srv.AcceptRefinance(ctx, types.NewMsgAcceptRefinance(lender, "m1", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The unpaid interest of the first installment is added to the balance
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = srv.AcceptRefinance(ctx, types.NewMsgAcceptRefinance(lendee, "m1", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	require.True(t, hasEvent(ctx, &types.EventRefinanceAccepted{}))
+
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Nil(t, mortgage.RefinanceProposal)
+	require.Equal(t, types.APPROVED, mortgage.Status)
+	require.Equal(t, "0.06", mortgage.InterestRate)
+	require.Equal(t, uint32(24), mortgage.TermMonths)
+	require.Equal(t, uint64(1200), mortgage.Amount)
+	require.Equal(t, uint64(1212), mortgage.OutstandingAmount)
+	require.Equal(t, uint64(1212), mortgage.Principal)
+	require.Zero(t, mortgage.InterestDue)
+	require.Zero(t, mortgage.AmountPaid)
+	require.Equal(t, start.AddDate(0, 1, 1), mortgage.StartDate)
+	require.Equal(t, start.AddDate(0, 2, 1), mortgage.NextDueDate)
+
+	require.Len(t, mortgage.TermsHistory, 2)
+	require.Equal(t, "0.12", mortgage.TermsHistory[0].InterestRate)
+	require.Equal(t, types.MortgageTerms{
+		Version:          2,
+		InterestRate:     "0.06",
+		TermMonths:       24,
+		PaymentFrequency: types.MONTHLY,
+		Amortization:     types.ANNUITY,
+		Principal:        1212,
+		StartDate:        start.AddDate(0, 1, 1),
+	}, mortgage.TermsHistory[1])
+
+	schedule, err := mortgage.AmortizationSchedule()
+	require.NoError(t, err)
+	require.Len(t, schedule, 24)
+	require.Zero(t, schedule[23].Balance)
+
+	// The lendee may turn a proposal down
+	_, err = srv.ProposeRefinance(ctx, types.NewMsgProposeRefinance(lender, "m1", "0.24", 12, types.MONTHLY, types.ANNUITY))
+	require.NoError(t, err)
+	_, err = srv.RejectRefinance(ctx, types.NewMsgRejectRefinance(lender, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RejectRefinance(ctx, types.NewMsgRejectRefinance(lendee, "m1"))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Nil(t, mortgage.RefinanceProposal)
+	require.Len(t, mortgage.TermsHistory, 2)
+	_, err = srv.RejectRefinance(ctx, types.NewMsgRejectRefinance(lendee, "m1"))
+	require.ErrorIs(t, err, types.ErrNoRefinanceProposal)
+
+	// Requests are not refinanced
+	_, err = srv.RequestMortgage(ctx, types.NewMsgRequestMortgage(lendee, "m2", lender, "addr 2", 100, "0.05", 12, types.MONTHLY, types.ANNUITY, nil))
+	require.NoError(t, err)
+	_, err = srv.ProposeRefinance(ctx, types.NewMsgProposeRefinance(lender, "m2", "0.06", 24, types.MONTHLY, types.ANNUITY))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	start := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	k.SetMortgage(ctx, types.Mortgage{Index: "funded", Status: types.DELINQUENT, Amount: 1200, InterestRate: "0.05", TermMonths: 12, StartDate: start})
+	k.SetMortgage(ctx, types.Mortgage{Index: "requested", Status: types.REQUESTED, Amount: 1200, InterestRate: "0.05", TermMonths: 12})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate6to7(ctx))
+
+	funded, _ := k.GetMortgage(ctx, "funded")
+	require.Equal(t, []types.MortgageTerms{{Version: 1, InterestRate: "0.05", TermMonths: 12, Principal: 1200, StartDate: start}}, funded.TermsHistory)
+	requested, _ := k.GetMortgage(ctx, "requested")
+	require.Empty(t, requested.TermsHistory)
+}
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// queueReview schedules the next late payment check of a mortgage, replacing
+// any check already scheduled.
+func (k Keeper) queueReview(ctx context.Context, mortgage *types.Mortgage, reviewTime time.Time) {
+	k.dequeueReview(ctx, mortgage)
+	mortgage.ReviewTime = reviewTime
+	k.SetMortgageReview(ctx, *mortgage)
+}
+
+// SetMortgageReview adds a mortgage to the review queue at its review time.
+func (k Keeper) SetMortgageReview(ctx context.Context, mortgage types.Mortgage) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageReviewKeyPrefix))
+	store.Set(types.MortgageReviewKey(mortgage.ReviewTime, mortgage.Index), []byte{})
+}
+
+// dequeueReview cancels the scheduled late payment check of a mortgage.
+func (k Keeper) dequeueReview(ctx context.Context, mortgage *types.Mortgage) {
+	if mortgage.ReviewTime.IsZero() {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MortgageReviewKeyPrefix))
+	store.Delete(types.MortgageReviewKey(mortgage.ReviewTime, mortgage.Index))
+	mortgage.ReviewTime = time.Time{}
+}
+
+// popDueReviews removes the checks scheduled at or before t from the queue and
+// returns the indexes of their mortgages, in the order they are due.
+func (k Keeper) popDueReviews(ctx context.Context, t time.Time) []string {
+	return k.popDue(ctx, types.MortgageReviewKeyPrefix, t)
+}
+
+// popDue removes the entries scheduled at or before t from the time-ordered
+// queue under keyPrefix and returns their mortgage indexes, in the order they
+// are due.
+func (k Keeper) popDue(ctx context.Context, keyPrefix string, t time.Time) (indexes []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+
+	var keys [][]byte
+	timeLen := len(sdk.FormatTimeBytes(t))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+		indexes = append(indexes, string(key[timeLen:]))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return
+}
+
+// reviewPayments finds the oldest installment the payments so far do not cover
+// and moves the mortgage between APPROVED, DELINQUENT and DEFAULTED depending on
+// how long it is overdue. It schedules the next check of the mortgage, if any.
+// Mortgages without a structured term are not tracked.
+func (k Keeper) reviewPayments(ctx context.Context, mortgage *types.Mortgage) error {
+	schedule, err := mortgage.AmortizationSchedule()
+	if err != nil {
+		k.dequeueReview(ctx, mortgage)
+		return nil
+	}
+
+	next, owed := schedule[len(schedule)-1], uint64(0)
+	for _, installment := range schedule {
+		owed += installment.Payment
+		if owed > mortgage.AmountPaid {
+			next = installment
+			break
+		}
+	}
+	overdue := owed - min(owed, mortgage.AmountPaid)
+	if overdue == 0 {
+		// Every installment is paid but rounding or late interest is left over
+		overdue = mortgage.OutstandingAmount + mortgage.InterestDue
+	}
+	mortgage.NextDueDate = next.DueDate
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	now := sdkCtx.BlockTime()
+	params := k.GetParams(ctx)
+
+	switch {
+	case now.Before(next.DueDate.Add(params.GracePeriod)):
+		if mortgage.Status == types.DELINQUENT {
+			mortgage.Status = types.APPROVED
+			mortgage.LateFeeCharged = false
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMortgageCured{
+				Index:  mortgage.Index,
+				Lender: mortgage.Lender,
+				Lendee: mortgage.Lendee,
+			}); err != nil {
+				return err
+			}
+		}
+		k.queueReview(ctx, mortgage, next.DueDate.Add(params.GracePeriod))
+
+	case now.Before(next.DueDate.Add(params.DefaultPeriod)):
+		// A failed automatic repayment makes the mortgage delinquent before
+		// the grace period ends; the late fee is charged once it ended
+		if !mortgage.LateFeeCharged {
+			lateFeeRate, err := math.LegacyNewDecFromStr(params.LateFeeRate)
+			if err != nil {
+				return err
+			}
+			lateFee := lateFeeRate.MulInt(math.NewIntFromUint64(overdue)).TruncateInt().Uint64()
+			mortgage.LateFeesDue += lateFee
+			mortgage.LateFeeCharged = true
+			mortgage.Status = types.DELINQUENT
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMortgageDelinquent{
+				Index:   mortgage.Index,
+				Lender:  mortgage.Lender,
+				Lendee:  mortgage.Lendee,
+				DueDate: next.DueDate,
+				Overdue: overdue,
+				LateFee: lateFee,
+			}); err != nil {
+				return err
+			}
+		}
+		k.queueReview(ctx, mortgage, next.DueDate.Add(params.DefaultPeriod))
+
+	default:
+		mortgage.Status = types.DEFAULTED
+		k.dequeueReview(ctx, mortgage)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMortgageDefaulted{
+			Index:   mortgage.Index,
+			Lender:  mortgage.Lender,
+			Lendee:  mortgage.Lendee,
+			DueDate: next.DueDate,
+			Overdue: overdue,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// retryDelay is how long the EndBlocker waits before it retries an automatic
+// repayment or a foreclosure settlement that failed.
+const retryDelay = time.Hour
+
+// EndBlocker pulls the automatic repayments that fell due, reviews the
+// mortgages whose next late payment check is due and settles the foreclosure
+// auctions that closed. A repayment or settlement that fails is rolled back,
+// reported and retried later rather than halting the chain.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, index := range k.popDue(ctx, types.MortgageAutoRepaymentKeyPrefix, sdkCtx.BlockTime()) {
+		mortgage, found := k.GetMortgage(ctx, index)
+		if !found || mortgage.AutoRepayment == nil {
+			continue
+		}
+		if err := k.attempt(sdkCtx, func(ctx sdk.Context) error {
+			if err := k.pullRepayment(ctx, &mortgage); err != nil {
+				return err
+			}
+			k.SetMortgage(ctx, mortgage)
+			return nil
+		}); err != nil {
+			if err := k.retryAutoRepayment(sdkCtx, index, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, index := range k.popDueReviews(ctx, sdkCtx.BlockTime()) {
+		mortgage, found := k.GetMortgage(ctx, index)
+		if !found {
+			continue
+		}
+		// The queue entry is already gone
+		mortgage.ReviewTime = time.Time{}
+
+		if mortgage.Status == types.FORECLOSING {
+			if err := k.attempt(sdkCtx, func(ctx sdk.Context) error {
+				if err := k.settleForeclosure(ctx, &mortgage); err != nil {
+					return err
+				}
+				k.SetMortgage(ctx, mortgage)
+				return nil
+			}); err != nil {
+				if err := k.retryForeclosure(sdkCtx, index, err); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !mortgage.IsActive() {
+			continue
+		}
+
+		if err := k.accrueInterest(ctx, &mortgage); err != nil {
+			return err
+		}
+		if err := k.reviewPayments(ctx, &mortgage); err != nil {
+			return err
+		}
+		k.SetMortgage(ctx, mortgage)
+
+		if mortgage.Status != types.APPROVED {
+			k.Logger().Info("mortgage payment overdue", "index", mortgage.Index, "status", mortgage.Status.String(), "due", mortgage.NextDueDate)
+		}
+	}
+
+	return nil
+}
+
+// attempt runs fn against a cached copy of the state and keeps its changes and
+// events only if it succeeds.
+func (k Keeper) attempt(ctx sdk.Context, fn func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// retryAutoRepayment records that the automatic repayment of a mortgage failed
+// and schedules another pull after the retry delay.
+func (k Keeper) retryAutoRepayment(ctx sdk.Context, index string, cause error) error {
+	mortgage, found := k.GetMortgage(ctx, index)
+	if !found || mortgage.AutoRepayment == nil {
+		return nil
+	}
+	k.Logger().Error("automatic repayment failed", "index", index, "error", cause)
+
+	auto := mortgage.AutoRepayment
+	auto.LastAttempt = ctx.BlockTime()
+	auto.Failures++
+	auto.LastError = cause.Error()
+	k.queueAutoRepayment(ctx, &mortgage, ctx.BlockTime().Add(retryDelay))
+	k.SetMortgage(ctx, mortgage)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAutoRepaymentFailed{
+		Index:  mortgage.Index,
+		Lendee: mortgage.Lendee,
+		Amount: auto.LastAmount,
+		Error:  auto.LastError,
+	})
+}
+
+// retryForeclosure records that the foreclosure of a mortgage could not be
+// settled and schedules another review after the retry delay.
+func (k Keeper) retryForeclosure(ctx sdk.Context, index string, cause error) error {
+	mortgage, found := k.GetMortgage(ctx, index)
+	if !found {
+		return nil
+	}
+	k.Logger().Error("foreclosure settlement failed", "index", index, "error", cause)
+
+	// The queue entry is already gone
+	mortgage.ReviewTime = time.Time{}
+	retryTime := ctx.BlockTime().Add(retryDelay)
+	k.queueReview(ctx, &mortgage, retryTime)
+	k.SetMortgage(ctx, mortgage)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForeclosureSettlementFailed{
+		Index:     mortgage.Index,
+		Error:     cause.Error(),
+		RetryTime: retryTime,
+	})
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func hasEvent(ctx sdk.Context, typed proto.Message) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == events.Type(typed) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMortgageDelinquencyAndDefault(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+	k, ctx, srv, lendee, mortgage := f.Keeper, f.Ctx, f.Srv, f.Lendee, f.Mortgage
+	start, params := keepertest.MortgageStart, k.GetParams(ctx)
+
+	firstDue := start.AddDate(0, 1, 0)
+	require.Equal(t, firstDue, mortgage.NextDueDate)
+	require.Equal(t, firstDue.Add(params.GracePeriod), mortgage.ReviewTime)
+
+	// Nothing happens within the grace period
+	ctx = ctx.WithBlockTime(firstDue.Add(params.GracePeriod - time.Second))
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.APPROVED, mortgage.Status)
+
+	// The first installment of 100 is late: 5% late fee
+	ctx = ctx.WithBlockTime(firstDue.Add(params.GracePeriod)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.DELINQUENT, mortgage.Status)
+	require.Equal(t, uint64(5), mortgage.LateFeesDue)
+	require.Equal(t, firstDue.Add(params.DefaultPeriod), mortgage.ReviewTime)
+	require.True(t, hasEvent(ctx, &types.EventMortgageDelinquent{}))
+
+	// Paying the late fee and the installment cures the mortgage
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 105))
+	require.NoError(t, err)
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.APPROVED, mortgage.Status)
+	require.Zero(t, mortgage.LateFeesDue)
+	require.Equal(t, uint64(100), mortgage.AmountPaid)
+	require.Equal(t, uint64(1100), mortgage.OutstandingAmount)
+	require.Equal(t, start.AddDate(0, 2, 0), mortgage.NextDueDate)
+	require.True(t, hasEvent(ctx, &types.EventMortgageCured{}))
+
+	// Missing the second installment past the default period defaults the loan,
+	// and blocks only process what is due
+	secondDue := start.AddDate(0, 2, 0)
+	ctx = ctx.WithBlockTime(secondDue.Add(params.GracePeriod))
+	require.NoError(t, k.EndBlocker(ctx))
+	ctx = ctx.WithBlockTime(secondDue.Add(params.DefaultPeriod)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.DEFAULTED, mortgage.Status)
+	require.True(t, mortgage.ReviewTime.IsZero())
+	require.True(t, hasEvent(ctx, &types.EventMortgageDefaulted{}))
+
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 100))
+	require.Error(t, err)
+
+	// A defaulted mortgage is no longer reviewed
+	ctx = ctx.WithBlockTime(secondDue.AddDate(1, 0, 0)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestMortgagePaidLeavesReviewQueue(t *testing.T) {
+	f := keepertest.NewFundedMortgageFixture(t, 1200, "0", 12)
+	k, ctx := f.Keeper, f.Ctx
+	_, err := f.Srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(f.Lendee, "m1", 1200))
+	require.NoError(t, err)
+
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.PAID, mortgage.Status)
+	require.True(t, mortgage.ReviewTime.IsZero())
+
+	ctx = ctx.WithBlockTime(keepertest.MortgageStart.AddDate(2, 0, 0)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.EndBlocker(ctx))
+	mortgage, _ = k.GetMortgage(ctx, "m1")
+	require.Equal(t, types.PAID, mortgage.Status)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	start := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start.AddDate(0, 1, 0))
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+
+	k.SetMortgage(ctx, types.Mortgage{Index: "active", Status: types.APPROVED, Amount: 1200, OutstandingAmount: 1000, InterestRate: "0", TermMonths: 12, StartDate: start})
+	k.SetMortgage(ctx, types.Mortgage{Index: "untracked", Status: types.APPROVED, Amount: 1200, OutstandingAmount: 1200})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate4to5(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// 200 repaid covers the first two installments
+	active, _ := k.GetMortgage(ctx, "active")
+	require.Equal(t, uint64(200), active.AmountPaid)
+	require.Equal(t, start.AddDate(0, 3, 0), active.NextDueDate)
+	require.Equal(t, start.AddDate(0, 3, 0).Add(types.DefaultGracePeriod), active.ReviewTime)
+
+	untracked, _ := k.GetMortgage(ctx, "untracked")
+	require.True(t, untracked.ReviewTime.IsZero())
+}
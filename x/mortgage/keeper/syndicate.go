@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+// fundMortgage pays the amount of a mortgage to the lendee: from the lender
+// alone, or from every member of its syndicate. A syndicate only funds the
+// mortgage once each member other than the lender committed to it; approving
+// the request commits the lender.
+func (k Keeper) fundMortgage(ctx context.Context, mortgage *types.Mortgage, lendee sdk.AccAddress) error {
+	if !mortgage.IsSyndicated() {
+		lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, lender, lendee, usdardaCoins(mortgage.Amount)); err != nil {
+			return errorsmod.Wrap(err, "failed to send funds from lender to lendee")
+		}
+		return nil
+	}
+
+	for i := range mortgage.Syndicate {
+		member := &mortgage.Syndicate[i]
+		if member.Lender == mortgage.Lender {
+			member.Committed = true
+		}
+		if !member.Committed {
+			return errorsmod.Wrapf(types.ErrSyndicateIncomplete, "lender %s has not committed", member.Lender)
+		}
+	}
+	for _, member := range mortgage.Syndicate {
+		lender, err := sdk.AccAddressFromBech32(member.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, lender, lendee, usdardaCoins(member.Contribution)); err != nil {
+			return errorsmod.Wrapf(err, "failed to send funds from lender %s to lendee", member.Lender)
+		}
+	}
+	return nil
+}
+
+// payLenders pays amount to the lender of a mortgage, or splits it among the
+// members of its syndicate in proportion to their contributions.
+func (k Keeper) payLenders(ctx context.Context, mortgage *types.Mortgage, amount uint64, pay func(lender sdk.AccAddress, amount uint64) error) error {
+	if !mortgage.IsSyndicated() {
+		lender, err := sdk.AccAddressFromBech32(mortgage.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		return pay(lender, amount)
+	}
+
+	for i, share := range mortgage.SyndicateShares(amount) {
+		member := &mortgage.Syndicate[i]
+		if share == 0 {
+			continue
+		}
+		lender, err := sdk.AccAddressFromBech32(member.Lender)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+		}
+		if err := pay(lender, share); err != nil {
+			return err
+		}
+		member.Received += share
+	}
+	return nil
+}
+
+// lenderPositions returns what each lender of a mortgage contributed and its
+// share of the principal still owed.
+func lenderPositions(mortgage types.Mortgage) []types.LenderPosition {
+	if !mortgage.IsSyndicated() {
+		return []types.LenderPosition{{
+			Lender:       mortgage.Lender,
+			Contribution: mortgage.Amount,
+			Outstanding:  mortgage.OutstandingAmount,
+		}}
+	}
+
+	outstanding := mortgage.SyndicateShares(mortgage.OutstandingAmount)
+	positions := make([]types.LenderPosition, len(mortgage.Syndicate))
+	for i, member := range mortgage.Syndicate {
+		positions[i] = types.LenderPosition{
+			Lender:       member.Lender,
+			Contribution: member.Contribution,
+			Outstanding:  outstanding[i],
+			Received:     member.Received,
+		}
+	}
+	return positions
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+)
+
+func TestSyndicatedMortgage(t *testing.T) {
+	f := keepertest.NewMortgageFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	lead, second, third, lendee := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	f.RegisterCollateral("addr 1", lendee, 100)
+	for _, lender := range []string{lead, second, third} {
+		bk.Fund(sdk.MustAccAddressFromBech32(lender), sdk.NewCoins(sdk.NewInt64Coin("usdarda", 1000)))
+	}
+	usdarda := func(addr string) int64 { return bk.Balances[addr].AmountOf("usdarda").Int64() }
+
+	msg := types.NewMsgRequestMortgage(lendee, "m1", lead, "addr 1", 1000, "0", 10, types.MONTHLY, types.ANNUITY, nil)
+	msg.Syndicate = []types.SyndicateLender{
+		{Lender: lead, Contribution: 600},
+		{Lender: second, Contribution: 300},
+		{Lender: third, Contribution: 100},
+	}
+	_, err := srv.RequestMortgage(ctx, msg)
+	require.NoError(t, err)
+
+	// Every other member commits before the lead lender can fund the mortgage
+	_, err = srv.JoinSyndicate(ctx, types.NewMsgJoinSyndicate(lendee, "m1"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.JoinSyndicate(ctx, types.NewMsgJoinSyndicate(second, "m1"))
+	require.NoError(t, err)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lead, "m1"))
+	require.ErrorIs(t, err, types.ErrSyndicateIncomplete)
+	require.Equal(t, int64(1000), usdarda(lead))
+
+	_, err = srv.JoinSyndicate(ctx, types.NewMsgJoinSyndicate(third, "m1"))
+	require.NoError(t, err)
+	_, err = srv.ApproveMortgage(ctx, types.NewMsgApproveMortgage(lead, "m1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), usdarda(lendee))
+	require.Equal(t, int64(400), usdarda(lead))
+	require.Equal(t, int64(700), usdarda(second))
+	require.Equal(t, int64(900), usdarda(third))
+
+	// Repayments are split pro rata; the last member gets the rounding remainder
+	_, err = srv.RepayMortgage(ctx, types.NewMsgRepayMortgage(lendee, "m1", 101))
+	require.NoError(t, err)
+	require.Equal(t, int64(460), usdarda(lead))
+	require.Equal(t, int64(730), usdarda(second))
+	require.Equal(t, int64(911), usdarda(third))
+
+	res, err := k.MortgageSyndicate(ctx, &types.QueryMortgageSyndicateRequest{Index: "m1"})
+	require.NoError(t, err)
+	require.Equal(t, []types.LenderPosition{
+		{Lender: lead, Contribution: 600, Outstanding: 539, Received: 60},
+		{Lender: second, Contribution: 300, Outstanding: 270, Received: 30},
+		{Lender: third, Contribution: 100, Outstanding: 90, Received: 11},
+	}, res.Lenders)
+
+	held, err := k.MortgagesByHolder(ctx, &types.QueryMortgagesByHolderRequest{Holder: third})
+	require.NoError(t, err)
+	require.Len(t, held.Mortgage, 1)
+
+	// The buyer of the lead's note takes over its part of the syndicate
+	buyer := sample.AccAddress()
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(lead, "m1", second))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.TransferLoanNote(ctx, types.NewMsgTransferLoanNote(lead, "m1", buyer))
+	require.NoError(t, err)
+	mortgage, _ := k.GetMortgage(ctx, "m1")
+	require.Equal(t, buyer, mortgage.Syndicate[0].Lender)
+
+	// Without an auction the members divide the collateral
+	mortgage.Status = types.DEFAULTED
+	k.SetMortgage(ctx, mortgage)
+	_, err = srv.Foreclose(ctx, types.NewMsgForeclose(buyer, "m1", false))
+	require.NoError(t, err)
+
+	property, _ := f.PropertyKeeper.GetProperty(ctx, "addr 1")
+	owners := f.PropertyKeeper.ConvertPropertyOwnersToMap(property)
+	require.Equal(t, uint64(60), owners[buyer])
+	require.Equal(t, uint64(30), owners[second])
+	require.Equal(t, uint64(10), owners[third])
+	require.Zero(t, owners[lendee])
+}
+
+func TestQueryMortgageSyndicateSingleLender(t *testing.T) {
+	k, ctx := keepertest.MortgageKeeper(t)
+	k.SetMortgage(ctx, types.Mortgage{Index: "m1", Lender: "lender", Amount: 100, OutstandingAmount: 40, Status: types.APPROVED})
+
+	res, err := k.MortgageSyndicate(ctx, &types.QueryMortgageSyndicateRequest{Index: "m1"})
+	require.NoError(t, err)
+	require.Equal(t, []types.LenderPosition{{Lender: "lender", Contribution: 100, Outstanding: 40}}, res.Lenders)
+
+	_, err = k.MortgageSyndicate(ctx, &types.QueryMortgageSyndicateRequest{Index: "missing"})
+	require.Error(t, err)
+}
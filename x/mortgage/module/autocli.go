@@ -28,6 +28,71 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Shows a Mortgage",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "DenomAlias",
+					Use:            "denom-alias [alias]",
+					Short:          "Resolve a legacy marker denom to its current denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "alias"}},
+				},
+				{
+					RpcMethod:      "MortgagesByLender",
+					Use:            "mortgages-by-lender [lender]",
+					Short:          "List the mortgages of a lender, including syndicated ones",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lender"}},
+				},
+				{
+					RpcMethod:      "MortgagesByLendee",
+					Use:            "mortgages-by-lendee [lendee]",
+					Short:          "List the mortgages of a lendee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lendee"}},
+				},
+				{
+					RpcMethod:      "MortgagesByCollateral",
+					Use:            "mortgages-by-collateral [collateral]",
+					Short:          "List the mortgages secured by a property",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "collateral"}},
+				},
+				{
+					RpcMethod:      "MortgagesByStatus",
+					Use:            "mortgages-by-status [status]",
+					Short:          "List the mortgages with a status, e.g. DELINQUENT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "status"}},
+				},
+				{
+					RpcMethod: "OutstandingTotals",
+					Use:       "outstanding-totals",
+					Short:     "Shows the principal owed on funded mortgages per lender and per property",
+				},
+				{
+					RpcMethod:      "MortgageRequestsByLender",
+					Use:            "requests-by-lender [lender]",
+					Short:          "List the pending mortgage requests made to a lender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lender"}},
+				},
+				{
+					RpcMethod:      "MortgageRequestsByLendee",
+					Use:            "requests-by-lendee [lendee]",
+					Short:          "List the pending mortgage requests made by a lendee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "lendee"}},
+				},
+				{
+					RpcMethod:      "AmortizationSchedule",
+					Use:            "schedule [index]",
+					Short:          "Shows the amortization schedule of a Mortgage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "MortgagesByHolder",
+					Use:            "mortgages-by-holder [holder]",
+					Short:          "List the mortgages whose loan notes an address holds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "holder"}},
+				},
+				{
+					RpcMethod:      "MortgageSyndicate",
+					Use:            "syndicate [index]",
+					Short:          "Shows the contribution and outstanding share of each lender of a Mortgage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -39,18 +104,6 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
-				{
-					RpcMethod:      "CreateMortgage",
-					Use:            "create-mortgage [index] [lender] [lendee] [collateral] [amount] [interestRate] [term]",
-					Short:          "Create a new Mortgage",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "lender"}, {ProtoField: "lendee"}, {ProtoField: "collateral"}, {ProtoField: "amount"}, {ProtoField: "interestRate"}, {ProtoField: "term"}},
-				},
-				{
-					RpcMethod:      "UpdateMortgage",
-					Use:            "update-mortgage [index] [lender] [lendee] [collateral] [amount] [interestRate] [term]",
-					Short:          "Update Mortgage",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "lender"}, {ProtoField: "lendee"}, {ProtoField: "collateral"}, {ProtoField: "amount"}, {ProtoField: "interestRate"}, {ProtoField: "term"}},
-				},
 				{
 					RpcMethod:      "DeleteMortgage",
 					Use:            "delete-mortgage [index]",
@@ -61,8 +114,115 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "RepayMortgage",
 					Use:            "repay-mortgage [mortgage-id] [amount]",
 					Short:          "Send a repay-mortgage tx",
+					Long:           "Repay a mortgage. Payments settle late fees and interest before principal. Principal repaid ahead of the schedule is subject to the mortgage's prepayment terms, and any prepayment penalty is paid on top of the amount.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mortgageId"}, {ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "RequestMortgage",
+					Use:            "request-mortgage [index] [lender] [collateral] [amount] [interest-rate] [term-months]",
+					Short:          "Request a mortgage from a lender",
+					Long:           "Request a mortgage from a lender. The interest rate is an annual decimal rate, e.g. 0.05 for 5%. Use --payment-frequency and --amortization to change the monthly annuity schedule. Use --purchase with the sellers, their shares and the price as JSON to finance buying the collateral shares. Use --syndicate with the lenders and their contributions as JSON to have several lenders fund the mortgage. Use --prepayment with the penalty rate, lock-in months and maximum yearly prepayment as JSON to restrict repaying principal early.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "lender"}, {ProtoField: "collateral"}, {ProtoField: "amount"}, {ProtoField: "interest_rate"}, {ProtoField: "term_months"}},
+				},
+				{
+					RpcMethod:      "ApproveMortgage",
+					Use:            "approve-mortgage [index]",
+					Short:          "Approve and fund a pending mortgage request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "PurchaseWithMortgage",
+					Use:            "purchase-with-mortgage [index]",
+					Short:          "Fund a mortgage request that finances a property purchase",
+					Long:           "Fund a mortgage request that finances a property purchase. The lendee buys the collateral shares from the sellers and they go into escrow in the same transaction. Every seller must have accepted the purchase first.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "AcceptPurchase",
+					Use:            "accept-purchase [index]",
+					Short:          "Agree to sell your shares at the price of a mortgage request",
+					Long:           "Agree to sell your shares of the collateral at the price of a mortgage request that finances their purchase. The shares are only sold once the lender funds the request.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "RequestEquityLoan",
+					Use:            "request-equity-loan [index] [lender] [collateral] [amount] [interest-rate] [term-months]",
+					Short:          "Request a home equity loan against property shares you own",
+					Long:           "Request a home equity loan against property shares you own. The amount and the debt already secured by liens on the shares may not exceed the maximum loan-to-value of their current value. Use --payment-frequency and --amortization to change the monthly annuity schedule.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "lender"}, {ProtoField: "collateral"}, {ProtoField: "amount"}, {ProtoField: "interest_rate"}, {ProtoField: "term_months"}},
+				},
+				{
+					RpcMethod:      "RejectMortgage",
+					Use:            "reject-mortgage [index]",
+					Short:          "Reject a pending mortgage request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "CancelMortgageRequest",
+					Use:            "cancel-mortgage-request [index]",
+					Short:          "Cancel a pending mortgage request",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "Foreclose",
+					Use:            "foreclose [index]",
+					Short:          "Foreclose on the collateral of a defaulted mortgage",
+					Long:           "Foreclose on the collateral of a defaulted mortgage. The escrowed shares go to the lender, or with --auction are auctioned off with any surplus going to the lendee.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "BidForeclosure",
+					Use:            "bid-foreclosure [index] [amount]",
+					Short:          "Bid usdarda for the collateral of a mortgage being foreclosed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ProposeRefinance",
+					Use:            "propose-refinance [index] [interest-rate] [term-months]",
+					Short:          "Offer the lendee new terms for the balance of a mortgage",
+					Long:           "Offer the lendee new terms for the balance of a mortgage. The interest rate is an annual decimal rate, e.g. 0.05 for 5%. Use --payment-frequency and --amortization to change the monthly annuity schedule.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "interest_rate"}, {ProtoField: "term_months"}},
+				},
+				{
+					RpcMethod:      "AcceptRefinance",
+					Use:            "accept-refinance [index] [interest-rate] [term-months]",
+					Short:          "Accept the refinance terms proposed by the lender",
+					Long:           "Accept the refinance terms proposed by the lender. The terms must match the proposal, including --payment-frequency and --amortization.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "interest_rate"}, {ProtoField: "term_months"}},
+				},
+				{
+					RpcMethod:      "RejectRefinance",
+					Use:            "reject-refinance [index]",
+					Short:          "Reject the refinance terms proposed by the lender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "TransferLoanNote",
+					Use:            "transfer-loan-note [index] [recipient]",
+					Short:          "Assign your position as lender of a mortgage to another address",
+					Long:           "Assign your position as lender of a mortgage to another address. The recipient receives the loan note and becomes the lender, receiving all further repayments.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}, {ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "JoinSyndicate",
+					Use:            "join-syndicate [index]",
+					Short:          "Commit to fund your contribution to a syndicated mortgage request",
+					Long:           "Commit to fund your contribution to a syndicated mortgage request. The contribution is paid to the lendee, together with those of the other lenders, once the lead lender approves the request.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "EnableAutoRepayment",
+					Use:            "enable-auto-repayment [index]",
+					Short:          "Have the installments of your mortgage pulled from your account as they fall due",
+					Long:           "Have the installments of your mortgage pulled from your account as they fall due. Grant the mortgage module account a send authorization for usdarda with the authz module first; a mortgage whose installment cannot be pulled becomes delinquent.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
+				{
+					RpcMethod:      "DisableAutoRepayment",
+					Use:            "disable-auto-repayment [index]",
+					Short:          "Stop the automatic repayment of your mortgage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
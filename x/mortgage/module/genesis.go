@@ -12,6 +12,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the mortgage
 	for _, elem := range genState.MortgageList {
 		k.SetMortgage(ctx, elem)
+		if !elem.ReviewTime.IsZero() {
+			k.SetMortgageReview(ctx, elem)
+		}
+	}
+	// Set all the denom aliases
+	for _, elem := range genState.DenomAliasList {
+		k.SetDenomAlias(ctx, elem)
 	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
@@ -25,6 +32,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.MortgageList = k.GetAllMortgage(ctx)
+	genesis.DenomAliasList = k.GetAllDenomAlias(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -23,14 +23,6 @@ var (
 )
 
 const (
-	opWeightMsgCreateMortgage = "op_weight_msg_mortgage"
-	// TODO: Determine the simulation weight value
-	defaultWeightMsgCreateMortgage int = 100
-
-	opWeightMsgUpdateMortgage = "op_weight_msg_mortgage"
-	// TODO: Determine the simulation weight value
-	defaultWeightMsgUpdateMortgage int = 100
-
 	opWeightMsgDeleteMortgage = "op_weight_msg_mortgage"
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgDeleteMortgage int = 100
@@ -39,6 +31,14 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRepayMortgage int = 100
 
+	opWeightMsgRequestMortgage = "op_weight_msg_request_mortgage"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestMortgage int = 100
+
+	opWeightMsgApproveMortgage = "op_weight_msg_approve_mortgage"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgApproveMortgage int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -72,26 +72,26 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 
-	var weightMsgCreateMortgage int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateMortgage, &weightMsgCreateMortgage, nil,
+	var weightMsgRequestMortgage int
+	simState.AppParams.GetOrGenerate(opWeightMsgRequestMortgage, &weightMsgRequestMortgage, nil,
 		func(_ *rand.Rand) {
-			weightMsgCreateMortgage = defaultWeightMsgCreateMortgage
+			weightMsgRequestMortgage = defaultWeightMsgRequestMortgage
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateMortgage,
-		mortgagesimulation.SimulateMsgCreateMortgage(am.accountKeeper, am.bankKeeper, am.keeper),
+		weightMsgRequestMortgage,
+		mortgagesimulation.SimulateMsgRequestMortgage(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
-	var weightMsgUpdateMortgage int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateMortgage, &weightMsgUpdateMortgage, nil,
+	var weightMsgApproveMortgage int
+	simState.AppParams.GetOrGenerate(opWeightMsgApproveMortgage, &weightMsgApproveMortgage, nil,
 		func(_ *rand.Rand) {
-			weightMsgUpdateMortgage = defaultWeightMsgUpdateMortgage
+			weightMsgApproveMortgage = defaultWeightMsgApproveMortgage
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateMortgage,
-		mortgagesimulation.SimulateMsgUpdateMortgage(am.accountKeeper, am.bankKeeper, am.keeper),
+		weightMsgApproveMortgage,
+		mortgagesimulation.SimulateMsgApproveMortgage(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	var weightMsgDeleteMortgage int
@@ -125,18 +125,18 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
 	return []simtypes.WeightedProposalMsg{
 		simulation.NewWeightedProposalMsg(
-			opWeightMsgCreateMortgage,
-			defaultWeightMsgCreateMortgage,
+			opWeightMsgRequestMortgage,
+			defaultWeightMsgRequestMortgage,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				mortgagesimulation.SimulateMsgCreateMortgage(am.accountKeeper, am.bankKeeper, am.keeper)
+				mortgagesimulation.SimulateMsgRequestMortgage(am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
 		simulation.NewWeightedProposalMsg(
-			opWeightMsgUpdateMortgage,
-			defaultWeightMsgUpdateMortgage,
+			opWeightMsgApproveMortgage,
+			defaultWeightMsgApproveMortgage,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				mortgagesimulation.SimulateMsgUpdateMortgage(am.accountKeeper, am.bankKeeper, am.keeper)
+				mortgagesimulation.SimulateMsgApproveMortgage(am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
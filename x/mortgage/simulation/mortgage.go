@@ -16,88 +16,6 @@ import (
 // Prevent strconv unused error
 var _ = strconv.IntSize
 
-func SimulateMsgCreateMortgage(
-	ak types.AccountKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
-		msg := &types.MsgCreateMortgage{
-			Creator: simAccount.Address.String(),
-			Index:   strconv.Itoa(i),
-		}
-
-		_, found := k.GetMortgage(ctx, msg.Index)
-		if found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Mortgage already exist"), nil, nil
-		}
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgUpdateMortgage(
-	ak types.AccountKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount  = simtypes.Account{}
-			mortgage    = types.Mortgage{}
-			msg         = &types.MsgUpdateMortgage{}
-			allMortgage = k.GetAllMortgage(ctx)
-			found       = false
-		)
-		for _, obj := range allMortgage {
-			simAccount, found = FindAccount(accs, obj.Creator)
-			if found {
-				mortgage = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "mortgage creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-
-		msg.Index = mortgage.Index
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
 func SimulateMsgDeleteMortgage(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -108,11 +26,15 @@ func SimulateMsgDeleteMortgage(
 		var (
 			simAccount  = simtypes.Account{}
 			mortgage    = types.Mortgage{}
-			msg         = &types.MsgUpdateMortgage{}
+			msg         = &types.MsgDeleteMortgage{}
 			allMortgage = k.GetAllMortgage(ctx)
 			found       = false
 		)
 		for _, obj := range allMortgage {
+			// Funded mortgages cannot be deleted
+			if !obj.IsUnfunded() {
+				continue
+			}
 			simAccount, found = FindAccount(accs, obj.Creator)
 			if found {
 				mortgage = obj
@@ -0,0 +1,121 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/ardaglobal/arda-poc/x/mortgage/keeper"
+	"github.com/ardaglobal/arda-poc/x/mortgage/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// SimulateMsgRequestMortgage requests a mortgage from a random lender. The
+// request only records the terms, so the collateral need not exist yet.
+func SimulateMsgRequestMortgage(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		lender, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgRequestMortgage(
+			simAccount.Address.String(),
+			strconv.Itoa(r.Int()),
+			lender.Address.String(),
+			simtypes.RandStringOfLength(r, 10),
+			uint64(simtypes.RandIntBetween(r, 1, 1_000_000)),
+			"0.05",
+			uint32(simtypes.RandIntBetween(r, 1, 361)),
+			types.MONTHLY,
+			types.ANNUITY,
+			nil,
+		)
+		if lender.Address.Equals(simAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "lender and lendee must differ"), nil, nil
+		}
+		if _, found := k.GetMortgage(ctx, msg.Index); found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Mortgage already exist"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgApproveMortgage funds a pending request whose lender can pay the
+// amount and whose lendee holds shares of the collateral.
+func SimulateMsgApproveMortgage(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			simAccount = simtypes.Account{}
+			msg        = &types.MsgApproveMortgage{}
+			spent      = sdk.NewCoins()
+			found      = false
+		)
+		for _, mortgage := range k.GetAllMortgage(ctx) {
+			if mortgage.Status != types.REQUESTED || mortgage.Purchase != nil || len(mortgage.Syndicate) > 0 {
+				continue
+			}
+			simAccount, found = FindAccount(accs, mortgage.Lender)
+			if !found {
+				continue
+			}
+			funds := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(usdtypes.USDArdaDenom)
+			shares := bk.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(mortgage.Lendee)).AmountOf(propertytypes.PropertyShareDenom(mortgage.Collateral))
+			amount := math.NewIntFromUint64(mortgage.Amount)
+			if funds.LT(amount) || !shares.IsPositive() {
+				found = false
+				continue
+			}
+			msg = types.NewMsgApproveMortgage(mortgage.Lender, mortgage.Index)
+			spent = sdk.NewCoins(sdk.NewCoin(usdtypes.USDArdaDenom, amount))
+			break
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "no mortgage request can be funded"), nil, nil
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spent,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -9,13 +9,41 @@ import (
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgCreateMortgage{},
-		&MsgUpdateMortgage{},
 		&MsgDeleteMortgage{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRepayMortgage{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestMortgage{},
+		&MsgApproveMortgage{},
+		&MsgRejectMortgage{},
+		&MsgCancelMortgageRequest{},
+		&MsgPurchaseWithMortgage{},
+		&MsgAcceptPurchase{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestEquityLoan{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForeclose{},
+		&MsgBidForeclosure{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeRefinance{},
+		&MsgAcceptRefinance{},
+		&MsgRejectRefinance{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferLoanNote{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgJoinSyndicate{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgEnableAutoRepayment{},
+		&MsgDisableAutoRepayment{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
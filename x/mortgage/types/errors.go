@@ -10,4 +10,19 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrCollateralEncumbered = sdkerrors.Register(ModuleName, 1102, "collateral is already encumbered")
+	ErrMortgageNotRequested = sdkerrors.Register(ModuleName, 1103, "mortgage is not a pending request")
+	ErrCollateralNotOwned   = sdkerrors.Register(ModuleName, 1104, "lendee owns no shares of the collateral")
+	ErrMortgageNotDefaulted = sdkerrors.Register(ModuleName, 1105, "mortgage is not defaulted")
+	ErrAuctionClosed        = sdkerrors.Register(ModuleName, 1106, "no foreclosure auction is open")
+	ErrBidTooLow            = sdkerrors.Register(ModuleName, 1107, "bid does not exceed the highest bid")
+	ErrNoRefinanceProposal  = sdkerrors.Register(ModuleName, 1108, "mortgage has no refinance proposal")
+	ErrRefinanceMismatch    = sdkerrors.Register(ModuleName, 1109, "terms do not match the refinance proposal")
+	ErrLoanToValueExceeded  = sdkerrors.Register(ModuleName, 1110, "loan exceeds the maximum loan-to-value")
+	ErrSyndicateIncomplete  = sdkerrors.Register(ModuleName, 1111, "not every syndicate lender has committed")
+	ErrNoRepaymentGrant     = sdkerrors.Register(ModuleName, 1112, "lendee granted the module no send authorization")
+	ErrPrepaymentRestricted = sdkerrors.Register(ModuleName, 1113, "prepayment is not allowed by the mortgage terms")
+	ErrPurchaseNotAccepted  = sdkerrors.Register(ModuleName, 1114, "not every seller has accepted the purchase")
+	ErrPriceOutOfRange      = sdkerrors.Register(ModuleName, 1115, "purchase price is too far from the value of the shares")
 )
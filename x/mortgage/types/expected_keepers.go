@@ -2,8 +2,14 @@ package types
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
@@ -20,6 +26,38 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	IterateAllBalances(ctx context.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+	SetSendEnabled(ctx context.Context, denom string, value bool)
+}
+
+// AuthzKeeper defines the expected interface for the Authz module.
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	DispatchActions(ctx context.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error)
+}
+
+// LienKeeper defines the expected interface for the Lien module.
+type LienKeeper interface {
+	GetPropertyLiens(ctx context.Context, propertyId string) []lientypes.Lien
+	PlaceLien(ctx context.Context, lien lientypes.Lien) (lientypes.Lien, error)
+	ReleaseLien(ctx context.Context, module string, id uint64) error
+	AssignLien(ctx context.Context, module string, id uint64, holder string) error
+	SetLienOwner(ctx context.Context, module string, id uint64, owner string) error
+}
+
+// PropertyKeeper defines the expected interface for the Property module.
+type PropertyKeeper interface {
+	GetProperty(ctx sdk.Context, id string) (propertytypes.Property, bool)
+	ReassignShares(ctx sdk.Context, propertyId string, from string, to string, shares uint64) error
+	SellShares(ctx sdk.Context, propertyId string, sellers []string, shares []uint64, buyer string, price uint64) error
+	CurrentValue(ctx context.Context, property propertytypes.Property) uint64
+}
+
+// UsdardaKeeper defines the expected interface for the USDArda module.
+type UsdardaKeeper interface {
+	AddVaultYield(ctx context.Context, from sdk.AccAddress, amount uint64) (bool, error)
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -10,7 +10,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		MortgageList: []Mortgage{},
+		MortgageList:   []Mortgage{},
+		DenomAliasList: []DenomAlias{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,6 +30,15 @@ func (gs GenesisState) Validate() error {
 		}
 		mortgageIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated denom aliases
+	denomAliasMap := make(map[string]struct{})
+
+	for _, elem := range gs.DenomAliasList {
+		if _, ok := denomAliasMap[elem.Alias]; ok {
+			return fmt.Errorf("duplicated denom alias %s", elem.Alias)
+		}
+		denomAliasMap[elem.Alias] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -27,8 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the mortgage module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params       Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	MortgageList []Mortgage `protobuf:"bytes,2,rep,name=mortgageList,proto3" json:"mortgageList"`
+	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	MortgageList   []Mortgage   `protobuf:"bytes,2,rep,name=mortgageList,proto3" json:"mortgageList"`
+	DenomAliasList []DenomAlias `protobuf:"bytes,3,rep,name=denomAliasList,proto3" json:"denomAliasList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,30 +79,95 @@ func (m *GenesisState) GetMortgageList() []Mortgage {
 	return nil
 }
 
+func (m *GenesisState) GetDenomAliasList() []DenomAlias {
+	if m != nil {
+		return m.DenomAliasList
+	}
+	return nil
+}
+
+// DenomAlias maps a legacy marker denom to the denom that replaced it.
+type DenomAlias struct {
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DenomAlias) Reset()         { *m = DenomAlias{} }
+func (m *DenomAlias) String() string { return proto.CompactTextString(m) }
+func (*DenomAlias) ProtoMessage()    {}
+func (*DenomAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acbd42364aedd3b2, []int{1}
+}
+func (m *DenomAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAlias.Merge(m, src)
+}
+func (m *DenomAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAlias proto.InternalMessageInfo
+
+func (m *DenomAlias) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *DenomAlias) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ardapoc.mortgage.GenesisState")
+	proto.RegisterType((*DenomAlias)(nil), "ardapoc.mortgage.DenomAlias")
 }
 
 func init() { proto.RegisterFile("ardapoc/mortgage/genesis.proto", fileDescriptor_acbd42364aedd3b2) }
 
 var fileDescriptor_acbd42364aedd3b2 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
+	// 307 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2c, 0x4a, 0x49,
 	0x2c, 0xc8, 0x4f, 0xd6, 0xcf, 0xcd, 0x2f, 0x2a, 0x49, 0x4f, 0x4c, 0x4f, 0xd5, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0xc1,
 	0xe4, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a,
 	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0x31, 0x8c, 0x2e, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x9a, 0x2c, 0x25, 0x8f, 0x21, 0x0d, 0x63, 0x40, 0x14, 0x28, 0x4d, 0x64, 0xe4, 0xe2,
+	0xcc, 0x85, 0x9a, 0x2c, 0x25, 0x8f, 0x21, 0x0d, 0x63, 0x40, 0x14, 0x28, 0xdd, 0x65, 0xe4, 0xe2,
 	0x71, 0x87, 0x38, 0x26, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0x0d, 0x62, 0x82, 0x04,
 	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba, 0xe3, 0xf4, 0x02, 0xc0, 0xf2, 0x4e, 0x9c,
 	0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x45, 0xc8, 0x85, 0x8b,
 	0x07, 0xa6, 0xca, 0x27, 0xb3, 0xb8, 0x44, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x0a, 0xd3,
-	0x08, 0x5f, 0x28, 0xc3, 0x89, 0x05, 0x64, 0x48, 0x10, 0x8a, 0x2e, 0x27, 0xcf, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x07, 0x99, 0x99, 0x9e, 0x93, 0x9f, 0x94, 0x98, 0x03, 0x66, 0xea, 0x82, 0x7c,
-	0x59, 0x81, 0xf0, 0x67, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x97, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x97, 0x17, 0xc6, 0x9f, 0x82, 0x01, 0x00, 0x00,
+	0x08, 0x5f, 0x28, 0xc3, 0x89, 0x05, 0x64, 0x48, 0x10, 0x8a, 0x2e, 0x21, 0x2f, 0x2e, 0xbe, 0x94,
+	0xd4, 0xbc, 0xfc, 0x5c, 0xc7, 0x9c, 0xcc, 0xc4, 0x62, 0xb0, 0x39, 0xcc, 0x60, 0x73, 0x64, 0x30,
+	0xcd, 0x71, 0x81, 0xab, 0x83, 0x9a, 0x84, 0xa6, 0x53, 0xc9, 0x82, 0x8b, 0x0b, 0xa1, 0x46, 0x48,
+	0x84, 0x8b, 0x35, 0x11, 0xc4, 0x00, 0xfb, 0x8d, 0x33, 0x88, 0x35, 0x11, 0x26, 0x0a, 0xd6, 0x25,
+	0xc1, 0x04, 0x11, 0x05, 0x73, 0x9c, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
+	0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe4, 0xa2,
+	0xf4, 0x9c, 0xfc, 0xa4, 0xc4, 0x1c, 0x30, 0x53, 0x17, 0x14, 0xd6, 0x15, 0x88, 0xd0, 0x2e, 0xa9,
+	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0xb5, 0x31, 0x60, 0x00, 0x2b, 0x6e, 0x61, 0x3b, 0x08,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +190,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomAliasList) > 0 {
+		for iNdEx := len(m.DenomAliasList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAliasList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MortgageList) > 0 {
 		for iNdEx := len(m.MortgageList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +231,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -176,6 +293,29 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomAliasList) > 0 {
+		for _, e := range m.DenomAliasList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -281,6 +421,154 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAliasList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAliasList = append(m.DenomAliasList, DenomAlias{})
+			if err := m.DenomAliasList[len(m.DenomAliasList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -31,6 +31,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				// this line is used by starport scaffolding # types/genesis/validField
+				Params: types.DefaultParams(),
 			},
 			valid: true,
 		},
@@ -48,6 +49,57 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated denom alias",
+			genState: &types.GenesisState{
+				DenomAliasList: []types.DenomAlias{
+					{
+						Alias: "mortgage/house/0",
+						Denom: types.MortgageMarkerDenom("0"),
+					},
+					{
+						Alias: "mortgage/house/0",
+						Denom: types.MortgageMarkerDenom("0"),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "default period within grace period",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultGracePeriod, types.DefaultLateFeeRate, types.DefaultGracePeriod, types.DefaultAuctionDuration, types.DefaultMaxLoanToValue, types.DefaultVaultInterestShare, types.DefaultMaxPriceDeviation),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid late fee rate",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultGracePeriod, "1.5", types.DefaultDefaultPeriod, types.DefaultAuctionDuration, types.DefaultMaxLoanToValue, types.DefaultVaultInterestShare, types.DefaultMaxPriceDeviation),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid max loan-to-value",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultGracePeriod, types.DefaultLateFeeRate, types.DefaultDefaultPeriod, types.DefaultAuctionDuration, "0", types.DefaultVaultInterestShare, types.DefaultMaxPriceDeviation),
+			},
+			valid: false,
+		},
+		{
+			desc: "vault interest share above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultGracePeriod, types.DefaultLateFeeRate, types.DefaultDefaultPeriod, types.DefaultAuctionDuration, types.DefaultMaxLoanToValue, "1.1", types.DefaultMaxPriceDeviation),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative max price deviation",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultGracePeriod, types.DefaultLateFeeRate, types.DefaultDefaultPeriod, types.DefaultAuctionDuration, types.DefaultMaxLoanToValue, types.DefaultVaultInterestShare, "-0.1"),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
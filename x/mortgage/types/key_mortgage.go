@@ -1,12 +1,48 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
 
-var _ binary.ByteOrder
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// MortgageKeyPrefix is the prefix to retrieve all Mortgage
 	MortgageKeyPrefix = "Mortgage/value/"
+
+	// DenomAliasKeyPrefix is the prefix mapping legacy marker denoms to their replacement
+	DenomAliasKeyPrefix = "DenomAlias/value/"
+
+	// MortgageReviewKeyPrefix is the prefix of the queue of mortgages ordered by
+	// the time they are next checked for late payment
+	MortgageReviewKeyPrefix = "Mortgage/review/"
+
+	// MortgageAutoRepaymentKeyPrefix is the prefix of the queue of mortgages
+	// ordered by the time their next installment is pulled automatically
+	MortgageAutoRepaymentKeyPrefix = "Mortgage/autorepay/"
+
+	// MortgageLenderKeyPrefix is the prefix of the index of mortgages by lender,
+	// including the members of a syndicate
+	MortgageLenderKeyPrefix = "Mortgage/lender/"
+
+	// MortgageLendeeKeyPrefix is the prefix of the index of mortgages by lendee
+	MortgageLendeeKeyPrefix = "Mortgage/lendee/"
+
+	// MortgageCollateralKeyPrefix is the prefix of the index of mortgages by collateral
+	MortgageCollateralKeyPrefix = "Mortgage/collateral/"
+
+	// MortgageStatusKeyPrefix is the prefix of the index of mortgages by status
+	MortgageStatusKeyPrefix = "Mortgage/status/"
+
+	// LenderOutstandingKeyPrefix is the prefix of the running totals of the
+	// principal owed to each lender on its funded mortgages, by lender
+	LenderOutstandingKeyPrefix = "Mortgage/outstanding/lender/"
+
+	// PropertyOutstandingKeyPrefix is the prefix of the running totals of the
+	// principal owed on the funded mortgages secured by each property, by
+	// collateral
+	PropertyOutstandingKeyPrefix = "Mortgage/outstanding/collateral/"
 )
 
 // MortgageKey returns the store key to retrieve a Mortgage from the index fields
@@ -21,3 +57,30 @@ func MortgageKey(
 
 	return key
 }
+
+// MortgageReviewKey returns the key of a mortgage in the review queue, relative
+// to MortgageReviewKeyPrefix. The auto repayment queue uses the same keys.
+func MortgageReviewKey(reviewTime time.Time, index string) []byte {
+	return append(sdk.FormatTimeBytes(reviewTime), []byte(index)...)
+}
+
+// MortgageIndexPrefix returns the prefix of the entries for value in the
+// lender, lendee or collateral index, relative to the index's key prefix.
+// Values are length-prefixed so that no value's entries share a prefix with
+// another's.
+func MortgageIndexPrefix(value string) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(value))), []byte(value)...)
+}
+
+// OutstandingKey returns the key of the outstanding total of a lender or
+// collateral, relative to its prefix. The terminating zero byte keeps the key of
+// an empty value valid and the totals sorted by lender or collateral.
+func OutstandingKey(value string) []byte {
+	return append([]byte(value), 0)
+}
+
+// MortgageStatusPrefix returns the prefix of the entries for status in the
+// status index, relative to MortgageStatusKeyPrefix.
+func MortgageStatusPrefix(status MortgageStatus) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(status))
+}
@@ -1,6 +1,10 @@
 package types
 
-import "strings"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
 
 const (
 	// ModuleName defines the module name
@@ -11,6 +15,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_mortgage"
+
+	// MortgageMarkerDenomPrefix defines the prefix of mortgage marker denoms
+	MortgageMarkerDenomPrefix = "mortgage-"
+
+	// MortgageNoteDenomPrefix defines the prefix of loan note denoms
+	MortgageNoteDenomPrefix = "mortgagenote-"
 )
 
 var (
@@ -21,8 +31,24 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
-// MortgageMarkerDenom returns the sanitized bank denom for a mortgage marker token.
-func MortgageMarkerDenom(collateral, index string) string {
+// MortgageMarkerDenom returns the bank denom for a mortgage marker token. Mortgage
+// indexes are free-form, so the denom is derived from their hash to only contain
+// characters that are safe in denoms and URLs.
+func MortgageMarkerDenom(index string) string {
+	h := sha256.Sum256([]byte(index))
+	return MortgageMarkerDenomPrefix + hex.EncodeToString(h[:16])
+}
+
+// MortgageNoteDenom returns the bank denom for the loan note of a mortgage. Its
+// holder is the lender of the mortgage.
+func MortgageNoteDenom(index string) string {
+	h := sha256.Sum256([]byte(index))
+	return MortgageNoteDenomPrefix + hex.EncodeToString(h[:16])
+}
+
+// LegacyMortgageMarkerDenom returns the marker denom used before v0.3.0. It is
+// kept to migrate balances and resolve aliases.
+func LegacyMortgageMarkerDenom(collateral, index string) string {
 	sanitizedCollateral := strings.ReplaceAll(collateral, " ", "")
 	sanitizedIndex := strings.ReplaceAll(index, " ", "")
 	return "mortgage/" + sanitizedCollateral + "/" + sanitizedIndex
@@ -0,0 +1,45 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgEnableAutoRepayment{}
+
+func NewMsgEnableAutoRepayment(lendee string, index string) *MsgEnableAutoRepayment {
+	return &MsgEnableAutoRepayment{
+		Lendee: lendee,
+		Index:  index,
+	}
+}
+
+func (msg *MsgEnableAutoRepayment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDisableAutoRepayment{}
+
+func NewMsgDisableAutoRepayment(lendee string, index string) *MsgDisableAutoRepayment {
+	return &MsgDisableAutoRepayment{
+		Lendee: lendee,
+		Index:  index,
+	}
+}
+
+func (msg *MsgDisableAutoRepayment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgEnableAutoRepayment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgEnableAutoRepayment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEnableAutoRepayment{
+				Lendee: "invalid_address",
+				Index:  "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgEnableAutoRepayment{
+				Lendee: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgEnableAutoRepayment{
+				Lendee: sample.AccAddress(),
+				Index:  "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDisableAutoRepayment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDisableAutoRepayment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDisableAutoRepayment{
+				Lendee: "invalid_address",
+				Index:  "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgDisableAutoRepayment{
+				Lendee: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgDisableAutoRepayment{
+				Lendee: sample.AccAddress(),
+				Index:  "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRequestEquityLoan{}
+
+func NewMsgRequestEquityLoan(
+	lendee string,
+	index string,
+	lender string,
+	collateral string,
+	amount uint64,
+	interestRate string,
+	termMonths uint32,
+	paymentFrequency PaymentFrequency,
+	amortization AmortizationType,
+) *MsgRequestEquityLoan {
+	return &MsgRequestEquityLoan{
+		Lendee:           lendee,
+		Index:            index,
+		Lender:           lender,
+		Collateral:       collateral,
+		Amount:           amount,
+		InterestRate:     interestRate,
+		TermMonths:       termMonths,
+		PaymentFrequency: paymentFrequency,
+		Amortization:     amortization,
+	}
+}
+
+func (msg *MsgRequestEquityLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Lender == msg.Lendee {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lender and lendee must differ")
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	if msg.Collateral == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "collateral cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	if msg.Prepayment != nil {
+		if err := msg.Prepayment.Validate(); err != nil {
+			return err
+		}
+	}
+	return ValidateMortgageTerms(msg.InterestRate, msg.TermMonths, msg.PaymentFrequency, msg.Amortization)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgForeclose{}
+
+func NewMsgForeclose(lender string, index string, auction bool) *MsgForeclose {
+	return &MsgForeclose{
+		Lender:  lender,
+		Index:   index,
+		Auction: auction,
+	}
+}
+
+func (msg *MsgForeclose) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgBidForeclosure{}
+
+func NewMsgBidForeclosure(bidder string, index string, amount uint64) *MsgBidForeclosure {
+	return &MsgBidForeclosure{
+		Bidder: bidder,
+		Index:  index,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBidForeclosure) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bidder address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bid must be positive")
+	}
+	if msg.Amount > math.MaxInt64 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bid cannot exceed %d", int64(math.MaxInt64))
+	}
+	return nil
+}
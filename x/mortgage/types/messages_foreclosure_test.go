@@ -0,0 +1,107 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgForeclose_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgForeclose
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgForeclose{
+				Lender: "invalid_address",
+				Index:  "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgForeclose{
+				Lender: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgForeclose{
+				Lender:  sample.AccAddress(),
+				Index:   "1",
+				Auction: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgBidForeclosure_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBidForeclosure
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBidForeclosure{
+				Bidder: "invalid_address",
+				Index:  "1",
+				Amount: 10,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgBidForeclosure{
+				Bidder: sample.AccAddress(),
+				Amount: 10,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero bid",
+			msg: MsgBidForeclosure{
+				Bidder: sample.AccAddress(),
+				Index:  "1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "bid above max int64",
+			msg: MsgBidForeclosure{
+				Bidder: sample.AccAddress(),
+				Index:  "1",
+				Amount: math.MaxInt64 + 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgBidForeclosure{
+				Bidder: sample.AccAddress(),
+				Index:  "1",
+				Amount: 10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferLoanNote{}
+
+func NewMsgTransferLoanNote(holder string, index string, recipient string) *MsgTransferLoanNote {
+	return &MsgTransferLoanNote{
+		Holder:    holder,
+		Index:     index,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgTransferLoanNote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	if msg.Recipient == msg.Holder {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient already holds the loan note")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferLoanNote_ValidateBasic(t *testing.T) {
+	holder := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferLoanNote
+		err  error
+	}{
+		{
+			name: "invalid holder",
+			msg: MsgTransferLoanNote{
+				Holder:    "invalid_address",
+				Index:     "1",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg: MsgTransferLoanNote{
+				Holder:    sample.AccAddress(),
+				Index:     "1",
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgTransferLoanNote{
+				Holder:    sample.AccAddress(),
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "transfer to the holder",
+			msg: MsgTransferLoanNote{
+				Holder:    holder,
+				Index:     "1",
+				Recipient: holder,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgTransferLoanNote{
+				Holder:    sample.AccAddress(),
+				Index:     "1",
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRequestMortgage{}
+
+func NewMsgRequestMortgage(
+	lendee string,
+	index string,
+	lender string,
+	collateral string,
+	amount uint64,
+	interestRate string,
+	termMonths uint32,
+	paymentFrequency PaymentFrequency,
+	amortization AmortizationType,
+	purchase *MortgagePurchase,
+
+) *MsgRequestMortgage {
+	return &MsgRequestMortgage{
+		Lendee:           lendee,
+		Index:            index,
+		Lender:           lender,
+		Collateral:       collateral,
+		Amount:           amount,
+		InterestRate:     interestRate,
+		TermMonths:       termMonths,
+		PaymentFrequency: paymentFrequency,
+		Amortization:     amortization,
+		Purchase:         purchase,
+	}
+}
+
+func (msg *MsgRequestMortgage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Lender == msg.Lendee {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lender and lendee must differ")
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	if msg.Collateral == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "collateral cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	if msg.Purchase != nil {
+		if err := msg.Purchase.Validate(msg.Lendee, msg.Amount); err != nil {
+			return err
+		}
+	}
+	if err := ValidateSyndicate(msg.Syndicate, msg.Lender, msg.Amount); err != nil {
+		return err
+	}
+	if msg.Prepayment != nil {
+		if err := msg.Prepayment.Validate(); err != nil {
+			return err
+		}
+	}
+	return ValidateMortgageTerms(msg.InterestRate, msg.TermMonths, msg.PaymentFrequency, msg.Amortization)
+}
+
+var _ sdk.Msg = &MsgApproveMortgage{}
+
+func NewMsgApproveMortgage(lender string, index string) *MsgApproveMortgage {
+	return &MsgApproveMortgage{
+		Lender: lender,
+		Index:  index,
+	}
+}
+
+func (msg *MsgApproveMortgage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRejectMortgage{}
+
+func NewMsgRejectMortgage(lender string, index string) *MsgRejectMortgage {
+	return &MsgRejectMortgage{
+		Lender: lender,
+		Index:  index,
+	}
+}
+
+func (msg *MsgRejectMortgage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelMortgageRequest{}
+
+func NewMsgCancelMortgageRequest(lendee string, index string) *MsgCancelMortgageRequest {
+	return &MsgCancelMortgageRequest{
+		Lendee: lendee,
+		Index:  index,
+	}
+}
+
+func (msg *MsgCancelMortgageRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgPurchaseWithMortgage{}
+
+func NewMsgPurchaseWithMortgage(lender string, index string) *MsgPurchaseWithMortgage {
+	return &MsgPurchaseWithMortgage{
+		Lender: lender,
+		Index:  index,
+	}
+}
+
+func (msg *MsgPurchaseWithMortgage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAcceptPurchase{}
+
+func NewMsgAcceptPurchase(seller string, index string) *MsgAcceptPurchase {
+	return &MsgAcceptPurchase{
+		Seller: seller,
+		Index:  index,
+	}
+}
+
+func (msg *MsgAcceptPurchase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Seller); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
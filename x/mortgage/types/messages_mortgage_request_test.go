@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestMortgage_ValidateBasic(t *testing.T) {
+	lendee, lender, member := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgRequestMortgage
+		err  error
+	}{
+		{
+			name: "invalid lendee",
+			msg:  MsgRequestMortgage{Lendee: "invalid_address", Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid lender",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: "invalid_address", Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "self lending",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lendee, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty index",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero amount",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid rate",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "5%", TermMonths: 360},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "partial period",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 13, PaymentFrequency: QUARTERLY},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "purchase without sellers",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Purchase: &MortgagePurchase{Price: 10}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "purchase from lendee",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Purchase: &MortgagePurchase{FromOwners: []string{lendee}, FromShares: []uint64{5}, Price: 10}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "amount above price",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Purchase: &MortgagePurchase{FromOwners: []string{lender}, FromShares: []uint64{5}, Price: 9}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "purchase accepted by the lendee",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Purchase: &MortgagePurchase{FromOwners: []string{lender}, FromShares: []uint64{5}, Price: 10, AcceptedBy: []string{lender}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "syndicate without lender",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Syndicate: []SyndicateLender{{Lender: member, Contribution: 10}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "syndicate lender twice",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Syndicate: []SyndicateLender{{Lender: lender, Contribution: 5}, {Lender: lender, Contribution: 5}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "contributions short of amount",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Syndicate: []SyndicateLender{{Lender: lender, Contribution: 5}, {Lender: member, Contribution: 4}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero contribution",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Syndicate: []SyndicateLender{{Lender: lender, Contribution: 10}, {Lender: member}}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "prepayment penalty above prepayment",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Prepayment: &PrepaymentTerms{PenaltyRate: "1.5"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid prepayment penalty",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Prepayment: &PrepaymentTerms{PenaltyRate: "2%"}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360},
+		}, {
+			name: "valid syndicate",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Syndicate: []SyndicateLender{{Lender: member, Contribution: 3}, {Lender: lender, Contribution: 7}}},
+		}, {
+			name: "valid purchase",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Purchase: &MortgagePurchase{FromOwners: []string{lender}, FromShares: []uint64{5}, Price: 12}},
+		}, {
+			name: "valid prepayment terms",
+			msg:  MsgRequestMortgage{Lendee: lendee, Lender: lender, Index: "1", Collateral: "addr", Amount: 10, InterestRate: "0.05", TermMonths: 360, Prepayment: &PrepaymentTerms{PenaltyRate: "0.02", LockInMonths: 12, MaxYearlyPrepayment: 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgApproveMortgage_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgApproveMortgage("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgApproveMortgage(sample.AccAddress(), "1").ValidateBasic())
+	require.ErrorIs(t, NewMsgRejectMortgage("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgRejectMortgage(sample.AccAddress(), "1").ValidateBasic())
+	require.ErrorIs(t, NewMsgCancelMortgageRequest("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgCancelMortgageRequest(sample.AccAddress(), "1").ValidateBasic())
+	require.ErrorIs(t, NewMsgPurchaseWithMortgage("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgPurchaseWithMortgage(sample.AccAddress(), "").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgPurchaseWithMortgage(sample.AccAddress(), "1").ValidateBasic())
+	require.ErrorIs(t, NewMsgAcceptPurchase("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgAcceptPurchase(sample.AccAddress(), "").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgAcceptPurchase(sample.AccAddress(), "1").ValidateBasic())
+}
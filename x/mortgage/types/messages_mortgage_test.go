@@ -8,68 +8,6 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestMsgCreateMortgage_ValidateBasic(t *testing.T) {
-	tests := []struct {
-		name string
-		msg  MsgCreateMortgage
-		err  error
-	}{
-		{
-			name: "invalid address",
-			msg: MsgCreateMortgage{
-				Creator: "invalid_address",
-			},
-			err: sdkerrors.ErrInvalidAddress,
-		}, {
-			name: "valid address",
-			msg: MsgCreateMortgage{
-				Creator: sample.AccAddress(),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := tt.msg.ValidateBasic()
-			if tt.err != nil {
-				require.ErrorIs(t, err, tt.err)
-				return
-			}
-			require.NoError(t, err)
-		})
-	}
-}
-
-func TestMsgUpdateMortgage_ValidateBasic(t *testing.T) {
-	tests := []struct {
-		name string
-		msg  MsgUpdateMortgage
-		err  error
-	}{
-		{
-			name: "invalid address",
-			msg: MsgUpdateMortgage{
-				Creator: "invalid_address",
-			},
-			err: sdkerrors.ErrInvalidAddress,
-		}, {
-			name: "valid address",
-			msg: MsgUpdateMortgage{
-				Creator: sample.AccAddress(),
-			},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			err := tt.msg.ValidateBasic()
-			if tt.err != nil {
-				require.ErrorIs(t, err, tt.err)
-				return
-			}
-			require.NoError(t, err)
-		})
-	}
-}
-
 func TestMsgDeleteMortgage_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
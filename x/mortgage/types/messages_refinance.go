@@ -0,0 +1,86 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgProposeRefinance{}
+
+func NewMsgProposeRefinance(
+	lender string,
+	index string,
+	interestRate string,
+	termMonths uint32,
+	paymentFrequency PaymentFrequency,
+	amortization AmortizationType,
+) *MsgProposeRefinance {
+	return &MsgProposeRefinance{
+		Lender:           lender,
+		Index:            index,
+		InterestRate:     interestRate,
+		TermMonths:       termMonths,
+		PaymentFrequency: paymentFrequency,
+		Amortization:     amortization,
+	}
+}
+
+func (msg *MsgProposeRefinance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return ValidateMortgageTerms(msg.InterestRate, msg.TermMonths, msg.PaymentFrequency, msg.Amortization)
+}
+
+var _ sdk.Msg = &MsgAcceptRefinance{}
+
+func NewMsgAcceptRefinance(
+	lendee string,
+	index string,
+	interestRate string,
+	termMonths uint32,
+	paymentFrequency PaymentFrequency,
+	amortization AmortizationType,
+) *MsgAcceptRefinance {
+	return &MsgAcceptRefinance{
+		Lendee:           lendee,
+		Index:            index,
+		InterestRate:     interestRate,
+		TermMonths:       termMonths,
+		PaymentFrequency: paymentFrequency,
+		Amortization:     amortization,
+	}
+}
+
+func (msg *MsgAcceptRefinance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return ValidateMortgageTerms(msg.InterestRate, msg.TermMonths, msg.PaymentFrequency, msg.Amortization)
+}
+
+var _ sdk.Msg = &MsgRejectRefinance{}
+
+func NewMsgRejectRefinance(lendee string, index string) *MsgRejectRefinance {
+	return &MsgRejectRefinance{
+		Lendee: lendee,
+		Index:  index,
+	}
+}
+
+func (msg *MsgRejectRefinance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lendee); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lendee address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
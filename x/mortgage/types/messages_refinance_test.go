@@ -0,0 +1,156 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgProposeRefinance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgProposeRefinance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgProposeRefinance{
+				Lender:           "invalid_address",
+				Index:            "1",
+				InterestRate:     "0.05",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty index",
+			msg: MsgProposeRefinance{
+				Lender:           sample.AccAddress(),
+				InterestRate:     "0.05",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid interest rate",
+			msg: MsgProposeRefinance{
+				Lender:           sample.AccAddress(),
+				Index:            "1",
+				InterestRate:     "5%",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgProposeRefinance{
+				Lender:           sample.AccAddress(),
+				Index:            "1",
+				InterestRate:     "0.05",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptRefinance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAcceptRefinance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAcceptRefinance{
+				Lendee:           "invalid_address",
+				Index:            "1",
+				InterestRate:     "0.05",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no term",
+			msg: MsgAcceptRefinance{
+				Lendee:           sample.AccAddress(),
+				Index:            "1",
+				InterestRate:     "0.05",
+				TermMonths:       0,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgAcceptRefinance{
+				Lendee:           sample.AccAddress(),
+				Index:            "1",
+				InterestRate:     "0.05",
+				TermMonths:       12,
+				PaymentFrequency: MONTHLY,
+				Amortization:     ANNUITY,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRejectRefinance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRejectRefinance
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRejectRefinance{
+				Lendee: "invalid_address",
+				Index:  "1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgRejectRefinance{
+				Lendee: sample.AccAddress(),
+				Index:  "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
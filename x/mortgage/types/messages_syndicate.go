@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgJoinSyndicate{}
+
+func NewMsgJoinSyndicate(lender string, index string) *MsgJoinSyndicate {
+	return &MsgJoinSyndicate{
+		Lender: lender,
+		Index:  index,
+	}
+}
+
+func (msg *MsgJoinSyndicate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Lender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lender address (%s)", err)
+	}
+	if msg.Index == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index cannot be empty")
+	}
+	return nil
+}
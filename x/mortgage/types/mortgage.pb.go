@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +36,14 @@ const (
 	REJECTED  MortgageStatus = 2
 	PAID      MortgageStatus = 3
 	CANCELLED MortgageStatus = 4
+	// DELINQUENT mortgages have an installment unpaid past the grace period.
+	DELINQUENT MortgageStatus = 5
+	// DEFAULTED mortgages have an installment unpaid past the default period.
+	DEFAULTED MortgageStatus = 6
+	// FORECLOSING mortgages have their escrowed collateral up for auction.
+	FORECLOSING MortgageStatus = 7
+	// FORECLOSED mortgages were settled by handing over the escrowed collateral.
+	FORECLOSED MortgageStatus = 8
 )
 
 var MortgageStatus_name = map[int32]string{
@@ -39,14 +52,22 @@ var MortgageStatus_name = map[int32]string{
 	2: "REJECTED",
 	3: "PAID",
 	4: "CANCELLED",
+	5: "DELINQUENT",
+	6: "DEFAULTED",
+	7: "FORECLOSING",
+	8: "FORECLOSED",
 }
 
 var MortgageStatus_value = map[string]int32{
-	"REQUESTED": 0,
-	"APPROVED":  1,
-	"REJECTED":  2,
-	"PAID":      3,
-	"CANCELLED": 4,
+	"REQUESTED":   0,
+	"APPROVED":    1,
+	"REJECTED":    2,
+	"PAID":        3,
+	"CANCELLED":   4,
+	"DELINQUENT":  5,
+	"DEFAULTED":   6,
+	"FORECLOSING": 7,
+	"FORECLOSED":  8,
 }
 
 func (x MortgageStatus) String() string {
@@ -57,17 +78,140 @@ func (MortgageStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_247875c39e7208c6, []int{0}
 }
 
+// PaymentFrequency defines how often mortgage installments fall due.
+type PaymentFrequency int32
+
+const (
+	MONTHLY       PaymentFrequency = 0
+	QUARTERLY     PaymentFrequency = 1
+	SEMI_ANNUALLY PaymentFrequency = 2
+	ANNUALLY      PaymentFrequency = 3
+)
+
+var PaymentFrequency_name = map[int32]string{
+	0: "MONTHLY",
+	1: "QUARTERLY",
+	2: "SEMI_ANNUALLY",
+	3: "ANNUALLY",
+}
+
+var PaymentFrequency_value = map[string]int32{
+	"MONTHLY":       0,
+	"QUARTERLY":     1,
+	"SEMI_ANNUALLY": 2,
+	"ANNUALLY":      3,
+}
+
+func (x PaymentFrequency) String() string {
+	return proto.EnumName(PaymentFrequency_name, int32(x))
+}
+
+func (PaymentFrequency) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{1}
+}
+
+// AmortizationType defines how the principal of a mortgage is paid down.
+type AmortizationType int32
+
+const (
+	// ANNUITY pays equal installments of interest and principal.
+	ANNUITY AmortizationType = 0
+	// INTEREST_ONLY pays interest each period and the principal with the last installment.
+	INTEREST_ONLY AmortizationType = 1
+)
+
+var AmortizationType_name = map[int32]string{
+	0: "ANNUITY",
+	1: "INTEREST_ONLY",
+}
+
+var AmortizationType_value = map[string]int32{
+	"ANNUITY":       0,
+	"INTEREST_ONLY": 1,
+}
+
+func (x AmortizationType) String() string {
+	return proto.EnumName(AmortizationType_name, int32(x))
+}
+
+func (AmortizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{2}
+}
+
 type Mortgage struct {
-	Creator           string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index             string         `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender            string         `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee            string         `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral        string         `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount            uint64         `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate      string         `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term              string         `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
-	Status            MortgageStatus `protobuf:"varint,9,opt,name=status,proto3,enum=ardapoc.mortgage.MortgageStatus" json:"status,omitempty"`
-	OutstandingAmount uint64         `protobuf:"varint,10,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index      string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender     string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Lendee     string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Collateral string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount     uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// legacy_interest_rate and legacy_term hold the free-form terms of mortgages
+	// created before v0.6.0 that could not be parsed.
+	LegacyInterestRate string         `protobuf:"bytes,7,opt,name=legacy_interest_rate,json=legacyInterestRate,proto3" json:"legacy_interest_rate,omitempty"` // Deprecated: Do not use.
+	LegacyTerm         string         `protobuf:"bytes,8,opt,name=legacy_term,json=legacyTerm,proto3" json:"legacy_term,omitempty"`                           // Deprecated: Do not use.
+	Status             MortgageStatus `protobuf:"varint,9,opt,name=status,proto3,enum=ardapoc.mortgage.MortgageStatus" json:"status,omitempty"`
+	// outstanding_amount is the principal still owed.
+	OutstandingAmount uint64 `protobuf:"varint,10,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// interest_rate is the annual interest rate as a decimal, e.g. "0.05" for 5%.
+	InterestRate     string           `protobuf:"bytes,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,12,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,13,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,14,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// start_date is when the mortgage was funded; installments fall due from it.
+	StartDate time.Time `protobuf:"bytes,15,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date"`
+	// interest_due is interest accrued on past installments and not yet paid.
+	InterestDue uint64 `protobuf:"varint,16,opt,name=interest_due,json=interestDue,proto3" json:"interest_due,omitempty"`
+	// periods_accrued is the number of installments whose interest has accrued.
+	PeriodsAccrued uint32 `protobuf:"varint,17,opt,name=periods_accrued,json=periodsAccrued,proto3" json:"periods_accrued,omitempty"`
+	// amount_paid is the total repaid towards the schedule, excluding late fees.
+	AmountPaid uint64 `protobuf:"varint,18,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// next_due_date is the due date of the oldest installment not fully paid.
+	NextDueDate time.Time `protobuf:"bytes,19,opt,name=next_due_date,json=nextDueDate,proto3,stdtime" json:"next_due_date"`
+	// late_fees_due is the late fees charged and not yet paid.
+	LateFeesDue uint64 `protobuf:"varint,20,opt,name=late_fees_due,json=lateFeesDue,proto3" json:"late_fees_due,omitempty"`
+	// review_time is when the mortgage is next checked for late payment.
+	ReviewTime time.Time `protobuf:"bytes,21,opt,name=review_time,json=reviewTime,proto3,stdtime" json:"review_time"`
+	// escrowed_shares is the number of collateral shares of the lendee held by
+	// the module account while the mortgage is funded.
+	EscrowedShares uint64 `protobuf:"varint,22,opt,name=escrowed_shares,json=escrowedShares,proto3" json:"escrowed_shares,omitempty"`
+	// auction is the foreclosure auction of the escrowed shares, if any.
+	Auction *ForeclosureAuction `protobuf:"bytes,23,opt,name=auction,proto3" json:"auction,omitempty"`
+	// principal is the balance amortized by the current terms. It is zero until
+	// the mortgage is refinanced; until then the terms amortize the amount.
+	Principal uint64 `protobuf:"varint,24,opt,name=principal,proto3" json:"principal,omitempty"`
+	// terms_history lists the terms the mortgage was funded and refinanced on,
+	// oldest first. The last entry is the current version.
+	TermsHistory []MortgageTerms `protobuf:"bytes,25,rep,name=terms_history,json=termsHistory,proto3" json:"terms_history"`
+	// refinance_proposal holds new terms offered by the lender until the lendee
+	// accepts or rejects them.
+	RefinanceProposal *MortgageTerms `protobuf:"bytes,26,opt,name=refinance_proposal,json=refinanceProposal,proto3" json:"refinance_proposal,omitempty"`
+	// purchase is the property purchase the mortgage finances, if any.
+	Purchase *MortgagePurchase `protobuf:"bytes,27,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// equity marks a home equity loan against shares the lendee already owns. It
+	// may rank behind other liens on the collateral and its amount is limited by
+	// the maximum loan-to-value.
+	Equity bool `protobuf:"varint,28,opt,name=equity,proto3" json:"equity,omitempty"`
+	// note_assignments lists the transfers of the loan note, oldest first. The
+	// holder of the note is the lender of the mortgage.
+	NoteAssignments []NoteAssignment `protobuf:"bytes,29,rep,name=note_assignments,json=noteAssignments,proto3" json:"note_assignments"`
+	// syndicate lists the lenders funding a syndicated mortgage with their
+	// contributions, which add up to the amount. It is empty when the lender
+	// funds the mortgage alone. The lender leads the syndicate and acts for it.
+	Syndicate []SyndicateLender `protobuf:"bytes,30,rep,name=syndicate,proto3" json:"syndicate"`
+	// auto_repayment is the lendee's standing instruction to repay the mortgage
+	// automatically, if any.
+	AutoRepayment *AutoRepayment `protobuf:"bytes,31,opt,name=auto_repayment,json=autoRepayment,proto3" json:"auto_repayment,omitempty"`
+	// late_fee_charged is set once the late fee of the current delinquency was
+	// charged and cleared when the mortgage is cured.
+	LateFeeCharged bool `protobuf:"varint,32,opt,name=late_fee_charged,json=lateFeeCharged,proto3" json:"late_fee_charged,omitempty"`
+	// prepayment restricts repaying principal ahead of the schedule. A mortgage
+	// without prepayment terms may be prepaid at any time without penalty.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,33,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+	// prepayment_year is the loan year, counting from zero at funding, that
+	// prepaid_this_year refers to.
+	PrepaymentYear uint32 `protobuf:"varint,34,opt,name=prepayment_year,json=prepaymentYear,proto3" json:"prepayment_year,omitempty"`
+	// prepaid_this_year is the principal prepaid in the prepayment year.
+	PrepaidThisYear uint64 `protobuf:"varint,35,opt,name=prepaid_this_year,json=prepaidThisYear,proto3" json:"prepaid_this_year,omitempty"`
 }
 
 func (m *Mortgage) Reset()         { *m = Mortgage{} }
@@ -145,16 +289,18 @@ func (m *Mortgage) GetAmount() uint64 {
 	return 0
 }
 
-func (m *Mortgage) GetInterestRate() string {
+// Deprecated: Do not use.
+func (m *Mortgage) GetLegacyInterestRate() string {
 	if m != nil {
-		return m.InterestRate
+		return m.LegacyInterestRate
 	}
 	return ""
 }
 
-func (m *Mortgage) GetTerm() string {
+// Deprecated: Do not use.
+func (m *Mortgage) GetLegacyTerm() string {
 	if m != nil {
-		return m.Term
+		return m.LegacyTerm
 	}
 	return ""
 }
@@ -173,194 +319,3618 @@ func (m *Mortgage) GetOutstandingAmount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ardapoc.mortgage.MortgageStatus", MortgageStatus_name, MortgageStatus_value)
-	proto.RegisterType((*Mortgage)(nil), "ardapoc.mortgage.Mortgage")
+func (m *Mortgage) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("ardapoc/mortgage/mortgage.proto", fileDescriptor_247875c39e7208c6) }
-
-var fileDescriptor_247875c39e7208c6 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x8e, 0xd3, 0x30,
-	0x1c, 0xc6, 0xe3, 0x5e, 0x2e, 0x97, 0xfe, 0x75, 0x9c, 0x82, 0x75, 0x42, 0xd6, 0x0d, 0x26, 0xba,
-	0xa9, 0x42, 0xba, 0x44, 0x82, 0x85, 0x35, 0x34, 0x1e, 0x8a, 0x0a, 0x94, 0x14, 0x18, 0xba, 0x20,
-	0x37, 0xb1, 0x42, 0xa4, 0x24, 0xae, 0x1c, 0x47, 0x2a, 0x6f, 0xc0, 0xc8, 0x3b, 0xf0, 0x28, 0x2c,
-	0x8c, 0x1d, 0x19, 0x51, 0xfb, 0x22, 0x28, 0x6e, 0x5a, 0x5a, 0xb6, 0xef, 0xfb, 0x7d, 0xdf, 0x3f,
-	0xb2, 0xe3, 0x3f, 0x3c, 0xe5, 0x2a, 0xe3, 0x2b, 0x99, 0x86, 0x95, 0x54, 0x3a, 0xe7, 0xb9, 0x38,
-	0x8a, 0x60, 0xa5, 0xa4, 0x96, 0xd8, 0xeb, 0x0b, 0xc1, 0x81, 0xdf, 0xdd, 0xe6, 0x32, 0x97, 0x26,
-	0x0c, 0x3b, 0xb5, 0xef, 0xdd, 0xff, 0x1c, 0x80, 0xfb, 0xa6, 0xaf, 0x60, 0x02, 0x57, 0xa9, 0x12,
-	0x5c, 0x4b, 0x45, 0x90, 0x8f, 0x46, 0xc3, 0xe4, 0x60, 0xf1, 0x2d, 0x5c, 0x16, 0x75, 0x26, 0xd6,
-	0x64, 0x60, 0xf8, 0xde, 0xe0, 0x27, 0xe0, 0x94, 0xa2, 0xce, 0x84, 0x22, 0x17, 0x06, 0xf7, 0xee,
-	0xc8, 0x05, 0xb1, 0x4f, 0xb8, 0xc0, 0x14, 0x20, 0x95, 0x65, 0xc9, 0xb5, 0x50, 0xbc, 0x24, 0x97,
-	0x26, 0x3b, 0x21, 0xdd, 0x1c, 0xaf, 0x64, 0x5b, 0x6b, 0xe2, 0xf8, 0x68, 0x64, 0x27, 0xbd, 0xc3,
-	0xf7, 0x70, 0x5d, 0xd4, 0x5a, 0x28, 0xd1, 0xe8, 0x84, 0x6b, 0x41, 0xae, 0xcc, 0xe4, 0x19, 0xc3,
-	0x18, 0x6c, 0x2d, 0x54, 0x45, 0x5c, 0x93, 0x19, 0x8d, 0x5f, 0x82, 0xd3, 0x68, 0xae, 0xdb, 0x86,
-	0x0c, 0x7d, 0x34, 0xba, 0x79, 0xee, 0x07, 0xff, 0xff, 0x95, 0xe0, 0x70, 0xf7, 0xb9, 0xe9, 0x25,
-	0x7d, 0x1f, 0x3f, 0x00, 0x96, 0xad, 0x6e, 0x34, 0xaf, 0xb3, 0xa2, 0xce, 0x3f, 0xf7, 0xa7, 0x02,
-	0x73, 0xaa, 0xc7, 0x27, 0x49, 0x64, 0x82, 0x67, 0x0b, 0xb8, 0x39, 0xff, 0x10, 0x7e, 0x04, 0xc3,
-	0x84, 0xbd, 0xff, 0xc8, 0xe6, 0x1f, 0x58, 0xec, 0x59, 0xf8, 0x1a, 0xdc, 0x68, 0x36, 0x4b, 0xde,
-	0x7d, 0x62, 0xb1, 0x87, 0x3a, 0x97, 0xb0, 0xd7, 0x6c, 0xdc, 0x65, 0x03, 0xec, 0x82, 0x3d, 0x8b,
-	0x26, 0xb1, 0x77, 0xd1, 0x0d, 0x8d, 0xa3, 0xb7, 0x63, 0x36, 0x9d, 0xb2, 0xd8, 0xb3, 0xef, 0xec,
-	0x6f, 0x3f, 0xa8, 0xf5, 0x6a, 0xf2, 0x6b, 0x4b, 0xd1, 0x66, 0x4b, 0xd1, 0x9f, 0x2d, 0x45, 0xdf,
-	0x77, 0xd4, 0xda, 0xec, 0xa8, 0xf5, 0x7b, 0x47, 0xad, 0x45, 0x98, 0x17, 0xfa, 0x4b, 0xbb, 0x0c,
-	0x52, 0x59, 0x85, 0xdd, 0xc5, 0xf2, 0x52, 0x2e, 0x79, 0x69, 0xe4, 0x43, 0xb7, 0x1b, 0xeb, 0x7f,
-	0xdb, 0xa1, 0xbf, 0xae, 0x44, 0xb3, 0x74, 0xcc, 0x9b, 0xbf, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x26, 0x94, 0x00, 0xb6, 0x3e, 0x02, 0x00, 0x00,
+func (m *Mortgage) GetTermMonths() uint32 {
+	if m != nil {
+		return m.TermMonths
+	}
+	return 0
 }
 
-func (m *Mortgage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Mortgage) GetPaymentFrequency() PaymentFrequency {
+	if m != nil {
+		return m.PaymentFrequency
 	}
-	return dAtA[:n], nil
+	return MONTHLY
 }
 
-func (m *Mortgage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Mortgage) GetAmortization() AmortizationType {
+	if m != nil {
+		return m.Amortization
+	}
+	return ANNUITY
 }
 
-func (m *Mortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OutstandingAmount != 0 {
-		i = encodeVarintMortgage(dAtA, i, uint64(m.OutstandingAmount))
-		i--
-		dAtA[i] = 0x50
+func (m *Mortgage) GetStartDate() time.Time {
+	if m != nil {
+		return m.StartDate
 	}
-	if m.Status != 0 {
-		i = encodeVarintMortgage(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x48
+	return time.Time{}
+}
+
+func (m *Mortgage) GetInterestDue() uint64 {
+	if m != nil {
+		return m.InterestDue
 	}
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *Mortgage) GetPeriodsAccrued() uint32 {
+	if m != nil {
+		return m.PeriodsAccrued
 	}
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *Mortgage) GetAmountPaid() uint64 {
+	if m != nil {
+		return m.AmountPaid
 	}
-	if m.Amount != 0 {
-		i = encodeVarintMortgage(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *Mortgage) GetNextDueDate() time.Time {
+	if m != nil {
+		return m.NextDueDate
 	}
-	if len(m.Collateral) > 0 {
-		i -= len(m.Collateral)
-		copy(dAtA[i:], m.Collateral)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Collateral)))
-		i--
-		dAtA[i] = 0x2a
+	return time.Time{}
+}
+
+func (m *Mortgage) GetLateFeesDue() uint64 {
+	if m != nil {
+		return m.LateFeesDue
 	}
-	if len(m.Lendee) > 0 {
-		i -= len(m.Lendee)
-		copy(dAtA[i:], m.Lendee)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Lendee)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *Mortgage) GetReviewTime() time.Time {
+	if m != nil {
+		return m.ReviewTime
 	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0x1a
+	return time.Time{}
+}
+
+func (m *Mortgage) GetEscrowedShares() uint64 {
+	if m != nil {
+		return m.EscrowedShares
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Mortgage) GetAuction() *ForeclosureAuction {
+	if m != nil {
+		return m.Auction
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Mortgage) GetPrincipal() uint64 {
+	if m != nil {
+		return m.Principal
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMortgage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMortgage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Mortgage) GetTermsHistory() []MortgageTerms {
+	if m != nil {
+		return m.TermsHistory
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Mortgage) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Mortgage) GetRefinanceProposal() *MortgageTerms {
+	if m != nil {
+		return m.RefinanceProposal
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return nil
+}
+
+func (m *Mortgage) GetPurchase() *MortgagePurchase {
+	if m != nil {
+		return m.Purchase
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return nil
+}
+
+func (m *Mortgage) GetEquity() bool {
+	if m != nil {
+		return m.Equity
 	}
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return false
+}
+
+func (m *Mortgage) GetNoteAssignments() []NoteAssignment {
+	if m != nil {
+		return m.NoteAssignments
 	}
-	l = len(m.Lendee)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return nil
+}
+
+func (m *Mortgage) GetSyndicate() []SyndicateLender {
+	if m != nil {
+		return m.Syndicate
 	}
-	l = len(m.Collateral)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return nil
+}
+
+func (m *Mortgage) GetAutoRepayment() *AutoRepayment {
+	if m != nil {
+		return m.AutoRepayment
 	}
-	if m.Amount != 0 {
-		n += 1 + sovMortgage(uint64(m.Amount))
+	return nil
+}
+
+func (m *Mortgage) GetLateFeeCharged() bool {
+	if m != nil {
+		return m.LateFeeCharged
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return false
+}
+
+func (m *Mortgage) GetPrepayment() *PrepaymentTerms {
+	if m != nil {
+		return m.Prepayment
 	}
-	l = len(m.Term)
-	if l > 0 {
-		n += 1 + l + sovMortgage(uint64(l))
+	return nil
+}
+
+func (m *Mortgage) GetPrepaymentYear() uint32 {
+	if m != nil {
+		return m.PrepaymentYear
 	}
-	if m.Status != 0 {
-		n += 1 + sovMortgage(uint64(m.Status))
+	return 0
+}
+
+func (m *Mortgage) GetPrepaidThisYear() uint64 {
+	if m != nil {
+		return m.PrepaidThisYear
 	}
-	if m.OutstandingAmount != 0 {
-		n += 1 + sovMortgage(uint64(m.OutstandingAmount))
+	return 0
+}
+
+// AutoRepayment is a lendee's standing instruction to repay a mortgage from an
+// authz SendAuthorization granted to the mortgage module account. What is due
+// is pulled at the end of the block in which an installment falls due.
+type AutoRepayment struct {
+	// next_pull is when the next installment is pulled.
+	NextPull    time.Time `protobuf:"bytes,1,opt,name=next_pull,json=nextPull,proto3,stdtime" json:"next_pull"`
+	Successes   uint32    `protobuf:"varint,2,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures    uint32    `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastAttempt time.Time `protobuf:"bytes,4,opt,name=last_attempt,json=lastAttempt,proto3,stdtime" json:"last_attempt"`
+	LastAmount  uint64    `protobuf:"varint,5,opt,name=last_amount,json=lastAmount,proto3" json:"last_amount,omitempty"`
+	// last_error is why the last pull failed. It is empty if it succeeded.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *AutoRepayment) Reset()         { *m = AutoRepayment{} }
+func (m *AutoRepayment) String() string { return proto.CompactTextString(m) }
+func (*AutoRepayment) ProtoMessage()    {}
+func (*AutoRepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{1}
+}
+func (m *AutoRepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AutoRepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRepayment.Merge(m, src)
+}
+func (m *AutoRepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRepayment.DiscardUnknown(m)
 }
 
-func sovMortgage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_AutoRepayment proto.InternalMessageInfo
+
+func (m *AutoRepayment) GetNextPull() time.Time {
+	if m != nil {
+		return m.NextPull
+	}
+	return time.Time{}
 }
-func sozMortgage(x uint64) (n int) {
-	return sovMortgage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *AutoRepayment) GetSuccesses() uint32 {
+	if m != nil {
+		return m.Successes
+	}
+	return 0
 }
-func (m *Mortgage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *AutoRepayment) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *AutoRepayment) GetLastAttempt() time.Time {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return time.Time{}
+}
+
+func (m *AutoRepayment) GetLastAmount() uint64 {
+	if m != nil {
+		return m.LastAmount
+	}
+	return 0
+}
+
+func (m *AutoRepayment) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+// PrepaymentTerms are the conditions on which the lendee may repay principal
+// ahead of the amortization schedule.
+type PrepaymentTerms struct {
+	// penalty_rate is the penalty charged on the principal prepaid, as a decimal,
+	// e.g. "0.02" for 2%. It is paid to the lenders on top of the repayment.
+	PenaltyRate string `protobuf:"bytes,1,opt,name=penalty_rate,json=penaltyRate,proto3" json:"penalty_rate,omitempty"`
+	// lock_in_months is how long after funding no principal may be prepaid.
+	LockInMonths uint32 `protobuf:"varint,2,opt,name=lock_in_months,json=lockInMonths,proto3" json:"lock_in_months,omitempty"`
+	// max_yearly_prepayment caps the principal prepaid per loan year. Zero means
+	// there is no cap.
+	MaxYearlyPrepayment uint64 `protobuf:"varint,3,opt,name=max_yearly_prepayment,json=maxYearlyPrepayment,proto3" json:"max_yearly_prepayment,omitempty"`
+}
+
+func (m *PrepaymentTerms) Reset()         { *m = PrepaymentTerms{} }
+func (m *PrepaymentTerms) String() string { return proto.CompactTextString(m) }
+func (*PrepaymentTerms) ProtoMessage()    {}
+func (*PrepaymentTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{2}
+}
+func (m *PrepaymentTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepaymentTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepaymentTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrepaymentTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepaymentTerms.Merge(m, src)
+}
+func (m *PrepaymentTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepaymentTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepaymentTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepaymentTerms proto.InternalMessageInfo
+
+func (m *PrepaymentTerms) GetPenaltyRate() string {
+	if m != nil {
+		return m.PenaltyRate
+	}
+	return ""
+}
+
+func (m *PrepaymentTerms) GetLockInMonths() uint32 {
+	if m != nil {
+		return m.LockInMonths
+	}
+	return 0
+}
+
+func (m *PrepaymentTerms) GetMaxYearlyPrepayment() uint64 {
+	if m != nil {
+		return m.MaxYearlyPrepayment
+	}
+	return 0
+}
+
+// SyndicateLender is a member of the syndicate funding a mortgage. Repayments
+// are split among the members in proportion to their contributions.
+type SyndicateLender struct {
+	Lender       string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Contribution uint64 `protobuf:"varint,2,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	// committed is set once the lender agreed to fund its contribution.
+	Committed bool `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
+	// received is the total of the repayments paid to the lender.
+	Received uint64 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *SyndicateLender) Reset()         { *m = SyndicateLender{} }
+func (m *SyndicateLender) String() string { return proto.CompactTextString(m) }
+func (*SyndicateLender) ProtoMessage()    {}
+func (*SyndicateLender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{3}
+}
+func (m *SyndicateLender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyndicateLender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyndicateLender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyndicateLender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyndicateLender.Merge(m, src)
+}
+func (m *SyndicateLender) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyndicateLender) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyndicateLender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyndicateLender proto.InternalMessageInfo
+
+func (m *SyndicateLender) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *SyndicateLender) GetContribution() uint64 {
+	if m != nil {
+		return m.Contribution
+	}
+	return 0
+}
+
+func (m *SyndicateLender) GetCommitted() bool {
+	if m != nil {
+		return m.Committed
+	}
+	return false
+}
+
+func (m *SyndicateLender) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+// NoteAssignment records the transfer of a loan note from one lender to the next.
+type NoteAssignment struct {
+	From string    `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *NoteAssignment) Reset()         { *m = NoteAssignment{} }
+func (m *NoteAssignment) String() string { return proto.CompactTextString(m) }
+func (*NoteAssignment) ProtoMessage()    {}
+func (*NoteAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{4}
+}
+func (m *NoteAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoteAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoteAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoteAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoteAssignment.Merge(m, src)
+}
+func (m *NoteAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoteAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoteAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoteAssignment proto.InternalMessageInfo
+
+func (m *NoteAssignment) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *NoteAssignment) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *NoteAssignment) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// MortgagePurchase is a purchase of property shares financed by a mortgage.
+// The shares bought are the collateral of the mortgage.
+type MortgagePurchase struct {
+	FromOwners []string `protobuf:"bytes,1,rep,name=from_owners,json=fromOwners,proto3" json:"from_owners,omitempty"`
+	FromShares []uint64 `protobuf:"varint,2,rep,packed,name=from_shares,json=fromShares,proto3" json:"from_shares,omitempty"`
+	// price is the total paid to the sellers, split in proportion to the shares
+	// each one sells. The buyer pays what the mortgage does not cover.
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// accepted_by lists the sellers who agreed to sell at the price with
+	// MsgAcceptPurchase. The purchase is only funded once every seller did.
+	AcceptedBy []string `protobuf:"bytes,4,rep,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"`
+}
+
+func (m *MortgagePurchase) Reset()         { *m = MortgagePurchase{} }
+func (m *MortgagePurchase) String() string { return proto.CompactTextString(m) }
+func (*MortgagePurchase) ProtoMessage()    {}
+func (*MortgagePurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{5}
+}
+func (m *MortgagePurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MortgagePurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MortgagePurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MortgagePurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MortgagePurchase.Merge(m, src)
+}
+func (m *MortgagePurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MortgagePurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MortgagePurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MortgagePurchase proto.InternalMessageInfo
+
+func (m *MortgagePurchase) GetFromOwners() []string {
+	if m != nil {
+		return m.FromOwners
+	}
+	return nil
+}
+
+func (m *MortgagePurchase) GetFromShares() []uint64 {
+	if m != nil {
+		return m.FromShares
+	}
+	return nil
+}
+
+func (m *MortgagePurchase) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *MortgagePurchase) GetAcceptedBy() []string {
+	if m != nil {
+		return m.AcceptedBy
+	}
+	return nil
+}
+
+// MortgageTerms is one version of the repayment terms of a mortgage.
+type MortgageTerms struct {
+	Version          uint32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	InterestRate     string           `protobuf:"bytes,2,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,3,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,4,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,5,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// principal is the balance the terms amortize.
+	Principal uint64 `protobuf:"varint,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	// start_date is when the terms took effect.
+	StartDate time.Time `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3,stdtime" json:"start_date"`
+}
+
+func (m *MortgageTerms) Reset()         { *m = MortgageTerms{} }
+func (m *MortgageTerms) String() string { return proto.CompactTextString(m) }
+func (*MortgageTerms) ProtoMessage()    {}
+func (*MortgageTerms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{6}
+}
+func (m *MortgageTerms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MortgageTerms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MortgageTerms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MortgageTerms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MortgageTerms.Merge(m, src)
+}
+func (m *MortgageTerms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MortgageTerms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MortgageTerms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MortgageTerms proto.InternalMessageInfo
+
+func (m *MortgageTerms) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MortgageTerms) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
+	}
+	return ""
+}
+
+func (m *MortgageTerms) GetTermMonths() uint32 {
+	if m != nil {
+		return m.TermMonths
+	}
+	return 0
+}
+
+func (m *MortgageTerms) GetPaymentFrequency() PaymentFrequency {
+	if m != nil {
+		return m.PaymentFrequency
+	}
+	return MONTHLY
+}
+
+func (m *MortgageTerms) GetAmortization() AmortizationType {
+	if m != nil {
+		return m.Amortization
+	}
+	return ANNUITY
+}
+
+func (m *MortgageTerms) GetPrincipal() uint64 {
+	if m != nil {
+		return m.Principal
+	}
+	return 0
+}
+
+func (m *MortgageTerms) GetStartDate() time.Time {
+	if m != nil {
+		return m.StartDate
+	}
+	return time.Time{}
+}
+
+// ForeclosureAuction is an auction of the escrowed collateral of a defaulted
+// mortgage. The highest bid is held by the module account until it closes.
+type ForeclosureAuction struct {
+	EndTime       time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	HighestBidder string    `protobuf:"bytes,2,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	HighestBid    uint64    `protobuf:"varint,3,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`
+}
+
+func (m *ForeclosureAuction) Reset()         { *m = ForeclosureAuction{} }
+func (m *ForeclosureAuction) String() string { return proto.CompactTextString(m) }
+func (*ForeclosureAuction) ProtoMessage()    {}
+func (*ForeclosureAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{7}
+}
+func (m *ForeclosureAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForeclosureAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForeclosureAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForeclosureAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeclosureAuction.Merge(m, src)
+}
+func (m *ForeclosureAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForeclosureAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeclosureAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForeclosureAuction proto.InternalMessageInfo
+
+func (m *ForeclosureAuction) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *ForeclosureAuction) GetHighestBidder() string {
+	if m != nil {
+		return m.HighestBidder
+	}
+	return ""
+}
+
+func (m *ForeclosureAuction) GetHighestBid() uint64 {
+	if m != nil {
+		return m.HighestBid
+	}
+	return 0
+}
+
+// Installment is one payment of a mortgage's amortization schedule.
+type Installment struct {
+	Number    uint32    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DueDate   time.Time `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3,stdtime" json:"due_date"`
+	Payment   uint64    `protobuf:"varint,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Interest  uint64    `protobuf:"varint,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal uint64    `protobuf:"varint,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	// balance is the principal left after the installment.
+	Balance uint64 `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *Installment) Reset()         { *m = Installment{} }
+func (m *Installment) String() string { return proto.CompactTextString(m) }
+func (*Installment) ProtoMessage()    {}
+func (*Installment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_247875c39e7208c6, []int{8}
+}
+func (m *Installment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Installment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Installment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Installment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Installment.Merge(m, src)
+}
+func (m *Installment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Installment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Installment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Installment proto.InternalMessageInfo
+
+func (m *Installment) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Installment) GetDueDate() time.Time {
+	if m != nil {
+		return m.DueDate
+	}
+	return time.Time{}
+}
+
+func (m *Installment) GetPayment() uint64 {
+	if m != nil {
+		return m.Payment
+	}
+	return 0
+}
+
+func (m *Installment) GetInterest() uint64 {
+	if m != nil {
+		return m.Interest
+	}
+	return 0
+}
+
+func (m *Installment) GetPrincipal() uint64 {
+	if m != nil {
+		return m.Principal
+	}
+	return 0
+}
+
+func (m *Installment) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ardapoc.mortgage.MortgageStatus", MortgageStatus_name, MortgageStatus_value)
+	proto.RegisterEnum("ardapoc.mortgage.PaymentFrequency", PaymentFrequency_name, PaymentFrequency_value)
+	proto.RegisterEnum("ardapoc.mortgage.AmortizationType", AmortizationType_name, AmortizationType_value)
+	proto.RegisterType((*Mortgage)(nil), "ardapoc.mortgage.Mortgage")
+	proto.RegisterType((*AutoRepayment)(nil), "ardapoc.mortgage.AutoRepayment")
+	proto.RegisterType((*PrepaymentTerms)(nil), "ardapoc.mortgage.PrepaymentTerms")
+	proto.RegisterType((*SyndicateLender)(nil), "ardapoc.mortgage.SyndicateLender")
+	proto.RegisterType((*NoteAssignment)(nil), "ardapoc.mortgage.NoteAssignment")
+	proto.RegisterType((*MortgagePurchase)(nil), "ardapoc.mortgage.MortgagePurchase")
+	proto.RegisterType((*MortgageTerms)(nil), "ardapoc.mortgage.MortgageTerms")
+	proto.RegisterType((*ForeclosureAuction)(nil), "ardapoc.mortgage.ForeclosureAuction")
+	proto.RegisterType((*Installment)(nil), "ardapoc.mortgage.Installment")
+}
+
+func init() { proto.RegisterFile("ardapoc/mortgage/mortgage.proto", fileDescriptor_247875c39e7208c6) }
+
+var fileDescriptor_247875c39e7208c6 = []byte{
+	// 1667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x94, 0x48, 0x3e, 0xfe, 0xd1, 0x6a, 0xe2, 0xb8, 0x13, 0x35, 0xa1, 0x68, 0x26,
+	0x45, 0x05, 0x03, 0x96, 0x50, 0xa7, 0x07, 0x9f, 0x52, 0x50, 0xe2, 0x2a, 0x66, 0x40, 0x53, 0xf4,
+	0x8a, 0x2a, 0xa0, 0x5e, 0x16, 0xc3, 0xdd, 0x11, 0xb9, 0xe8, 0x72, 0x67, 0x33, 0x33, 0x6b, 0x8b,
+	0xfd, 0x02, 0x2d, 0xd0, 0x4b, 0x2e, 0xbd, 0x14, 0x68, 0x2f, 0xfd, 0x0a, 0xfd, 0x10, 0x39, 0x06,
+	0x45, 0x0f, 0x05, 0x0a, 0xb4, 0x85, 0xfd, 0x31, 0x7a, 0x29, 0x66, 0x76, 0x96, 0x22, 0x29, 0x1b,
+	0x88, 0x82, 0xde, 0xf6, 0xfd, 0xe6, 0xf7, 0xde, 0xbc, 0x99, 0xf7, 0x67, 0xde, 0xc2, 0x01, 0xe1,
+	0x01, 0x49, 0x98, 0x7f, 0x3c, 0x67, 0x5c, 0x4e, 0xc9, 0x94, 0x2e, 0x3f, 0x8e, 0x12, 0xce, 0x24,
+	0x43, 0xb6, 0x21, 0x1c, 0xe5, 0xf8, 0xfe, 0x47, 0x3e, 0x13, 0x73, 0x26, 0x3c, 0xbd, 0x7e, 0x9c,
+	0x09, 0x19, 0x79, 0xff, 0xc1, 0x94, 0x4d, 0x59, 0x86, 0xab, 0x2f, 0x83, 0x1e, 0x4c, 0x19, 0x9b,
+	0x46, 0xf4, 0x58, 0x4b, 0x93, 0xf4, 0xfa, 0x58, 0x86, 0x73, 0x2a, 0x24, 0x99, 0x27, 0x19, 0xa1,
+	0xf3, 0xcf, 0x06, 0x54, 0x5e, 0x18, 0xf3, 0x08, 0x43, 0xd9, 0xe7, 0x94, 0x48, 0xc6, 0xb1, 0xd5,
+	0xb6, 0x0e, 0xab, 0x6e, 0x2e, 0xa2, 0x07, 0xb0, 0x1d, 0xc6, 0x01, 0xbd, 0xc1, 0x05, 0x8d, 0x67,
+	0x02, 0x7a, 0x08, 0x3b, 0x11, 0x8d, 0x03, 0xca, 0x71, 0x51, 0xc3, 0x46, 0x5a, 0xe2, 0x14, 0x97,
+	0x56, 0x70, 0x8a, 0x5a, 0x00, 0x3e, 0x8b, 0x22, 0x22, 0x29, 0x27, 0x11, 0xde, 0xd6, 0x6b, 0x2b,
+	0x88, 0xd2, 0x23, 0x73, 0x96, 0xc6, 0x12, 0xef, 0xb4, 0xad, 0xc3, 0x92, 0x6b, 0x24, 0xf4, 0x73,
+	0x78, 0x10, 0xd1, 0x29, 0xf1, 0x17, 0x5e, 0x18, 0x4b, 0xca, 0xa9, 0x90, 0x1e, 0x27, 0x92, 0xe2,
+	0xb2, 0xb2, 0x70, 0x52, 0xc0, 0x96, 0x8b, 0xb2, 0xf5, 0xbe, 0x59, 0x76, 0x89, 0xa4, 0xe8, 0x53,
+	0xa8, 0x19, 0x2d, 0x49, 0xf9, 0x1c, 0x57, 0x96, 0x64, 0xc8, 0xe0, 0x31, 0xe5, 0x73, 0xf4, 0x0c,
+	0x76, 0x84, 0x24, 0x32, 0x15, 0xb8, 0xda, 0xb6, 0x0e, 0x9b, 0x4f, 0xdb, 0x47, 0x9b, 0x97, 0x7e,
+	0x94, 0x5f, 0xcf, 0x85, 0xe6, 0xb9, 0x86, 0x8f, 0x9e, 0x00, 0x62, 0xa9, 0x14, 0x92, 0xc4, 0x41,
+	0x18, 0x4f, 0x3d, 0xe3, 0x38, 0x68, 0xc7, 0xf7, 0x56, 0x56, 0xba, 0xd9, 0x19, 0x3e, 0x87, 0xc6,
+	0xba, 0xf3, 0x35, 0xed, 0x4f, 0xf3, 0x6f, 0x7f, 0x7d, 0x02, 0x26, 0x90, 0x3d, 0xea, 0xbb, 0xf5,
+	0x70, 0xf5, 0x08, 0x07, 0x50, 0x53, 0xbe, 0x7b, 0x73, 0x16, 0xcb, 0x99, 0xc0, 0xf5, 0xb6, 0x75,
+	0xd8, 0x70, 0x41, 0x41, 0x2f, 0x34, 0x82, 0xce, 0x61, 0x2f, 0x21, 0x8b, 0x39, 0x8d, 0xa5, 0x77,
+	0xcd, 0xe9, 0xd7, 0x29, 0x8d, 0xfd, 0x05, 0x6e, 0xe8, 0x93, 0x74, 0xee, 0x9e, 0x64, 0x94, 0x51,
+	0xcf, 0x72, 0xa6, 0x6b, 0x27, 0x1b, 0x08, 0x3a, 0x83, 0x3a, 0x51, 0xfc, 0xf0, 0x37, 0x44, 0x86,
+	0x2c, 0xc6, 0xcd, 0xf7, 0xd9, 0xea, 0xae, 0xb0, 0xc6, 0x8b, 0x84, 0xba, 0x6b, 0x7a, 0xe8, 0x14,
+	0x40, 0x48, 0xc2, 0xa5, 0x17, 0xa8, 0xb3, 0xee, 0xb6, 0xad, 0xc3, 0xda, 0xd3, 0xfd, 0xa3, 0x2c,
+	0x1b, 0x8f, 0xf2, 0x6c, 0x3c, 0x1a, 0xe7, 0xd9, 0x78, 0x52, 0xf9, 0xf6, 0x5f, 0x07, 0x5b, 0xdf,
+	0xfc, 0xfb, 0xc0, 0x72, 0xab, 0x5a, 0xaf, 0xa7, 0x8e, 0xff, 0x08, 0x96, 0xd7, 0xe1, 0x05, 0x29,
+	0xc5, 0xb6, 0xbe, 0xdc, 0x5a, 0x8e, 0xf5, 0x52, 0x8a, 0x7e, 0x0a, 0xbb, 0x09, 0xe5, 0x21, 0x0b,
+	0x84, 0x47, 0x7c, 0x9f, 0xa7, 0x34, 0xc0, 0x7b, 0xfa, 0x96, 0x9a, 0x06, 0xee, 0x66, 0xa8, 0xba,
+	0xca, 0x2c, 0x44, 0x5e, 0x42, 0xc2, 0x00, 0x23, 0x6d, 0x0a, 0x32, 0x68, 0x44, 0xc2, 0x00, 0x3d,
+	0x87, 0x46, 0x4c, 0x6f, 0xf4, 0x46, 0x99, 0xd3, 0x1f, 0xdc, 0xc3, 0xe9, 0x9a, 0x52, 0xed, 0xa5,
+	0x54, 0xbb, 0xdd, 0x81, 0x86, 0xca, 0x68, 0xef, 0x9a, 0x52, 0xa1, 0xfd, 0x7e, 0x90, 0xf9, 0xad,
+	0xc0, 0x33, 0x4a, 0x85, 0xf2, 0xdb, 0x81, 0x1a, 0xa7, 0xaf, 0x42, 0xfa, 0xda, 0x53, 0x15, 0x89,
+	0x3f, 0xbc, 0xc7, 0x5e, 0x90, 0x29, 0xaa, 0x25, 0x75, 0x7c, 0x2a, 0x7c, 0xce, 0x5e, 0xd3, 0xc0,
+	0x13, 0x33, 0xc2, 0xa9, 0xc0, 0x0f, 0xf5, 0x66, 0xcd, 0x1c, 0xbe, 0xd0, 0x28, 0xfa, 0x02, 0xca,
+	0x24, 0xf5, 0x75, 0x48, 0x7f, 0xa4, 0xf7, 0xfa, 0xec, 0x6e, 0x48, 0xcf, 0x18, 0xa7, 0x7e, 0xc4,
+	0x44, 0xca, 0x69, 0x37, 0xe3, 0xba, 0xb9, 0x12, 0xfa, 0x18, 0xaa, 0x09, 0x0f, 0x63, 0x3f, 0x4c,
+	0x48, 0x84, 0xb1, 0xde, 0xe2, 0x16, 0x40, 0x5f, 0x41, 0x43, 0x25, 0xa5, 0xf0, 0x66, 0xa1, 0x90,
+	0x8c, 0x2f, 0xf0, 0x47, 0xed, 0xe2, 0x61, 0xed, 0xe9, 0xc1, 0xfb, 0x8b, 0x49, 0x15, 0x9f, 0x38,
+	0x29, 0xa9, 0x43, 0xb9, 0x75, 0xad, 0xfb, 0x3c, 0x53, 0x45, 0x43, 0x40, 0x9c, 0x5e, 0x87, 0x31,
+	0x89, 0x7d, 0xaa, 0x1a, 0x5d, 0xc2, 0x04, 0x89, 0xf0, 0x7e, 0xdb, 0xfa, 0x1e, 0x06, 0xdd, 0xbd,
+	0xa5, 0xea, 0xc8, 0x68, 0xa2, 0x2f, 0xa0, 0x92, 0xa4, 0xdc, 0x9f, 0x11, 0x41, 0xf1, 0x8f, 0xb5,
+	0x95, 0xce, 0xfb, 0xad, 0x8c, 0x0c, 0xd3, 0x5d, 0xea, 0xa8, 0xa6, 0x44, 0xbf, 0x4e, 0x43, 0xb9,
+	0xc0, 0x1f, 0xb7, 0xad, 0xc3, 0x8a, 0x6b, 0x24, 0xf4, 0x12, 0xec, 0x98, 0x49, 0xea, 0x11, 0x21,
+	0xc2, 0x69, 0xac, 0xaa, 0x48, 0xe0, 0x4f, 0xf4, 0xb1, 0xdf, 0xd1, 0x43, 0x86, 0x4c, 0xd2, 0xee,
+	0x92, 0x68, 0xce, 0xbd, 0x1b, 0xaf, 0xa1, 0x02, 0x39, 0x50, 0x15, 0x8b, 0x38, 0x08, 0x7d, 0x95,
+	0x7e, 0x2d, 0x6d, 0xeb, 0xd1, 0x5d, 0x5b, 0x17, 0x39, 0x65, 0xa0, 0xbb, 0xad, 0x31, 0x76, 0xab,
+	0x89, 0xce, 0xa0, 0x49, 0x52, 0xc9, 0x3c, 0x4e, 0x4d, 0x79, 0xe3, 0x83, 0xf7, 0xdd, 0x5e, 0x37,
+	0x95, 0xcc, 0xcd, 0x69, 0x6e, 0x83, 0xac, 0x8a, 0xe8, 0x10, 0xec, 0x3c, 0x8f, 0x3d, 0x7f, 0x46,
+	0xf8, 0x94, 0x06, 0xb8, 0xad, 0xef, 0xa0, 0x69, 0x52, 0xf9, 0x34, 0x43, 0x51, 0x17, 0x20, 0xb9,
+	0xdd, 0xed, 0x51, 0xdb, 0x7a, 0xb7, 0xe7, 0xa3, 0x25, 0x27, 0x8b, 0xd6, 0x8a, 0x92, 0x2e, 0xe4,
+	0xa5, 0xe4, 0x2d, 0x28, 0xe1, 0xb8, 0x63, 0x0a, 0x79, 0x09, 0x5f, 0x51, 0xc2, 0xd1, 0x63, 0xd8,
+	0xd3, 0x48, 0x18, 0x78, 0x72, 0x16, 0x8a, 0x8c, 0xfa, 0xa9, 0xce, 0xc8, 0x5d, 0xb3, 0x30, 0x9e,
+	0x85, 0x42, 0x71, 0x3b, 0x7f, 0x28, 0x40, 0x63, 0xed, 0x88, 0xa8, 0x0b, 0x55, 0x5d, 0xe5, 0x49,
+	0x1a, 0x45, 0xd8, 0xba, 0x47, 0xd5, 0x55, 0x94, 0xda, 0x28, 0x8d, 0x22, 0x55, 0x0a, 0x22, 0xf5,
+	0x7d, 0x2a, 0x04, 0x15, 0xfa, 0x3d, 0x6c, 0xb8, 0xb7, 0x00, 0xda, 0x87, 0xca, 0x35, 0x09, 0xa3,
+	0x54, 0x95, 0x62, 0x51, 0x2f, 0x2e, 0x65, 0xf4, 0x25, 0xd4, 0x23, 0x22, 0xa4, 0x47, 0xa4, 0xa4,
+	0xf3, 0x44, 0xe2, 0xd2, 0x3d, 0xf6, 0xaf, 0x29, 0xcd, 0x6e, 0xa6, 0xa8, 0x9a, 0x59, 0x66, 0x28,
+	0x7b, 0x74, 0xb6, 0xb3, 0x66, 0xa6, 0x19, 0x1a, 0x41, 0x9f, 0x80, 0x96, 0x3c, 0xca, 0x39, 0xe3,
+	0xfa, 0x35, 0xad, 0xba, 0x55, 0x85, 0x38, 0x0a, 0xe8, 0xfc, 0xd9, 0x82, 0xdd, 0x8d, 0x60, 0xa0,
+	0x9f, 0x41, 0x3d, 0xa1, 0x31, 0x89, 0xe4, 0x22, 0x7b, 0x9f, 0xac, 0x77, 0xbe, 0x4f, 0x35, 0xc3,
+	0xd1, 0xcf, 0xd3, 0x67, 0xd0, 0x8c, 0x98, 0xff, 0x6b, 0x2f, 0x8c, 0xf3, 0x17, 0x2a, 0xbb, 0x8e,
+	0xba, 0x42, 0xfb, 0xb1, 0x79, 0xa3, 0x9e, 0xc2, 0x87, 0x73, 0x72, 0xa3, 0xe3, 0x14, 0x2d, 0xbc,
+	0x95, 0x3c, 0x29, 0x6a, 0xb7, 0x3f, 0x98, 0x93, 0x9b, 0x2b, 0xbd, 0x76, 0xeb, 0x51, 0xe7, 0xb7,
+	0x16, 0xec, 0x6e, 0xe4, 0xf9, 0xca, 0xb4, 0x61, 0xad, 0x4d, 0x1b, 0x1d, 0xa8, 0xfb, 0x2c, 0x96,
+	0x3c, 0x9c, 0xa4, 0xba, 0xbf, 0x15, 0xb4, 0xd9, 0x35, 0x4c, 0xc5, 0xcc, 0x67, 0xf3, 0x79, 0x28,
+	0x25, 0x0d, 0xf4, 0xbe, 0x15, 0xf7, 0x16, 0x50, 0x31, 0xe3, 0xd4, 0xa7, 0xe1, 0x2b, 0x1a, 0xe8,
+	0x98, 0x94, 0xdc, 0xa5, 0xdc, 0x89, 0xa1, 0xb9, 0x5e, 0xbc, 0x08, 0x41, 0xe9, 0x9a, 0xb3, 0xb9,
+	0xf1, 0x42, 0x7f, 0xa3, 0x26, 0x14, 0x24, 0x33, 0xc3, 0x51, 0x41, 0x32, 0xf4, 0x0c, 0x4a, 0xba,
+	0xaf, 0x17, 0xef, 0x11, 0x61, 0xad, 0xd1, 0xf9, 0xbd, 0x05, 0xf6, 0x66, 0x37, 0x52, 0xf1, 0x56,
+	0xdb, 0x78, 0xec, 0x75, 0x4c, 0xb9, 0xc0, 0x56, 0xbb, 0xa8, 0x26, 0x27, 0x05, 0x9d, 0x6b, 0x64,
+	0x49, 0x30, 0x6f, 0x40, 0xa1, 0x5d, 0x54, 0x09, 0xa1, 0x20, 0xd3, 0xff, 0x1f, 0xc0, 0x76, 0xc2,
+	0x43, 0x9f, 0x9a, 0x4b, 0xcf, 0x04, 0xa5, 0x46, 0x7c, 0x9f, 0x26, 0x92, 0x06, 0xde, 0x64, 0x81,
+	0x4b, 0x99, 0xdd, 0x1c, 0x3a, 0x59, 0x74, 0xfe, 0x5b, 0x80, 0xc6, 0x5a, 0x87, 0x55, 0x33, 0xe2,
+	0x2b, 0xca, 0x85, 0xba, 0x68, 0x4b, 0x07, 0x3b, 0x17, 0xef, 0x4e, 0x38, 0x85, 0xfb, 0x4f, 0x38,
+	0xc5, 0xef, 0x37, 0xe1, 0x94, 0xfe, 0x8f, 0x13, 0xce, 0xf6, 0x0f, 0x9c, 0x70, 0xd6, 0x5e, 0xc4,
+	0x9d, 0xcd, 0x17, 0x71, 0x7d, 0xfe, 0x29, 0xff, 0xa0, 0xf9, 0xa7, 0xf3, 0x27, 0x0b, 0xd0, 0xdd,
+	0x47, 0x19, 0xfd, 0x02, 0x2a, 0x34, 0x0e, 0xb2, 0xc1, 0xe1, 0x3e, 0x2d, 0xac, 0x4c, 0xe3, 0x40,
+	0xe1, 0xe8, 0x27, 0xd0, 0x9c, 0x85, 0xd3, 0x99, 0x0a, 0xd4, 0x24, 0x0c, 0x54, 0x45, 0x65, 0x99,
+	0xdb, 0x30, 0xe8, 0x89, 0x06, 0x55, 0x6c, 0x56, 0x68, 0x26, 0x73, 0xe0, 0x96, 0xd3, 0xf9, 0xbb,
+	0x05, 0xb5, 0x7e, 0x2c, 0x24, 0x89, 0x22, 0x5d, 0x19, 0x0f, 0x61, 0x27, 0x4e, 0xe7, 0x13, 0x53,
+	0xa1, 0x0d, 0xd7, 0x48, 0xca, 0xe1, 0xe5, 0x54, 0x55, 0xb8, 0x8f, 0xc3, 0x81, 0x99, 0xa8, 0x30,
+	0x94, 0xd7, 0x9b, 0x46, 0x2e, 0xaa, 0xd2, 0xcd, 0xf3, 0x29, 0x2f, 0xdd, 0x5c, 0x5e, 0x8f, 0xd0,
+	0xf6, 0x66, 0x84, 0x30, 0x94, 0x27, 0x24, 0x52, 0xa3, 0x82, 0x89, 0x5e, 0x2e, 0x3e, 0xfe, 0xa3,
+	0x05, 0xcd, 0xf5, 0xa1, 0x1f, 0x35, 0xa0, 0xea, 0x3a, 0x2f, 0x2f, 0x9d, 0x8b, 0xb1, 0xd3, 0xb3,
+	0xb7, 0x50, 0x1d, 0x2a, 0xdd, 0xd1, 0xc8, 0x3d, 0xff, 0xa5, 0xd3, 0xb3, 0x2d, 0x25, 0xb9, 0xce,
+	0x57, 0xce, 0xa9, 0x5a, 0x2b, 0xa0, 0x0a, 0x94, 0x46, 0xdd, 0x7e, 0xcf, 0x2e, 0x2a, 0xa5, 0xd3,
+	0xee, 0xf0, 0xd4, 0x19, 0x0c, 0x9c, 0x9e, 0x5d, 0x42, 0x4d, 0x80, 0x9e, 0x33, 0xe8, 0x0f, 0x5f,
+	0x5e, 0x3a, 0xc3, 0xb1, 0xbd, 0xad, 0x96, 0x7b, 0xce, 0x59, 0xf7, 0x72, 0xa0, 0xf4, 0x76, 0xd0,
+	0x2e, 0xd4, 0xce, 0xce, 0x5d, 0xe7, 0x74, 0x70, 0x7e, 0xd1, 0x1f, 0x7e, 0x69, 0x97, 0x15, 0x3f,
+	0x07, 0x9c, 0x9e, 0x5d, 0xd9, 0x2f, 0xfd, 0xee, 0x2f, 0xad, 0xad, 0xc7, 0x97, 0x60, 0x6f, 0x26,
+	0x39, 0xaa, 0x41, 0xf9, 0xc5, 0xf9, 0x70, 0xfc, 0x7c, 0x70, 0x65, 0x6f, 0x29, 0xb3, 0x2f, 0x2f,
+	0xbb, 0xee, 0xd8, 0x71, 0x07, 0x57, 0xb6, 0x85, 0xf6, 0xa0, 0x71, 0xe1, 0xbc, 0xe8, 0x7b, 0xdd,
+	0xe1, 0xf0, 0xb2, 0x3b, 0x18, 0x5c, 0xd9, 0x05, 0xed, 0x7d, 0x2e, 0x15, 0x8d, 0xd9, 0x67, 0x60,
+	0x6f, 0xe6, 0xbb, 0x32, 0xab, 0x78, 0xfd, 0xb1, 0x32, 0xbb, 0x07, 0x8d, 0xfe, 0x70, 0xec, 0xb8,
+	0xce, 0xc5, 0xd8, 0x3b, 0x1f, 0x2a, 0xd3, 0x99, 0xe6, 0x49, 0xff, 0xdb, 0x37, 0x2d, 0xeb, 0xbb,
+	0x37, 0x2d, 0xeb, 0x3f, 0x6f, 0x5a, 0xd6, 0x37, 0x6f, 0x5b, 0x5b, 0xdf, 0xbd, 0x6d, 0x6d, 0xfd,
+	0xe3, 0x6d, 0x6b, 0xeb, 0x57, 0xc7, 0xd3, 0x50, 0xce, 0xd2, 0xc9, 0x91, 0xcf, 0xe6, 0xc7, 0xaa,
+	0xba, 0xa6, 0x11, 0x9b, 0x90, 0x48, 0x7f, 0x3e, 0x51, 0xff, 0xbd, 0x37, 0xb7, 0x7f, 0xbe, 0x72,
+	0x91, 0x50, 0x31, 0xd9, 0xd1, 0xd9, 0xf0, 0xf9, 0xff, 0x06, 0x00, 0xe3, 0x71, 0xa7, 0x3c, 0x1a,
+	0x0f, 0x00, 0x00,
+}
+
+func (m *Mortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Mortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrepaidThisYear != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.PrepaidThisYear))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.PrepaymentYear != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.PrepaymentYear))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Prepayment != nil {
+		{
+			size, err := m.Prepayment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMortgage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.LateFeeCharged {
+		i--
+		if m.LateFeeCharged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.AutoRepayment != nil {
+		{
+			size, err := m.AutoRepayment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMortgage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.Syndicate) > 0 {
+		for iNdEx := len(m.Syndicate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Syndicate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMortgage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.NoteAssignments) > 0 {
+		for iNdEx := len(m.NoteAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NoteAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMortgage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.Equity {
+		i--
+		if m.Equity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.Purchase != nil {
+		{
+			size, err := m.Purchase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMortgage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.RefinanceProposal != nil {
+		{
+			size, err := m.RefinanceProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMortgage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.TermsHistory) > 0 {
+		for iNdEx := len(m.TermsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TermsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMortgage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.Principal != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Principal))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMortgage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.EscrowedShares != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.EscrowedShares))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReviewTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReviewTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMortgage(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.LateFeesDue != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.LateFeesDue))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextDueDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDueDate):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMortgage(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.AmountPaid != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.AmountPaid))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PeriodsAccrued != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.PeriodsAccrued))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.InterestDue != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.InterestDue))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartDate):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMortgage(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x7a
+	if m.Amortization != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.OutstandingAmount != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.OutstandingAmount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.LegacyTerm) > 0 {
+		i -= len(m.LegacyTerm)
+		copy(dAtA[i:], m.LegacyTerm)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.LegacyTerm)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LegacyInterestRate) > 0 {
+		i -= len(m.LegacyInterestRate)
+		copy(dAtA[i:], m.LegacyInterestRate)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.LegacyInterestRate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastAmount != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.LastAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastAttempt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAttempt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMortgage(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.Failures != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Successes != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Successes))
+		i--
+		dAtA[i] = 0x10
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextPull, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPull):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMortgage(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepaymentTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepaymentTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepaymentTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxYearlyPrepayment != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.MaxYearlyPrepayment))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LockInMonths != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.LockInMonths))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PenaltyRate) > 0 {
+		i -= len(m.PenaltyRate)
+		copy(dAtA[i:], m.PenaltyRate)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.PenaltyRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyndicateLender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyndicateLender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyndicateLender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Received != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Committed {
+		i--
+		if m.Committed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Contribution != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Contribution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NoteAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoteAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoteAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintMortgage(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MortgagePurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MortgagePurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MortgagePurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcceptedBy) > 0 {
+		for iNdEx := len(m.AcceptedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedBy[iNdEx])
+			copy(dAtA[i:], m.AcceptedBy[iNdEx])
+			i = encodeVarintMortgage(dAtA, i, uint64(len(m.AcceptedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Price != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FromShares) > 0 {
+		dAtA13 := make([]byte, len(m.FromShares)*10)
+		var j12 int
+		for _, num := range m.FromShares {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintMortgage(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromOwners) > 0 {
+		for iNdEx := len(m.FromOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FromOwners[iNdEx])
+			copy(dAtA[i:], m.FromOwners[iNdEx])
+			i = encodeVarintMortgage(dAtA, i, uint64(len(m.FromOwners[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MortgageTerms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MortgageTerms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MortgageTerms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartDate):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintMortgage(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	if m.Principal != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Principal))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Amortization != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForeclosureAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeclosureAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForeclosureAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HighestBid != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.HighestBid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HighestBidder) > 0 {
+		i -= len(m.HighestBidder)
+		copy(dAtA[i:], m.HighestBidder)
+		i = encodeVarintMortgage(dAtA, i, uint64(len(m.HighestBidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMortgage(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Installment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Installment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Installment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Principal != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Principal))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interest != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Interest))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Payment != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Payment))
+		i--
+		dAtA[i] = 0x18
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DueDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DueDate):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintMortgage(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	if m.Number != 0 {
+		i = encodeVarintMortgage(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMortgage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMortgage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Mortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovMortgage(uint64(m.Amount))
+	}
+	l = len(m.LegacyInterestRate)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.LegacyTerm)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMortgage(uint64(m.Status))
+	}
+	if m.OutstandingAmount != 0 {
+		n += 1 + sovMortgage(uint64(m.OutstandingAmount))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovMortgage(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovMortgage(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovMortgage(uint64(m.Amortization))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartDate)
+	n += 1 + l + sovMortgage(uint64(l))
+	if m.InterestDue != 0 {
+		n += 2 + sovMortgage(uint64(m.InterestDue))
+	}
+	if m.PeriodsAccrued != 0 {
+		n += 2 + sovMortgage(uint64(m.PeriodsAccrued))
+	}
+	if m.AmountPaid != 0 {
+		n += 2 + sovMortgage(uint64(m.AmountPaid))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextDueDate)
+	n += 2 + l + sovMortgage(uint64(l))
+	if m.LateFeesDue != 0 {
+		n += 2 + sovMortgage(uint64(m.LateFeesDue))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReviewTime)
+	n += 2 + l + sovMortgage(uint64(l))
+	if m.EscrowedShares != 0 {
+		n += 2 + sovMortgage(uint64(m.EscrowedShares))
+	}
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 2 + l + sovMortgage(uint64(l))
+	}
+	if m.Principal != 0 {
+		n += 2 + sovMortgage(uint64(m.Principal))
+	}
+	if len(m.TermsHistory) > 0 {
+		for _, e := range m.TermsHistory {
+			l = e.Size()
+			n += 2 + l + sovMortgage(uint64(l))
+		}
+	}
+	if m.RefinanceProposal != nil {
+		l = m.RefinanceProposal.Size()
+		n += 2 + l + sovMortgage(uint64(l))
+	}
+	if m.Purchase != nil {
+		l = m.Purchase.Size()
+		n += 2 + l + sovMortgage(uint64(l))
+	}
+	if m.Equity {
+		n += 3
+	}
+	if len(m.NoteAssignments) > 0 {
+		for _, e := range m.NoteAssignments {
+			l = e.Size()
+			n += 2 + l + sovMortgage(uint64(l))
+		}
+	}
+	if len(m.Syndicate) > 0 {
+		for _, e := range m.Syndicate {
+			l = e.Size()
+			n += 2 + l + sovMortgage(uint64(l))
+		}
+	}
+	if m.AutoRepayment != nil {
+		l = m.AutoRepayment.Size()
+		n += 2 + l + sovMortgage(uint64(l))
+	}
+	if m.LateFeeCharged {
+		n += 3
+	}
+	if m.Prepayment != nil {
+		l = m.Prepayment.Size()
+		n += 2 + l + sovMortgage(uint64(l))
+	}
+	if m.PrepaymentYear != 0 {
+		n += 2 + sovMortgage(uint64(m.PrepaymentYear))
+	}
+	if m.PrepaidThisYear != 0 {
+		n += 2 + sovMortgage(uint64(m.PrepaidThisYear))
+	}
+	return n
+}
+
+func (m *AutoRepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPull)
+	n += 1 + l + sovMortgage(uint64(l))
+	if m.Successes != 0 {
+		n += 1 + sovMortgage(uint64(m.Successes))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovMortgage(uint64(m.Failures))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastAttempt)
+	n += 1 + l + sovMortgage(uint64(l))
+	if m.LastAmount != 0 {
+		n += 1 + sovMortgage(uint64(m.LastAmount))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	return n
+}
+
+func (m *PrepaymentTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PenaltyRate)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.LockInMonths != 0 {
+		n += 1 + sovMortgage(uint64(m.LockInMonths))
+	}
+	if m.MaxYearlyPrepayment != 0 {
+		n += 1 + sovMortgage(uint64(m.MaxYearlyPrepayment))
+	}
+	return n
+}
+
+func (m *SyndicateLender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.Contribution != 0 {
+		n += 1 + sovMortgage(uint64(m.Contribution))
+	}
+	if m.Committed {
+		n += 2
+	}
+	if m.Received != 0 {
+		n += 1 + sovMortgage(uint64(m.Received))
+	}
+	return n
+}
+
+func (m *NoteAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMortgage(uint64(l))
+	return n
+}
+
+func (m *MortgagePurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FromOwners) > 0 {
+		for _, s := range m.FromOwners {
+			l = len(s)
+			n += 1 + l + sovMortgage(uint64(l))
+		}
+	}
+	if len(m.FromShares) > 0 {
+		l = 0
+		for _, e := range m.FromShares {
+			l += sovMortgage(uint64(e))
+		}
+		n += 1 + sovMortgage(uint64(l)) + l
+	}
+	if m.Price != 0 {
+		n += 1 + sovMortgage(uint64(m.Price))
+	}
+	if len(m.AcceptedBy) > 0 {
+		for _, s := range m.AcceptedBy {
+			l = len(s)
+			n += 1 + l + sovMortgage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MortgageTerms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMortgage(uint64(m.Version))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovMortgage(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovMortgage(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovMortgage(uint64(m.Amortization))
+	}
+	if m.Principal != 0 {
+		n += 1 + sovMortgage(uint64(m.Principal))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartDate)
+	n += 1 + l + sovMortgage(uint64(l))
+	return n
+}
+
+func (m *ForeclosureAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMortgage(uint64(l))
+	l = len(m.HighestBidder)
+	if l > 0 {
+		n += 1 + l + sovMortgage(uint64(l))
+	}
+	if m.HighestBid != 0 {
+		n += 1 + sovMortgage(uint64(m.HighestBid))
+	}
+	return n
+}
+
+func (m *Installment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovMortgage(uint64(m.Number))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DueDate)
+	n += 1 + l + sovMortgage(uint64(l))
+	if m.Payment != 0 {
+		n += 1 + sovMortgage(uint64(m.Payment))
+	}
+	if m.Interest != 0 {
+		n += 1 + sovMortgage(uint64(m.Interest))
+	}
+	if m.Principal != 0 {
+		n += 1 + sovMortgage(uint64(m.Principal))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovMortgage(uint64(m.Balance))
+	}
+	return n
+}
+
+func sovMortgage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMortgage(x uint64) (n int) {
+	return sovMortgage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Mortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTerm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyTerm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MortgageStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingAmount", wireType)
+			}
+			m.OutstandingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+			}
+			m.TermMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+			}
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+			}
+			m.Amortization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amortization |= AmortizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestDue", wireType)
+			}
+			m.InterestDue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestDue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodsAccrued", wireType)
+			}
+			m.PeriodsAccrued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodsAccrued |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPaid", wireType)
+			}
+			m.AmountPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDueDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextDueDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFeesDue", wireType)
+			}
+			m.LateFeesDue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateFeesDue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReviewTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedShares", wireType)
+			}
+			m.EscrowedShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowedShares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &ForeclosureAuction{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			m.Principal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Principal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHistory = append(m.TermsHistory, MortgageTerms{})
+			if err := m.TermsHistory[len(m.TermsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefinanceProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefinanceProposal == nil {
+				m.RefinanceProposal = &MortgageTerms{}
+			}
+			if err := m.RefinanceProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Purchase == nil {
+				m.Purchase = &MortgagePurchase{}
+			}
+			if err := m.Purchase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Equity = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoteAssignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoteAssignments = append(m.NoteAssignments, NoteAssignment{})
+			if err := m.NoteAssignments[len(m.NoteAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syndicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Syndicate = append(m.Syndicate, SyndicateLender{})
+			if err := m.Syndicate[len(m.Syndicate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRepayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRepayment == nil {
+				m.AutoRepayment = &AutoRepayment{}
+			}
+			if err := m.AutoRepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFeeCharged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LateFeeCharged = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prepayment == nil {
+				m.Prepayment = &PrepaymentTerms{}
+			}
+			if err := m.Prepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaymentYear", wireType)
+			}
+			m.PrepaymentYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepaymentYear |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidThisYear", wireType)
+			}
+			m.PrepaidThisYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepaidThisYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRepayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRepayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPull", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextPull, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successes", wireType)
+			}
+			m.Successes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastAttempt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAmount", wireType)
+			}
+			m.LastAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepaymentTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepaymentTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepaymentTerms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockInMonths", wireType)
+			}
+			m.LockInMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockInMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxYearlyPrepayment", wireType)
+			}
+			m.MaxYearlyPrepayment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxYearlyPrepayment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyndicateLender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyndicateLender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyndicateLender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
+			}
+			m.Contribution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Contribution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Committed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoteAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoteAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoteAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MortgagePurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -382,15 +3952,243 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mortgage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MortgagePurchase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MortgagePurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromOwners = append(m.FromOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMortgage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FromShares = append(m.FromShares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMortgage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMortgage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMortgage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FromShares) == 0 {
+					m.FromShares = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMortgage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FromShares = append(m.FromShares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromShares", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedBy = append(m.AcceptedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MortgageTerms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MortgageTerms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MortgageTerms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -418,13 +4216,89 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+			}
+			m.TermMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+			}
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+			}
+			m.Amortization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amortization |= AmortizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			m.Principal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Principal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -434,29 +4308,80 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMortgage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMortgage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMortgage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeclosureAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeclosureAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeclosureAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -466,27 +4391,28 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMortgage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMortgage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -514,13 +4440,13 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lendee = string(dAtA[iNdEx:postIndex])
+			m.HighestBidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
 			}
-			var stringLen uint64
+			m.HighestBid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -530,29 +4456,66 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HighestBid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMortgage
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMortgage(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMortgage
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Installment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMortgage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Installment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Installment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.Amount = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -562,16 +4525,16 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Number |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -581,29 +4544,30 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMortgage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMortgage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DueDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
 			}
-			var stringLen uint64
+			m.Payment = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -613,29 +4577,35 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Payment |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMortgage
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMortgage
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Interest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMortgage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Term = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
 			}
-			m.Status = 0
+			m.Principal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -645,16 +4615,16 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MortgageStatus(b&0x7F) << shift
+				m.Principal |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.OutstandingAmount = 0
+			m.Balance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMortgage
@@ -664,7 +4634,7 @@ func (m *Mortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutstandingAmount |= uint64(b&0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
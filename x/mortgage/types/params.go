@@ -1,32 +1,186 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyGracePeriod     = []byte("GracePeriod")
+	DefaultGracePeriod = 15 * 24 * time.Hour
+
+	KeyLateFeeRate     = []byte("LateFeeRate")
+	DefaultLateFeeRate = "0.05"
+
+	KeyDefaultPeriod     = []byte("DefaultPeriod")
+	DefaultDefaultPeriod = 90 * 24 * time.Hour
+
+	KeyAuctionDuration     = []byte("AuctionDuration")
+	DefaultAuctionDuration = 7 * 24 * time.Hour
+
+	KeyMaxLoanToValue     = []byte("MaxLoanToValue")
+	DefaultMaxLoanToValue = "0.8"
+
+	KeyVaultInterestShare     = []byte("VaultInterestShare")
+	DefaultVaultInterestShare = "0.1"
+
+	KeyMaxPriceDeviation     = []byte("MaxPriceDeviation")
+	DefaultMaxPriceDeviation = "0.2"
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	gracePeriod time.Duration,
+	lateFeeRate string,
+	defaultPeriod time.Duration,
+	auctionDuration time.Duration,
+	maxLoanToValue string,
+	vaultInterestShare string,
+	maxPriceDeviation string,
+) Params {
+	return Params{
+		GracePeriod:        gracePeriod,
+		LateFeeRate:        lateFeeRate,
+		DefaultPeriod:      defaultPeriod,
+		AuctionDuration:    auctionDuration,
+		MaxLoanToValue:     maxLoanToValue,
+		VaultInterestShare: vaultInterestShare,
+		MaxPriceDeviation:  maxPriceDeviation,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultGracePeriod,
+		DefaultLateFeeRate,
+		DefaultDefaultPeriod,
+		DefaultAuctionDuration,
+		DefaultMaxLoanToValue,
+		DefaultVaultInterestShare,
+		DefaultMaxPriceDeviation,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGracePeriod, &p.GracePeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyLateFeeRate, &p.LateFeeRate, validateLateFeeRate),
+		paramtypes.NewParamSetPair(KeyDefaultPeriod, &p.DefaultPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyAuctionDuration, &p.AuctionDuration, validatePeriod),
+		paramtypes.NewParamSetPair(KeyMaxLoanToValue, &p.MaxLoanToValue, validateMaxLoanToValue),
+		paramtypes.NewParamSetPair(KeyVaultInterestShare, &p.VaultInterestShare, validateVaultInterestShare),
+		paramtypes.NewParamSetPair(KeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validatePeriod(p.GracePeriod); err != nil {
+		return fmt.Errorf("invalid grace period: %w", err)
+	}
+	if err := validateLateFeeRate(p.LateFeeRate); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.DefaultPeriod); err != nil {
+		return fmt.Errorf("invalid default period: %w", err)
+	}
+	if p.DefaultPeriod <= p.GracePeriod {
+		return fmt.Errorf("default period %s must be longer than the grace period %s", p.DefaultPeriod, p.GracePeriod)
+	}
+	if err := validatePeriod(p.AuctionDuration); err != nil {
+		return fmt.Errorf("invalid auction duration: %w", err)
+	}
+	if err := validateMaxLoanToValue(p.MaxLoanToValue); err != nil {
+		return err
+	}
+	if err := validateVaultInterestShare(p.VaultInterestShare); err != nil {
+		return err
+	}
+	if err := validateMaxPriceDeviation(p.MaxPriceDeviation); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("period cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateLateFeeRate(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	rate, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid late fee rate %q: %w", v, err)
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("late fee rate must be between 0 and 1: %s", rate)
+	}
+	return nil
+}
+
+func validateMaxLoanToValue(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	ltv, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid max loan-to-value %q: %w", v, err)
+	}
+	if !ltv.IsPositive() || ltv.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max loan-to-value must be above 0 and at most 1: %s", ltv)
+	}
+	return nil
+}
+
+func validateVaultInterestShare(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	share, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid vault interest share %q: %w", v, err)
+	}
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vault interest share must be between 0 and 1: %s", share)
+	}
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	deviation, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid max price deviation %q: %w", v, err)
+	}
+	if deviation.IsNegative() || deviation.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max price deviation must be between 0 and 1: %s", deviation)
+	}
 	return nil
 }
@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// grace_period is how long an installment may stay unpaid after its due date
+	// before the mortgage becomes delinquent.
+	GracePeriod time.Duration `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3,stdduration" json:"grace_period"`
+	// late_fee_rate is the fee charged on the overdue amount when a mortgage
+	// becomes delinquent, as a decimal fraction.
+	LateFeeRate string `protobuf:"bytes,2,opt,name=late_fee_rate,json=lateFeeRate,proto3" json:"late_fee_rate,omitempty"`
+	// default_period is how long an installment may stay unpaid after its due
+	// date before the mortgage defaults.
+	DefaultPeriod time.Duration `protobuf:"bytes,3,opt,name=default_period,json=defaultPeriod,proto3,stdduration" json:"default_period"`
+	// auction_duration is how long a foreclosure auction takes bids.
+	AuctionDuration time.Duration `protobuf:"bytes,4,opt,name=auction_duration,json=auctionDuration,proto3,stdduration" json:"auction_duration"`
+	// max_loan_to_value is the largest share of the value of a lendee's shares
+	// that equity loans and the liens already on the property may add up to, as
+	// a decimal fraction.
+	MaxLoanToValue string `protobuf:"bytes,5,opt,name=max_loan_to_value,json=maxLoanToValue,proto3" json:"max_loan_to_value,omitempty"`
+	// vault_interest_share is the share of the interest lenders receive that is
+	// paid into the usdarda savings vault, as a decimal fraction.
+	VaultInterestShare string `protobuf:"bytes,6,opt,name=vault_interest_share,json=vaultInterestShare,proto3" json:"vault_interest_share,omitempty"`
+	// max_price_deviation is how far the price of a purchase financed by a
+	// mortgage may be from the current value of the shares bought, as a decimal
+	// fraction of that value.
+	MaxPriceDeviation string `protobuf:"bytes,7,opt,name=max_price_deviation,json=maxPriceDeviation,proto3" json:"max_price_deviation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +88,55 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetGracePeriod() time.Duration {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetLateFeeRate() string {
+	if m != nil {
+		return m.LateFeeRate
+	}
+	return ""
+}
+
+func (m *Params) GetDefaultPeriod() time.Duration {
+	if m != nil {
+		return m.DefaultPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetAuctionDuration() time.Duration {
+	if m != nil {
+		return m.AuctionDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLoanToValue() string {
+	if m != nil {
+		return m.MaxLoanToValue
+	}
+	return ""
+}
+
+func (m *Params) GetVaultInterestShare() string {
+	if m != nil {
+		return m.VaultInterestShare
+	}
+	return ""
+}
+
+func (m *Params) GetMaxPriceDeviation() string {
+	if m != nil {
+		return m.MaxPriceDeviation
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ardapoc.mortgage.Params")
 }
@@ -68,18 +144,35 @@ func init() {
 func init() { proto.RegisterFile("ardapoc/mortgage/params.proto", fileDescriptor_54c9abd59ddb832c) }
 
 var fileDescriptor_54c9abd59ddb832c = []byte{
-	// 175 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2c, 0x4a, 0x49,
-	0x2c, 0xc8, 0x4f, 0xd6, 0xcf, 0xcd, 0x2f, 0x2a, 0x49, 0x4f, 0x4c, 0x4f, 0xd5, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x74, 0xb8, 0xd8, 0x02, 0xc0, 0x46, 0x59,
-	0x29, 0xbd, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x24, 0xcc, 0xb2, 0x0a, 0x84, 0x75,
-	0x10, 0x35, 0x4e, 0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xd2, 0x9f, 0x9e, 0x93, 0x9f,
-	0x94, 0x98, 0x03, 0x66, 0xea, 0xa2, 0x99, 0x55, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xb6,
-	0xdf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x23, 0x33, 0x97, 0xdb, 0x00, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x50, 0x82, 0xb8, 0x90, 0xd0, 0x9a, 0x0e, 0x49, 0x25, 0x9c, 0xaa, 0x53, 0x55,
+	0xa9, 0xb6, 0x54, 0x26, 0x18, 0x10, 0x8a, 0x22, 0xa4, 0x0a, 0x24, 0xa2, 0x14, 0x31, 0xb0, 0x9c,
+	0x5e, 0xec, 0x97, 0xab, 0x25, 0xdb, 0xcf, 0x3a, 0x9f, 0xa3, 0xf0, 0x15, 0x98, 0x3a, 0x32, 0x32,
+	0x32, 0x76, 0xe0, 0x43, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xf9, 0x18, 0xe8, 0xce, 0x67,
+	0x21, 0xa1, 0x2c, 0x5d, 0xac, 0x7b, 0xf7, 0x7e, 0xff, 0xbf, 0xff, 0xef, 0xf4, 0xf8, 0x13, 0x50,
+	0x31, 0x14, 0x14, 0x85, 0x19, 0x29, 0x2d, 0x41, 0x62, 0x58, 0x80, 0x82, 0xac, 0x0c, 0x0a, 0x45,
+	0x9a, 0xbc, 0x6d, 0xd7, 0x0e, 0x9a, 0xf6, 0xde, 0x0e, 0x64, 0x49, 0x4e, 0xa1, 0xfd, 0xd6, 0xd0,
+	0xde, 0x20, 0xa2, 0x32, 0xa3, 0x52, 0xd8, 0x2a, 0xac, 0x0b, 0xd7, 0xda, 0x95, 0x24, 0xa9, 0xbe,
+	0x37, 0x27, 0x77, 0xeb, 0x4b, 0x22, 0x99, 0x62, 0x68, 0xab, 0x59, 0x35, 0x0f, 0xe3, 0x4a, 0x81,
+	0x4e, 0x28, 0xaf, 0xfb, 0x07, 0x17, 0x5b, 0xbc, 0x3d, 0xb1, 0x31, 0xbc, 0xd7, 0xfc, 0xa1, 0x54,
+	0x10, 0xa1, 0x28, 0x50, 0x25, 0x14, 0xf7, 0xd9, 0x3e, 0x3b, 0xec, 0x9c, 0x0c, 0x82, 0xda, 0x21,
+	0x68, 0x1c, 0x82, 0xb1, 0x73, 0x18, 0x75, 0xaf, 0x7e, 0x0e, 0x5b, 0x9f, 0x7f, 0x0d, 0xd9, 0xd7,
+	0x9b, 0xcb, 0x23, 0x36, 0xed, 0x58, 0xf5, 0xc4, 0x8a, 0xbd, 0x13, 0xde, 0x4d, 0x41, 0xa3, 0x98,
+	0x23, 0x0a, 0x05, 0x1a, 0xfb, 0x77, 0xf6, 0xd9, 0xe1, 0x83, 0x51, 0xef, 0xfb, 0xb7, 0x63, 0xee,
+	0x62, 0x8f, 0x31, 0x9a, 0x76, 0x0c, 0xf4, 0x0a, 0x71, 0x0a, 0x1a, 0xbd, 0xb7, 0xbc, 0x17, 0xe3,
+	0x1c, 0xaa, 0x54, 0x37, 0x11, 0xee, 0xde, 0x32, 0x42, 0xd7, 0xe9, 0x5d, 0x88, 0x33, 0xbe, 0x0d,
+	0x55, 0x64, 0x40, 0xd1, 0x8c, 0xdd, 0xdf, 0xba, 0xa5, 0xe5, 0x23, 0xe7, 0xd0, 0xf4, 0xbd, 0x67,
+	0x7c, 0x27, 0x83, 0xa5, 0x48, 0x09, 0x72, 0xa1, 0x49, 0x2c, 0x20, 0xad, 0xb0, 0x7f, 0x6f, 0xe3,
+	0x74, 0xbd, 0x0c, 0x96, 0x6f, 0x08, 0xf2, 0x77, 0xf4, 0xde, 0x50, 0xde, 0x4b, 0xbe, 0xbb, 0xb0,
+	0xe3, 0x25, 0xb9, 0x46, 0x85, 0xa5, 0x16, 0xe5, 0x39, 0x28, 0xec, 0xb7, 0x37, 0xaa, 0x3d, 0xcb,
+	0x9e, 0x3a, 0xf4, 0xcc, 0x90, 0xde, 0x0b, 0xfe, 0xd8, 0xfc, 0xbc, 0x50, 0x49, 0x84, 0x22, 0xc6,
+	0x45, 0x52, 0x0f, 0x75, 0x7f, 0xa3, 0x81, 0xc9, 0x39, 0x31, 0xe4, 0xb8, 0x01, 0x9f, 0x1f, 0xfc,
+	0xf9, 0x32, 0x64, 0x9f, 0x6e, 0x2e, 0x8f, 0x06, 0xcd, 0x32, 0x2e, 0xff, 0xad, 0x63, 0xbd, 0x07,
+	0xa3, 0xd3, 0xab, 0x95, 0xcf, 0xae, 0x57, 0x3e, 0xfb, 0xbd, 0xf2, 0xd9, 0xc5, 0xda, 0x6f, 0x5d,
+	0xaf, 0xfd, 0xd6, 0x8f, 0xb5, 0xdf, 0xfa, 0x10, 0xca, 0x44, 0x9f, 0x57, 0xb3, 0x20, 0xa2, 0x2c,
+	0x34, 0x7a, 0x99, 0xd2, 0x0c, 0x52, 0x7b, 0x3c, 0xfe, 0xcf, 0x4b, 0x7f, 0x2c, 0xb0, 0x9c, 0xb5,
+	0xed, 0xf3, 0x3e, 0xfd, 0x3b, 0x00, 0x0f, 0x36, 0x76, 0x0d, 0xfb, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +194,27 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.GracePeriod != that1.GracePeriod {
+		return false
+	}
+	if this.LateFeeRate != that1.LateFeeRate {
+		return false
+	}
+	if this.DefaultPeriod != that1.DefaultPeriod {
+		return false
+	}
+	if this.AuctionDuration != that1.AuctionDuration {
+		return false
+	}
+	if this.MaxLoanToValue != that1.MaxLoanToValue {
+		return false
+	}
+	if this.VaultInterestShare != that1.VaultInterestShare {
+		return false
+	}
+	if this.MaxPriceDeviation != that1.MaxPriceDeviation {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +237,58 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxPriceDeviation) > 0 {
+		i -= len(m.MaxPriceDeviation)
+		copy(dAtA[i:], m.MaxPriceDeviation)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxPriceDeviation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VaultInterestShare) > 0 {
+		i -= len(m.VaultInterestShare)
+		copy(dAtA[i:], m.VaultInterestShare)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VaultInterestShare)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxLoanToValue) > 0 {
+		i -= len(m.MaxLoanToValue)
+		copy(dAtA[i:], m.MaxLoanToValue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxLoanToValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuctionDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuctionDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LateFeeRate) > 0 {
+		i -= len(m.LateFeeRate)
+		copy(dAtA[i:], m.LateFeeRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LateFeeRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.GracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -143,6 +309,28 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.GracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.LateFeeRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuctionDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.MaxLoanToValue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.VaultInterestShare)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxPriceDeviation)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -181,6 +369,233 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.GracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LateFeeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DefaultPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AuctionDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxLoanToValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultInterestShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultInterestShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPriceDeviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
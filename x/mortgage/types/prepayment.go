@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the prepayment terms charge a penalty between zero and
+// the whole prepayment.
+func (p PrepaymentTerms) Validate() error {
+	rate, err := math.LegacyNewDecFromStr(p.PenaltyRate)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid prepayment penalty rate %q: %s", p.PenaltyRate, err)
+	}
+	if rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "prepayment penalty rate must be between 0 and 1: %s", rate)
+	}
+	return nil
+}
+
+// FundedAt returns when the mortgage was funded, which refinancing does not
+// change.
+func (m Mortgage) FundedAt() time.Time {
+	if len(m.TermsHistory) > 0 {
+		return m.TermsHistory[0].StartDate
+	}
+	return m.StartDate
+}
+
+// LoanYear returns the year of the mortgage t falls in, counting from zero at
+// funding.
+func (m Mortgage) LoanYear(t time.Time) uint32 {
+	var year uint32
+	for !m.FundedAt().AddDate(int(year)+1, 0, 0).After(t) {
+		year++
+	}
+	return year
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that a purchase financed by a mortgage of amount can be
+// carried out for the buyer.
+func (p MortgagePurchase) Validate(buyer string, amount uint64) error {
+	if len(p.FromOwners) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "purchase needs at least one seller")
+	}
+	if len(p.FromOwners) != len(p.FromShares) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mismatch in from_owners and from_shares")
+	}
+	for i, owner := range p.FromOwners {
+		if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller address (%s)", err)
+		}
+		if owner == buyer {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "lendee cannot buy shares from itself")
+		}
+		if p.FromShares[i] == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "seller %s sells no shares", owner)
+		}
+	}
+	if len(p.AcceptedBy) > 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sellers accept the purchase themselves")
+	}
+	if amount > p.Price {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage amount %d exceeds purchase price %d", amount, p.Price)
+	}
+	return nil
+}
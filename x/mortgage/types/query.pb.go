@@ -298,484 +298,3176 @@ func (m *QueryAllMortgageResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "ardapoc.mortgage.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "ardapoc.mortgage.QueryParamsResponse")
-	proto.RegisterType((*QueryGetMortgageRequest)(nil), "ardapoc.mortgage.QueryGetMortgageRequest")
-	proto.RegisterType((*QueryGetMortgageResponse)(nil), "ardapoc.mortgage.QueryGetMortgageResponse")
-	proto.RegisterType((*QueryAllMortgageRequest)(nil), "ardapoc.mortgage.QueryAllMortgageRequest")
-	proto.RegisterType((*QueryAllMortgageResponse)(nil), "ardapoc.mortgage.QueryAllMortgageResponse")
+type QueryDenomAliasRequest struct {
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func init() { proto.RegisterFile("ardapoc/mortgage/query.proto", fileDescriptor_b79a4bf3dd2442c6) }
-
-var fileDescriptor_b79a4bf3dd2442c6 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0x2d, 0x8d, 0xda, 0xeb, 0x02, 0x47, 0x24, 0x22, 0xab, 0xb8, 0xc8, 0x2a, 0xa1,
-	0x44, 0x70, 0x47, 0x8b, 0xc4, 0x02, 0x4b, 0x33, 0x50, 0x31, 0x20, 0x15, 0x4f, 0x88, 0xed, 0x9c,
-	0x9e, 0x0e, 0x4b, 0xb6, 0x1f, 0xd7, 0xbe, 0xa0, 0x56, 0x88, 0x05, 0xbe, 0x00, 0x12, 0x2c, 0x8c,
-	0x6c, 0x8c, 0x7c, 0x04, 0xc6, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x90, 0xf8, 0x1a, 0xc8, 0x77,
-	0xe7, 0xa6, 0xe9, 0x25, 0x34, 0x2c, 0xd1, 0x13, 0xdf, 0xff, 0xe5, 0x17, 0xdf, 0xa3, 0xe0, 0x35,
-	0x5e, 0xec, 0xf3, 0x1c, 0xfa, 0x2c, 0x85, 0x42, 0x49, 0x2e, 0x05, 0x3b, 0x18, 0x88, 0xe2, 0x88,
-	0xe6, 0x05, 0x28, 0x20, 0x97, 0xed, 0x29, 0xad, 0x4f, 0xbd, 0x2b, 0x3c, 0x8d, 0x33, 0x60, 0xfa,
-	0xd3, 0x88, 0xbc, 0x96, 0x04, 0x09, 0x7a, 0x64, 0xd5, 0x64, 0x9f, 0xae, 0x49, 0x00, 0x99, 0x08,
-	0xc6, 0xf3, 0x98, 0xf1, 0x2c, 0x03, 0xc5, 0x55, 0x0c, 0x59, 0x69, 0x4f, 0xbb, 0x7d, 0x28, 0x53,
-	0x28, 0x59, 0xc4, 0x4b, 0xdb, 0xc8, 0x5e, 0x6d, 0x45, 0x42, 0xf1, 0x2d, 0x96, 0x73, 0x19, 0x67,
-	0x5a, 0x6c, 0xb5, 0xd7, 0x1d, 0xc4, 0x9c, 0x17, 0x3c, 0xad, 0xa3, 0xd6, 0x9d, 0xe3, 0x7a, 0x30,
-	0x82, 0xa0, 0x85, 0xc9, 0xb3, 0xaa, 0x61, 0x4f, 0xbb, 0x42, 0x71, 0x30, 0x10, 0xa5, 0x0a, 0x42,
-	0x7c, 0x75, 0xe2, 0x69, 0x99, 0x43, 0x56, 0x0a, 0xf2, 0x10, 0x37, 0x4d, 0x7a, 0x1b, 0xdd, 0x40,
-	0x9b, 0xab, 0xdb, 0x6d, 0x7a, 0xfe, 0x15, 0x50, 0xe3, 0xe8, 0xad, 0x1c, 0xff, 0x5c, 0x6f, 0x7c,
-	0xf9, 0xf3, 0xb5, 0x8b, 0x42, 0x6b, 0x09, 0x18, 0xbe, 0xa6, 0x33, 0x77, 0x85, 0x7a, 0x6a, 0xd5,
-	0xb6, 0x8e, 0xb4, 0xf0, 0x52, 0x9c, 0xed, 0x8b, 0x43, 0x1d, 0xbb, 0x12, 0x9a, 0x2f, 0xc1, 0x73,
-	0xdc, 0x76, 0x0d, 0x96, 0xe4, 0x11, 0x5e, 0xae, 0x2b, 0x2d, 0x8b, 0xe7, 0xb2, 0xd4, 0xae, 0xde,
-	0xa5, 0x8a, 0x26, 0x3c, 0x75, 0x04, 0xdc, 0xa2, 0xec, 0x24, 0xc9, 0x79, 0x94, 0xc7, 0x18, 0x8f,
-	0xdf, 0xb1, 0x8d, 0xee, 0x50, 0x73, 0x21, 0xb4, 0xba, 0x10, 0x6a, 0x56, 0xc0, 0x5e, 0x08, 0xdd,
-	0x1b, 0x7b, 0xc3, 0x33, 0xce, 0xe0, 0x33, 0xb2, 0xf4, 0x13, 0x1d, 0x53, 0xe9, 0x17, 0xff, 0x8f,
-	0x9e, 0xec, 0x4e, 0x20, 0x2e, 0x68, 0xc4, 0x5b, 0x17, 0x22, 0x9a, 0xea, 0xb3, 0x8c, 0xdb, 0xdf,
-	0x16, 0xf1, 0x92, 0x66, 0x24, 0xef, 0x10, 0x6e, 0x9a, 0x9b, 0x23, 0x1b, 0x2e, 0x89, 0xbb, 0x20,
-	0xde, 0xcd, 0x0b, 0x54, 0xa6, 0x2d, 0xb8, 0xf3, 0xf6, 0xfb, 0xef, 0x0f, 0x0b, 0x1d, 0xb2, 0xc1,
-	0x2a, 0xb9, 0x4c, 0x20, 0xe2, 0x89, 0x1e, 0xef, 0x4e, 0x59, 0x59, 0xf2, 0x09, 0xe1, 0xe5, 0xfa,
-	0x57, 0x93, 0xdb, 0x33, 0x1a, 0xdc, 0xf5, 0xf1, 0xba, 0xf3, 0x48, 0x2d, 0xd1, 0x03, 0x4d, 0x74,
-	0x8f, 0xd0, 0x7f, 0x13, 0x9d, 0x0e, 0xaf, 0xf5, 0x2e, 0xbe, 0x21, 0x1f, 0x11, 0x5e, 0xad, 0xc3,
-	0x76, 0x92, 0x64, 0x26, 0x9e, 0xbb, 0x52, 0x33, 0xf1, 0xa6, 0x6c, 0x46, 0x40, 0x35, 0xde, 0x26,
-	0xe9, 0xcc, 0x87, 0xd7, 0x7b, 0x72, 0x3c, 0xf4, 0xd1, 0xc9, 0xd0, 0x47, 0xbf, 0x86, 0x3e, 0x7a,
-	0x3f, 0xf2, 0x1b, 0x27, 0x23, 0xbf, 0xf1, 0x63, 0xe4, 0x37, 0x5e, 0x30, 0x19, 0xab, 0x97, 0x83,
-	0x88, 0xf6, 0x21, 0x9d, 0x9a, 0x75, 0x38, 0x4e, 0x53, 0x47, 0xb9, 0x28, 0xa3, 0xa6, 0xfe, 0x43,
-	0xb8, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x56, 0x7a, 0x7a, 0xf5, 0x04, 0x00, 0x00,
+func (m *QueryDenomAliasRequest) Reset()         { *m = QueryDenomAliasRequest{} }
+func (m *QueryDenomAliasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAliasRequest) ProtoMessage()    {}
+func (*QueryDenomAliasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{6}
+}
+func (m *QueryDenomAliasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomAliasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAliasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomAliasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAliasRequest.Merge(m, src)
+}
+func (m *QueryDenomAliasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAliasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAliasRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryDenomAliasRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of Mortgage items.
-	Mortgage(ctx context.Context, in *QueryGetMortgageRequest, opts ...grpc.CallOption) (*QueryGetMortgageResponse, error)
-	MortgageAll(ctx context.Context, in *QueryAllMortgageRequest, opts ...grpc.CallOption) (*QueryAllMortgageResponse, error)
+func (m *QueryDenomAliasRequest) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryDenomAliasResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryDenomAliasResponse) Reset()         { *m = QueryDenomAliasResponse{} }
+func (m *QueryDenomAliasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomAliasResponse) ProtoMessage()    {}
+func (*QueryDenomAliasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryDenomAliasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Mortgage(ctx context.Context, in *QueryGetMortgageRequest, opts ...grpc.CallOption) (*QueryGetMortgageResponse, error) {
-	out := new(QueryGetMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/Mortgage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDenomAliasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomAliasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDenomAliasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomAliasResponse.Merge(m, src)
+}
+func (m *QueryDenomAliasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomAliasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomAliasResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MortgageAll(ctx context.Context, in *QueryAllMortgageRequest, opts ...grpc.CallOption) (*QueryAllMortgageResponse, error) {
-	out := new(QueryAllMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgageAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomAliasResponse proto.InternalMessageInfo
+
+func (m *QueryDenomAliasResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of Mortgage items.
-	Mortgage(context.Context, *QueryGetMortgageRequest) (*QueryGetMortgageResponse, error)
-	MortgageAll(context.Context, *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error)
+type QueryMortgageRequestsByLenderRequest struct {
+	Lender     string             `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMortgageRequestsByLenderRequest) Reset()         { *m = QueryMortgageRequestsByLenderRequest{} }
+func (m *QueryMortgageRequestsByLenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgageRequestsByLenderRequest) ProtoMessage()    {}
+func (*QueryMortgageRequestsByLenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMortgageRequestsByLenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Mortgage(ctx context.Context, req *QueryGetMortgageRequest) (*QueryGetMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mortgage not implemented")
+func (m *QueryMortgageRequestsByLenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgageRequestsByLenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MortgageAll(ctx context.Context, req *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MortgageAll not implemented")
+func (m *QueryMortgageRequestsByLenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgageRequestsByLenderRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryMortgageRequestsByLenderRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryMortgageRequestsByLenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgageRequestsByLenderRequest.DiscardUnknown(m)
 }
 
-func _Query_Mortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMortgageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Mortgage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Query/Mortgage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Mortgage(ctx, req.(*QueryGetMortgageRequest))
+var xxx_messageInfo_QueryMortgageRequestsByLenderRequest proto.InternalMessageInfo
+
+func (m *QueryMortgageRequestsByLenderRequest) GetLender() string {
+	if m != nil {
+		return m.Lender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_MortgageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMortgageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MortgageAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Query/MortgageAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MortgageAll(ctx, req.(*QueryAllMortgageRequest))
+func (m *QueryMortgageRequestsByLenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ardapoc.mortgage.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Mortgage",
-			Handler:    _Query_Mortgage_Handler,
-		},
-		{
-			MethodName: "MortgageAll",
-			Handler:    _Query_MortgageAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ardapoc/mortgage/query.proto",
+type QueryMortgageRequestsByLendeeRequest struct {
+	Lendee     string             `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMortgageRequestsByLendeeRequest) Reset()         { *m = QueryMortgageRequestsByLendeeRequest{} }
+func (m *QueryMortgageRequestsByLendeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgageRequestsByLendeeRequest) ProtoMessage()    {}
+func (*QueryMortgageRequestsByLendeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{9}
+}
+func (m *QueryMortgageRequestsByLendeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgageRequestsByLendeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgageRequestsByLendeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMortgageRequestsByLendeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgageRequestsByLendeeRequest.Merge(m, src)
+}
+func (m *QueryMortgageRequestsByLendeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgageRequestsByLendeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgageRequestsByLendeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMortgageRequestsByLendeeRequest proto.InternalMessageInfo
+
+func (m *QueryMortgageRequestsByLendeeRequest) GetLendee() string {
+	if m != nil {
+		return m.Lendee
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMortgageRequestsByLendeeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMortgageRequestsResponse struct {
+	Mortgage   []Mortgage          `protobuf:"bytes,1,rep,name=mortgage,proto3" json:"mortgage"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryMortgageRequestsResponse) Reset()         { *m = QueryMortgageRequestsResponse{} }
+func (m *QueryMortgageRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgageRequestsResponse) ProtoMessage()    {}
+func (*QueryMortgageRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{10}
+}
+func (m *QueryMortgageRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgageRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgageRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetMortgageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMortgageRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgageRequestsResponse.Merge(m, src)
 }
-
-func (m *QueryGetMortgageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMortgageRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgageRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgageRequestsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetMortgageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMortgageRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryMortgageRequestsResponse) GetMortgage() []Mortgage {
+	if m != nil {
+		return m.Mortgage
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMortgageRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAmortizationScheduleRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryGetMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Mortgage.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAmortizationScheduleRequest) Reset()         { *m = QueryAmortizationScheduleRequest{} }
+func (m *QueryAmortizationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAmortizationScheduleRequest) ProtoMessage()    {}
+func (*QueryAmortizationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{11}
+}
+func (m *QueryAmortizationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmortizationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmortizationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAmortizationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmortizationScheduleRequest.Merge(m, src)
+}
+func (m *QueryAmortizationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmortizationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmortizationScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMortgageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAmortizationScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryAmortizationScheduleRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllMortgageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAmortizationScheduleResponse struct {
+	Installments []Installment `protobuf:"bytes,1,rep,name=installments,proto3" json:"installments"`
 }
 
-func (m *QueryAllMortgageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAmortizationScheduleResponse) Reset()         { *m = QueryAmortizationScheduleResponse{} }
+func (m *QueryAmortizationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmortizationScheduleResponse) ProtoMessage()    {}
+func (*QueryAmortizationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{12}
+}
+func (m *QueryAmortizationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmortizationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmortizationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAmortizationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmortizationScheduleResponse.Merge(m, src)
+}
+func (m *QueryAmortizationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmortizationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmortizationScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAmortizationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryAmortizationScheduleResponse) GetInstallments() []Installment {
+	if m != nil {
+		return m.Installments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMortgagesByLenderRequest struct {
+	Lender     string             `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Mortgage) > 0 {
-		for iNdEx := len(m.Mortgage) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mortgage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMortgagesByLenderRequest) Reset()         { *m = QueryMortgagesByLenderRequest{} }
+func (m *QueryMortgagesByLenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByLenderRequest) ProtoMessage()    {}
+func (*QueryMortgagesByLenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{13}
+}
+func (m *QueryMortgagesByLenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByLenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByLenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryMortgagesByLenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByLenderRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryMortgagesByLenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByLenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByLenderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMortgagesByLenderRequest proto.InternalMessageInfo
+
+func (m *QueryMortgagesByLenderRequest) GetLender() string {
+	if m != nil {
+		return m.Lender
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetMortgageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMortgagesByLenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Mortgage.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryMortgagesByLendeeRequest struct {
+	Lendee     string             `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllMortgageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMortgagesByLendeeRequest) Reset()         { *m = QueryMortgagesByLendeeRequest{} }
+func (m *QueryMortgagesByLendeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByLendeeRequest) ProtoMessage()    {}
+func (*QueryMortgagesByLendeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{14}
+}
+func (m *QueryMortgagesByLendeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByLendeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByLendeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMortgagesByLendeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByLendeeRequest.Merge(m, src)
+}
+func (m *QueryMortgagesByLendeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByLendeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByLendeeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMortgagesByLendeeRequest proto.InternalMessageInfo
+
+func (m *QueryMortgagesByLendeeRequest) GetLendee() string {
+	if m != nil {
+		return m.Lendee
+	}
+	return ""
+}
+
+func (m *QueryMortgagesByLendeeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMortgagesByCollateralRequest struct {
+	Collateral string             `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMortgagesByCollateralRequest) Reset()         { *m = QueryMortgagesByCollateralRequest{} }
+func (m *QueryMortgagesByCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByCollateralRequest) ProtoMessage()    {}
+func (*QueryMortgagesByCollateralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{15}
+}
+func (m *QueryMortgagesByCollateralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByCollateralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgagesByCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByCollateralRequest.Merge(m, src)
+}
+func (m *QueryMortgagesByCollateralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByCollateralRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgagesByCollateralRequest proto.InternalMessageInfo
+
+func (m *QueryMortgagesByCollateralRequest) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
+	}
+	return ""
+}
+
+func (m *QueryMortgagesByCollateralRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMortgagesByStatusRequest struct {
+	Status     MortgageStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=ardapoc.mortgage.MortgageStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMortgagesByStatusRequest) Reset()         { *m = QueryMortgagesByStatusRequest{} }
+func (m *QueryMortgagesByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByStatusRequest) ProtoMessage()    {}
+func (*QueryMortgagesByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{16}
+}
+func (m *QueryMortgagesByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgagesByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByStatusRequest.Merge(m, src)
+}
+func (m *QueryMortgagesByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgagesByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryMortgagesByStatusRequest) GetStatus() MortgageStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REQUESTED
+}
+
+func (m *QueryMortgagesByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMortgagesResponse struct {
+	Mortgage   []Mortgage          `protobuf:"bytes,1,rep,name=mortgage,proto3" json:"mortgage"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMortgagesResponse) Reset()         { *m = QueryMortgagesResponse{} }
+func (m *QueryMortgagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesResponse) ProtoMessage()    {}
+func (*QueryMortgagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{17}
+}
+func (m *QueryMortgagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesResponse.Merge(m, src)
+}
+func (m *QueryMortgagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgagesResponse proto.InternalMessageInfo
+
+func (m *QueryMortgagesResponse) GetMortgage() []Mortgage {
+	if m != nil {
+		return m.Mortgage
+	}
+	return nil
+}
+
+func (m *QueryMortgagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryOutstandingTotalsRequest struct {
+}
+
+func (m *QueryOutstandingTotalsRequest) Reset()         { *m = QueryOutstandingTotalsRequest{} }
+func (m *QueryOutstandingTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingTotalsRequest) ProtoMessage()    {}
+func (*QueryOutstandingTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{18}
+}
+func (m *QueryOutstandingTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutstandingTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingTotalsRequest.Merge(m, src)
+}
+func (m *QueryOutstandingTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutstandingTotalsRequest proto.InternalMessageInfo
+
+type QueryOutstandingTotalsResponse struct {
+	// lenders are sorted by address and properties by id.
+	Lenders    []LenderOutstanding   `protobuf:"bytes,1,rep,name=lenders,proto3" json:"lenders"`
+	Properties []PropertyOutstanding `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties"`
+}
+
+func (m *QueryOutstandingTotalsResponse) Reset()         { *m = QueryOutstandingTotalsResponse{} }
+func (m *QueryOutstandingTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutstandingTotalsResponse) ProtoMessage()    {}
+func (*QueryOutstandingTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{19}
+}
+func (m *QueryOutstandingTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutstandingTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutstandingTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutstandingTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutstandingTotalsResponse.Merge(m, src)
+}
+func (m *QueryOutstandingTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutstandingTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutstandingTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutstandingTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryOutstandingTotalsResponse) GetLenders() []LenderOutstanding {
+	if m != nil {
+		return m.Lenders
+	}
+	return nil
+}
+
+func (m *QueryOutstandingTotalsResponse) GetProperties() []PropertyOutstanding {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// LenderOutstanding is the principal owed to a lender on its funded mortgages.
+type LenderOutstanding struct {
+	Lender      string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Outstanding uint64 `protobuf:"varint,2,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Mortgages   uint32 `protobuf:"varint,3,opt,name=mortgages,proto3" json:"mortgages,omitempty"`
+}
+
+func (m *LenderOutstanding) Reset()         { *m = LenderOutstanding{} }
+func (m *LenderOutstanding) String() string { return proto.CompactTextString(m) }
+func (*LenderOutstanding) ProtoMessage()    {}
+func (*LenderOutstanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{20}
+}
+func (m *LenderOutstanding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LenderOutstanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LenderOutstanding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LenderOutstanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LenderOutstanding.Merge(m, src)
+}
+func (m *LenderOutstanding) XXX_Size() int {
+	return m.Size()
+}
+func (m *LenderOutstanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_LenderOutstanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LenderOutstanding proto.InternalMessageInfo
+
+func (m *LenderOutstanding) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *LenderOutstanding) GetOutstanding() uint64 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *LenderOutstanding) GetMortgages() uint32 {
+	if m != nil {
+		return m.Mortgages
+	}
+	return 0
+}
+
+// PropertyOutstanding is the principal owed on the funded mortgages secured by
+// a property.
+type PropertyOutstanding struct {
+	Collateral  string `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Outstanding uint64 `protobuf:"varint,2,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Mortgages   uint32 `protobuf:"varint,3,opt,name=mortgages,proto3" json:"mortgages,omitempty"`
+}
+
+func (m *PropertyOutstanding) Reset()         { *m = PropertyOutstanding{} }
+func (m *PropertyOutstanding) String() string { return proto.CompactTextString(m) }
+func (*PropertyOutstanding) ProtoMessage()    {}
+func (*PropertyOutstanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{21}
+}
+func (m *PropertyOutstanding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PropertyOutstanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PropertyOutstanding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PropertyOutstanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PropertyOutstanding.Merge(m, src)
+}
+func (m *PropertyOutstanding) XXX_Size() int {
+	return m.Size()
+}
+func (m *PropertyOutstanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PropertyOutstanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PropertyOutstanding proto.InternalMessageInfo
+
+func (m *PropertyOutstanding) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
+	}
+	return ""
+}
+
+func (m *PropertyOutstanding) GetOutstanding() uint64 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *PropertyOutstanding) GetMortgages() uint32 {
+	if m != nil {
+		return m.Mortgages
+	}
+	return 0
+}
+
+type QueryMortgagesByHolderRequest struct {
+	Holder     string             `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMortgagesByHolderRequest) Reset()         { *m = QueryMortgagesByHolderRequest{} }
+func (m *QueryMortgagesByHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByHolderRequest) ProtoMessage()    {}
+func (*QueryMortgagesByHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{22}
+}
+func (m *QueryMortgagesByHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgagesByHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByHolderRequest.Merge(m, src)
+}
+func (m *QueryMortgagesByHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByHolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgagesByHolderRequest proto.InternalMessageInfo
+
+func (m *QueryMortgagesByHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *QueryMortgagesByHolderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMortgagesByHolderResponse struct {
+	Mortgage   []Mortgage          `protobuf:"bytes,1,rep,name=mortgage,proto3" json:"mortgage"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMortgagesByHolderResponse) Reset()         { *m = QueryMortgagesByHolderResponse{} }
+func (m *QueryMortgagesByHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgagesByHolderResponse) ProtoMessage()    {}
+func (*QueryMortgagesByHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{23}
+}
+func (m *QueryMortgagesByHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgagesByHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgagesByHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgagesByHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgagesByHolderResponse.Merge(m, src)
+}
+func (m *QueryMortgagesByHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgagesByHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgagesByHolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgagesByHolderResponse proto.InternalMessageInfo
+
+func (m *QueryMortgagesByHolderResponse) GetMortgage() []Mortgage {
+	if m != nil {
+		return m.Mortgage
+	}
+	return nil
+}
+
+func (m *QueryMortgagesByHolderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMortgageSyndicateRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryMortgageSyndicateRequest) Reset()         { *m = QueryMortgageSyndicateRequest{} }
+func (m *QueryMortgageSyndicateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgageSyndicateRequest) ProtoMessage()    {}
+func (*QueryMortgageSyndicateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{24}
+}
+func (m *QueryMortgageSyndicateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgageSyndicateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgageSyndicateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgageSyndicateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgageSyndicateRequest.Merge(m, src)
+}
+func (m *QueryMortgageSyndicateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgageSyndicateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgageSyndicateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgageSyndicateRequest proto.InternalMessageInfo
+
+func (m *QueryMortgageSyndicateRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryMortgageSyndicateResponse struct {
+	Lenders []LenderPosition `protobuf:"bytes,1,rep,name=lenders,proto3" json:"lenders"`
+}
+
+func (m *QueryMortgageSyndicateResponse) Reset()         { *m = QueryMortgageSyndicateResponse{} }
+func (m *QueryMortgageSyndicateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMortgageSyndicateResponse) ProtoMessage()    {}
+func (*QueryMortgageSyndicateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{25}
+}
+func (m *QueryMortgageSyndicateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMortgageSyndicateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMortgageSyndicateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMortgageSyndicateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMortgageSyndicateResponse.Merge(m, src)
+}
+func (m *QueryMortgageSyndicateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMortgageSyndicateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMortgageSyndicateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMortgageSyndicateResponse proto.InternalMessageInfo
+
+func (m *QueryMortgageSyndicateResponse) GetLenders() []LenderPosition {
+	if m != nil {
+		return m.Lenders
+	}
+	return nil
+}
+
+// LenderPosition is what one lender put into a mortgage and is still owed.
+type LenderPosition struct {
+	Lender       string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Contribution uint64 `protobuf:"varint,2,opt,name=contribution,proto3" json:"contribution,omitempty"`
+	// outstanding is the lender's share of the principal still owed.
+	Outstanding uint64 `protobuf:"varint,3,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	// received is the total of the repayments paid to the lender. It is only
+	// tracked for members of a syndicate.
+	Received uint64 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *LenderPosition) Reset()         { *m = LenderPosition{} }
+func (m *LenderPosition) String() string { return proto.CompactTextString(m) }
+func (*LenderPosition) ProtoMessage()    {}
+func (*LenderPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b79a4bf3dd2442c6, []int{26}
+}
+func (m *LenderPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LenderPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LenderPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LenderPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LenderPosition.Merge(m, src)
+}
+func (m *LenderPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LenderPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LenderPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LenderPosition proto.InternalMessageInfo
+
+func (m *LenderPosition) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *LenderPosition) GetContribution() uint64 {
+	if m != nil {
+		return m.Contribution
+	}
+	return 0
+}
+
+func (m *LenderPosition) GetOutstanding() uint64 {
+	if m != nil {
+		return m.Outstanding
+	}
+	return 0
+}
+
+func (m *LenderPosition) GetReceived() uint64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ardapoc.mortgage.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ardapoc.mortgage.QueryParamsResponse")
+	proto.RegisterType((*QueryGetMortgageRequest)(nil), "ardapoc.mortgage.QueryGetMortgageRequest")
+	proto.RegisterType((*QueryGetMortgageResponse)(nil), "ardapoc.mortgage.QueryGetMortgageResponse")
+	proto.RegisterType((*QueryAllMortgageRequest)(nil), "ardapoc.mortgage.QueryAllMortgageRequest")
+	proto.RegisterType((*QueryAllMortgageResponse)(nil), "ardapoc.mortgage.QueryAllMortgageResponse")
+	proto.RegisterType((*QueryDenomAliasRequest)(nil), "ardapoc.mortgage.QueryDenomAliasRequest")
+	proto.RegisterType((*QueryDenomAliasResponse)(nil), "ardapoc.mortgage.QueryDenomAliasResponse")
+	proto.RegisterType((*QueryMortgageRequestsByLenderRequest)(nil), "ardapoc.mortgage.QueryMortgageRequestsByLenderRequest")
+	proto.RegisterType((*QueryMortgageRequestsByLendeeRequest)(nil), "ardapoc.mortgage.QueryMortgageRequestsByLendeeRequest")
+	proto.RegisterType((*QueryMortgageRequestsResponse)(nil), "ardapoc.mortgage.QueryMortgageRequestsResponse")
+	proto.RegisterType((*QueryAmortizationScheduleRequest)(nil), "ardapoc.mortgage.QueryAmortizationScheduleRequest")
+	proto.RegisterType((*QueryAmortizationScheduleResponse)(nil), "ardapoc.mortgage.QueryAmortizationScheduleResponse")
+	proto.RegisterType((*QueryMortgagesByLenderRequest)(nil), "ardapoc.mortgage.QueryMortgagesByLenderRequest")
+	proto.RegisterType((*QueryMortgagesByLendeeRequest)(nil), "ardapoc.mortgage.QueryMortgagesByLendeeRequest")
+	proto.RegisterType((*QueryMortgagesByCollateralRequest)(nil), "ardapoc.mortgage.QueryMortgagesByCollateralRequest")
+	proto.RegisterType((*QueryMortgagesByStatusRequest)(nil), "ardapoc.mortgage.QueryMortgagesByStatusRequest")
+	proto.RegisterType((*QueryMortgagesResponse)(nil), "ardapoc.mortgage.QueryMortgagesResponse")
+	proto.RegisterType((*QueryOutstandingTotalsRequest)(nil), "ardapoc.mortgage.QueryOutstandingTotalsRequest")
+	proto.RegisterType((*QueryOutstandingTotalsResponse)(nil), "ardapoc.mortgage.QueryOutstandingTotalsResponse")
+	proto.RegisterType((*LenderOutstanding)(nil), "ardapoc.mortgage.LenderOutstanding")
+	proto.RegisterType((*PropertyOutstanding)(nil), "ardapoc.mortgage.PropertyOutstanding")
+	proto.RegisterType((*QueryMortgagesByHolderRequest)(nil), "ardapoc.mortgage.QueryMortgagesByHolderRequest")
+	proto.RegisterType((*QueryMortgagesByHolderResponse)(nil), "ardapoc.mortgage.QueryMortgagesByHolderResponse")
+	proto.RegisterType((*QueryMortgageSyndicateRequest)(nil), "ardapoc.mortgage.QueryMortgageSyndicateRequest")
+	proto.RegisterType((*QueryMortgageSyndicateResponse)(nil), "ardapoc.mortgage.QueryMortgageSyndicateResponse")
+	proto.RegisterType((*LenderPosition)(nil), "ardapoc.mortgage.LenderPosition")
+}
+
+func init() { proto.RegisterFile("ardapoc/mortgage/query.proto", fileDescriptor_b79a4bf3dd2442c6) }
+
+var fileDescriptor_b79a4bf3dd2442c6 = []byte{
+	// 1267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0x79, 0xfb, 0x25, 0x4f, 0xfa, 0xab, 0xc8, 0x34, 0x14, 0x6b, 0x95, 0x38, 0xee,
+	0x92, 0x86, 0xc4, 0xb4, 0xbb, 0x79, 0x69, 0xd2, 0x00, 0x2d, 0x34, 0x29, 0x6a, 0xa8, 0x00, 0x11,
+	0x1c, 0x0e, 0x88, 0x0b, 0x1a, 0xdb, 0x23, 0x67, 0xc5, 0x7a, 0xc7, 0xdd, 0x5d, 0x57, 0x0d, 0x56,
+	0x84, 0x04, 0x12, 0x07, 0xb8, 0x20, 0xc1, 0x85, 0x53, 0xd5, 0x03, 0x6f, 0x12, 0x07, 0x84, 0x84,
+	0xc4, 0x11, 0x89, 0x4b, 0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0x41, 0xe2, 0x4f, 0xe0, 0x8a, 0x3c,
+	0x33, 0xbb, 0xb6, 0x77, 0x76, 0xed, 0x35, 0x84, 0x28, 0x17, 0x7b, 0x67, 0xf6, 0xf9, 0x3e, 0xf3,
+	0x79, 0x9e, 0x79, 0x66, 0xf7, 0xb1, 0x61, 0x9a, 0xb8, 0x65, 0x52, 0x63, 0x25, 0xb3, 0xca, 0x5c,
+	0xbf, 0x42, 0x2a, 0xd4, 0xbc, 0x53, 0xa7, 0xee, 0xbe, 0x51, 0x73, 0x99, 0xcf, 0xf0, 0x63, 0xf2,
+	0xae, 0x11, 0xdc, 0xd5, 0x26, 0x49, 0xd5, 0x72, 0x98, 0xc9, 0x3f, 0x85, 0x91, 0x36, 0x55, 0x61,
+	0x15, 0xc6, 0x2f, 0xcd, 0xe6, 0x95, 0x9c, 0x9d, 0xae, 0x30, 0x56, 0xb1, 0xa9, 0x49, 0x6a, 0x96,
+	0x49, 0x1c, 0x87, 0xf9, 0xc4, 0xb7, 0x98, 0xe3, 0xc9, 0xbb, 0xf9, 0x12, 0xf3, 0xaa, 0xcc, 0x33,
+	0x8b, 0xc4, 0x93, 0x2b, 0x9a, 0x77, 0x97, 0x8b, 0xd4, 0x27, 0xcb, 0x66, 0x8d, 0x54, 0x2c, 0x87,
+	0x1b, 0x4b, 0xdb, 0x19, 0x05, 0xb1, 0x46, 0x5c, 0x52, 0x0d, 0x5c, 0xcd, 0x2a, 0xb7, 0x83, 0x0b,
+	0x61, 0xa0, 0x4f, 0x01, 0x7e, 0xbd, 0xb9, 0xc2, 0x0e, 0x57, 0x15, 0xe8, 0x9d, 0x3a, 0xf5, 0x7c,
+	0xbd, 0x00, 0xe7, 0x3a, 0x66, 0xbd, 0x1a, 0x73, 0x3c, 0x8a, 0x9f, 0x83, 0x51, 0xe1, 0x3d, 0x83,
+	0x72, 0x68, 0x61, 0x62, 0x25, 0x63, 0x44, 0x53, 0x60, 0x08, 0xc5, 0xd6, 0xf8, 0xc3, 0xdf, 0x66,
+	0x07, 0xbe, 0xfe, 0xf3, 0xbb, 0x3c, 0x2a, 0x48, 0x89, 0x6e, 0xc2, 0x13, 0xdc, 0xe7, 0x36, 0xf5,
+	0x5f, 0x95, 0xd6, 0x72, 0x39, 0x3c, 0x05, 0x23, 0x96, 0x53, 0xa6, 0xf7, 0xb8, 0xdb, 0xf1, 0x82,
+	0x18, 0xe8, 0x6f, 0x42, 0x46, 0x15, 0x48, 0x92, 0x6b, 0x30, 0x16, 0x2c, 0x29, 0x59, 0x34, 0x95,
+	0x25, 0x50, 0x6d, 0x0d, 0x37, 0x69, 0x0a, 0xa1, 0x42, 0x27, 0x12, 0x65, 0xd3, 0xb6, 0xa3, 0x28,
+	0xb7, 0x00, 0x5a, 0x39, 0x96, 0xae, 0xe7, 0x0d, 0xb1, 0x21, 0x46, 0x73, 0x43, 0x0c, 0x51, 0x02,
+	0x72, 0x43, 0x8c, 0x9d, 0x96, 0xb6, 0xd0, 0xa6, 0xd4, 0x1f, 0x20, 0xc8, 0xa8, 0x6b, 0xc4, 0xd2,
+	0x0f, 0xf5, 0x47, 0x8f, 0xb7, 0x3b, 0x10, 0x07, 0x39, 0xe2, 0x53, 0x3d, 0x11, 0xc5, 0xd2, 0x1d,
+	0x8c, 0x06, 0x9c, 0xe7, 0x88, 0x2f, 0x52, 0x87, 0x55, 0x37, 0x6d, 0x8b, 0x78, 0x6d, 0x1b, 0x42,
+	0x9a, 0xe3, 0x60, 0x43, 0xf8, 0x20, 0xdc, 0xc1, 0x76, 0x7b, 0x19, 0xd1, 0x14, 0x8c, 0x94, 0x9b,
+	0xb3, 0x81, 0x80, 0x0f, 0xf4, 0x0f, 0x11, 0xcc, 0x71, 0x45, 0x24, 0xcb, 0xde, 0xd6, 0xfe, 0x2b,
+	0xd4, 0x29, 0x53, 0x37, 0x58, 0xef, 0x3c, 0x8c, 0xda, 0x7c, 0x42, 0xea, 0xe5, 0x08, 0xdf, 0x8a,
+	0x09, 0xf5, 0x9f, 0xec, 0x46, 0x2f, 0x10, 0x1a, 0x05, 0xa1, 0x1d, 0x20, 0xf4, 0xd8, 0x40, 0xbe,
+	0x44, 0x30, 0x13, 0x0b, 0x72, 0xda, 0x6a, 0x63, 0x03, 0x72, 0xa2, 0x7c, 0x9b, 0xae, 0xad, 0x77,
+	0xf9, 0xe4, 0x6e, 0x69, 0x8f, 0x96, 0xeb, 0x76, 0x8f, 0x63, 0x6b, 0xc3, 0x85, 0x2e, 0x4a, 0x19,
+	0xe5, 0x36, 0x9c, 0xb1, 0x1c, 0xcf, 0x27, 0xb6, 0x5d, 0xa5, 0x8e, 0xef, 0xc9, 0x48, 0x67, 0xd4,
+	0x48, 0x6f, 0xb7, 0xac, 0x64, 0xb0, 0x1d, 0x42, 0xfd, 0xbd, 0x48, 0x3e, 0x4f, 0xbc, 0xb4, 0x92,
+	0x00, 0x4e, 0xac, 0xa4, 0x3e, 0x46, 0x70, 0x21, 0x4a, 0x70, 0x93, 0xd9, 0x36, 0xf1, 0xa9, 0x4b,
+	0xec, 0x80, 0x22, 0x0b, 0x50, 0x0a, 0x27, 0x25, 0x49, 0xdb, 0xcc, 0xb1, 0xd1, 0x3c, 0x40, 0x6a,
+	0x3e, 0x76, 0x7d, 0xe2, 0xd7, 0xc3, 0x67, 0xcb, 0x06, 0x8c, 0x7a, 0x7c, 0x82, 0x53, 0x9c, 0x5d,
+	0xc9, 0x25, 0x97, 0xb7, 0x14, 0x4a, 0xfb, 0x63, 0x63, 0xbc, 0x8f, 0xe4, 0x83, 0x2f, 0x64, 0x3c,
+	0x6d, 0xa7, 0x6f, 0x56, 0x26, 0xf1, 0xb5, 0xba, 0xef, 0xf9, 0xc4, 0x29, 0x5b, 0x4e, 0xe5, 0x0d,
+	0xe6, 0x13, 0x3b, 0x7c, 0x41, 0x7f, 0x8f, 0x20, 0x9b, 0x64, 0x21, 0x43, 0xb9, 0x09, 0xff, 0x13,
+	0xa5, 0x1e, 0x9c, 0xae, 0x27, 0xd5, 0x48, 0xc4, 0x51, 0x69, 0xf3, 0x21, 0x43, 0x0a, 0x94, 0xf8,
+	0x65, 0x80, 0x9a, 0xcb, 0x6a, 0xd4, 0xf5, 0x2d, 0xea, 0x65, 0x06, 0xb9, 0x9f, 0x8b, 0x31, 0x6f,
+	0x7d, 0x61, 0xb3, 0xaf, 0x7a, 0x6a, 0x93, 0xeb, 0xef, 0xc0, 0xa4, 0xb2, 0x60, 0xe2, 0xf9, 0xcc,
+	0xc1, 0x04, 0x6b, 0x99, 0xf1, 0x64, 0x0e, 0x17, 0xda, 0xa7, 0xf0, 0x34, 0x8c, 0x07, 0x00, 0x5e,
+	0x66, 0x28, 0x87, 0x16, 0xfe, 0x5f, 0x68, 0x4d, 0xe8, 0x75, 0x38, 0x17, 0x43, 0xd5, 0xf3, 0x1c,
+	0xfc, 0xdb, 0x65, 0x63, 0x1e, 0x07, 0x2f, 0x31, 0xbb, 0xf3, 0x79, 0xb4, 0xc7, 0x27, 0x82, 0x78,
+	0xc5, 0xe8, 0xd8, 0x8a, 0xfb, 0xab, 0xa0, 0x32, 0x62, 0x08, 0x4e, 0x57, 0x91, 0xaf, 0x45, 0x52,
+	0xb5, 0xbb, 0xef, 0x94, 0xad, 0x12, 0xf1, 0x7b, 0xbc, 0x5f, 0x8a, 0x90, 0x4d, 0x92, 0xc9, 0xf8,
+	0x6e, 0x44, 0x2b, 0x3f, 0x97, 0x54, 0xf9, 0x3b, 0xcc, 0xb3, 0x9a, 0x2c, 0x91, 0xb2, 0xd7, 0x3f,
+	0x42, 0x70, 0xb6, 0xd3, 0x22, 0xb1, 0x4e, 0x75, 0x38, 0x53, 0x62, 0x8e, 0xef, 0x5a, 0xc5, 0x7a,
+	0x98, 0x90, 0xe1, 0x42, 0xc7, 0x5c, 0xb4, 0xa8, 0x86, 0xd4, 0xa2, 0xd2, 0x60, 0xcc, 0xa5, 0x25,
+	0x6a, 0xdd, 0xa5, 0xe5, 0xcc, 0x30, 0xbf, 0x1d, 0x8e, 0x57, 0xfe, 0xc2, 0x30, 0xc2, 0x23, 0xc6,
+	0x1f, 0x20, 0x18, 0x15, 0x0d, 0x36, 0x9e, 0x53, 0x43, 0x52, 0xfb, 0x78, 0xed, 0x62, 0x0f, 0x2b,
+	0x91, 0x30, 0xfd, 0xd2, 0xfb, 0xbf, 0xfc, 0xf1, 0xe9, 0xe0, 0x3c, 0x9e, 0x33, 0x9b, 0xe6, 0x15,
+	0x9b, 0x15, 0x89, 0xcd, 0x2f, 0x2f, 0xc7, 0xfc, 0xb2, 0xc0, 0x9f, 0x23, 0x18, 0x0b, 0x92, 0x8f,
+	0x17, 0x13, 0x56, 0x50, 0xbb, 0x7c, 0x2d, 0x9f, 0xc6, 0x54, 0x12, 0xad, 0x73, 0xa2, 0x25, 0x6c,
+	0x74, 0x27, 0x0a, 0x2f, 0x1a, 0xbc, 0x36, 0x0e, 0xf0, 0x67, 0x08, 0x26, 0x02, 0x67, 0x9b, 0xb6,
+	0x9d, 0x88, 0xa7, 0x76, 0xfe, 0x5a, 0x3e, 0x8d, 0xa9, 0xc4, 0x33, 0x38, 0xde, 0x02, 0x9e, 0x4f,
+	0x87, 0x87, 0xef, 0x23, 0x80, 0x56, 0xd7, 0x8c, 0x17, 0x12, 0x96, 0x52, 0x1a, 0x71, 0x6d, 0x31,
+	0x85, 0xa5, 0x64, 0xba, 0xc6, 0x99, 0xd6, 0xf1, 0x95, 0xee, 0x4c, 0xbc, 0x33, 0x7f, 0x9b, 0x37,
+	0xf4, 0x66, 0x83, 0x7f, 0x5d, 0xcf, 0xe7, 0x0f, 0xf0, 0x17, 0x08, 0x26, 0x95, 0x1e, 0x0a, 0x9b,
+	0x09, 0xcb, 0x27, 0x75, 0x5b, 0xda, 0x42, 0x2f, 0x41, 0x88, 0xbb, 0xc6, 0x71, 0x4d, 0x7c, 0xb9,
+	0x3b, 0xae, 0x38, 0x65, 0x66, 0x43, 0x7c, 0xc7, 0x73, 0xd2, 0xd4, 0x9c, 0xf4, 0xbf, 0xe5, 0xa4,
+	0x92, 0x93, 0x1e, 0xe0, 0x1f, 0x10, 0x3c, 0x1e, 0xdb, 0x90, 0xe1, 0xd5, 0xde, 0xac, 0x4a, 0xfb,
+	0xd6, 0x07, 0xef, 0x75, 0xce, 0x7b, 0x15, 0xaf, 0x75, 0xe7, 0x6d, 0xbd, 0xf2, 0xcc, 0x46, 0xeb,
+	0x5a, 0xc9, 0xaf, 0xe8, 0xc0, 0xd2, 0xe4, 0xb7, 0xa3, 0xc9, 0x3b, 0xfe, 0xfc, 0x8a, 0x16, 0xd0,
+	0x6c, 0x88, 0xef, 0x03, 0xfc, 0x0d, 0x82, 0x49, 0xa5, 0xf7, 0x49, 0xe4, 0x4c, 0xea, 0xa3, 0xb4,
+	0xa5, 0xf4, 0x02, 0xc9, 0xbb, 0xcc, 0x79, 0x9f, 0xc6, 0x8b, 0xdd, 0x79, 0xdb, 0x1f, 0xee, 0x3f,
+	0x23, 0xc8, 0x24, 0xfd, 0x02, 0xc6, 0xeb, 0x3d, 0x32, 0x95, 0xf0, 0x93, 0x59, 0x33, 0x53, 0xea,
+	0x42, 0xf0, 0xe7, 0x39, 0xf8, 0x06, 0x5e, 0xef, 0x0e, 0xee, 0x4a, 0x9d, 0x72, 0xf2, 0xba, 0x44,
+	0x41, 0xfb, 0x8d, 0x82, 0x9e, 0x6c, 0x14, 0x6d, 0xe7, 0xf2, 0xdb, 0xce, 0xfa, 0x16, 0x9d, 0x51,
+	0x9a, 0xfa, 0xee, 0xe8, 0xe2, 0xb4, 0xa5, 0xf4, 0x02, 0x09, 0x7e, 0x85, 0x83, 0x1b, 0xf8, 0x52,
+	0x77, 0x70, 0x87, 0xf9, 0xd4, 0x33, 0x1b, 0xa2, 0x29, 0x3c, 0xc0, 0x3f, 0xb6, 0xe1, 0x86, 0x8d,
+	0x4e, 0x4f, 0xdc, 0x68, 0x27, 0xa5, 0x2d, 0xa5, 0x17, 0x48, 0xdc, 0x1b, 0x1c, 0xf7, 0x59, 0xbc,
+	0xd1, 0xdf, 0x0b, 0xd8, 0xf4, 0x42, 0xc8, 0x9f, 0x10, 0x4c, 0xc5, 0xfd, 0x07, 0x80, 0x57, 0x92,
+	0x5e, 0xb4, 0xc9, 0x7f, 0x35, 0x68, 0xab, 0x7d, 0x69, 0x64, 0x0c, 0x2f, 0xf0, 0x18, 0x9e, 0xc1,
+	0x57, 0xfb, 0x8d, 0x41, 0x3a, 0xda, 0xba, 0xfd, 0xf0, 0x30, 0x8b, 0x1e, 0x1d, 0x66, 0xd1, 0xef,
+	0x87, 0x59, 0xf4, 0xc9, 0x51, 0x76, 0xe0, 0xd1, 0x51, 0x76, 0xe0, 0xd7, 0xa3, 0xec, 0xc0, 0x5b,
+	0x66, 0xc5, 0xf2, 0xf7, 0xea, 0x45, 0xa3, 0xc4, 0xaa, 0xb1, 0xce, 0xef, 0xb5, 0xbc, 0xfa, 0xfb,
+	0x35, 0xea, 0x15, 0x47, 0xf9, 0xdf, 0xad, 0xab, 0x7f, 0x0f, 0x00, 0x7b, 0xc3, 0x72, 0xdc, 0x53,
+	0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of Mortgage items.
+	Mortgage(ctx context.Context, in *QueryGetMortgageRequest, opts ...grpc.CallOption) (*QueryGetMortgageResponse, error)
+	MortgageAll(ctx context.Context, in *QueryAllMortgageRequest, opts ...grpc.CallOption) (*QueryAllMortgageResponse, error)
+	// DenomAlias resolves a legacy marker denom to the denom that replaced it.
+	DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error)
+	// MortgagesByLender lists the mortgages of a lender, including those it funds
+	// as a member of a syndicate.
+	MortgagesByLender(ctx context.Context, in *QueryMortgagesByLenderRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByLendee lists the mortgages of a lendee.
+	MortgagesByLendee(ctx context.Context, in *QueryMortgagesByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByCollateral lists the mortgages secured by a property.
+	MortgagesByCollateral(ctx context.Context, in *QueryMortgagesByCollateralRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// MortgagesByStatus lists the mortgages with a status.
+	MortgagesByStatus(ctx context.Context, in *QueryMortgagesByStatusRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error)
+	// OutstandingTotals sums the principal still owed on funded mortgages per
+	// lender and per property.
+	OutstandingTotals(ctx context.Context, in *QueryOutstandingTotalsRequest, opts ...grpc.CallOption) (*QueryOutstandingTotalsResponse, error)
+	// MortgageRequestsByLender lists the pending mortgage requests made to a lender.
+	MortgageRequestsByLender(ctx context.Context, in *QueryMortgageRequestsByLenderRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error)
+	// MortgageRequestsByLendee lists the pending mortgage requests made by a lendee.
+	MortgageRequestsByLendee(ctx context.Context, in *QueryMortgageRequestsByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error)
+	// MortgagesByHolder lists the funded mortgages an address lends to, as holder
+	// of the loan note or as a member of the syndicate.
+	MortgagesByHolder(ctx context.Context, in *QueryMortgagesByHolderRequest, opts ...grpc.CallOption) (*QueryMortgagesByHolderResponse, error)
+	// MortgageSyndicate shows the contribution and outstanding share of each
+	// lender of a mortgage.
+	MortgageSyndicate(ctx context.Context, in *QueryMortgageSyndicateRequest, opts ...grpc.CallOption) (*QueryMortgageSyndicateResponse, error)
+	// AmortizationSchedule returns the installments of a mortgage.
+	AmortizationSchedule(ctx context.Context, in *QueryAmortizationScheduleRequest, opts ...grpc.CallOption) (*QueryAmortizationScheduleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mortgage(ctx context.Context, in *QueryGetMortgageRequest, opts ...grpc.CallOption) (*QueryGetMortgageResponse, error) {
+	out := new(QueryGetMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/Mortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageAll(ctx context.Context, in *QueryAllMortgageRequest, opts ...grpc.CallOption) (*QueryAllMortgageResponse, error) {
+	out := new(QueryAllMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgageAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomAlias(ctx context.Context, in *QueryDenomAliasRequest, opts ...grpc.CallOption) (*QueryDenomAliasResponse, error) {
+	out := new(QueryDenomAliasResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/DenomAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByLender(ctx context.Context, in *QueryMortgagesByLenderRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgagesByLender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByLendee(ctx context.Context, in *QueryMortgagesByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgagesByLendee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByCollateral(ctx context.Context, in *QueryMortgagesByCollateralRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgagesByCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByStatus(ctx context.Context, in *QueryMortgagesByStatusRequest, opts ...grpc.CallOption) (*QueryMortgagesResponse, error) {
+	out := new(QueryMortgagesResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgagesByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutstandingTotals(ctx context.Context, in *QueryOutstandingTotalsRequest, opts ...grpc.CallOption) (*QueryOutstandingTotalsResponse, error) {
+	out := new(QueryOutstandingTotalsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/OutstandingTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageRequestsByLender(ctx context.Context, in *QueryMortgageRequestsByLenderRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error) {
+	out := new(QueryMortgageRequestsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgageRequestsByLender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageRequestsByLendee(ctx context.Context, in *QueryMortgageRequestsByLendeeRequest, opts ...grpc.CallOption) (*QueryMortgageRequestsResponse, error) {
+	out := new(QueryMortgageRequestsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgageRequestsByLendee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgagesByHolder(ctx context.Context, in *QueryMortgagesByHolderRequest, opts ...grpc.CallOption) (*QueryMortgagesByHolderResponse, error) {
+	out := new(QueryMortgagesByHolderResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgagesByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MortgageSyndicate(ctx context.Context, in *QueryMortgageSyndicateRequest, opts ...grpc.CallOption) (*QueryMortgageSyndicateResponse, error) {
+	out := new(QueryMortgageSyndicateResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/MortgageSyndicate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmortizationSchedule(ctx context.Context, in *QueryAmortizationScheduleRequest, opts ...grpc.CallOption) (*QueryAmortizationScheduleResponse, error) {
+	out := new(QueryAmortizationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Query/AmortizationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of Mortgage items.
+	Mortgage(context.Context, *QueryGetMortgageRequest) (*QueryGetMortgageResponse, error)
+	MortgageAll(context.Context, *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error)
+	// DenomAlias resolves a legacy marker denom to the denom that replaced it.
+	DenomAlias(context.Context, *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error)
+	// MortgagesByLender lists the mortgages of a lender, including those it funds
+	// as a member of a syndicate.
+	MortgagesByLender(context.Context, *QueryMortgagesByLenderRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByLendee lists the mortgages of a lendee.
+	MortgagesByLendee(context.Context, *QueryMortgagesByLendeeRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByCollateral lists the mortgages secured by a property.
+	MortgagesByCollateral(context.Context, *QueryMortgagesByCollateralRequest) (*QueryMortgagesResponse, error)
+	// MortgagesByStatus lists the mortgages with a status.
+	MortgagesByStatus(context.Context, *QueryMortgagesByStatusRequest) (*QueryMortgagesResponse, error)
+	// OutstandingTotals sums the principal still owed on funded mortgages per
+	// lender and per property.
+	OutstandingTotals(context.Context, *QueryOutstandingTotalsRequest) (*QueryOutstandingTotalsResponse, error)
+	// MortgageRequestsByLender lists the pending mortgage requests made to a lender.
+	MortgageRequestsByLender(context.Context, *QueryMortgageRequestsByLenderRequest) (*QueryMortgageRequestsResponse, error)
+	// MortgageRequestsByLendee lists the pending mortgage requests made by a lendee.
+	MortgageRequestsByLendee(context.Context, *QueryMortgageRequestsByLendeeRequest) (*QueryMortgageRequestsResponse, error)
+	// MortgagesByHolder lists the funded mortgages an address lends to, as holder
+	// of the loan note or as a member of the syndicate.
+	MortgagesByHolder(context.Context, *QueryMortgagesByHolderRequest) (*QueryMortgagesByHolderResponse, error)
+	// MortgageSyndicate shows the contribution and outstanding share of each
+	// lender of a mortgage.
+	MortgageSyndicate(context.Context, *QueryMortgageSyndicateRequest) (*QueryMortgageSyndicateResponse, error)
+	// AmortizationSchedule returns the installments of a mortgage.
+	AmortizationSchedule(context.Context, *QueryAmortizationScheduleRequest) (*QueryAmortizationScheduleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Mortgage(ctx context.Context, req *QueryGetMortgageRequest) (*QueryGetMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mortgage not implemented")
+}
+func (*UnimplementedQueryServer) MortgageAll(ctx context.Context, req *QueryAllMortgageRequest) (*QueryAllMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageAll not implemented")
+}
+func (*UnimplementedQueryServer) DenomAlias(ctx context.Context, req *QueryDenomAliasRequest) (*QueryDenomAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAlias not implemented")
+}
+func (*UnimplementedQueryServer) MortgagesByLender(ctx context.Context, req *QueryMortgagesByLenderRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByLender not implemented")
+}
+func (*UnimplementedQueryServer) MortgagesByLendee(ctx context.Context, req *QueryMortgagesByLendeeRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByLendee not implemented")
+}
+func (*UnimplementedQueryServer) MortgagesByCollateral(ctx context.Context, req *QueryMortgagesByCollateralRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByCollateral not implemented")
+}
+func (*UnimplementedQueryServer) MortgagesByStatus(ctx context.Context, req *QueryMortgagesByStatusRequest) (*QueryMortgagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByStatus not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingTotals(ctx context.Context, req *QueryOutstandingTotalsRequest) (*QueryOutstandingTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingTotals not implemented")
+}
+func (*UnimplementedQueryServer) MortgageRequestsByLender(ctx context.Context, req *QueryMortgageRequestsByLenderRequest) (*QueryMortgageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageRequestsByLender not implemented")
+}
+func (*UnimplementedQueryServer) MortgageRequestsByLendee(ctx context.Context, req *QueryMortgageRequestsByLendeeRequest) (*QueryMortgageRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageRequestsByLendee not implemented")
+}
+func (*UnimplementedQueryServer) MortgagesByHolder(ctx context.Context, req *QueryMortgagesByHolderRequest) (*QueryMortgagesByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgagesByHolder not implemented")
+}
+func (*UnimplementedQueryServer) MortgageSyndicate(ctx context.Context, req *QueryMortgageSyndicateRequest) (*QueryMortgageSyndicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MortgageSyndicate not implemented")
+}
+func (*UnimplementedQueryServer) AmortizationSchedule(ctx context.Context, req *QueryAmortizationScheduleRequest) (*QueryAmortizationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmortizationSchedule not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMortgageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/Mortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mortgage(ctx, req.(*QueryGetMortgageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMortgageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgageAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageAll(ctx, req.(*QueryAllMortgageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/DenomAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAlias(ctx, req.(*QueryDenomAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByLenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgagesByLender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByLender(ctx, req.(*QueryMortgagesByLenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByLendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByLendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByLendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgagesByLendee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByLendee(ctx, req.(*QueryMortgagesByLendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgagesByCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByCollateral(ctx, req.(*QueryMortgagesByCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgagesByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByStatus(ctx, req.(*QueryMortgagesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/OutstandingTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingTotals(ctx, req.(*QueryOutstandingTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageRequestsByLender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageRequestsByLenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageRequestsByLender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgageRequestsByLender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageRequestsByLender(ctx, req.(*QueryMortgageRequestsByLenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageRequestsByLendee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageRequestsByLendeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageRequestsByLendee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgageRequestsByLendee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageRequestsByLendee(ctx, req.(*QueryMortgageRequestsByLendeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgagesByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgagesByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgagesByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgagesByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgagesByHolder(ctx, req.(*QueryMortgagesByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MortgageSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMortgageSyndicateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MortgageSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/MortgageSyndicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MortgageSyndicate(ctx, req.(*QueryMortgageSyndicateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmortizationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmortizationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmortizationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Query/AmortizationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmortizationSchedule(ctx, req.(*QueryAmortizationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.mortgage.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Mortgage",
+			Handler:    _Query_Mortgage_Handler,
+		},
+		{
+			MethodName: "MortgageAll",
+			Handler:    _Query_MortgageAll_Handler,
+		},
+		{
+			MethodName: "DenomAlias",
+			Handler:    _Query_DenomAlias_Handler,
+		},
+		{
+			MethodName: "MortgagesByLender",
+			Handler:    _Query_MortgagesByLender_Handler,
+		},
+		{
+			MethodName: "MortgagesByLendee",
+			Handler:    _Query_MortgagesByLendee_Handler,
+		},
+		{
+			MethodName: "MortgagesByCollateral",
+			Handler:    _Query_MortgagesByCollateral_Handler,
+		},
+		{
+			MethodName: "MortgagesByStatus",
+			Handler:    _Query_MortgagesByStatus_Handler,
+		},
+		{
+			MethodName: "OutstandingTotals",
+			Handler:    _Query_OutstandingTotals_Handler,
+		},
+		{
+			MethodName: "MortgageRequestsByLender",
+			Handler:    _Query_MortgageRequestsByLender_Handler,
+		},
+		{
+			MethodName: "MortgageRequestsByLendee",
+			Handler:    _Query_MortgageRequestsByLendee_Handler,
+		},
+		{
+			MethodName: "MortgagesByHolder",
+			Handler:    _Query_MortgagesByHolder_Handler,
+		},
+		{
+			MethodName: "MortgageSyndicate",
+			Handler:    _Query_MortgageSyndicate_Handler,
+		},
+		{
+			MethodName: "AmortizationSchedule",
+			Handler:    _Query_AmortizationSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/mortgage/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMortgageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMortgageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMortgageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mortgage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMortgageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMortgageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMortgageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mortgage) > 0 {
+		for iNdEx := len(m.Mortgage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mortgage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAliasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAliasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAliasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAliasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAliasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAliasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgageRequestsByLenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgageRequestsByLenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgageRequestsByLenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgageRequestsByLendeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgageRequestsByLendeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgageRequestsByLendeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgageRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgageRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgageRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mortgage) > 0 {
+		for iNdEx := len(m.Mortgage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mortgage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmortizationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmortizationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmortizationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmortizationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmortizationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmortizationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Installments) > 0 {
+		for iNdEx := len(m.Installments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Installments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByLenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByLenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByLenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByLendeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByLendeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByLendeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mortgage) > 0 {
+		for iNdEx := len(m.Mortgage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mortgage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Lenders) > 0 {
+		for iNdEx := len(m.Lenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LenderOutstanding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LenderOutstanding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LenderOutstanding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mortgages != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mortgages))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PropertyOutstanding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PropertyOutstanding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PropertyOutstanding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mortgages != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mortgages))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgagesByHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgagesByHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgagesByHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mortgage) > 0 {
+		for iNdEx := len(m.Mortgage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mortgage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgageSyndicateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgageSyndicateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgageSyndicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMortgageSyndicateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMortgageSyndicateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMortgageSyndicateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lenders) > 0 {
+		for iNdEx := len(m.Lenders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lenders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LenderPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LenderPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LenderPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Received != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Received))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Outstanding != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Outstanding))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Contribution != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Contribution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetMortgageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mortgage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMortgageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mortgage) > 0 {
+		for _, e := range m.Mortgage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAliasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAliasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgageRequestsByLenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgageRequestsByLendeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgageRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mortgage) > 0 {
+		for _, e := range m.Mortgage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmortizationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmortizationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Installments) > 0 {
+		for _, e := range m.Installments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMortgagesByLenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgagesByLendeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgagesByCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgagesByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mortgage) > 0 {
+		for _, e := range m.Mortgage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOutstandingTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lenders) > 0 {
+		for _, e := range m.Lenders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LenderOutstanding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovQuery(uint64(m.Outstanding))
+	}
+	if m.Mortgages != 0 {
+		n += 1 + sovQuery(uint64(m.Mortgages))
+	}
+	return n
+}
+
+func (m *PropertyOutstanding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovQuery(uint64(m.Outstanding))
+	}
+	if m.Mortgages != 0 {
+		n += 1 + sovQuery(uint64(m.Mortgages))
+	}
+	return n
+}
+
+func (m *QueryMortgagesByHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgagesByHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -785,20 +3477,2127 @@ func (m *QueryAllMortgageResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgageSyndicateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMortgageSyndicateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lenders) > 0 {
+		for _, e := range m.Lenders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LenderPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Contribution != 0 {
+		n += 1 + sovQuery(uint64(m.Contribution))
+	}
+	if m.Outstanding != 0 {
+		n += 1 + sovQuery(uint64(m.Outstanding))
+	}
+	if m.Received != 0 {
+		n += 1 + sovQuery(uint64(m.Received))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMortgageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMortgageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mortgage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMortgageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMortgageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mortgage = append(m.Mortgage, Mortgage{})
+			if err := m.Mortgage[len(m.Mortgage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAliasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAliasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAliasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAliasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAliasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAliasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgageRequestsByLenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgageRequestsByLenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgageRequestsByLenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgageRequestsByLendeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgageRequestsByLendeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgageRequestsByLendeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgageRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgageRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgageRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mortgage = append(m.Mortgage, Mortgage{})
+			if err := m.Mortgage[len(m.Mortgage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAmortizationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAmortizationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAmortizationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAmortizationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAmortizationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAmortizationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Installments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Installments = append(m.Installments, Installment{})
+			if err := m.Installments[len(m.Installments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgagesByLenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgagesByLenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgagesByLenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgagesByLendeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgagesByLendeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgagesByLendeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgagesByCollateralRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgagesByCollateralRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgagesByCollateralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgagesByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgagesByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgagesByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MortgageStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMortgagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMortgagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMortgagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mortgage = append(m.Mortgage, Mortgage{})
+			if err := m.Mortgage[len(m.Mortgage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingTotalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingTotalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lenders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lenders = append(m.Lenders, LenderOutstanding{})
+			if err := m.Lenders[len(m.Lenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, PropertyOutstanding{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LenderOutstanding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LenderOutstanding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LenderOutstanding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgages", wireType)
+			}
+			m.Mortgages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mortgages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *PropertyOutstanding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,12 +5620,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PropertyOutstanding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PropertyOutstanding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgages", wireType)
+			}
+			m.Mortgages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mortgages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -848,7 +5717,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMortgagesByHolderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,15 +5740,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMortgagesByHolderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMortgagesByHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -906,7 +5807,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -931,7 +5835,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMortgageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMortgagesByHolderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -954,17 +5858,17 @@ func (m *QueryGetMortgageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMortgageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMortgagesByHolderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMortgagesByHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -974,23 +5878,61 @@ func (m *QueryGetMortgageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Mortgage = append(m.Mortgage, Mortgage{})
+			if err := m.Mortgage[len(m.Mortgage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1013,7 +5955,7 @@ func (m *QueryGetMortgageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMortgageSyndicateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,17 +5978,17 @@ func (m *QueryGetMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMortgageSyndicateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMortgageSyndicateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1056,24 +5998,23 @@ func (m *QueryGetMortgageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Mortgage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1096,7 +6037,7 @@ func (m *QueryGetMortgageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMortgageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMortgageSyndicateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1119,15 +6060,15 @@ func (m *QueryAllMortgageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMortgageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMortgageSyndicateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMortgageSyndicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lenders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1154,10 +6095,8 @@ func (m *QueryAllMortgageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lenders = append(m.Lenders, LenderPosition{})
+			if err := m.Lenders[len(m.Lenders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1182,7 +6121,7 @@ func (m *QueryAllMortgageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *LenderPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,17 +6144,17 @@ func (m *QueryAllMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LenderPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LenderPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mortgage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1225,31 +6164,29 @@ func (m *QueryAllMortgageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mortgage = append(m.Mortgage, Mortgage{})
-			if err := m.Mortgage[len(m.Mortgage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contribution", wireType)
 			}
-			var msglen int
+			m.Contribution = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1259,28 +6196,49 @@ func (m *QueryAllMortgageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Contribution |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Outstanding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outstanding |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Received = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Received |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
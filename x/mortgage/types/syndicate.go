@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateSyndicate checks that a syndicate led by lead funds amount. An empty
+// syndicate is valid: the lead funds the mortgage alone.
+func ValidateSyndicate(syndicate []SyndicateLender, lead string, amount uint64) error {
+	if len(syndicate) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(syndicate))
+	var total uint64
+	for _, member := range syndicate {
+		if _, err := sdk.AccAddressFromBech32(member.Lender); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid syndicate lender address (%s)", err)
+		}
+		if seen[member.Lender] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lender %s is in the syndicate twice", member.Lender)
+		}
+		seen[member.Lender] = true
+		if member.Contribution == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lender %s contributes nothing", member.Lender)
+		}
+		if member.Contribution > amount-total {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contributions exceed amount %d", amount)
+		}
+		total += member.Contribution
+	}
+	if !seen[lead] {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the lender must be a member of the syndicate")
+	}
+	if total != amount {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contributions add up to %d instead of amount %d", total, amount)
+	}
+	return nil
+}
+
+// IsSyndicated reports whether the mortgage is funded by a syndicate of lenders.
+func (m Mortgage) IsSyndicated() bool {
+	return len(m.Syndicate) > 0
+}
+
+// SyndicateShares splits amount among the members of the syndicate in
+// proportion to their contributions. Each member gets the difference of the
+// rounded-down cumulative shares, so the parts always add up to amount and the
+// rounding never depends on anything but the order of the members.
+func (m Mortgage) SyndicateShares(amount uint64) []uint64 {
+	total := math.ZeroInt()
+	for _, member := range m.Syndicate {
+		total = total.Add(math.NewIntFromUint64(member.Contribution))
+	}
+
+	shares := make([]uint64, len(m.Syndicate))
+	if total.IsZero() {
+		return shares
+	}
+	cumulative, allotted := math.ZeroInt(), uint64(0)
+	for i, member := range m.Syndicate {
+		cumulative = cumulative.Add(math.NewIntFromUint64(member.Contribution))
+		upTo := cumulative.Mul(math.NewIntFromUint64(amount)).Quo(total).Uint64()
+		shares[i] = upTo - allotted
+		allotted = upTo
+	}
+	return shares
+}
+
+// HasLender reports whether addr lends to the mortgage, as its lender or as a
+// member of its syndicate.
+func (m Mortgage) HasLender(addr string) bool {
+	if m.Lender == addr {
+		return true
+	}
+	for _, member := range m.Syndicate {
+		if member.Lender == addr {
+			return true
+		}
+	}
+	return false
+}
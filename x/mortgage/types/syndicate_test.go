@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyndicateShares(t *testing.T) {
+	mortgage := Mortgage{Syndicate: []SyndicateLender{
+		{Lender: "a", Contribution: 500},
+		{Lender: "b", Contribution: 300},
+		{Lender: "c", Contribution: 200},
+	}}
+	require.Equal(t, []uint64{50, 30, 20}, mortgage.SyndicateShares(100))
+	require.Equal(t, []uint64{0, 0, 1}, mortgage.SyndicateShares(1))
+	require.Equal(t, []uint64{5, 3, 2}, mortgage.SyndicateShares(10))
+	require.Equal(t, []uint64{16, 10, 7}, mortgage.SyndicateShares(33))
+	require.Equal(t, []uint64{0, 0, 0}, mortgage.SyndicateShares(0))
+
+	thirds := Mortgage{Syndicate: []SyndicateLender{
+		{Lender: "a", Contribution: 1},
+		{Lender: "b", Contribution: 1},
+		{Lender: "c", Contribution: 1},
+	}}
+	require.Equal(t, []uint64{33, 33, 34}, thirds.SyndicateShares(100))
+	require.Empty(t, Mortgage{}.SyndicateShares(100))
+}
+
+func TestMsgJoinSyndicate_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgJoinSyndicate("invalid_address", "1").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgJoinSyndicate(sample.AccAddress(), "").ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgJoinSyndicate(sample.AccAddress(), "1").ValidateBasic())
+}
@@ -0,0 +1,224 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MonthsPerPeriod returns the number of months between two installments.
+func (f PaymentFrequency) MonthsPerPeriod() uint32 {
+	switch f {
+	case QUARTERLY:
+		return 3
+	case SEMI_ANNUALLY:
+		return 6
+	case ANNUALLY:
+		return 12
+	default:
+		return 1
+	}
+}
+
+// ValidateMortgageTerms checks that a mortgage's rate, term and schedule options
+// describe a valid amortization schedule.
+func ValidateMortgageTerms(interestRate string, termMonths uint32, frequency PaymentFrequency, amortization AmortizationType) error {
+	rate, err := math.LegacyNewDecFromStr(interestRate)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid interest rate %q: %s", interestRate, err)
+	}
+	if rate.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "interest rate cannot be negative: %s", rate)
+	}
+	if _, ok := PaymentFrequency_name[int32(frequency)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown payment frequency %d", frequency)
+	}
+	if _, ok := AmortizationType_name[int32(amortization)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown amortization type %d", amortization)
+	}
+	if termMonths == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "term must be at least one month")
+	}
+	if termMonths%frequency.MonthsPerPeriod() != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "term of %d months is not a whole number of %s periods", termMonths, frequency)
+	}
+	return nil
+}
+
+// ParseInterestRate parses a free-form annual interest rate such as "5%", "5",
+// "3.25" or "0.05" into a decimal rate. Values above 1 without a percent sign are
+// read as percentages.
+func ParseInterestRate(s string) (math.LegacyDec, error) {
+	s = strings.TrimSpace(s)
+	percent := strings.HasSuffix(s, "%")
+	s = strings.TrimSpace(strings.TrimSuffix(s, "%"))
+
+	rate, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid interest rate %q: %w", s, err)
+	}
+	if rate.IsNegative() {
+		return math.LegacyDec{}, fmt.Errorf("interest rate cannot be negative: %s", s)
+	}
+	if percent || rate.GT(math.LegacyOneDec()) {
+		rate = rate.QuoInt64(100)
+	}
+	return rate, nil
+}
+
+// ParseTermMonths parses a free-form term such as "30y", "30 years", "360m" or
+// "360 months" into a number of months. A bare number is read as months.
+func ParseTermMonths(s string) (uint32, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	multiplier := uint64(1)
+	for _, unit := range []struct {
+		suffix string
+		months uint64
+	}{
+		{"years", 12}, {"year", 12}, {"yrs", 12}, {"yr", 12}, {"y", 12},
+		{"months", 1}, {"month", 1}, {"mos", 1}, {"mo", 1}, {"m", 1},
+	} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.months
+			break
+		}
+	}
+
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid term %q: %w", s, err)
+	}
+	months := n * multiplier
+	if months == 0 || months > uint64(^uint32(0)) {
+		return 0, fmt.Errorf("term out of range: %d months", months)
+	}
+	return uint32(months), nil
+}
+
+// PeriodRate returns the interest rate applied per installment period. Mortgages
+// without a rate bear no interest.
+func (m Mortgage) PeriodRate() (math.LegacyDec, error) {
+	if m.InterestRate == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	rate, err := math.LegacyNewDecFromStr(m.InterestRate)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "invalid interest rate of mortgage %s: %s", m.Index, err)
+	}
+	return rate.MulInt64(int64(m.PaymentFrequency.MonthsPerPeriod())).QuoInt64(12), nil
+}
+
+// NumInstallments returns the number of installments over the term.
+func (m Mortgage) NumInstallments() uint32 {
+	return m.TermMonths / m.PaymentFrequency.MonthsPerPeriod()
+}
+
+// InstallmentDueDate returns the due date of the n-th installment, counting from one.
+func (m Mortgage) InstallmentDueDate(n uint32) time.Time {
+	return m.StartDate.AddDate(0, int(n*m.PaymentFrequency.MonthsPerPeriod()), 0)
+}
+
+// PeriodInterest returns the interest a principal accrues over one period.
+func PeriodInterest(principal uint64, periodRate math.LegacyDec) uint64 {
+	return periodRate.MulInt(math.NewIntFromUint64(principal)).TruncateInt().Uint64()
+}
+
+// SchedulePrincipal returns the balance amortized by the current terms: the
+// amount, or the balance refinanced on the last refinance.
+func (m Mortgage) SchedulePrincipal() uint64 {
+	if m.Principal > 0 {
+		return m.Principal
+	}
+	return m.Amount
+}
+
+// CurrentTerms returns the repayment terms the mortgage is on.
+func (m Mortgage) CurrentTerms() MortgageTerms {
+	return MortgageTerms{
+		InterestRate:     m.InterestRate,
+		TermMonths:       m.TermMonths,
+		PaymentFrequency: m.PaymentFrequency,
+		Amortization:     m.Amortization,
+		Principal:        m.SchedulePrincipal(),
+		StartDate:        m.StartDate,
+	}
+}
+
+// AmortizationSchedule returns the contractual installments of the mortgage
+// from its principal, rate and term. The last installment settles any rounding.
+func (m Mortgage) AmortizationSchedule() ([]Installment, error) {
+	n := m.NumInstallments()
+	if n == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "mortgage %s has no term", m.Index)
+	}
+	r, err := m.PeriodRate()
+	if err != nil {
+		return nil, err
+	}
+
+	// Equal installments for annuities: P * r / (1 - (1 + r)^-n)
+	base := m.SchedulePrincipal()
+	payment := math.LegacyNewDecFromInt(math.NewIntFromUint64(base)).QuoInt64(int64(n))
+	if m.Amortization == ANNUITY && r.IsPositive() {
+		growth := math.LegacyOneDec().Add(r).Power(uint64(n))
+		payment = r.MulInt(math.NewIntFromUint64(base)).Mul(growth).Quo(growth.Sub(math.LegacyOneDec()))
+	}
+	annuityPayment := payment.Ceil().TruncateInt().Uint64()
+
+	schedule := make([]Installment, 0, n)
+	balance := base
+	for i := uint32(1); i <= n; i++ {
+		interest := PeriodInterest(balance, r)
+
+		var principal uint64
+		switch {
+		case i == n:
+			principal = balance
+		case m.Amortization == INTEREST_ONLY:
+			principal = 0
+		case annuityPayment > interest:
+			principal = min(annuityPayment-interest, balance)
+		}
+		balance -= principal
+
+		schedule = append(schedule, Installment{
+			Number:    i,
+			DueDate:   m.InstallmentDueDate(i),
+			Payment:   interest + principal,
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		})
+	}
+	return schedule, nil
+}
+
+// IsActive reports whether the mortgage is funded and still being repaid.
+func (m Mortgage) IsActive() bool {
+	return m.Status == APPROVED || m.Status == DELINQUENT
+}
+
+// IsFunded reports whether the mortgage was funded and is not settled, so its
+// collateral is under lien and in escrow.
+func (m Mortgage) IsFunded() bool {
+	return m.IsActive() || m.Status == DEFAULTED || m.Status == FORECLOSING
+}
+
+// IsUnfunded reports whether the mortgage is a request that was never funded.
+func (m Mortgage) IsUnfunded() bool {
+	return m.Status == REQUESTED || m.Status == REJECTED || m.Status == CANCELLED
+}
+
+// RequestedByLendee reports whether the lendee requested the mortgage, and so
+// agreed to its collateral going into escrow. Lenders used to be able to create
+// mortgages on their own.
+func (m Mortgage) RequestedByLendee() bool {
+	return m.Creator == m.Lendee
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterestRate(t *testing.T) {
+	for in, want := range map[string]string{
+		"5%":   "0.05",
+		"5":    "0.05",
+		"3.25": "0.0325",
+		"0.05": "0.05",
+		" 7 %": "0.07",
+	} {
+		rate, err := ParseInterestRate(in)
+		require.NoError(t, err, in)
+		require.True(t, math.LegacyMustNewDecFromStr(want).Equal(rate), in)
+	}
+	for _, in := range []string{"", "five", "-1"} {
+		_, err := ParseInterestRate(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestParseTermMonths(t *testing.T) {
+	for in, want := range map[string]uint32{
+		"30y":        360,
+		"30 years":   360,
+		"1 year":     12,
+		"360m":       360,
+		"360 months": 360,
+		"18":         18,
+	} {
+		months, err := ParseTermMonths(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, months, in)
+	}
+	for _, in := range []string{"", "0", "thirty years", "-5y"} {
+		_, err := ParseTermMonths(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	start := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	mortgage := Mortgage{
+		Index:        "m1",
+		Amount:       1200,
+		InterestRate: "0.12",
+		TermMonths:   12,
+		StartDate:    start,
+	}
+
+	schedule, err := mortgage.AmortizationSchedule()
+	require.NoError(t, err)
+	require.Len(t, schedule, 12)
+
+	// 1% a month on 1200 over 12 months is an annuity of 106.62
+	require.Equal(t, Installment{Number: 1, DueDate: start.AddDate(0, 1, 0), Payment: 107, Interest: 12, Principal: 95, Balance: 1105}, schedule[0])
+	var principal uint64
+	for _, installment := range schedule {
+		principal += installment.Principal
+		require.Equal(t, installment.Interest+installment.Principal, installment.Payment)
+	}
+	require.Equal(t, mortgage.Amount, principal)
+	require.Zero(t, schedule[11].Balance)
+
+	mortgage.Amortization = INTEREST_ONLY
+	mortgage.PaymentFrequency = QUARTERLY
+	schedule, err = mortgage.AmortizationSchedule()
+	require.NoError(t, err)
+	require.Len(t, schedule, 4)
+	for _, installment := range schedule[:3] {
+		require.Equal(t, uint64(36), installment.Payment)
+		require.Equal(t, mortgage.Amount, installment.Balance)
+	}
+	require.Equal(t, uint64(1236), schedule[3].Payment)
+	require.Equal(t, start.AddDate(0, 12, 0), schedule[3].DueDate)
+
+	mortgage.TermMonths = 0
+	_, err = mortgage.AmortizationSchedule()
+	require.Error(t, err)
+}
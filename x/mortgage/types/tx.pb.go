@@ -124,29 +124,23 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-type MsgCreateMortgage struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender       string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee       string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral   string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount       uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate string `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term         string `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
-}
-
-func (m *MsgCreateMortgage) Reset()         { *m = MsgCreateMortgage{} }
-func (m *MsgCreateMortgage) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateMortgage) ProtoMessage()    {}
-func (*MsgCreateMortgage) Descriptor() ([]byte, []int) {
+type MsgDeleteMortgage struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgDeleteMortgage) Reset()         { *m = MsgDeleteMortgage{} }
+func (m *MsgDeleteMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMortgage) ProtoMessage()    {}
+func (*MsgDeleteMortgage) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0636b4d9e2dd4f7a, []int{2}
 }
-func (m *MsgCreateMortgage) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteMortgage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateMortgage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteMortgage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -156,89 +150,149 @@ func (m *MsgCreateMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateMortgage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateMortgage.Merge(m, src)
+func (m *MsgDeleteMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMortgage.Merge(m, src)
 }
-func (m *MsgCreateMortgage) XXX_Size() int {
+func (m *MsgDeleteMortgage) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateMortgage) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateMortgage.DiscardUnknown(m)
+func (m *MsgDeleteMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMortgage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateMortgage proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteMortgage proto.InternalMessageInfo
 
-func (m *MsgCreateMortgage) GetCreator() string {
+func (m *MsgDeleteMortgage) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateMortgage) GetIndex() string {
+func (m *MsgDeleteMortgage) GetIndex() string {
 	if m != nil {
 		return m.Index
 	}
 	return ""
 }
 
-func (m *MsgCreateMortgage) GetLender() string {
-	if m != nil {
-		return m.Lender
-	}
-	return ""
+type MsgDeleteMortgageResponse struct {
 }
 
-func (m *MsgCreateMortgage) GetLendee() string {
-	if m != nil {
-		return m.Lendee
+func (m *MsgDeleteMortgageResponse) Reset()         { *m = MsgDeleteMortgageResponse{} }
+func (m *MsgDeleteMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteMortgageResponse) ProtoMessage()    {}
+func (*MsgDeleteMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{3}
+}
+func (m *MsgDeleteMortgageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteMortgageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgDeleteMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteMortgageResponse.Merge(m, src)
+}
+func (m *MsgDeleteMortgageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteMortgageResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateMortgage) GetCollateral() string {
-	if m != nil {
-		return m.Collateral
+var xxx_messageInfo_MsgDeleteMortgageResponse proto.InternalMessageInfo
+
+type MsgRepayMortgage struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	MortgageId string `protobuf:"bytes,2,opt,name=mortgageId,proto3" json:"mortgageId,omitempty"`
+	Amount     uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgRepayMortgage) Reset()         { *m = MsgRepayMortgage{} }
+func (m *MsgRepayMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayMortgage) ProtoMessage()    {}
+func (*MsgRepayMortgage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{4}
+}
+func (m *MsgRepayMortgage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayMortgage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
+func (m *MsgRepayMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayMortgage.Merge(m, src)
+}
+func (m *MsgRepayMortgage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayMortgage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepayMortgage proto.InternalMessageInfo
 
-func (m *MsgCreateMortgage) GetAmount() uint64 {
+func (m *MsgRepayMortgage) GetCreator() string {
 	if m != nil {
-		return m.Amount
+		return m.Creator
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgCreateMortgage) GetInterestRate() string {
+func (m *MsgRepayMortgage) GetMortgageId() string {
 	if m != nil {
-		return m.InterestRate
+		return m.MortgageId
 	}
 	return ""
 }
 
-func (m *MsgCreateMortgage) GetTerm() string {
+func (m *MsgRepayMortgage) GetAmount() uint64 {
 	if m != nil {
-		return m.Term
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-type MsgCreateMortgageResponse struct {
+// MsgRepayMortgageResponse breaks a repayment down into the late fees,
+// interest and principal it settled and the prepayment penalty charged on top.
+type MsgRepayMortgageResponse struct {
+	LateFees  uint64 `protobuf:"varint,1,opt,name=late_fees,json=lateFees,proto3" json:"late_fees,omitempty"`
+	Interest  uint64 `protobuf:"varint,2,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal uint64 `protobuf:"varint,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Penalty   uint64 `protobuf:"varint,4,opt,name=penalty,proto3" json:"penalty,omitempty"`
 }
 
-func (m *MsgCreateMortgageResponse) Reset()         { *m = MsgCreateMortgageResponse{} }
-func (m *MsgCreateMortgageResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateMortgageResponse) ProtoMessage()    {}
-func (*MsgCreateMortgageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{3}
+func (m *MsgRepayMortgageResponse) Reset()         { *m = MsgRepayMortgageResponse{} }
+func (m *MsgRepayMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayMortgageResponse) ProtoMessage()    {}
+func (*MsgRepayMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{5}
 }
-func (m *MsgCreateMortgageResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRepayMortgageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRepayMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateMortgageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRepayMortgageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -248,41 +302,78 @@ func (m *MsgCreateMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateMortgageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateMortgageResponse.Merge(m, src)
+func (m *MsgRepayMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayMortgageResponse.Merge(m, src)
 }
-func (m *MsgCreateMortgageResponse) XXX_Size() int {
+func (m *MsgRepayMortgageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateMortgageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateMortgageResponse.DiscardUnknown(m)
+func (m *MsgRepayMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayMortgageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepayMortgageResponse proto.InternalMessageInfo
+
+func (m *MsgRepayMortgageResponse) GetLateFees() uint64 {
+	if m != nil {
+		return m.LateFees
+	}
+	return 0
+}
+
+func (m *MsgRepayMortgageResponse) GetInterest() uint64 {
+	if m != nil {
+		return m.Interest
+	}
+	return 0
 }
 
-var xxx_messageInfo_MsgCreateMortgageResponse proto.InternalMessageInfo
+func (m *MsgRepayMortgageResponse) GetPrincipal() uint64 {
+	if m != nil {
+		return m.Principal
+	}
+	return 0
+}
 
-type MsgUpdateMortgage struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index        string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	Lender       string `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
-	Lendee       string `protobuf:"bytes,4,opt,name=lendee,proto3" json:"lendee,omitempty"`
-	Collateral   string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	Amount       uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	InterestRate string `protobuf:"bytes,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Term         string `protobuf:"bytes,8,opt,name=term,proto3" json:"term,omitempty"`
+func (m *MsgRepayMortgageResponse) GetPenalty() uint64 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
 }
 
-func (m *MsgUpdateMortgage) Reset()         { *m = MsgUpdateMortgage{} }
-func (m *MsgUpdateMortgage) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateMortgage) ProtoMessage()    {}
-func (*MsgUpdateMortgage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{4}
+type MsgRequestMortgage struct {
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender           string           `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Collateral       string           `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount           uint64           `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	InterestRate     string           `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,8,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,9,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// purchase makes the mortgage finance buying the collateral shares. Such a
+	// request is approved with MsgPurchaseWithMortgage.
+	Purchase *MortgagePurchase `protobuf:"bytes,10,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	// syndicate makes the mortgage funded by several lenders, led by the lender.
+	// Each other member commits with MsgJoinSyndicate before the lender approves.
+	Syndicate []SyndicateLender `protobuf:"bytes,11,rep,name=syndicate,proto3" json:"syndicate"`
+	// prepayment restricts repaying principal ahead of the schedule, if set.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,12,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+}
+
+func (m *MsgRequestMortgage) Reset()         { *m = MsgRequestMortgage{} }
+func (m *MsgRequestMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestMortgage) ProtoMessage()    {}
+func (*MsgRequestMortgage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{6}
 }
-func (m *MsgUpdateMortgage) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestMortgage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateMortgage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestMortgage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -292,89 +383,117 @@ func (m *MsgUpdateMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateMortgage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateMortgage.Merge(m, src)
+func (m *MsgRequestMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestMortgage.Merge(m, src)
 }
-func (m *MsgUpdateMortgage) XXX_Size() int {
+func (m *MsgRequestMortgage) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateMortgage) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateMortgage.DiscardUnknown(m)
+func (m *MsgRequestMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestMortgage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateMortgage proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestMortgage proto.InternalMessageInfo
 
-func (m *MsgUpdateMortgage) GetCreator() string {
+func (m *MsgRequestMortgage) GetLendee() string {
 	if m != nil {
-		return m.Creator
+		return m.Lendee
 	}
 	return ""
 }
 
-func (m *MsgUpdateMortgage) GetIndex() string {
+func (m *MsgRequestMortgage) GetIndex() string {
 	if m != nil {
 		return m.Index
 	}
 	return ""
 }
 
-func (m *MsgUpdateMortgage) GetLender() string {
+func (m *MsgRequestMortgage) GetLender() string {
 	if m != nil {
 		return m.Lender
 	}
 	return ""
 }
 
-func (m *MsgUpdateMortgage) GetLendee() string {
+func (m *MsgRequestMortgage) GetCollateral() string {
 	if m != nil {
-		return m.Lendee
+		return m.Collateral
 	}
 	return ""
 }
 
-func (m *MsgUpdateMortgage) GetCollateral() string {
+func (m *MsgRequestMortgage) GetAmount() uint64 {
 	if m != nil {
-		return m.Collateral
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgRequestMortgage) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
 	return ""
 }
 
-func (m *MsgUpdateMortgage) GetAmount() uint64 {
+func (m *MsgRequestMortgage) GetTermMonths() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.TermMonths
 	}
 	return 0
 }
 
-func (m *MsgUpdateMortgage) GetInterestRate() string {
+func (m *MsgRequestMortgage) GetPaymentFrequency() PaymentFrequency {
 	if m != nil {
-		return m.InterestRate
+		return m.PaymentFrequency
 	}
-	return ""
+	return MONTHLY
 }
 
-func (m *MsgUpdateMortgage) GetTerm() string {
+func (m *MsgRequestMortgage) GetAmortization() AmortizationType {
 	if m != nil {
-		return m.Term
+		return m.Amortization
 	}
-	return ""
+	return ANNUITY
 }
 
-type MsgUpdateMortgageResponse struct {
+func (m *MsgRequestMortgage) GetPurchase() *MortgagePurchase {
+	if m != nil {
+		return m.Purchase
+	}
+	return nil
 }
 
-func (m *MsgUpdateMortgageResponse) Reset()         { *m = MsgUpdateMortgageResponse{} }
-func (m *MsgUpdateMortgageResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateMortgageResponse) ProtoMessage()    {}
-func (*MsgUpdateMortgageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{5}
+func (m *MsgRequestMortgage) GetSyndicate() []SyndicateLender {
+	if m != nil {
+		return m.Syndicate
+	}
+	return nil
+}
+
+func (m *MsgRequestMortgage) GetPrepayment() *PrepaymentTerms {
+	if m != nil {
+		return m.Prepayment
+	}
+	return nil
+}
+
+type MsgRequestMortgageResponse struct {
+}
+
+func (m *MsgRequestMortgageResponse) Reset()         { *m = MsgRequestMortgageResponse{} }
+func (m *MsgRequestMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestMortgageResponse) ProtoMessage()    {}
+func (*MsgRequestMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{7}
 }
-func (m *MsgUpdateMortgageResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRequestMortgageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRequestMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateMortgageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRequestMortgageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -384,35 +503,35 @@ func (m *MsgUpdateMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateMortgageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateMortgageResponse.Merge(m, src)
+func (m *MsgRequestMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestMortgageResponse.Merge(m, src)
 }
-func (m *MsgUpdateMortgageResponse) XXX_Size() int {
+func (m *MsgRequestMortgageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateMortgageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateMortgageResponse.DiscardUnknown(m)
+func (m *MsgRequestMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestMortgageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateMortgageResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRequestMortgageResponse proto.InternalMessageInfo
 
-type MsgDeleteMortgage struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Index   string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+type MsgApproveMortgage struct {
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *MsgDeleteMortgage) Reset()         { *m = MsgDeleteMortgage{} }
-func (m *MsgDeleteMortgage) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteMortgage) ProtoMessage()    {}
-func (*MsgDeleteMortgage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{6}
+func (m *MsgApproveMortgage) Reset()         { *m = MsgApproveMortgage{} }
+func (m *MsgApproveMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMortgage) ProtoMessage()    {}
+func (*MsgApproveMortgage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{8}
 }
-func (m *MsgDeleteMortgage) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMortgage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteMortgage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMortgage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -422,47 +541,47 @@ func (m *MsgDeleteMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteMortgage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteMortgage.Merge(m, src)
+func (m *MsgApproveMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMortgage.Merge(m, src)
 }
-func (m *MsgDeleteMortgage) XXX_Size() int {
+func (m *MsgApproveMortgage) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteMortgage) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteMortgage.DiscardUnknown(m)
+func (m *MsgApproveMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMortgage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteMortgage proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMortgage proto.InternalMessageInfo
 
-func (m *MsgDeleteMortgage) GetCreator() string {
+func (m *MsgApproveMortgage) GetLender() string {
 	if m != nil {
-		return m.Creator
+		return m.Lender
 	}
 	return ""
 }
 
-func (m *MsgDeleteMortgage) GetIndex() string {
+func (m *MsgApproveMortgage) GetIndex() string {
 	if m != nil {
 		return m.Index
 	}
 	return ""
 }
 
-type MsgDeleteMortgageResponse struct {
+type MsgApproveMortgageResponse struct {
 }
 
-func (m *MsgDeleteMortgageResponse) Reset()         { *m = MsgDeleteMortgageResponse{} }
-func (m *MsgDeleteMortgageResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteMortgageResponse) ProtoMessage()    {}
-func (*MsgDeleteMortgageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{7}
+func (m *MsgApproveMortgageResponse) Reset()         { *m = MsgApproveMortgageResponse{} }
+func (m *MsgApproveMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMortgageResponse) ProtoMessage()    {}
+func (*MsgApproveMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{9}
 }
-func (m *MsgDeleteMortgageResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMortgageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteMortgageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMortgageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -472,36 +591,35 @@ func (m *MsgDeleteMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteMortgageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteMortgageResponse.Merge(m, src)
+func (m *MsgApproveMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMortgageResponse.Merge(m, src)
 }
-func (m *MsgDeleteMortgageResponse) XXX_Size() int {
+func (m *MsgApproveMortgageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteMortgageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteMortgageResponse.DiscardUnknown(m)
+func (m *MsgApproveMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMortgageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteMortgageResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMortgageResponse proto.InternalMessageInfo
 
-type MsgRepayMortgage struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	MortgageId string `protobuf:"bytes,2,opt,name=mortgageId,proto3" json:"mortgageId,omitempty"`
-	Amount     uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+type MsgRejectMortgage struct {
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *MsgRepayMortgage) Reset()         { *m = MsgRepayMortgage{} }
-func (m *MsgRepayMortgage) String() string { return proto.CompactTextString(m) }
-func (*MsgRepayMortgage) ProtoMessage()    {}
-func (*MsgRepayMortgage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{8}
+func (m *MsgRejectMortgage) Reset()         { *m = MsgRejectMortgage{} }
+func (m *MsgRejectMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectMortgage) ProtoMessage()    {}
+func (*MsgRejectMortgage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{10}
 }
-func (m *MsgRepayMortgage) XXX_Unmarshal(b []byte) error {
+func (m *MsgRejectMortgage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRepayMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRejectMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRepayMortgage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRejectMortgage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -511,54 +629,47 @@ func (m *MsgRepayMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgRepayMortgage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRepayMortgage.Merge(m, src)
+func (m *MsgRejectMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectMortgage.Merge(m, src)
 }
-func (m *MsgRepayMortgage) XXX_Size() int {
+func (m *MsgRejectMortgage) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRepayMortgage) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRepayMortgage.DiscardUnknown(m)
+func (m *MsgRejectMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectMortgage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRepayMortgage proto.InternalMessageInfo
+var xxx_messageInfo_MsgRejectMortgage proto.InternalMessageInfo
 
-func (m *MsgRepayMortgage) GetCreator() string {
+func (m *MsgRejectMortgage) GetLender() string {
 	if m != nil {
-		return m.Creator
+		return m.Lender
 	}
 	return ""
 }
 
-func (m *MsgRepayMortgage) GetMortgageId() string {
+func (m *MsgRejectMortgage) GetIndex() string {
 	if m != nil {
-		return m.MortgageId
+		return m.Index
 	}
 	return ""
 }
 
-func (m *MsgRepayMortgage) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-type MsgRepayMortgageResponse struct {
+type MsgRejectMortgageResponse struct {
 }
 
-func (m *MsgRepayMortgageResponse) Reset()         { *m = MsgRepayMortgageResponse{} }
-func (m *MsgRepayMortgageResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRepayMortgageResponse) ProtoMessage()    {}
-func (*MsgRepayMortgageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0636b4d9e2dd4f7a, []int{9}
+func (m *MsgRejectMortgageResponse) Reset()         { *m = MsgRejectMortgageResponse{} }
+func (m *MsgRejectMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectMortgageResponse) ProtoMessage()    {}
+func (*MsgRejectMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{11}
 }
-func (m *MsgRepayMortgageResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRejectMortgageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRepayMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRejectMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRepayMortgageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRejectMortgageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -568,888 +679,6814 @@ func (m *MsgRepayMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRepayMortgageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRepayMortgageResponse.Merge(m, src)
+func (m *MsgRejectMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectMortgageResponse.Merge(m, src)
 }
-func (m *MsgRepayMortgageResponse) XXX_Size() int {
+func (m *MsgRejectMortgageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRepayMortgageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRepayMortgageResponse.DiscardUnknown(m)
+func (m *MsgRejectMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectMortgageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRepayMortgageResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRejectMortgageResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "ardapoc.mortgage.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ardapoc.mortgage.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateMortgage)(nil), "ardapoc.mortgage.MsgCreateMortgage")
-	proto.RegisterType((*MsgCreateMortgageResponse)(nil), "ardapoc.mortgage.MsgCreateMortgageResponse")
-	proto.RegisterType((*MsgUpdateMortgage)(nil), "ardapoc.mortgage.MsgUpdateMortgage")
-	proto.RegisterType((*MsgUpdateMortgageResponse)(nil), "ardapoc.mortgage.MsgUpdateMortgageResponse")
-	proto.RegisterType((*MsgDeleteMortgage)(nil), "ardapoc.mortgage.MsgDeleteMortgage")
-	proto.RegisterType((*MsgDeleteMortgageResponse)(nil), "ardapoc.mortgage.MsgDeleteMortgageResponse")
-	proto.RegisterType((*MsgRepayMortgage)(nil), "ardapoc.mortgage.MsgRepayMortgage")
-	proto.RegisterType((*MsgRepayMortgageResponse)(nil), "ardapoc.mortgage.MsgRepayMortgageResponse")
+type MsgCancelMortgageRequest struct {
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func init() { proto.RegisterFile("ardapoc/mortgage/tx.proto", fileDescriptor_0636b4d9e2dd4f7a) }
-
-var fileDescriptor_0636b4d9e2dd4f7a = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0x8e, 0x7f, 0x69, 0xd3, 0x5f, 0x8e, 0x02, 0xed, 0xa9, 0xa2, 0x17, 0x23, 0xdc, 0xe2, 0x2e,
-	0x21, 0xa8, 0xb1, 0x28, 0x82, 0xa1, 0x4c, 0x04, 0x96, 0x0e, 0x91, 0xc0, 0x88, 0x05, 0x21, 0x55,
-	0x97, 0xf8, 0x74, 0xb5, 0x64, 0xfb, 0xac, 0xbb, 0x0b, 0x4a, 0x36, 0xc4, 0xc8, 0xc4, 0x9f, 0xc1,
-	0x98, 0x81, 0x95, 0xbd, 0x63, 0xc5, 0xc4, 0x84, 0x50, 0x32, 0x64, 0x66, 0x62, 0x45, 0x3e, 0xdb,
-	0x89, 0xed, 0x18, 0x52, 0xb1, 0xb2, 0x24, 0xf7, 0xbe, 0xf7, 0xde, 0x7d, 0xdf, 0x7d, 0x7a, 0x77,
-	0x06, 0x0d, 0xcc, 0x1d, 0x1c, 0xb2, 0xbe, 0xe5, 0x33, 0x2e, 0x29, 0xa6, 0xc4, 0x92, 0xc3, 0x76,
-	0xc8, 0x99, 0x64, 0x70, 0x2b, 0x49, 0xb5, 0xd3, 0x94, 0xbe, 0x8d, 0x7d, 0x37, 0x60, 0x96, 0xfa,
-	0x8d, 0x8b, 0xf4, 0xdd, 0x3e, 0x13, 0x3e, 0x13, 0x96, 0x2f, 0xa8, 0xf5, 0xe6, 0x5e, 0xf4, 0x97,
-	0x24, 0x1a, 0x71, 0xe2, 0x54, 0x45, 0x56, 0x1c, 0x24, 0xa9, 0x1d, 0xca, 0x28, 0x8b, 0xf1, 0x68,
-	0x95, 0xa0, 0xb7, 0x96, 0x94, 0x84, 0x98, 0x63, 0x3f, 0x6d, 0xda, 0x5b, 0x4a, 0xa7, 0x8b, 0xb8,
-	0xc0, 0xfc, 0xac, 0x81, 0xeb, 0x5d, 0x41, 0x5f, 0x86, 0x0e, 0x96, 0xe4, 0x99, 0x6a, 0x85, 0x0f,
-	0x41, 0x1d, 0x0f, 0xe4, 0x19, 0xe3, 0xae, 0x1c, 0x21, 0x6d, 0x5f, 0x6b, 0xd6, 0x3b, 0xe8, 0xcb,
-	0xa7, 0xc3, 0x9d, 0x44, 0xce, 0x63, 0xc7, 0xe1, 0x44, 0x88, 0x17, 0x92, 0xbb, 0x01, 0xb5, 0x17,
-	0xa5, 0xf0, 0x11, 0xa8, 0xc5, 0xe4, 0xe8, 0xbf, 0x7d, 0xad, 0x79, 0xe5, 0x08, 0xb5, 0x8b, 0x5e,
-	0xb4, 0x63, 0x86, 0x4e, 0xfd, 0xfc, 0xdb, 0x5e, 0xe5, 0xe3, 0x6c, 0xdc, 0xd2, 0xec, 0xa4, 0xe5,
-	0xf8, 0xc1, 0xbb, 0xd9, 0xb8, 0xb5, 0xd8, 0xec, 0xfd, 0x6c, 0xdc, 0x32, 0x53, 0xf1, 0xc3, 0x85,
-	0xfc, 0x82, 0x56, 0xb3, 0x01, 0x76, 0x0b, 0x90, 0x4d, 0x44, 0xc8, 0x02, 0x41, 0xcc, 0x1f, 0x1a,
-	0xd8, 0xee, 0x0a, 0xfa, 0x84, 0x13, 0x2c, 0x49, 0x37, 0xd9, 0x00, 0x22, 0xb0, 0xd1, 0x8f, 0x10,
-	0xc6, 0xe3, 0xa3, 0xd9, 0x69, 0x08, 0x77, 0xc0, 0xba, 0x1b, 0x38, 0x64, 0xa8, 0xd4, 0xd7, 0xed,
-	0x38, 0x80, 0x37, 0x40, 0xcd, 0x23, 0x81, 0x43, 0x38, 0xaa, 0x2a, 0x38, 0x89, 0xe6, 0x38, 0x41,
-	0x6b, 0x19, 0x9c, 0x40, 0x03, 0x80, 0x3e, 0xf3, 0x3c, 0x2c, 0x09, 0xc7, 0x1e, 0x5a, 0x57, 0xb9,
-	0x0c, 0x12, 0xf5, 0x61, 0x9f, 0x0d, 0x02, 0x89, 0x6a, 0xfb, 0x5a, 0x73, 0xcd, 0x4e, 0x22, 0x68,
-	0x82, 0x4d, 0x37, 0x90, 0x84, 0x13, 0x21, 0x6d, 0x2c, 0x09, 0xda, 0x50, 0x9d, 0x39, 0x0c, 0x42,
-	0xb0, 0x26, 0x09, 0xf7, 0xd1, 0xff, 0x2a, 0xa7, 0xd6, 0xc7, 0x9b, 0x91, 0x6f, 0xe9, 0x19, 0xcc,
-	0x9b, 0xa0, 0xb1, 0x74, 0xe4, 0xa2, 0x21, 0xb1, 0x59, 0xff, 0x94, 0x21, 0xf9, 0x23, 0xcf, 0x0d,
-	0x79, 0xae, 0xfc, 0x78, 0x4a, 0x3c, 0xf2, 0xf7, 0x7e, 0x94, 0xf2, 0xe5, 0xb7, 0x9c, 0xf3, 0x71,
-	0xb0, 0xd5, 0x15, 0xd4, 0x26, 0x21, 0x1e, 0x5d, 0x82, 0xce, 0x00, 0x20, 0x1d, 0xfb, 0x13, 0x27,
-	0xe1, 0xcc, 0x20, 0x19, 0xe3, 0xaa, 0x59, 0xe3, 0x0a, 0x82, 0x74, 0x80, 0x8a, 0x9c, 0xa9, 0x9e,
-	0xa3, 0x9f, 0x55, 0x50, 0xed, 0x0a, 0x0a, 0x5f, 0x83, 0xcd, 0xdc, 0x03, 0x70, 0x7b, 0xf9, 0xe2,
-	0x16, 0x2e, 0x99, 0x7e, 0x67, 0x65, 0x49, 0xca, 0x02, 0x7b, 0xe0, 0x5a, 0xe1, 0x0e, 0x1e, 0x94,
-	0x36, 0xe7, 0x8b, 0xf4, 0xbb, 0x97, 0x28, 0xca, 0x72, 0x14, 0xc6, 0xfa, 0xe0, 0x0f, 0x02, 0x57,
-	0x70, 0x94, 0x4f, 0x4b, 0xc4, 0x51, 0x18, 0x95, 0x72, 0x8e, 0x7c, 0xd1, 0x6f, 0x38, 0xca, 0x27,
-	0x04, 0x9e, 0x82, 0xab, 0xf9, 0xf1, 0x30, 0x4b, 0xbb, 0x73, 0x35, 0x7a, 0x6b, 0x75, 0x4d, 0x4a,
-	0xa0, 0xaf, 0xbf, 0x8d, 0x5e, 0xdd, 0xce, 0xc9, 0xf9, 0xc4, 0xd0, 0x2e, 0x26, 0x86, 0xf6, 0x7d,
-	0x62, 0x68, 0x1f, 0xa6, 0x46, 0xe5, 0x62, 0x6a, 0x54, 0xbe, 0x4e, 0x8d, 0xca, 0x2b, 0x8b, 0xba,
-	0xf2, 0x6c, 0xd0, 0x6b, 0xf7, 0x99, 0x6f, 0x45, 0xdb, 0x52, 0x8f, 0xf5, 0xb0, 0xa7, 0x96, 0x87,
-	0x85, 0xb7, 0x58, 0x8e, 0x42, 0x22, 0x7a, 0x35, 0xf5, 0x21, 0xb9, 0xff, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0xe6, 0xc7, 0x79, 0x14, 0x07, 0x00, 0x00,
+func (m *MsgCancelMortgageRequest) Reset()         { *m = MsgCancelMortgageRequest{} }
+func (m *MsgCancelMortgageRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMortgageRequest) ProtoMessage()    {}
+func (*MsgCancelMortgageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{12}
+}
+func (m *MsgCancelMortgageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMortgageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMortgageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelMortgageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMortgageRequest.Merge(m, src)
+}
+func (m *MsgCancelMortgageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMortgageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMortgageRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgCancelMortgageRequest proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateMortgage(ctx context.Context, in *MsgCreateMortgage, opts ...grpc.CallOption) (*MsgCreateMortgageResponse, error)
-	UpdateMortgage(ctx context.Context, in *MsgUpdateMortgage, opts ...grpc.CallOption) (*MsgUpdateMortgageResponse, error)
-	DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error)
-	RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error)
+func (m *MsgCancelMortgageRequest) GetLendee() string {
+	if m != nil {
+		return m.Lendee
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelMortgageRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgCancelMortgageRequestResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelMortgageRequestResponse) Reset()         { *m = MsgCancelMortgageRequestResponse{} }
+func (m *MsgCancelMortgageRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelMortgageRequestResponse) ProtoMessage()    {}
+func (*MsgCancelMortgageRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{13}
+}
+func (m *MsgCancelMortgageRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelMortgageRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelMortgageRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelMortgageRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelMortgageRequestResponse.Merge(m, src)
+}
+func (m *MsgCancelMortgageRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelMortgageRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelMortgageRequestResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateMortgage(ctx context.Context, in *MsgCreateMortgage, opts ...grpc.CallOption) (*MsgCreateMortgageResponse, error) {
-	out := new(MsgCreateMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/CreateMortgage", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCancelMortgageRequestResponse proto.InternalMessageInfo
+
+type MsgPurchaseWithMortgage struct {
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (c *msgClient) UpdateMortgage(ctx context.Context, in *MsgUpdateMortgage, opts ...grpc.CallOption) (*MsgUpdateMortgageResponse, error) {
-	out := new(MsgUpdateMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/UpdateMortgage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPurchaseWithMortgage) Reset()         { *m = MsgPurchaseWithMortgage{} }
+func (m *MsgPurchaseWithMortgage) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseWithMortgage) ProtoMessage()    {}
+func (*MsgPurchaseWithMortgage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{14}
+}
+func (m *MsgPurchaseWithMortgage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseWithMortgage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseWithMortgage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPurchaseWithMortgage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseWithMortgage.Merge(m, src)
+}
+func (m *MsgPurchaseWithMortgage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseWithMortgage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseWithMortgage.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error) {
-	out := new(MsgDeleteMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/DeleteMortgage", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPurchaseWithMortgage proto.InternalMessageInfo
+
+func (m *MsgPurchaseWithMortgage) GetLender() string {
+	if m != nil {
+		return m.Lender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error) {
-	out := new(MsgRepayMortgageResponse)
-	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RepayMortgage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPurchaseWithMortgage) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateMortgage(context.Context, *MsgCreateMortgage) (*MsgCreateMortgageResponse, error)
-	UpdateMortgage(context.Context, *MsgUpdateMortgage) (*MsgUpdateMortgageResponse, error)
-	DeleteMortgage(context.Context, *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error)
-	RepayMortgage(context.Context, *MsgRepayMortgage) (*MsgRepayMortgageResponse, error)
+type MsgPurchaseWithMortgageResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgPurchaseWithMortgageResponse) Reset()         { *m = MsgPurchaseWithMortgageResponse{} }
+func (m *MsgPurchaseWithMortgageResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseWithMortgageResponse) ProtoMessage()    {}
+func (*MsgPurchaseWithMortgageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{15}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgPurchaseWithMortgageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateMortgage(ctx context.Context, req *MsgCreateMortgage) (*MsgCreateMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMortgage not implemented")
+func (m *MsgPurchaseWithMortgageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseWithMortgageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateMortgage(ctx context.Context, req *MsgUpdateMortgage) (*MsgUpdateMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMortgage not implemented")
+func (m *MsgPurchaseWithMortgageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseWithMortgageResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) DeleteMortgage(ctx context.Context, req *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMortgage not implemented")
+func (m *MsgPurchaseWithMortgageResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RepayMortgage(ctx context.Context, req *MsgRepayMortgage) (*MsgRepayMortgageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayMortgage not implemented")
+func (m *MsgPurchaseWithMortgageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseWithMortgageResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgPurchaseWithMortgageResponse proto.InternalMessageInfo
+
+type MsgAcceptPurchase struct {
+	Seller string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAcceptPurchase) Reset()         { *m = MsgAcceptPurchase{} }
+func (m *MsgAcceptPurchase) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPurchase) ProtoMessage()    {}
+func (*MsgAcceptPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{16}
+}
+func (m *MsgAcceptPurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPurchase.Merge(m, src)
+}
+func (m *MsgAcceptPurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPurchase.DiscardUnknown(m)
 }
 
-func _Msg_CreateMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateMortgage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateMortgage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Msg/CreateMortgage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateMortgage(ctx, req.(*MsgCreateMortgage))
+var xxx_messageInfo_MsgAcceptPurchase proto.InternalMessageInfo
+
+func (m *MsgAcceptPurchase) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateMortgage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateMortgage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Msg/UpdateMortgage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateMortgage(ctx, req.(*MsgUpdateMortgage))
+func (m *MsgAcceptPurchase) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteMortgage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteMortgage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Msg/DeleteMortgage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteMortgage(ctx, req.(*MsgDeleteMortgage))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgAcceptPurchaseResponse struct {
 }
 
-func _Msg_RepayMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayMortgage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayMortgage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ardapoc.mortgage.Msg/RepayMortgage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayMortgage(ctx, req.(*MsgRepayMortgage))
+func (m *MsgAcceptPurchaseResponse) Reset()         { *m = MsgAcceptPurchaseResponse{} }
+func (m *MsgAcceptPurchaseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPurchaseResponse) ProtoMessage()    {}
+func (*MsgAcceptPurchaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{17}
+}
+func (m *MsgAcceptPurchaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPurchaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPurchaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptPurchaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPurchaseResponse.Merge(m, src)
+}
+func (m *MsgAcceptPurchaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPurchaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPurchaseResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ardapoc.mortgage.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateMortgage",
-			Handler:    _Msg_CreateMortgage_Handler,
-		},
-		{
-			MethodName: "UpdateMortgage",
-			Handler:    _Msg_UpdateMortgage_Handler,
-		},
-		{
-			MethodName: "DeleteMortgage",
-			Handler:    _Msg_DeleteMortgage_Handler,
-		},
-		{
-			MethodName: "RepayMortgage",
-			Handler:    _Msg_RepayMortgage_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ardapoc/mortgage/tx.proto",
-}
+var xxx_messageInfo_MsgAcceptPurchaseResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgRequestEquityLoan struct {
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Lender           string           `protobuf:"bytes,3,opt,name=lender,proto3" json:"lender,omitempty"`
+	Collateral       string           `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	Amount           uint64           `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	InterestRate     string           `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,8,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,9,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+	// prepayment restricts repaying principal ahead of the schedule, if set.
+	Prepayment *PrepaymentTerms `protobuf:"bytes,10,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestEquityLoan) Reset()         { *m = MsgRequestEquityLoan{} }
+func (m *MsgRequestEquityLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestEquityLoan) ProtoMessage()    {}
+func (*MsgRequestEquityLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{18}
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRequestEquityLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestEquityLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestEquityLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRequestEquityLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestEquityLoan.Merge(m, src)
+}
+func (m *MsgRequestEquityLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestEquityLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestEquityLoan.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestEquityLoan proto.InternalMessageInfo
+
+func (m *MsgRequestEquityLoan) GetLendee() string {
+	if m != nil {
+		return m.Lendee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestEquityLoan) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRequestEquityLoan) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
 }
 
-func (m *MsgCreateMortgage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestEquityLoan) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateMortgage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestEquityLoan) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *MsgCreateMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Collateral) > 0 {
-		i -= len(m.Collateral)
-		copy(dAtA[i:], m.Collateral)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Collateral)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Lendee) > 0 {
-		i -= len(m.Lendee)
-		copy(dAtA[i:], m.Lendee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRequestEquityLoan) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgRequestEquityLoan) GetTermMonths() uint32 {
+	if m != nil {
+		return m.TermMonths
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgRequestEquityLoan) GetPaymentFrequency() PaymentFrequency {
+	if m != nil {
+		return m.PaymentFrequency
 	}
-	return len(dAtA) - i, nil
+	return MONTHLY
 }
 
-func (m *MsgCreateMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestEquityLoan) GetAmortization() AmortizationType {
+	if m != nil {
+		return m.Amortization
 	}
-	return dAtA[:n], nil
+	return ANNUITY
 }
 
-func (m *MsgCreateMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRequestEquityLoan) GetPrepayment() *PrepaymentTerms {
+	if m != nil {
+		return m.Prepayment
+	}
+	return nil
 }
 
-func (m *MsgCreateMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRequestEquityLoanResponse struct {
 }
 
-func (m *MsgUpdateMortgage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestEquityLoanResponse) Reset()         { *m = MsgRequestEquityLoanResponse{} }
+func (m *MsgRequestEquityLoanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestEquityLoanResponse) ProtoMessage()    {}
+func (*MsgRequestEquityLoanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{19}
+}
+func (m *MsgRequestEquityLoanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestEquityLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestEquityLoanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRequestEquityLoanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestEquityLoanResponse.Merge(m, src)
+}
+func (m *MsgRequestEquityLoanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestEquityLoanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestEquityLoanResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMortgage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRequestEquityLoanResponse proto.InternalMessageInfo
+
+type MsgForeclose struct {
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// auction puts the escrowed shares up for auction instead of handing them to
+	// the lender. The proceeds pay off the debt and any surplus goes to the lendee.
+	Auction bool `protobuf:"varint,3,opt,name=auction,proto3" json:"auction,omitempty"`
 }
 
-func (m *MsgUpdateMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x3a
+func (m *MsgForeclose) Reset()         { *m = MsgForeclose{} }
+func (m *MsgForeclose) String() string { return proto.CompactTextString(m) }
+func (*MsgForeclose) ProtoMessage()    {}
+func (*MsgForeclose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{20}
+}
+func (m *MsgForeclose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForeclose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForeclose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *MsgForeclose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForeclose.Merge(m, src)
+}
+func (m *MsgForeclose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForeclose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForeclose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForeclose proto.InternalMessageInfo
+
+func (m *MsgForeclose) GetLender() string {
+	if m != nil {
+		return m.Lender
 	}
-	if len(m.Collateral) > 0 {
-		i -= len(m.Collateral)
-		copy(dAtA[i:], m.Collateral)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Collateral)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *MsgForeclose) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.Lendee) > 0 {
-		i -= len(m.Lendee)
-		copy(dAtA[i:], m.Lendee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Lender) > 0 {
-		i -= len(m.Lender)
-		copy(dAtA[i:], m.Lender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForeclose) GetAuction() bool {
+	if m != nil {
+		return m.Auction
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgForecloseResponse struct {
 }
 
-func (m *MsgUpdateMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgForecloseResponse) Reset()         { *m = MsgForecloseResponse{} }
+func (m *MsgForecloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForecloseResponse) ProtoMessage()    {}
+func (*MsgForecloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{21}
 }
-
-func (m *MsgDeleteMortgage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgForecloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForecloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForecloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgForecloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForecloseResponse.Merge(m, src)
+}
+func (m *MsgForecloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForecloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForecloseResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteMortgage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgForecloseResponse proto.InternalMessageInfo
+
+type MsgBidForeclosure struct {
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgDeleteMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgBidForeclosure) Reset()         { *m = MsgBidForeclosure{} }
+func (m *MsgBidForeclosure) String() string { return proto.CompactTextString(m) }
+func (*MsgBidForeclosure) ProtoMessage()    {}
+func (*MsgBidForeclosure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{22}
+}
+func (m *MsgBidForeclosure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidForeclosure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidForeclosure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgBidForeclosure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidForeclosure.Merge(m, src)
+}
+func (m *MsgBidForeclosure) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidForeclosure) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidForeclosure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidForeclosure proto.InternalMessageInfo
+
+func (m *MsgBidForeclosure) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBidForeclosure) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBidForeclosure) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *MsgDeleteMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgBidForeclosureResponse struct {
 }
 
-func (m *MsgRepayMortgage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBidForeclosureResponse) Reset()         { *m = MsgBidForeclosureResponse{} }
+func (m *MsgBidForeclosureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBidForeclosureResponse) ProtoMessage()    {}
+func (*MsgBidForeclosureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{23}
+}
+func (m *MsgBidForeclosureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidForeclosureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidForeclosureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBidForeclosureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidForeclosureResponse.Merge(m, src)
+}
+func (m *MsgBidForeclosureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidForeclosureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidForeclosureResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRepayMortgage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBidForeclosureResponse proto.InternalMessageInfo
+
+type MsgProposeRefinance struct {
+	Lender           string           `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	InterestRate     string           `protobuf:"bytes,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,5,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,6,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
 }
 
-func (m *MsgRepayMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.MortgageId) > 0 {
-		i -= len(m.MortgageId)
-		copy(dAtA[i:], m.MortgageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MortgageId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgProposeRefinance) Reset()         { *m = MsgProposeRefinance{} }
+func (m *MsgProposeRefinance) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRefinance) ProtoMessage()    {}
+func (*MsgProposeRefinance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{24}
+}
+func (m *MsgProposeRefinance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRefinance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRefinance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgProposeRefinance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRefinance.Merge(m, src)
+}
+func (m *MsgProposeRefinance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRefinance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRefinance.DiscardUnknown(m)
 }
 
-func (m *MsgRepayMortgageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeRefinance proto.InternalMessageInfo
+
+func (m *MsgProposeRefinance) GetLender() string {
+	if m != nil {
+		return m.Lender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRepayMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeRefinance) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *MsgRepayMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgProposeRefinance) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgProposeRefinance) GetTermMonths() uint32 {
+	if m != nil {
+		return m.TermMonths
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgProposeRefinance) GetPaymentFrequency() PaymentFrequency {
+	if m != nil {
+		return m.PaymentFrequency
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return MONTHLY
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeRefinance) GetAmortization() AmortizationType {
+	if m != nil {
+		return m.Amortization
 	}
-	var l int
-	_ = l
-	return n
+	return ANNUITY
 }
 
-func (m *MsgCreateMortgage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Lendee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Collateral)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Term)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgProposeRefinanceResponse struct {
 }
 
-func (m *MsgCreateMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeRefinanceResponse) Reset()         { *m = MsgProposeRefinanceResponse{} }
+func (m *MsgProposeRefinanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeRefinanceResponse) ProtoMessage()    {}
+func (*MsgProposeRefinanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{25}
+}
+func (m *MsgProposeRefinanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeRefinanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeRefinanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgProposeRefinanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeRefinanceResponse.Merge(m, src)
+}
+func (m *MsgProposeRefinanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeRefinanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeRefinanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMortgage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgProposeRefinanceResponse proto.InternalMessageInfo
+
+// MsgAcceptRefinance accepts the pending refinance proposal of a mortgage. The
+// terms must match the proposal, so a proposal changed in the meantime is not
+// accepted by accident.
+type MsgAcceptRefinance struct {
+	Lendee           string           `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index            string           `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	InterestRate     string           `protobuf:"bytes,3,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	TermMonths       uint32           `protobuf:"varint,4,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	PaymentFrequency PaymentFrequency `protobuf:"varint,5,opt,name=payment_frequency,json=paymentFrequency,proto3,enum=ardapoc.mortgage.PaymentFrequency" json:"payment_frequency,omitempty"`
+	Amortization     AmortizationType `protobuf:"varint,6,opt,name=amortization,proto3,enum=ardapoc.mortgage.AmortizationType" json:"amortization,omitempty"`
+}
+
+func (m *MsgAcceptRefinance) Reset()         { *m = MsgAcceptRefinance{} }
+func (m *MsgAcceptRefinance) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptRefinance) ProtoMessage()    {}
+func (*MsgAcceptRefinance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{26}
+}
+func (m *MsgAcceptRefinance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptRefinance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptRefinance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAcceptRefinance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptRefinance.Merge(m, src)
+}
+func (m *MsgAcceptRefinance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptRefinance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptRefinance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptRefinance proto.InternalMessageInfo
+
+func (m *MsgAcceptRefinance) GetLendee() string {
+	if m != nil {
+		return m.Lendee
 	}
-	l = len(m.Lender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgAcceptRefinance) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.Lendee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgAcceptRefinance) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
-	l = len(m.Collateral)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgAcceptRefinance) GetTermMonths() uint32 {
+	if m != nil {
+		return m.TermMonths
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	return 0
+}
+
+func (m *MsgAcceptRefinance) GetPaymentFrequency() PaymentFrequency {
+	if m != nil {
+		return m.PaymentFrequency
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return MONTHLY
+}
+
+func (m *MsgAcceptRefinance) GetAmortization() AmortizationType {
+	if m != nil {
+		return m.Amortization
 	}
-	l = len(m.Term)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ANNUITY
+}
+
+type MsgAcceptRefinanceResponse struct {
+}
+
+func (m *MsgAcceptRefinanceResponse) Reset()         { *m = MsgAcceptRefinanceResponse{} }
+func (m *MsgAcceptRefinanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptRefinanceResponse) ProtoMessage()    {}
+func (*MsgAcceptRefinanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{27}
+}
+func (m *MsgAcceptRefinanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptRefinanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptRefinanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgAcceptRefinanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptRefinanceResponse.Merge(m, src)
+}
+func (m *MsgAcceptRefinanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptRefinanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptRefinanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAcceptRefinanceResponse proto.InternalMessageInfo
+
+type MsgRejectRefinance struct {
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgRejectRefinance) Reset()         { *m = MsgRejectRefinance{} }
+func (m *MsgRejectRefinance) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRefinance) ProtoMessage()    {}
+func (*MsgRejectRefinance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{28}
+}
+func (m *MsgRejectRefinance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRefinance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRefinance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgRejectRefinance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRefinance.Merge(m, src)
+}
+func (m *MsgRejectRefinance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRefinance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRefinance.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteMortgage) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRejectRefinance proto.InternalMessageInfo
+
+func (m *MsgRejectRefinance) GetLendee() string {
+	if m != nil {
+		return m.Lendee
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRejectRefinance) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgRejectRefinanceResponse struct {
+}
+
+func (m *MsgRejectRefinanceResponse) Reset()         { *m = MsgRejectRefinanceResponse{} }
+func (m *MsgRejectRefinanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectRefinanceResponse) ProtoMessage()    {}
+func (*MsgRejectRefinanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{29}
+}
+func (m *MsgRejectRefinanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectRefinanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectRefinanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRejectRefinanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectRefinanceResponse.Merge(m, src)
+}
+func (m *MsgRejectRefinanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectRefinanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectRefinanceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRejectRefinanceResponse proto.InternalMessageInfo
+
+type MsgTransferLoanNote struct {
+	Holder    string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	Index     string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgTransferLoanNote) Reset()         { *m = MsgTransferLoanNote{} }
+func (m *MsgTransferLoanNote) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLoanNote) ProtoMessage()    {}
+func (*MsgTransferLoanNote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{30}
+}
+func (m *MsgTransferLoanNote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLoanNote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLoanNote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgTransferLoanNote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLoanNote.Merge(m, src)
+}
+func (m *MsgTransferLoanNote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLoanNote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLoanNote.DiscardUnknown(m)
 }
 
-func (m *MsgRepayMortgage) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgTransferLoanNote proto.InternalMessageInfo
+
+func (m *MsgTransferLoanNote) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferLoanNote) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.MortgageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgTransferLoanNote) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type MsgTransferLoanNoteResponse struct {
+}
+
+func (m *MsgTransferLoanNoteResponse) Reset()         { *m = MsgTransferLoanNoteResponse{} }
+func (m *MsgTransferLoanNoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLoanNoteResponse) ProtoMessage()    {}
+func (*MsgTransferLoanNoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{31}
+}
+func (m *MsgTransferLoanNoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLoanNoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLoanNoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLoanNoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLoanNoteResponse.Merge(m, src)
+}
+func (m *MsgTransferLoanNoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLoanNoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLoanNoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLoanNoteResponse proto.InternalMessageInfo
+
+type MsgJoinSyndicate struct {
+	Lender string `protobuf:"bytes,1,opt,name=lender,proto3" json:"lender,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgJoinSyndicate) Reset()         { *m = MsgJoinSyndicate{} }
+func (m *MsgJoinSyndicate) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinSyndicate) ProtoMessage()    {}
+func (*MsgJoinSyndicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{32}
+}
+func (m *MsgJoinSyndicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinSyndicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinSyndicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinSyndicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinSyndicate.Merge(m, src)
+}
+func (m *MsgJoinSyndicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinSyndicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinSyndicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinSyndicate proto.InternalMessageInfo
+
+func (m *MsgJoinSyndicate) GetLender() string {
+	if m != nil {
+		return m.Lender
+	}
+	return ""
+}
+
+func (m *MsgJoinSyndicate) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type MsgJoinSyndicateResponse struct {
+}
+
+func (m *MsgJoinSyndicateResponse) Reset()         { *m = MsgJoinSyndicateResponse{} }
+func (m *MsgJoinSyndicateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinSyndicateResponse) ProtoMessage()    {}
+func (*MsgJoinSyndicateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{33}
+}
+func (m *MsgJoinSyndicateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinSyndicateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinSyndicateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinSyndicateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinSyndicateResponse.Merge(m, src)
+}
+func (m *MsgJoinSyndicateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinSyndicateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinSyndicateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinSyndicateResponse proto.InternalMessageInfo
+
+type MsgEnableAutoRepayment struct {
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgEnableAutoRepayment) Reset()         { *m = MsgEnableAutoRepayment{} }
+func (m *MsgEnableAutoRepayment) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoRepayment) ProtoMessage()    {}
+func (*MsgEnableAutoRepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{34}
+}
+func (m *MsgEnableAutoRepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAutoRepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoRepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAutoRepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoRepayment.Merge(m, src)
+}
+func (m *MsgEnableAutoRepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoRepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoRepayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAutoRepayment proto.InternalMessageInfo
+
+func (m *MsgEnableAutoRepayment) GetLendee() string {
+	if m != nil {
+		return m.Lendee
+	}
+	return ""
+}
+
+func (m *MsgEnableAutoRepayment) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type MsgEnableAutoRepaymentResponse struct {
+}
+
+func (m *MsgEnableAutoRepaymentResponse) Reset()         { *m = MsgEnableAutoRepaymentResponse{} }
+func (m *MsgEnableAutoRepaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableAutoRepaymentResponse) ProtoMessage()    {}
+func (*MsgEnableAutoRepaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{35}
+}
+func (m *MsgEnableAutoRepaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableAutoRepaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableAutoRepaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableAutoRepaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableAutoRepaymentResponse.Merge(m, src)
+}
+func (m *MsgEnableAutoRepaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableAutoRepaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableAutoRepaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableAutoRepaymentResponse proto.InternalMessageInfo
+
+type MsgDisableAutoRepayment struct {
+	Lendee string `protobuf:"bytes,1,opt,name=lendee,proto3" json:"lendee,omitempty"`
+	Index  string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgDisableAutoRepayment) Reset()         { *m = MsgDisableAutoRepayment{} }
+func (m *MsgDisableAutoRepayment) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoRepayment) ProtoMessage()    {}
+func (*MsgDisableAutoRepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{36}
+}
+func (m *MsgDisableAutoRepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableAutoRepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoRepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableAutoRepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoRepayment.Merge(m, src)
+}
+func (m *MsgDisableAutoRepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoRepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoRepayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableAutoRepayment proto.InternalMessageInfo
+
+func (m *MsgDisableAutoRepayment) GetLendee() string {
+	if m != nil {
+		return m.Lendee
+	}
+	return ""
+}
+
+func (m *MsgDisableAutoRepayment) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type MsgDisableAutoRepaymentResponse struct {
+}
+
+func (m *MsgDisableAutoRepaymentResponse) Reset()         { *m = MsgDisableAutoRepaymentResponse{} }
+func (m *MsgDisableAutoRepaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableAutoRepaymentResponse) ProtoMessage()    {}
+func (*MsgDisableAutoRepaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0636b4d9e2dd4f7a, []int{37}
+}
+func (m *MsgDisableAutoRepaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableAutoRepaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableAutoRepaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableAutoRepaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableAutoRepaymentResponse.Merge(m, src)
+}
+func (m *MsgDisableAutoRepaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableAutoRepaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableAutoRepaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableAutoRepaymentResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "ardapoc.mortgage.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ardapoc.mortgage.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDeleteMortgage)(nil), "ardapoc.mortgage.MsgDeleteMortgage")
+	proto.RegisterType((*MsgDeleteMortgageResponse)(nil), "ardapoc.mortgage.MsgDeleteMortgageResponse")
+	proto.RegisterType((*MsgRepayMortgage)(nil), "ardapoc.mortgage.MsgRepayMortgage")
+	proto.RegisterType((*MsgRepayMortgageResponse)(nil), "ardapoc.mortgage.MsgRepayMortgageResponse")
+	proto.RegisterType((*MsgRequestMortgage)(nil), "ardapoc.mortgage.MsgRequestMortgage")
+	proto.RegisterType((*MsgRequestMortgageResponse)(nil), "ardapoc.mortgage.MsgRequestMortgageResponse")
+	proto.RegisterType((*MsgApproveMortgage)(nil), "ardapoc.mortgage.MsgApproveMortgage")
+	proto.RegisterType((*MsgApproveMortgageResponse)(nil), "ardapoc.mortgage.MsgApproveMortgageResponse")
+	proto.RegisterType((*MsgRejectMortgage)(nil), "ardapoc.mortgage.MsgRejectMortgage")
+	proto.RegisterType((*MsgRejectMortgageResponse)(nil), "ardapoc.mortgage.MsgRejectMortgageResponse")
+	proto.RegisterType((*MsgCancelMortgageRequest)(nil), "ardapoc.mortgage.MsgCancelMortgageRequest")
+	proto.RegisterType((*MsgCancelMortgageRequestResponse)(nil), "ardapoc.mortgage.MsgCancelMortgageRequestResponse")
+	proto.RegisterType((*MsgPurchaseWithMortgage)(nil), "ardapoc.mortgage.MsgPurchaseWithMortgage")
+	proto.RegisterType((*MsgPurchaseWithMortgageResponse)(nil), "ardapoc.mortgage.MsgPurchaseWithMortgageResponse")
+	proto.RegisterType((*MsgAcceptPurchase)(nil), "ardapoc.mortgage.MsgAcceptPurchase")
+	proto.RegisterType((*MsgAcceptPurchaseResponse)(nil), "ardapoc.mortgage.MsgAcceptPurchaseResponse")
+	proto.RegisterType((*MsgRequestEquityLoan)(nil), "ardapoc.mortgage.MsgRequestEquityLoan")
+	proto.RegisterType((*MsgRequestEquityLoanResponse)(nil), "ardapoc.mortgage.MsgRequestEquityLoanResponse")
+	proto.RegisterType((*MsgForeclose)(nil), "ardapoc.mortgage.MsgForeclose")
+	proto.RegisterType((*MsgForecloseResponse)(nil), "ardapoc.mortgage.MsgForecloseResponse")
+	proto.RegisterType((*MsgBidForeclosure)(nil), "ardapoc.mortgage.MsgBidForeclosure")
+	proto.RegisterType((*MsgBidForeclosureResponse)(nil), "ardapoc.mortgage.MsgBidForeclosureResponse")
+	proto.RegisterType((*MsgProposeRefinance)(nil), "ardapoc.mortgage.MsgProposeRefinance")
+	proto.RegisterType((*MsgProposeRefinanceResponse)(nil), "ardapoc.mortgage.MsgProposeRefinanceResponse")
+	proto.RegisterType((*MsgAcceptRefinance)(nil), "ardapoc.mortgage.MsgAcceptRefinance")
+	proto.RegisterType((*MsgAcceptRefinanceResponse)(nil), "ardapoc.mortgage.MsgAcceptRefinanceResponse")
+	proto.RegisterType((*MsgRejectRefinance)(nil), "ardapoc.mortgage.MsgRejectRefinance")
+	proto.RegisterType((*MsgRejectRefinanceResponse)(nil), "ardapoc.mortgage.MsgRejectRefinanceResponse")
+	proto.RegisterType((*MsgTransferLoanNote)(nil), "ardapoc.mortgage.MsgTransferLoanNote")
+	proto.RegisterType((*MsgTransferLoanNoteResponse)(nil), "ardapoc.mortgage.MsgTransferLoanNoteResponse")
+	proto.RegisterType((*MsgJoinSyndicate)(nil), "ardapoc.mortgage.MsgJoinSyndicate")
+	proto.RegisterType((*MsgJoinSyndicateResponse)(nil), "ardapoc.mortgage.MsgJoinSyndicateResponse")
+	proto.RegisterType((*MsgEnableAutoRepayment)(nil), "ardapoc.mortgage.MsgEnableAutoRepayment")
+	proto.RegisterType((*MsgEnableAutoRepaymentResponse)(nil), "ardapoc.mortgage.MsgEnableAutoRepaymentResponse")
+	proto.RegisterType((*MsgDisableAutoRepayment)(nil), "ardapoc.mortgage.MsgDisableAutoRepayment")
+	proto.RegisterType((*MsgDisableAutoRepaymentResponse)(nil), "ardapoc.mortgage.MsgDisableAutoRepaymentResponse")
+}
+
+func init() { proto.RegisterFile("ardapoc/mortgage/tx.proto", fileDescriptor_0636b4d9e2dd4f7a) }
+
+var fileDescriptor_0636b4d9e2dd4f7a = []byte{
+	// 1438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xc1, 0x6f, 0x1c, 0xb5,
+	0x17, 0xce, 0x36, 0x9b, 0x34, 0xfb, 0x92, 0xb6, 0xc9, 0x34, 0xbf, 0x74, 0x32, 0x4d, 0x37, 0xdb,
+	0x6d, 0x7f, 0xd5, 0x92, 0x34, 0x59, 0x1a, 0x04, 0x87, 0x22, 0x21, 0x25, 0xb4, 0x91, 0x8a, 0xba,
+	0x6d, 0x99, 0xa4, 0x42, 0x42, 0x48, 0x91, 0x33, 0xeb, 0xcc, 0x0e, 0xcc, 0x8e, 0xa7, 0xb6, 0xb7,
+	0x74, 0x39, 0x21, 0x8e, 0x70, 0xe1, 0xcf, 0xe0, 0x04, 0x3d, 0x70, 0xe5, 0x84, 0x84, 0x7a, 0xac,
+	0x38, 0x71, 0x42, 0xa8, 0x3d, 0xf4, 0xdf, 0x40, 0xe3, 0x99, 0xf1, 0xce, 0x78, 0xbd, 0xc9, 0xb4,
+	0x09, 0x27, 0xb8, 0x24, 0x6b, 0xfb, 0xf3, 0xfb, 0x9e, 0x9f, 0x3f, 0x3f, 0x3f, 0x0f, 0x2c, 0x22,
+	0xda, 0x46, 0x21, 0x71, 0x9a, 0x5d, 0x42, 0xb9, 0x8b, 0x5c, 0xdc, 0xe4, 0x4f, 0xd6, 0x43, 0x4a,
+	0x38, 0x31, 0x66, 0x93, 0xa1, 0xf5, 0x74, 0xc8, 0x9a, 0x43, 0x5d, 0x2f, 0x20, 0x4d, 0xf1, 0x37,
+	0x06, 0x59, 0x17, 0x1c, 0xc2, 0xba, 0x84, 0x35, 0xbb, 0xcc, 0x6d, 0x3e, 0xbe, 0x11, 0xfd, 0x4b,
+	0x06, 0x16, 0xe3, 0x81, 0x3d, 0xd1, 0x6a, 0xc6, 0x8d, 0x64, 0x68, 0xde, 0x25, 0x2e, 0x89, 0xfb,
+	0xa3, 0x5f, 0x49, 0xef, 0xa5, 0x21, 0x4f, 0x42, 0x44, 0x51, 0x37, 0x9d, 0xb4, 0x3c, 0x34, 0x9c,
+	0xfe, 0x88, 0x01, 0xf5, 0x5f, 0x4a, 0x70, 0xae, 0xc5, 0xdc, 0x87, 0x61, 0x1b, 0x71, 0xfc, 0x40,
+	0x4c, 0x35, 0xde, 0x83, 0x0a, 0xea, 0xf1, 0x0e, 0xa1, 0x1e, 0xef, 0x9b, 0xa5, 0x5a, 0xa9, 0x51,
+	0xd9, 0x32, 0x7f, 0xff, 0x79, 0x6d, 0x3e, 0x71, 0x67, 0xb3, 0xdd, 0xa6, 0x98, 0xb1, 0x1d, 0x4e,
+	0xbd, 0xc0, 0xb5, 0x07, 0x50, 0xe3, 0x7d, 0x98, 0x8c, 0xc9, 0xcd, 0x53, 0xb5, 0x52, 0x63, 0x7a,
+	0xc3, 0x5c, 0x57, 0x63, 0xb1, 0x1e, 0x33, 0x6c, 0x55, 0x9e, 0xfd, 0xb9, 0x3c, 0xf6, 0xc3, 0xab,
+	0xa7, 0x2b, 0x25, 0x3b, 0x99, 0x72, 0xf3, 0xdd, 0x6f, 0x5e, 0x3d, 0x5d, 0x19, 0x18, 0xfb, 0xf6,
+	0xd5, 0xd3, 0x95, 0x7a, 0xea, 0xfc, 0x93, 0x81, 0xfb, 0x8a, 0xaf, 0xf5, 0x45, 0xb8, 0xa0, 0x74,
+	0xd9, 0x98, 0x85, 0x24, 0x60, 0xb8, 0xfe, 0x31, 0xcc, 0xb5, 0x98, 0x7b, 0x0b, 0xfb, 0x98, 0xe3,
+	0x56, 0x32, 0xdf, 0x30, 0xe1, 0xb4, 0x43, 0x31, 0xe2, 0x84, 0xc6, 0x2b, 0xb3, 0xd3, 0xa6, 0x31,
+	0x0f, 0x13, 0x5e, 0xd0, 0xc6, 0x4f, 0x84, 0xf3, 0x15, 0x3b, 0x6e, 0xdc, 0x9c, 0x89, 0xdc, 0x4a,
+	0x31, 0xf5, 0x8b, 0xb0, 0x38, 0x64, 0x52, 0xf2, 0x51, 0x98, 0x6d, 0x31, 0xd7, 0xc6, 0x21, 0xea,
+	0x17, 0xa0, 0xab, 0x02, 0xa4, 0x8b, 0xba, 0xd3, 0x4e, 0x38, 0x33, 0x3d, 0xc6, 0x02, 0x4c, 0xa2,
+	0x2e, 0xe9, 0x05, 0xdc, 0x1c, 0xaf, 0x95, 0x1a, 0x65, 0x3b, 0x69, 0x29, 0x0e, 0x7d, 0x57, 0x02,
+	0x53, 0x25, 0x4d, 0x1d, 0x32, 0x2e, 0x42, 0xc5, 0x47, 0x1c, 0xef, 0x1d, 0x60, 0xcc, 0x04, 0x7d,
+	0xd9, 0x9e, 0x8a, 0x3a, 0xb6, 0x31, 0x66, 0x86, 0x05, 0x53, 0x5e, 0xc0, 0x31, 0xc5, 0x8c, 0x0b,
+	0xf6, 0xb2, 0x2d, 0xdb, 0xc6, 0x12, 0x54, 0x42, 0xea, 0x05, 0x8e, 0x17, 0x22, 0x3f, 0xa1, 0x1f,
+	0x74, 0x44, 0x6b, 0x0a, 0x71, 0x80, 0x7c, 0xde, 0x37, 0xcb, 0x62, 0x2c, 0x6d, 0xd6, 0x9f, 0x95,
+	0xc1, 0x10, 0xde, 0x3c, 0xea, 0x61, 0xc6, 0x65, 0x10, 0x16, 0x60, 0xd2, 0xc7, 0x41, 0x1b, 0xe3,
+	0x24, 0x06, 0x49, 0x4b, 0x1f, 0x71, 0x89, 0xa6, 0xe6, 0x78, 0x06, 0x2d, 0x02, 0xe6, 0x10, 0x3f,
+	0xf2, 0x9f, 0x22, 0x5f, 0x30, 0x57, 0xec, 0x4c, 0x4f, 0x26, 0x60, 0x13, 0xd9, 0x80, 0x19, 0x57,
+	0xe0, 0x4c, 0xba, 0xb0, 0x3d, 0x8a, 0x38, 0x36, 0x27, 0xc5, 0xd4, 0x99, 0xb4, 0xd3, 0x46, 0x1c,
+	0x1b, 0xcb, 0x30, 0xcd, 0x31, 0xed, 0xee, 0x75, 0x49, 0xc0, 0x3b, 0xcc, 0x3c, 0x5d, 0x2b, 0x35,
+	0xce, 0xd8, 0x10, 0x75, 0xb5, 0x44, 0x8f, 0x71, 0x1f, 0xe6, 0x42, 0xd4, 0xef, 0xe2, 0x80, 0xef,
+	0x1d, 0xd0, 0x68, 0x7d, 0x81, 0xd3, 0x37, 0xa7, 0x6a, 0xa5, 0xc6, 0xd9, 0x8d, 0xba, 0x4e, 0xe6,
+	0x02, 0xba, 0x9d, 0x22, 0xed, 0xd9, 0x50, 0xe9, 0x31, 0xb6, 0x61, 0x06, 0x45, 0x78, 0xef, 0x2b,
+	0xc4, 0x3d, 0x12, 0x98, 0x95, 0x51, 0xb6, 0x36, 0x33, 0xa8, 0xdd, 0x7e, 0x88, 0xed, 0xdc, 0x3c,
+	0xe3, 0x03, 0x98, 0x0a, 0x7b, 0xd4, 0xe9, 0x20, 0x86, 0x4d, 0x10, 0xc7, 0x4e, 0x63, 0x23, 0xdd,
+	0x8a, 0x07, 0x09, 0xd2, 0x96, 0x73, 0x8c, 0xdb, 0x50, 0x61, 0xfd, 0xa0, 0xed, 0x39, 0x51, 0x68,
+	0xa6, 0x6b, 0xe3, 0x8d, 0xe9, 0x8d, 0xcb, 0xc3, 0x06, 0x76, 0x52, 0xc8, 0x5d, 0xb1, 0x19, 0x5b,
+	0xe5, 0xe8, 0x00, 0xdb, 0x83, 0x99, 0xc6, 0x26, 0x40, 0x48, 0x71, 0xb2, 0x4a, 0x73, 0xa6, 0x56,
+	0xd2, 0xdb, 0x79, 0x20, 0x31, 0xbb, 0x98, 0x76, 0x99, 0x9d, 0x99, 0x74, 0x73, 0x3a, 0x52, 0x76,
+	0xa2, 0x8d, 0xfa, 0x12, 0x58, 0xc3, 0x4a, 0x92, 0x47, 0xed, 0xbe, 0xd0, 0xd9, 0x66, 0x18, 0x52,
+	0xf2, 0x18, 0x0f, 0xe9, 0x8c, 0xe6, 0x74, 0x36, 0xea, 0x64, 0x67, 0xe8, 0x68, 0x42, 0xa7, 0x18,
+	0x94, 0x74, 0xf7, 0x44, 0x26, 0xb1, 0xf1, 0xe7, 0xd8, 0xe1, 0x27, 0xc1, 0x16, 0xa7, 0x91, 0xbc,
+	0x3d, 0x49, 0xf6, 0x50, 0x9c, 0xe8, 0x0f, 0x51, 0xe0, 0x60, 0x7f, 0x30, 0x28, 0xe2, 0xf0, 0x7a,
+	0x27, 0x29, 0x1f, 0xd0, 0x3a, 0xd4, 0x46, 0x99, 0x95, 0xd4, 0xbb, 0x22, 0x99, 0xa6, 0x22, 0xf9,
+	0xc4, 0xe3, 0x9d, 0x93, 0x58, 0xed, 0x65, 0x58, 0x1e, 0x61, 0x55, 0x09, 0xf0, 0xa6, 0xe3, 0xe0,
+	0x90, 0xa7, 0xc0, 0x88, 0x92, 0x61, 0xdf, 0x1f, 0x50, 0xc6, 0xad, 0x43, 0x29, 0x63, 0x48, 0x12,
+	0xe0, 0xbc, 0x3d, 0x49, 0xf6, 0xeb, 0x38, 0xcc, 0x0f, 0xb4, 0x75, 0xfb, 0x51, 0xcf, 0xe3, 0xfd,
+	0xbb, 0x04, 0x05, 0xff, 0xe5, 0xa9, 0x7f, 0x32, 0x4f, 0xe5, 0x13, 0x04, 0x1c, 0x3b, 0x41, 0x54,
+	0x61, 0x49, 0xb7, 0x89, 0x72, 0x97, 0x1d, 0x98, 0x69, 0x31, 0x77, 0x9b, 0x50, 0xec, 0xf8, 0x84,
+	0xbd, 0xa6, 0x80, 0xa3, 0x3b, 0x0e, 0xf5, 0x1c, 0xb1, 0xe0, 0x68, 0x77, 0xa7, 0xec, 0xb4, 0x99,
+	0x97, 0xf6, 0x02, 0xcc, 0x67, 0x49, 0x24, 0xf9, 0x81, 0xd0, 0xf3, 0x96, 0xd7, 0x4e, 0x87, 0x7a,
+	0x54, 0x78, 0xb0, 0xef, 0xb5, 0x33, 0x1e, 0xc4, 0xad, 0xd1, 0xf2, 0xd2, 0xde, 0xff, 0x31, 0x7f,
+	0x3c, 0x35, 0xd1, 0x79, 0x9e, 0x47, 0x3a, 0xf1, 0xd3, 0x29, 0x38, 0x1f, 0x1d, 0x3c, 0x4a, 0x42,
+	0xe1, 0xdb, 0x81, 0x17, 0x44, 0xa7, 0xff, 0x35, 0x23, 0x31, 0x24, 0xcb, 0xf1, 0xa3, 0x65, 0x59,
+	0x2e, 0x26, 0xcb, 0x89, 0x13, 0x94, 0xe5, 0xe4, 0x9b, 0xc9, 0x32, 0xbf, 0x9d, 0x97, 0xe0, 0xa2,
+	0x26, 0x60, 0x32, 0xa0, 0x3f, 0x9e, 0x02, 0x43, 0xa6, 0x95, 0xe1, 0x78, 0x16, 0x4b, 0x1b, 0xff,
+	0x9e, 0x78, 0xa6, 0x97, 0xb8, 0x12, 0x2f, 0xe5, 0x12, 0x8f, 0x6f, 0xc1, 0x37, 0x8c, 0xa6, 0xbe,
+	0x66, 0xc8, 0x19, 0x94, 0x74, 0x81, 0x38, 0x0d, 0xbb, 0x14, 0x05, 0xec, 0x00, 0xd3, 0x28, 0x57,
+	0xdc, 0x23, 0x5c, 0xf0, 0x75, 0x88, 0x9f, 0x39, 0x0d, 0x71, 0x6b, 0xc4, 0xee, 0x2d, 0x41, 0x85,
+	0x62, 0xc7, 0x0b, 0x3d, 0x9c, 0x1c, 0xcc, 0x8a, 0x3d, 0xe8, 0x48, 0xbc, 0x89, 0x0d, 0x24, 0x62,
+	0x52, 0xf9, 0xa4, 0x3b, 0x2d, 0xf1, 0x5a, 0xf8, 0x88, 0x78, 0x81, 0xac, 0xad, 0x8e, 0x73, 0xc9,
+	0x5a, 0x60, 0xaa, 0xe6, 0x24, 0xd5, 0x0e, 0x2c, 0xb4, 0x98, 0x7b, 0x3b, 0x40, 0xfb, 0x3e, 0xde,
+	0xec, 0x71, 0x62, 0xa7, 0x19, 0xf5, 0x38, 0xc1, 0xae, 0x41, 0x55, 0x6f, 0x54, 0xa9, 0x26, 0x6e,
+	0x79, 0xec, 0x44, 0x79, 0xe3, 0x6a, 0x42, 0x67, 0x35, 0x25, 0xde, 0xf8, 0xed, 0x1c, 0x8c, 0xb7,
+	0x98, 0x6b, 0x7c, 0x06, 0x33, 0xb9, 0x77, 0xad, 0xe6, 0xba, 0x51, 0xde, 0x8e, 0xd6, 0x5b, 0x47,
+	0x42, 0xe4, 0xeb, 0x6a, 0x1f, 0xce, 0x2a, 0x6f, 0xcb, 0x2b, 0xda, 0xc9, 0x79, 0x90, 0xb5, 0x5a,
+	0x00, 0x24, 0x39, 0xf6, 0xe0, 0x4c, 0xfe, 0x3d, 0x59, 0xd7, 0xce, 0xce, 0x61, 0xac, 0x95, 0xa3,
+	0x31, 0x92, 0x00, 0xc3, 0x39, 0xf5, 0xb5, 0x76, 0x75, 0xc4, 0xf4, 0x1c, 0xca, 0xba, 0x5e, 0x04,
+	0x95, 0xa5, 0x51, 0x8b, 0x75, 0x3d, 0x8d, 0x82, 0xb2, 0xae, 0x17, 0x41, 0x65, 0xb7, 0x44, 0x29,
+	0xd2, 0xaf, 0x8c, 0x70, 0x33, 0x0b, 0xb2, 0x56, 0x0b, 0x80, 0x24, 0xc7, 0x97, 0xf0, 0x3f, 0x7d,
+	0x6d, 0xae, 0x0f, 0xbb, 0x16, 0x6b, 0x6d, 0x14, 0xc7, 0x4a, 0x62, 0x0e, 0xf3, 0xda, 0xca, 0x5c,
+	0x2f, 0x59, 0x1d, 0xd4, 0xba, 0x51, 0x18, 0x9a, 0x0d, 0xa9, 0x52, 0x96, 0xeb, 0x43, 0x9a, 0x07,
+	0x59, 0xab, 0x05, 0x40, 0x92, 0xe3, 0x0b, 0x98, 0x1b, 0x2e, 0xc6, 0xaf, 0x1d, 0x26, 0xb0, 0x01,
+	0xce, 0x5a, 0x2f, 0x86, 0x93, 0x64, 0x1d, 0x98, 0x1d, 0xba, 0x03, 0xfe, 0xaf, 0xb5, 0xa1, 0xc2,
+	0xac, 0xb5, 0x42, 0xb0, 0xec, 0xe1, 0xcd, 0xa7, 0x77, 0xfd, 0xe1, 0xcd, 0x61, 0xac, 0x95, 0xa3,
+	0x31, 0x92, 0xe0, 0x11, 0x9c, 0xd7, 0x25, 0xf5, 0x86, 0xd6, 0x84, 0x06, 0x69, 0xbd, 0x5d, 0x14,
+	0x99, 0x15, 0xa1, 0x36, 0xa1, 0xeb, 0x45, 0xa8, 0x83, 0x5a, 0x37, 0x0a, 0x43, 0x25, 0xeb, 0x0e,
+	0x54, 0x06, 0x85, 0x7c, 0x55, 0x3b, 0x5f, 0x8e, 0x5b, 0xd7, 0x0e, 0x1f, 0xcf, 0x2a, 0x5b, 0x29,
+	0xd0, 0xf5, 0xca, 0xce, 0x83, 0xac, 0xd5, 0x02, 0xa0, 0xac, 0xd8, 0x86, 0xca, 0x6f, 0xbd, 0xd8,
+	0x54, 0x98, 0xb5, 0x56, 0x08, 0x96, 0xcb, 0xb0, 0x4a, 0x5d, 0x7a, 0xf5, 0x90, 0x33, 0x38, 0xe0,
+	0xb9, 0x5e, 0x04, 0x95, 0xbf, 0x2f, 0xf2, 0x05, 0xdb, 0xd5, 0x43, 0xb2, 0xe7, 0x51, 0x34, 0x23,
+	0x6a, 0x35, 0x6b, 0xe2, 0xeb, 0xe8, 0xdb, 0xf0, 0xd6, 0x9d, 0x67, 0x2f, 0xaa, 0xa5, 0xe7, 0x2f,
+	0xaa, 0xa5, 0xbf, 0x5e, 0x54, 0x4b, 0xdf, 0xbf, 0xac, 0x8e, 0x3d, 0x7f, 0x59, 0x1d, 0xfb, 0xe3,
+	0x65, 0x75, 0xec, 0xd3, 0xa6, 0xeb, 0xf1, 0x4e, 0x6f, 0x7f, 0xdd, 0x21, 0xdd, 0x66, 0x64, 0xd8,
+	0xf5, 0xc9, 0x3e, 0xf2, 0xc5, 0xcf, 0x35, 0xe5, 0x8b, 0x31, 0xef, 0x87, 0x98, 0xed, 0x4f, 0x8a,
+	0xcf, 0xdd, 0xef, 0xfc, 0x3d, 0x00, 0x0c, 0xdb, 0x2b, 0xec, 0xba, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error)
+	RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error)
+	// RequestMortgage records a lendee's request for a mortgage. Nothing moves
+	// until the lender approves it.
+	RequestMortgage(ctx context.Context, in *MsgRequestMortgage, opts ...grpc.CallOption) (*MsgRequestMortgageResponse, error)
+	ApproveMortgage(ctx context.Context, in *MsgApproveMortgage, opts ...grpc.CallOption) (*MsgApproveMortgageResponse, error)
+	RejectMortgage(ctx context.Context, in *MsgRejectMortgage, opts ...grpc.CallOption) (*MsgRejectMortgageResponse, error)
+	CancelMortgageRequest(ctx context.Context, in *MsgCancelMortgageRequest, opts ...grpc.CallOption) (*MsgCancelMortgageRequestResponse, error)
+	// PurchaseWithMortgage approves a mortgage request that finances a property
+	// purchase. Funding the mortgage, paying the sellers, transferring the shares
+	// to the lendee and escrowing them happen together or not at all. Every
+	// seller must have accepted the purchase with AcceptPurchase.
+	PurchaseWithMortgage(ctx context.Context, in *MsgPurchaseWithMortgage, opts ...grpc.CallOption) (*MsgPurchaseWithMortgageResponse, error)
+	// AcceptPurchase agrees to sell a seller's shares at the price of a
+	// mortgage request that finances their purchase.
+	AcceptPurchase(ctx context.Context, in *MsgAcceptPurchase, opts ...grpc.CallOption) (*MsgAcceptPurchaseResponse, error)
+	// RequestEquityLoan requests a home equity loan against property shares the
+	// lendee owns. The lender approves it with ApproveMortgage.
+	RequestEquityLoan(ctx context.Context, in *MsgRequestEquityLoan, opts ...grpc.CallOption) (*MsgRequestEquityLoanResponse, error)
+	// TransferLoanNote assigns the lender's position in a mortgage to someone
+	// else. The recipient becomes the lender and receives future repayments.
+	TransferLoanNote(ctx context.Context, in *MsgTransferLoanNote, opts ...grpc.CallOption) (*MsgTransferLoanNoteResponse, error)
+	// JoinSyndicate commits a member of the syndicate of a mortgage request to
+	// fund its contribution once the lender approves the request.
+	JoinSyndicate(ctx context.Context, in *MsgJoinSyndicate, opts ...grpc.CallOption) (*MsgJoinSyndicateResponse, error)
+	// EnableAutoRepayment makes the mortgage module pull each installment from
+	// the lendee when it falls due. The lendee must have granted the module
+	// account a SendAuthorization through x/authz.
+	EnableAutoRepayment(ctx context.Context, in *MsgEnableAutoRepayment, opts ...grpc.CallOption) (*MsgEnableAutoRepaymentResponse, error)
+	DisableAutoRepayment(ctx context.Context, in *MsgDisableAutoRepayment, opts ...grpc.CallOption) (*MsgDisableAutoRepaymentResponse, error)
+	// Foreclose lets the lender of a defaulted mortgage take the escrowed
+	// collateral shares or put them up for auction.
+	Foreclose(ctx context.Context, in *MsgForeclose, opts ...grpc.CallOption) (*MsgForecloseResponse, error)
+	BidForeclosure(ctx context.Context, in *MsgBidForeclosure, opts ...grpc.CallOption) (*MsgBidForeclosureResponse, error)
+	// ProposeRefinance offers the lendee new repayment terms for the balance of
+	// an active mortgage. They only take effect once the lendee accepts them.
+	ProposeRefinance(ctx context.Context, in *MsgProposeRefinance, opts ...grpc.CallOption) (*MsgProposeRefinanceResponse, error)
+	AcceptRefinance(ctx context.Context, in *MsgAcceptRefinance, opts ...grpc.CallOption) (*MsgAcceptRefinanceResponse, error)
+	RejectRefinance(ctx context.Context, in *MsgRejectRefinance, opts ...grpc.CallOption) (*MsgRejectRefinanceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteMortgage(ctx context.Context, in *MsgDeleteMortgage, opts ...grpc.CallOption) (*MsgDeleteMortgageResponse, error) {
+	out := new(MsgDeleteMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/DeleteMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepayMortgage(ctx context.Context, in *MsgRepayMortgage, opts ...grpc.CallOption) (*MsgRepayMortgageResponse, error) {
+	out := new(MsgRepayMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RepayMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestMortgage(ctx context.Context, in *MsgRequestMortgage, opts ...grpc.CallOption) (*MsgRequestMortgageResponse, error) {
+	out := new(MsgRequestMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RequestMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMortgage(ctx context.Context, in *MsgApproveMortgage, opts ...grpc.CallOption) (*MsgApproveMortgageResponse, error) {
+	out := new(MsgApproveMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/ApproveMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectMortgage(ctx context.Context, in *MsgRejectMortgage, opts ...grpc.CallOption) (*MsgRejectMortgageResponse, error) {
+	out := new(MsgRejectMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RejectMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelMortgageRequest(ctx context.Context, in *MsgCancelMortgageRequest, opts ...grpc.CallOption) (*MsgCancelMortgageRequestResponse, error) {
+	out := new(MsgCancelMortgageRequestResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/CancelMortgageRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurchaseWithMortgage(ctx context.Context, in *MsgPurchaseWithMortgage, opts ...grpc.CallOption) (*MsgPurchaseWithMortgageResponse, error) {
+	out := new(MsgPurchaseWithMortgageResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/PurchaseWithMortgage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPurchase(ctx context.Context, in *MsgAcceptPurchase, opts ...grpc.CallOption) (*MsgAcceptPurchaseResponse, error) {
+	out := new(MsgAcceptPurchaseResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/AcceptPurchase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestEquityLoan(ctx context.Context, in *MsgRequestEquityLoan, opts ...grpc.CallOption) (*MsgRequestEquityLoanResponse, error) {
+	out := new(MsgRequestEquityLoanResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RequestEquityLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLoanNote(ctx context.Context, in *MsgTransferLoanNote, opts ...grpc.CallOption) (*MsgTransferLoanNoteResponse, error) {
+	out := new(MsgTransferLoanNoteResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/TransferLoanNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) JoinSyndicate(ctx context.Context, in *MsgJoinSyndicate, opts ...grpc.CallOption) (*MsgJoinSyndicateResponse, error) {
+	out := new(MsgJoinSyndicateResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/JoinSyndicate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableAutoRepayment(ctx context.Context, in *MsgEnableAutoRepayment, opts ...grpc.CallOption) (*MsgEnableAutoRepaymentResponse, error) {
+	out := new(MsgEnableAutoRepaymentResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/EnableAutoRepayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableAutoRepayment(ctx context.Context, in *MsgDisableAutoRepayment, opts ...grpc.CallOption) (*MsgDisableAutoRepaymentResponse, error) {
+	out := new(MsgDisableAutoRepaymentResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/DisableAutoRepayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Foreclose(ctx context.Context, in *MsgForeclose, opts ...grpc.CallOption) (*MsgForecloseResponse, error) {
+	out := new(MsgForecloseResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/Foreclose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidForeclosure(ctx context.Context, in *MsgBidForeclosure, opts ...grpc.CallOption) (*MsgBidForeclosureResponse, error) {
+	out := new(MsgBidForeclosureResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/BidForeclosure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeRefinance(ctx context.Context, in *MsgProposeRefinance, opts ...grpc.CallOption) (*MsgProposeRefinanceResponse, error) {
+	out := new(MsgProposeRefinanceResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/ProposeRefinance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptRefinance(ctx context.Context, in *MsgAcceptRefinance, opts ...grpc.CallOption) (*MsgAcceptRefinanceResponse, error) {
+	out := new(MsgAcceptRefinanceResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/AcceptRefinance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectRefinance(ctx context.Context, in *MsgRejectRefinance, opts ...grpc.CallOption) (*MsgRejectRefinanceResponse, error) {
+	out := new(MsgRejectRefinanceResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.mortgage.Msg/RejectRefinance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	DeleteMortgage(context.Context, *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error)
+	RepayMortgage(context.Context, *MsgRepayMortgage) (*MsgRepayMortgageResponse, error)
+	// RequestMortgage records a lendee's request for a mortgage. Nothing moves
+	// until the lender approves it.
+	RequestMortgage(context.Context, *MsgRequestMortgage) (*MsgRequestMortgageResponse, error)
+	ApproveMortgage(context.Context, *MsgApproveMortgage) (*MsgApproveMortgageResponse, error)
+	RejectMortgage(context.Context, *MsgRejectMortgage) (*MsgRejectMortgageResponse, error)
+	CancelMortgageRequest(context.Context, *MsgCancelMortgageRequest) (*MsgCancelMortgageRequestResponse, error)
+	// PurchaseWithMortgage approves a mortgage request that finances a property
+	// purchase. Funding the mortgage, paying the sellers, transferring the shares
+	// to the lendee and escrowing them happen together or not at all. Every
+	// seller must have accepted the purchase with AcceptPurchase.
+	PurchaseWithMortgage(context.Context, *MsgPurchaseWithMortgage) (*MsgPurchaseWithMortgageResponse, error)
+	// AcceptPurchase agrees to sell a seller's shares at the price of a
+	// mortgage request that finances their purchase.
+	AcceptPurchase(context.Context, *MsgAcceptPurchase) (*MsgAcceptPurchaseResponse, error)
+	// RequestEquityLoan requests a home equity loan against property shares the
+	// lendee owns. The lender approves it with ApproveMortgage.
+	RequestEquityLoan(context.Context, *MsgRequestEquityLoan) (*MsgRequestEquityLoanResponse, error)
+	// TransferLoanNote assigns the lender's position in a mortgage to someone
+	// else. The recipient becomes the lender and receives future repayments.
+	TransferLoanNote(context.Context, *MsgTransferLoanNote) (*MsgTransferLoanNoteResponse, error)
+	// JoinSyndicate commits a member of the syndicate of a mortgage request to
+	// fund its contribution once the lender approves the request.
+	JoinSyndicate(context.Context, *MsgJoinSyndicate) (*MsgJoinSyndicateResponse, error)
+	// EnableAutoRepayment makes the mortgage module pull each installment from
+	// the lendee when it falls due. The lendee must have granted the module
+	// account a SendAuthorization through x/authz.
+	EnableAutoRepayment(context.Context, *MsgEnableAutoRepayment) (*MsgEnableAutoRepaymentResponse, error)
+	DisableAutoRepayment(context.Context, *MsgDisableAutoRepayment) (*MsgDisableAutoRepaymentResponse, error)
+	// Foreclose lets the lender of a defaulted mortgage take the escrowed
+	// collateral shares or put them up for auction.
+	Foreclose(context.Context, *MsgForeclose) (*MsgForecloseResponse, error)
+	BidForeclosure(context.Context, *MsgBidForeclosure) (*MsgBidForeclosureResponse, error)
+	// ProposeRefinance offers the lendee new repayment terms for the balance of
+	// an active mortgage. They only take effect once the lendee accepts them.
+	ProposeRefinance(context.Context, *MsgProposeRefinance) (*MsgProposeRefinanceResponse, error)
+	AcceptRefinance(context.Context, *MsgAcceptRefinance) (*MsgAcceptRefinanceResponse, error)
+	RejectRefinance(context.Context, *MsgRejectRefinance) (*MsgRejectRefinanceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) DeleteMortgage(ctx context.Context, req *MsgDeleteMortgage) (*MsgDeleteMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMortgage not implemented")
+}
+func (*UnimplementedMsgServer) RepayMortgage(ctx context.Context, req *MsgRepayMortgage) (*MsgRepayMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayMortgage not implemented")
+}
+func (*UnimplementedMsgServer) RequestMortgage(ctx context.Context, req *MsgRequestMortgage) (*MsgRequestMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMortgage not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMortgage(ctx context.Context, req *MsgApproveMortgage) (*MsgApproveMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMortgage not implemented")
+}
+func (*UnimplementedMsgServer) RejectMortgage(ctx context.Context, req *MsgRejectMortgage) (*MsgRejectMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMortgage not implemented")
+}
+func (*UnimplementedMsgServer) CancelMortgageRequest(ctx context.Context, req *MsgCancelMortgageRequest) (*MsgCancelMortgageRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMortgageRequest not implemented")
+}
+func (*UnimplementedMsgServer) PurchaseWithMortgage(ctx context.Context, req *MsgPurchaseWithMortgage) (*MsgPurchaseWithMortgageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseWithMortgage not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPurchase(ctx context.Context, req *MsgAcceptPurchase) (*MsgAcceptPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPurchase not implemented")
+}
+func (*UnimplementedMsgServer) RequestEquityLoan(ctx context.Context, req *MsgRequestEquityLoan) (*MsgRequestEquityLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEquityLoan not implemented")
+}
+func (*UnimplementedMsgServer) TransferLoanNote(ctx context.Context, req *MsgTransferLoanNote) (*MsgTransferLoanNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLoanNote not implemented")
+}
+func (*UnimplementedMsgServer) JoinSyndicate(ctx context.Context, req *MsgJoinSyndicate) (*MsgJoinSyndicateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinSyndicate not implemented")
+}
+func (*UnimplementedMsgServer) EnableAutoRepayment(ctx context.Context, req *MsgEnableAutoRepayment) (*MsgEnableAutoRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAutoRepayment not implemented")
+}
+func (*UnimplementedMsgServer) DisableAutoRepayment(ctx context.Context, req *MsgDisableAutoRepayment) (*MsgDisableAutoRepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAutoRepayment not implemented")
+}
+func (*UnimplementedMsgServer) Foreclose(ctx context.Context, req *MsgForeclose) (*MsgForecloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Foreclose not implemented")
+}
+func (*UnimplementedMsgServer) BidForeclosure(ctx context.Context, req *MsgBidForeclosure) (*MsgBidForeclosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidForeclosure not implemented")
+}
+func (*UnimplementedMsgServer) ProposeRefinance(ctx context.Context, req *MsgProposeRefinance) (*MsgProposeRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeRefinance not implemented")
+}
+func (*UnimplementedMsgServer) AcceptRefinance(ctx context.Context, req *MsgAcceptRefinance) (*MsgAcceptRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptRefinance not implemented")
+}
+func (*UnimplementedMsgServer) RejectRefinance(ctx context.Context, req *MsgRejectRefinance) (*MsgRejectRefinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRefinance not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/DeleteMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteMortgage(ctx, req.(*MsgDeleteMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/RepayMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayMortgage(ctx, req.(*MsgRepayMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/RequestMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestMortgage(ctx, req.(*MsgRequestMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/ApproveMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMortgage(ctx, req.(*MsgApproveMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/RejectMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectMortgage(ctx, req.(*MsgRejectMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelMortgageRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMortgageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMortgageRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/CancelMortgageRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMortgageRequest(ctx, req.(*MsgCancelMortgageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseWithMortgage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseWithMortgage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseWithMortgage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/PurchaseWithMortgage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseWithMortgage(ctx, req.(*MsgPurchaseWithMortgage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPurchase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/AcceptPurchase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPurchase(ctx, req.(*MsgAcceptPurchase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestEquityLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestEquityLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestEquityLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/RequestEquityLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestEquityLoan(ctx, req.(*MsgRequestEquityLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLoanNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLoanNote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLoanNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/TransferLoanNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLoanNote(ctx, req.(*MsgTransferLoanNote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinSyndicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinSyndicate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinSyndicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/JoinSyndicate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinSyndicate(ctx, req.(*MsgJoinSyndicate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableAutoRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableAutoRepayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableAutoRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/EnableAutoRepayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableAutoRepayment(ctx, req.(*MsgEnableAutoRepayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableAutoRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableAutoRepayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableAutoRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/DisableAutoRepayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableAutoRepayment(ctx, req.(*MsgDisableAutoRepayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Foreclose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForeclose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Foreclose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/Foreclose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Foreclose(ctx, req.(*MsgForeclose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidForeclosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidForeclosure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidForeclosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/BidForeclosure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidForeclosure(ctx, req.(*MsgBidForeclosure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/ProposeRefinance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeRefinance(ctx, req.(*MsgProposeRefinance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/AcceptRefinance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptRefinance(ctx, req.(*MsgAcceptRefinance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RejectRefinance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectRefinance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RejectRefinance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.mortgage.Msg/RejectRefinance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectRefinance(ctx, req.(*MsgRejectRefinance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.mortgage.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "DeleteMortgage",
+			Handler:    _Msg_DeleteMortgage_Handler,
+		},
+		{
+			MethodName: "RepayMortgage",
+			Handler:    _Msg_RepayMortgage_Handler,
+		},
+		{
+			MethodName: "RequestMortgage",
+			Handler:    _Msg_RequestMortgage_Handler,
+		},
+		{
+			MethodName: "ApproveMortgage",
+			Handler:    _Msg_ApproveMortgage_Handler,
+		},
+		{
+			MethodName: "RejectMortgage",
+			Handler:    _Msg_RejectMortgage_Handler,
+		},
+		{
+			MethodName: "CancelMortgageRequest",
+			Handler:    _Msg_CancelMortgageRequest_Handler,
+		},
+		{
+			MethodName: "PurchaseWithMortgage",
+			Handler:    _Msg_PurchaseWithMortgage_Handler,
+		},
+		{
+			MethodName: "AcceptPurchase",
+			Handler:    _Msg_AcceptPurchase_Handler,
+		},
+		{
+			MethodName: "RequestEquityLoan",
+			Handler:    _Msg_RequestEquityLoan_Handler,
+		},
+		{
+			MethodName: "TransferLoanNote",
+			Handler:    _Msg_TransferLoanNote_Handler,
+		},
+		{
+			MethodName: "JoinSyndicate",
+			Handler:    _Msg_JoinSyndicate_Handler,
+		},
+		{
+			MethodName: "EnableAutoRepayment",
+			Handler:    _Msg_EnableAutoRepayment_Handler,
+		},
+		{
+			MethodName: "DisableAutoRepayment",
+			Handler:    _Msg_DisableAutoRepayment_Handler,
+		},
+		{
+			MethodName: "Foreclose",
+			Handler:    _Msg_Foreclose_Handler,
+		},
+		{
+			MethodName: "BidForeclosure",
+			Handler:    _Msg_BidForeclosure_Handler,
+		},
+		{
+			MethodName: "ProposeRefinance",
+			Handler:    _Msg_ProposeRefinance_Handler,
+		},
+		{
+			MethodName: "AcceptRefinance",
+			Handler:    _Msg_AcceptRefinance_Handler,
+		},
+		{
+			MethodName: "RejectRefinance",
+			Handler:    _Msg_RejectRefinance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/mortgage/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MortgageId) > 0 {
+		i -= len(m.MortgageId)
+		copy(dAtA[i:], m.MortgageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MortgageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Penalty != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Penalty))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Principal != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Principal))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interest != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LateFees != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LateFees))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prepayment != nil {
+		{
+			size, err := m.Prepayment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Syndicate) > 0 {
+		for iNdEx := len(m.Syndicate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Syndicate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Purchase != nil {
+		{
+			size, err := m.Purchase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Amortization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMortgageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMortgageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMortgageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelMortgageRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelMortgageRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelMortgageRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseWithMortgage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseWithMortgage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseWithMortgage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseWithMortgageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseWithMortgageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseWithMortgageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPurchaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPurchaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPurchaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestEquityLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestEquityLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestEquityLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prepayment != nil {
+		{
+			size, err := m.Prepayment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Amortization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestEquityLoanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestEquityLoanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestEquityLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForeclose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForeclose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForeclose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction {
+		i--
+		if m.Auction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForecloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForecloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForecloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidForeclosure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidForeclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidForeclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidForeclosureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidForeclosureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidForeclosureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRefinance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRefinance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRefinance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amortization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeRefinanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeRefinanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeRefinanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptRefinance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptRefinance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptRefinance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amortization != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amortization))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PaymentFrequency != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PaymentFrequency))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TermMonths != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TermMonths))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptRefinanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptRefinanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptRefinanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRefinance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRefinance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRefinance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectRefinanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectRefinanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectRefinanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLoanNote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLoanNote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLoanNote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLoanNoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLoanNoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLoanNoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSyndicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSyndicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSyndicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lender) > 0 {
+		i -= len(m.Lender)
+		copy(dAtA[i:], m.Lender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSyndicateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSyndicateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSyndicateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAutoRepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoRepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoRepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableAutoRepaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableAutoRepaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableAutoRepaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoRepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoRepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoRepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lendee) > 0 {
+		i -= len(m.Lendee)
+		copy(dAtA[i:], m.Lendee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lendee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAutoRepaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAutoRepaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAutoRepaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRepayMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MortgageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgRepayMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LateFees != 0 {
+		n += 1 + sovTx(uint64(m.LateFees))
+	}
+	if m.Interest != 0 {
+		n += 1 + sovTx(uint64(m.Interest))
+	}
+	if m.Principal != 0 {
+		n += 1 + sovTx(uint64(m.Principal))
+	}
+	if m.Penalty != 0 {
+		n += 1 + sovTx(uint64(m.Penalty))
+	}
+	return n
+}
+
+func (m *MsgRequestMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovTx(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovTx(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovTx(uint64(m.Amortization))
+	}
+	if m.Purchase != nil {
+		l = m.Purchase.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Syndicate) > 0 {
+		for _, e := range m.Syndicate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Prepayment != nil {
+		l = m.Prepayment.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelMortgageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelMortgageRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurchaseWithMortgage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPurchaseWithMortgageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptPurchaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestEquityLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovTx(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovTx(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovTx(uint64(m.Amortization))
+	}
+	if m.Prepayment != nil {
+		l = m.Prepayment.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestEquityLoanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForeclose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Auction {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgForecloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBidForeclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Amount != 0 {
 		n += 1 + sovTx(uint64(m.Amount))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgBidForeclosureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeRefinance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovTx(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovTx(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovTx(uint64(m.Amortization))
+	}
+	return n
+}
+
+func (m *MsgProposeRefinanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptRefinance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TermMonths != 0 {
+		n += 1 + sovTx(uint64(m.TermMonths))
+	}
+	if m.PaymentFrequency != 0 {
+		n += 1 + sovTx(uint64(m.PaymentFrequency))
+	}
+	if m.Amortization != 0 {
+		n += 1 + sovTx(uint64(m.Amortization))
+	}
+	return n
+}
+
+func (m *MsgAcceptRefinanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectRefinance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectRefinanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferLoanNote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferLoanNoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinSyndicate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinSyndicateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableAutoRepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableAutoRepaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableAutoRepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lendee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableAutoRepaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MortgageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MortgageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateFees", wireType)
+			}
+			m.LateFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interest", wireType)
+			}
+			m.Interest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			m.Principal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Principal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			m.Penalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Penalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+			}
+			m.TermMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+			}
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+			}
+			m.Amortization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amortization |= AmortizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Purchase == nil {
+				m.Purchase = &MortgagePurchase{}
+			}
+			if err := m.Purchase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syndicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Syndicate = append(m.Syndicate, SyndicateLender{})
+			if err := m.Syndicate[len(m.Syndicate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prepayment == nil {
+				m.Prepayment = &PrepaymentTerms{}
+			}
+			if err := m.Prepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMortgageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMortgageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMortgageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelMortgageRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelMortgageRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelMortgageRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurchaseWithMortgage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseWithMortgage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseWithMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurchaseWithMortgageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseWithMortgageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseWithMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPurchaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPurchaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPurchaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestEquityLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestEquityLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestEquityLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lendee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+			}
+			m.TermMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+			}
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+			}
+			m.Amortization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amortization |= AmortizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prepayment == nil {
+				m.Prepayment = &PrepaymentTerms{}
+			}
+			if err := m.Prepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestEquityLoanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestEquityLoanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestEquityLoanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForeclose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForeclose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForeclose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Auction = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgForecloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgForecloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgForecloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBidForeclosure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBidForeclosure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBidForeclosure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBidForeclosureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBidForeclosureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBidForeclosureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgRepayMortgageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeRefinance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,15 +7509,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeRefinance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1508,13 +7545,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Lender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1524,25 +7561,113 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
+			}
+			m.TermMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermMonths |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
+			}
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
+			}
+			m.Amortization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amortization |= AmortizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1564,7 +7689,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeRefinanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1587,10 +7712,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeRefinanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1614,7 +7739,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptRefinance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,15 +7762,15 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMortgage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptRefinance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1673,7 +7798,7 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Lendee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1709,7 +7834,7 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,13 +7862,13 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermMonths", wireType)
 			}
-			var stringLen uint64
+			m.TermMonths = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1753,29 +7878,35 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TermMonths |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentFrequency", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PaymentFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentFrequency |= PaymentFrequency(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Lendee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amortization", wireType)
 			}
-			var stringLen uint64
+			m.Amortization = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1785,46 +7916,114 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amortization |= AmortizationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptRefinanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptRefinanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRejectRefinance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRejectRefinance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRejectRefinance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1852,11 +8051,11 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			m.Lendee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1884,7 +8083,7 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Term = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1907,7 +8106,7 @@ func (m *MsgCreateMortgage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRejectRefinanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,10 +8129,10 @@ func (m *MsgCreateMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRejectRefinanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRejectRefinanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1957,7 +8156,7 @@ func (m *MsgCreateMortgageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLoanNote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,15 +8179,15 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMortgage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLoanNote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLoanNote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2016,7 +8215,7 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2052,7 +8251,7 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2080,94 +8279,111 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lender = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lendee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLoanNoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLoanNoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLoanNoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collateral = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinSyndicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinSyndicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinSyndicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2195,11 +8411,11 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			m.Lender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2227,7 +8443,7 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Term = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2250,7 +8466,7 @@ func (m *MsgUpdateMortgage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSyndicateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2273,10 +8489,10 @@ func (m *MsgUpdateMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSyndicateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSyndicateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2300,7 +8516,7 @@ func (m *MsgUpdateMortgageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteMortgage) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableAutoRepayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2323,15 +8539,15 @@ func (m *MsgDeleteMortgage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteMortgage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableAutoRepayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableAutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2359,7 +8575,7 @@ func (m *MsgDeleteMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Lendee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2414,7 +8630,7 @@ func (m *MsgDeleteMortgage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableAutoRepaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,10 +8653,10 @@ func (m *MsgDeleteMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableAutoRepaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableAutoRepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2464,7 +8680,7 @@ func (m *MsgDeleteMortgageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableAutoRepayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,15 +8703,15 @@ func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayMortgage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableAutoRepayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayMortgage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableAutoRepayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lendee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2523,11 +8739,11 @@ func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Lendee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MortgageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2555,27 +8771,8 @@ func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MortgageId = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2597,7 +8794,7 @@ func (m *MsgRepayMortgage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRepayMortgageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableAutoRepaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2620,10 +8817,10 @@ func (m *MsgRepayMortgageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayMortgageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableAutoRepaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayMortgageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableAutoRepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,67 @@
+package abci_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdknetwork "github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/app"
+	"github.com/ardaglobal/arda-poc/testutil/network"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// TestNetworkAggregatesVoteExtensions runs validators that each read a
+// different price file and checks that the chain stores the power-weighted
+// median of their observations.
+func TestNetworkAggregatesVoteExtensions(t *testing.T) {
+	dir := t.TempDir()
+	prices := map[string]string{
+		"node0": `{"observations": [{"region": "dubai", "value": "100"}, {"property_id": "villa", "value": "500000"}, {"property_id": "flat", "value": "90000"}]}`,
+		"node1": `{"observations": [{"region": "dubai", "value": "102"}, {"property_id": "villa", "value": "510000"}]}`,
+		"node2": `{"observations": [{"region": "dubai", "value": "104"}, {"property_id": "villa", "value": "900000"}]}`,
+		"node3": `{"observations": [{"region": "dubai", "value": "250"}]}`,
+	}
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = len(prices)
+	constructor := cfg.AppConstructor
+	cfg.AppConstructor = func(val sdknetwork.ValidatorI) servertypes.Application {
+		moniker := val.GetCtx().Config.Moniker
+		path := filepath.Join(dir, moniker+".json")
+		require.NoError(t, os.WriteFile(path, []byte(prices[moniker]), 0o600))
+		val.GetCtx().Viper.Set(app.FlagOraclePriceFile, path)
+		return constructor(val)
+	}
+	net := network.New(t, cfg)
+	val := net.Validators[0]
+
+	// The outlier of node3 does not move the median
+	var index types.QueryGetRegionIndexResponse
+	require.Eventually(t, func() bool {
+		bz, err := testutil.GetRequest(fmt.Sprintf("%s/ardaglobal/arda-poc/oracle/region_index/dubai", val.APIAddress))
+		return err == nil && val.ClientCtx.Codec.UnmarshalJSON(bz, &index) == nil && index.RegionIndex.Index != ""
+	}, time.Minute, time.Second)
+	require.Equal(t, "102.000000000000000000", index.RegionIndex.Index)
+	require.Positive(t, index.RegionIndex.Height)
+
+	var valuation types.QueryGetPropertyValuationResponse
+	bz, err := testutil.GetRequest(fmt.Sprintf("%s/ardaglobal/arda-poc/oracle/property_valuation/villa", val.APIAddress))
+	require.NoError(t, err)
+	require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(bz, &valuation))
+	require.Equal(t, uint64(510000), valuation.PropertyValuation.Value)
+
+	// A property observed by a quarter of the voting power is not valued
+	var valuations types.QueryAllPropertyValuationResponse
+	bz, err = testutil.GetRequest(fmt.Sprintf("%s/ardaglobal/arda-poc/oracle/property_valuation", val.APIAddress))
+	require.NoError(t, err)
+	require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(bz, &valuations))
+	require.Len(t, valuations.PropertyValuation, 1)
+	require.Equal(t, "villa", valuations.PropertyValuation[0].PropertyId)
+}
@@ -0,0 +1,30 @@
+package abci
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/keeper"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// NewPreBlocker returns a PreBlocker that aggregates the oracle votes injected
+// into the block by ProposalHandler before running next.
+func NewPreBlocker(k keeper.Keeper, next sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		if VoteExtensionsEnabled(ctx, req.Height) {
+			if len(req.Txs) == 0 {
+				return nil, errorsmod.Wrapf(types.ErrMissingVotes, "height %d", req.Height)
+			}
+			var extCommit abci.ExtendedCommitInfo
+			if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+				return nil, errorsmod.Wrapf(types.ErrMissingVotes, "height %d: %s", req.Height, err)
+			}
+			if err := k.AggregateVotes(ctx, extCommit.Votes); err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx, req)
+	}
+}
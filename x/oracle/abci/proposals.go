@@ -0,0 +1,94 @@
+package abci
+
+import (
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// VoteExtensionsEnabled reports whether the proposal for height carries the
+// vote extensions of the previous height.
+func VoteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
+
+// ProposalHandler injects the vote extensions of the last commit into block
+// proposals as their first tx, so that every validator aggregates the same
+// observations in PreBlock. The remaining txs are left to the wrapped
+// handlers.
+type ProposalHandler struct {
+	logger   log.Logger
+	valStore types.ValidatorStore
+	prepare  sdk.PrepareProposalHandler
+	process  sdk.ProcessProposalHandler
+}
+
+// NewProposalHandler returns a ProposalHandler wrapping the given prepare and
+// process proposal handlers.
+func NewProposalHandler(logger log.Logger, valStore types.ValidatorStore, prepare sdk.PrepareProposalHandler, process sdk.ProcessProposalHandler) ProposalHandler {
+	return ProposalHandler{
+		logger:   logger.With("module", "x/"+types.ModuleName),
+		valStore: valStore,
+		prepare:  prepare,
+		process:  process,
+	}
+}
+
+// PrepareProposal prepends the extended commit info of the last commit to the
+// txs selected by the wrapped handler.
+func (h ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !VoteExtensionsEnabled(ctx, req.Height) {
+			return h.prepare(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, err
+		}
+		votes, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		inner := *req
+		inner.MaxTxBytes -= int64(len(votes))
+		resp, err := h.prepare(ctx, &inner)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{votes}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposal rejects proposals whose first tx is not a validly signed
+// extended commit info, and passes the remaining txs to the wrapped handler.
+func (h ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !VoteExtensionsEnabled(ctx, req.Height) {
+			return h.process(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			h.logger.Error("rejecting proposal without oracle votes", "height", req.Height)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			h.logger.Error("rejecting proposal with undecodable oracle votes", "height", req.Height, "error", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			h.logger.Error("rejecting proposal with invalid oracle votes", "height", req.Height, "error", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		inner := *req
+		inner.Txs = req.Txs[1:]
+		return h.process(ctx, &inner)
+	}
+}
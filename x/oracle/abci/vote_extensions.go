@@ -0,0 +1,100 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// PriceSource supplies the observations a validator extends its votes with.
+type PriceSource interface {
+	Observations(ctx context.Context) ([]types.PriceObservation, error)
+}
+
+// FilePriceSource reads observations from a JSON file shaped like an
+// OracleVoteExtension, e.g.
+//
+//	{"observations": [{"region": "dubai", "value": "104.2"}, {"property_id": "1 main st", "value": "510000"}]}
+//
+// The file is read on every vote, so an operator's feed can rewrite it while
+// the node runs.
+type FilePriceSource string
+
+// Observations implements PriceSource.
+func (path FilePriceSource) Observations(_ context.Context) ([]types.PriceObservation, error) {
+	bz, err := os.ReadFile(string(path))
+	if err != nil {
+		return nil, err
+	}
+	var extension types.OracleVoteExtension
+	if err := json.Unmarshal(bz, &extension); err != nil {
+		return nil, err
+	}
+	return extension.Observations, nil
+}
+
+// VoteExtHandler extends the validator's precommits with its price
+// observations and verifies the extensions of the other validators.
+type VoteExtHandler struct {
+	logger log.Logger
+	source PriceSource
+}
+
+// NewVoteExtHandler returns a VoteExtHandler voting with the observations of
+// source. A nil source extends votes with no observations.
+func NewVoteExtHandler(logger log.Logger, source PriceSource) VoteExtHandler {
+	return VoteExtHandler{
+		logger: logger.With("module", "x/"+types.ModuleName),
+		source: source,
+	}
+}
+
+// ExtendVote returns the observations of the price source. A failing or
+// invalid source is logged and the vote carries no observations, so a broken
+// feed never keeps a validator from voting.
+func (h VoteExtHandler) ExtendVote() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		var extension types.OracleVoteExtension
+		if h.source != nil {
+			observations, err := h.source.Observations(ctx)
+			if err != nil {
+				h.logger.Error("failed to read price observations", "height", req.Height, "error", err)
+			} else {
+				extension.Observations = observations
+			}
+		}
+		if err := extension.ValidateBasic(); err != nil {
+			h.logger.Error("dropping invalid price observations", "height", req.Height, "error", err)
+			extension.Observations = nil
+		}
+
+		bz, err := extension.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtension rejects extensions that do not decode or that carry
+// invalid observations.
+func (h VoteExtHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		var extension types.OracleVoteExtension
+		if err := extension.Unmarshal(req.VoteExtension); err != nil {
+			h.logger.Error("rejecting undecodable vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height, "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		if err := extension.ValidateBasic(); err != nil {
+			h.logger.Error("rejecting invalid vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "height", req.Height, "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
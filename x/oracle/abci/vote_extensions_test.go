@@ -0,0 +1,72 @@
+package abci_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	oracleabci "github.com/ardaglobal/arda-poc/x/oracle/abci"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+type failingSource struct{}
+
+func (failingSource) Observations(context.Context) ([]types.PriceObservation, error) {
+	return nil, errors.New("feed down")
+}
+
+func extendVote(t *testing.T, source oracleabci.PriceSource) types.OracleVoteExtension {
+	resp, err := oracleabci.NewVoteExtHandler(log.NewNopLogger(), source).ExtendVote()(sdk.Context{}, &abci.RequestExtendVote{Height: 5})
+	require.NoError(t, err)
+	var extension types.OracleVoteExtension
+	require.NoError(t, extension.Unmarshal(resp.VoteExtension))
+	return extension
+}
+
+func TestExtendVote(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"observations": [{"region": "dubai", "value": "104.2"}, {"property_id": "0", "value": "500000"}]}`), 0o600))
+	require.Equal(t, []types.PriceObservation{
+		{Region: "dubai", Value: "104.2"},
+		{PropertyId: "0", Value: "500000"},
+	}, extendVote(t, oracleabci.FilePriceSource(path)).Observations)
+
+	// A broken or missing feed still votes, without observations
+	require.Empty(t, extendVote(t, nil).Observations)
+	require.Empty(t, extendVote(t, failingSource{}).Observations)
+	require.Empty(t, extendVote(t, oracleabci.FilePriceSource(filepath.Join(t.TempDir(), "missing.json"))).Observations)
+	require.NoError(t, os.WriteFile(path, []byte(`{"observations": [{"region": "dubai", "value": "-1"}]}`), 0o600))
+	require.Empty(t, extendVote(t, oracleabci.FilePriceSource(path)).Observations)
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	verify := oracleabci.NewVoteExtHandler(log.NewNopLogger(), nil).VerifyVoteExtension()
+	valid, err := (&types.OracleVoteExtension{Observations: []types.PriceObservation{{Region: "dubai", Value: "104.2"}}}).Marshal()
+	require.NoError(t, err)
+	invalid, err := (&types.OracleVoteExtension{Observations: []types.PriceObservation{{Region: "dubai", PropertyId: "0", Value: "104.2"}}}).Marshal()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name      string
+		extension []byte
+		status    abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{name: "valid", extension: valid, status: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{name: "empty", extension: nil, status: abci.ResponseVerifyVoteExtension_ACCEPT},
+		{name: "invalid observation", extension: invalid, status: abci.ResponseVerifyVoteExtension_REJECT},
+		{name: "undecodable", extension: []byte("not an extension"), status: abci.ResponseVerifyVoteExtension_REJECT},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := verify(sdk.Context{}, &abci.RequestVerifyVoteExtension{VoteExtension: tc.extension})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, resp.Status)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// GetRegionIndex returns the price index of a region.
+func (k Keeper) GetRegionIndex(ctx context.Context, region string) (types.RegionIndex, bool) {
+	index, err := k.Regions.Get(ctx, region)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RegionIndex{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return index, true
+}
+
+// SetRegionIndex stores the price index of a region.
+func (k Keeper) SetRegionIndex(ctx context.Context, index types.RegionIndex) {
+	if err := k.Regions.Set(ctx, index.Region, index); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRegionIndexes returns the price index of every region.
+func (k Keeper) GetAllRegionIndexes(ctx context.Context) []types.RegionIndex {
+	iterator, err := k.Regions.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	indexes, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return indexes
+}
+
+// GetPropertyValuation returns the oracle valuation of a property.
+func (k Keeper) GetPropertyValuation(ctx context.Context, propertyId string) (types.PropertyValuation, bool) {
+	valuation, err := k.Valuations.Get(ctx, propertyId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PropertyValuation{}, false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return valuation, true
+}
+
+// SetPropertyValuation stores the oracle valuation of a property.
+func (k Keeper) SetPropertyValuation(ctx context.Context, valuation types.PropertyValuation) {
+	if err := k.Valuations.Set(ctx, valuation.PropertyId, valuation); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPropertyValuations returns every oracle property valuation.
+func (k Keeper) GetAllPropertyValuations(ctx context.Context) []types.PropertyValuation {
+	iterator, err := k.Valuations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	valuations, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return valuations
+}
+
+// AggregateVotes updates the region indexes and property valuations from the
+// observations in the vote extensions of the last commit. Each region or
+// property observed by validators holding at least the vote threshold of the
+// voting power is set to the power-weighted median of its observations.
+// Extensions that do not decode or validate are skipped.
+func (k Keeper) AggregateVotes(ctx sdk.Context, votes []abci.ExtendedVoteInfo) error {
+	threshold, err := math.LegacyNewDecFromStr(k.GetParams(ctx).VoteThreshold)
+	if err != nil {
+		return err
+	}
+
+	var totalPower int64
+	regions := make(map[string][]types.WeightedValue)
+	properties := make(map[string][]types.WeightedValue)
+	for _, vote := range votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var extension types.OracleVoteExtension
+		if err := extension.Unmarshal(vote.VoteExtension); err != nil {
+			k.Logger().Error("skipping undecodable oracle vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "error", err)
+			continue
+		}
+		if err := extension.ValidateBasic(); err != nil {
+			k.Logger().Error("skipping invalid oracle vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "error", err)
+			continue
+		}
+
+		for _, o := range extension.Observations {
+			value := types.WeightedValue{Value: math.LegacyMustNewDecFromStr(o.Value), Power: vote.Validator.Power}
+			if o.Region != "" {
+				regions[o.Region] = append(regions[o.Region], value)
+			} else {
+				properties[o.PropertyId] = append(properties[o.PropertyId], value)
+			}
+		}
+	}
+	if totalPower <= 0 {
+		return nil
+	}
+	quorum := threshold.MulInt64(totalPower)
+
+	for _, region := range sortedKeys(regions) {
+		power := observedPower(regions[region])
+		if math.LegacyNewDec(power).LT(quorum) {
+			continue
+		}
+		index := types.WeightedMedian(regions[region])
+		k.SetRegionIndex(ctx, types.RegionIndex{
+			Region:    region,
+			Index:     index.String(),
+			Height:    ctx.BlockHeight(),
+			UpdatedAt: ctx.BlockTime(),
+		})
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRegionIndexUpdated{
+			Region: region,
+			Index:  index.String(),
+			Power:  power,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, propertyId := range sortedKeys(properties) {
+		power := observedPower(properties[propertyId])
+		if math.LegacyNewDec(power).LT(quorum) {
+			continue
+		}
+		value := types.WeightedMedian(properties[propertyId]).TruncateInt()
+		if !value.IsPositive() || !value.IsUint64() {
+			continue
+		}
+		k.SetPropertyValuation(ctx, types.PropertyValuation{
+			PropertyId: propertyId,
+			Value:      value.Uint64(),
+			Height:     ctx.BlockHeight(),
+			UpdatedAt:  ctx.BlockTime(),
+		})
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventPropertyValuationUpdated{
+			PropertyId: propertyId,
+			Value:      value.Uint64(),
+			Power:      power,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// observedPower sums the voting power behind a set of observations.
+func observedPower(values []types.WeightedValue) int64 {
+	var power int64
+	for _, v := range values {
+		power += v.Power
+	}
+	return power
+}
+
+// sortedKeys returns the keys of m in order, so that state is written and
+// events are emitted deterministically.
+func sortedKeys(m map[string][]types.WeightedValue) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// vote returns the commit vote of a validator with the given power extended
+// with observations.
+func vote(t *testing.T, power int64, observations ...types.PriceObservation) abci.ExtendedVoteInfo {
+	extension, err := (&types.OracleVoteExtension{Observations: observations}).Marshal()
+	require.NoError(t, err)
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Address: []byte{byte(power)}, Power: power},
+		VoteExtension: extension,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func region(name, value string) types.PriceObservation {
+	return types.PriceObservation{Region: name, Value: value}
+}
+
+func property(id, value string) types.PriceObservation {
+	return types.PriceObservation{PropertyId: id, Value: value}
+}
+
+func TestAggregateVotes(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	absent := vote(t, 40, region("dubai", "10"))
+	absent.BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	undecodable := vote(t, 5)
+	undecodable.VoteExtension = []byte("not an extension")
+
+	require.NoError(t, k.AggregateVotes(ctx, []abci.ExtendedVoteInfo{
+		vote(t, 30, region("dubai", "104"), property("0", "500000.7"), property("1", "90000")),
+		vote(t, 20, region("dubai", "110"), property("0", "520000")),
+		vote(t, 10, region("dubai", "300"), region("london", "98"), property("0", "100")),
+		absent,
+		undecodable,
+	}))
+
+	// 60 of the 105 power observed dubai; the absent validator's vote is not counted
+	index, found := k.GetRegionIndex(ctx, "dubai")
+	require.True(t, found)
+	require.Equal(t, types.RegionIndex{Region: "dubai", Index: "104.000000000000000000", Height: 12, UpdatedAt: ctx.BlockTime()}, index)
+
+	valuation, found := k.GetPropertyValuation(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, uint64(500000), valuation.Value)
+
+	// london and property 1 fall short of half of the voting power
+	_, found = k.GetRegionIndex(ctx, "london")
+	require.False(t, found)
+	_, found = k.GetPropertyValuation(ctx, "1")
+	require.False(t, found)
+
+	var updated []string
+	for _, event := range ctx.EventManager().Events() {
+		updated = append(updated, event.Type)
+	}
+	require.Equal(t, []string{events.Type(&types.EventRegionIndexUpdated{}), events.Type(&types.EventPropertyValuationUpdated{})}, updated)
+
+	// Lowering the threshold lets a quarter of the power update london
+	require.NoError(t, k.SetParams(ctx, types.NewParams("0.09")))
+	require.NoError(t, k.AggregateVotes(ctx.WithBlockHeight(13), []abci.ExtendedVoteInfo{
+		vote(t, 30),
+		vote(t, 10, region("london", "98")),
+		vote(t, 60),
+	}))
+	index, found = k.GetRegionIndex(ctx, "london")
+	require.True(t, found)
+	require.Equal(t, int64(13), index.Height)
+	// Regions nobody observed keep their index
+	index, found = k.GetRegionIndex(ctx, "dubai")
+	require.True(t, found)
+	require.Equal(t, int64(12), index.Height)
+}
+
+func TestRegionIndexQuery(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	dubai := types.RegionIndex{Region: "dubai", Index: "104.200000000000000000", Height: 3}
+	london := types.RegionIndex{Region: "london", Index: "98.000000000000000000", Height: 4}
+	k.SetRegionIndex(ctx, dubai)
+	k.SetRegionIndex(ctx, london)
+
+	resp, err := k.RegionIndex(ctx, &types.QueryGetRegionIndexRequest{Region: "dubai"})
+	require.NoError(t, err)
+	require.Equal(t, dubai, resp.RegionIndex)
+
+	_, err = k.RegionIndex(ctx, &types.QueryGetRegionIndexRequest{Region: "paris"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	all, err := k.RegionIndexAll(ctx, &types.QueryAllRegionIndexRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RegionIndex{dubai, london}, all.RegionIndex)
+
+	_, err = k.RegionIndex(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPropertyValuationQuery(t *testing.T) {
+	k, ctx := keepertest.OracleKeeper(t)
+	valuation := types.PropertyValuation{PropertyId: "0", Value: 500000, Height: 3}
+	k.SetPropertyValuation(ctx, valuation)
+
+	resp, err := k.PropertyValuation(ctx, &types.QueryGetPropertyValuationRequest{PropertyId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, valuation, resp.PropertyValuation)
+
+	_, err = k.PropertyValuation(ctx, &types.QueryGetPropertyValuationRequest{PropertyId: "1"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	all, err := k.PropertyValuationAll(ctx, &types.QueryAllPropertyValuationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PropertyValuation{valuation}, all.PropertyValuation)
+}
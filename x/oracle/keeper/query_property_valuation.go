@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+func (k Keeper) PropertyValuationAll(ctx context.Context, req *types.QueryAllPropertyValuationRequest) (*types.QueryAllPropertyValuationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valuations, pageRes, err := query.CollectionPaginate(ctx, k.Valuations, req.Pagination,
+		func(_ string, valuation types.PropertyValuation) (types.PropertyValuation, error) {
+			return valuation, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPropertyValuationResponse{PropertyValuation: valuations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PropertyValuation(ctx context.Context, req *types.QueryGetPropertyValuationRequest) (*types.QueryGetPropertyValuationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valuation, found := k.GetPropertyValuation(ctx, req.PropertyId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPropertyValuationResponse{PropertyValuation: valuation}, nil
+}
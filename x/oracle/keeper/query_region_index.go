@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+func (k Keeper) RegionIndexAll(ctx context.Context, req *types.QueryAllRegionIndexRequest) (*types.QueryAllRegionIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	indexes, pageRes, err := query.CollectionPaginate(ctx, k.Regions, req.Pagination,
+		func(_ string, index types.RegionIndex) (types.RegionIndex, error) {
+			return index, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRegionIndexResponse{RegionIndex: indexes, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RegionIndex(ctx context.Context, req *types.QueryGetRegionIndexRequest) (*types.QueryGetRegionIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	index, found := k.GetRegionIndex(ctx, req.Region)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRegionIndexResponse{RegionIndex: index}, nil
+}
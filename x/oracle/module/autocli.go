@@ -0,0 +1,57 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/oracle"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "RegionIndexAll",
+					Use:       "list-region-index",
+					Short:     "List the price index of every region",
+				},
+				{
+					RpcMethod:      "RegionIndex",
+					Use:            "show-region-index [region]",
+					Short:          "Shows the price index of a region",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "region"}},
+				},
+				{
+					RpcMethod: "PropertyValuationAll",
+					Use:       "list-property-valuation",
+					Short:     "List the oracle valuation of every property",
+				},
+				{
+					RpcMethod:      "PropertyValuation",
+					Use:            "show-property-valuation [property-id]",
+					Short:          "Shows the oracle valuation of a property",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/keeper"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, index := range genState.RegionIndexes {
+		k.SetRegionIndex(ctx, index)
+	}
+	for _, valuation := range genState.PropertyValuations {
+		k.SetPropertyValuation(ctx, valuation)
+	}
+	// this line is used by starport scaffolding # genesis/module/init
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+
+	genesis.RegionIndexes = k.GetAllRegionIndexes(ctx)
+	genesis.PropertyValuations = k.GetAllPropertyValuations(ctx)
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,39 @@
+package oracle_test
+
+import (
+	"testing"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/nullify"
+	oracle "github.com/ardaglobal/arda-poc/x/oracle/module"
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+
+		RegionIndexes: []types.RegionIndex{
+			{Region: "dubai", Index: "104.200000000000000000", Height: 7},
+			{Region: "london", Index: "98.000000000000000000", Height: 9},
+		},
+		PropertyValuations: []types.PropertyValuation{
+			{PropertyId: "0", Value: 500000, Height: 7},
+		},
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	k, ctx := keepertest.OracleKeeper(t)
+	oracle.InitGenesis(ctx, k, genesisState)
+	got := oracle.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	nullify.Fill(&genesisState)
+	nullify.Fill(got)
+
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.RegionIndexes, got.RegionIndexes)
+	require.ElementsMatch(t, genesisState.PropertyValuations, got.PropertyValuations)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample               = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidObservation   = sdkerrors.Register(ModuleName, 1102, "invalid price observation")
+	ErrInvalidVoteExtension = sdkerrors.Register(ModuleName, 1103, "invalid oracle vote extension")
+	ErrMissingVotes         = sdkerrors.Register(ModuleName, 1104, "proposal is missing the oracle votes")
+)
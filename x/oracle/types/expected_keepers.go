@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorStore defines the expected interface for the staking module, which
+// knows the consensus keys the vote extensions are signed with.
+type ValidatorStore interface {
+	GetPubKeyByConsAddr(context.Context, sdk.ConsAddress) (cmtprotocrypto.PublicKey, error)
+}
+
+// ParamSubspace defines the expected Subspace interface for parameters.
+type ParamSubspace interface {
+	Get(context.Context, []byte, interface{})
+	Set(context.Context, []byte, interface{})
+}
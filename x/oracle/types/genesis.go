@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultIndex is the default global index
+const DefaultIndex uint64 = 1
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		RegionIndexes:      []RegionIndex{},
+		PropertyValuations: []PropertyValuation{},
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	regions := make(map[string]bool)
+	for _, elem := range gs.RegionIndexes {
+		if regions[elem.Region] {
+			return fmt.Errorf("duplicated index for region %s", elem.Region)
+		}
+		index, err := math.LegacyNewDecFromStr(elem.Index)
+		if err != nil || !index.IsPositive() {
+			return fmt.Errorf("invalid index %q for region %s", elem.Index, elem.Region)
+		}
+		regions[elem.Region] = true
+	}
+	properties := make(map[string]bool)
+	for _, elem := range gs.PropertyValuations {
+		if properties[elem.PropertyId] {
+			return fmt.Errorf("duplicated valuation for property %s", elem.PropertyId)
+		}
+		properties[elem.PropertyId] = true
+	}
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	return gs.Params.Validate()
+}
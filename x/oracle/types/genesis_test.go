@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegionIndexes: []types.RegionIndex{
+					{Region: "dubai", Index: "104.2"},
+					{Region: "london", Index: "98"},
+				},
+				PropertyValuations: []types.PropertyValuation{
+					{PropertyId: "0", Value: 500000},
+					{PropertyId: "1", Value: 750000},
+				},
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated region index",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegionIndexes: []types.RegionIndex{
+					{Region: "dubai", Index: "104.2"},
+					{Region: "dubai", Index: "98"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-positive region index",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegionIndexes: []types.RegionIndex{
+					{Region: "dubai", Index: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated property valuation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PropertyValuations: []types.PropertyValuation{
+					{PropertyId: "0", Value: 500000},
+					{PropertyId: "0", Value: 750000},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid vote threshold",
+			genState: &types.GenesisState{
+				Params: types.NewParams("1.5"),
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_oracle"
+
+	// RegionIndexKeyPrefix stores the region price indexes by region
+	RegionIndexKeyPrefix = "RegionIndex/value/"
+
+	// PropertyValuationKeyPrefix stores the property valuations by property id
+	PropertyValuationKeyPrefix = "PropertyValuation/value/"
+)
+
+var (
+	ParamsKey = []byte("p_oracle")
+
+	RegionIndexPrefix       = collections.NewPrefix(RegionIndexKeyPrefix)
+	PropertyValuationPrefix = collections.NewPrefix(PropertyValuationKeyPrefix)
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyVoteThreshold     = []byte("VoteThreshold")
+	DefaultVoteThreshold = "0.5"
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	voteThreshold string,
+) Params {
+	return Params{
+		VoteThreshold: voteThreshold,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVoteThreshold,
+	)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVoteThreshold, &p.VoteThreshold, validateVoteThreshold),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateVoteThreshold(p.VoteThreshold); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateVoteThreshold(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	threshold, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid vote threshold %q: %w", v, err)
+	}
+	if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold must be above 0 and at most 1: %s", threshold)
+	}
+	return nil
+}
@@ -0,0 +1,2235 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/oracle/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryGetRegionIndexRequest struct {
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (m *QueryGetRegionIndexRequest) Reset()         { *m = QueryGetRegionIndexRequest{} }
+func (m *QueryGetRegionIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegionIndexRequest) ProtoMessage()    {}
+func (*QueryGetRegionIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{2}
+}
+func (m *QueryGetRegionIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegionIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegionIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRegionIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegionIndexRequest.Merge(m, src)
+}
+func (m *QueryGetRegionIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegionIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegionIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRegionIndexRequest proto.InternalMessageInfo
+
+func (m *QueryGetRegionIndexRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type QueryGetRegionIndexResponse struct {
+	RegionIndex RegionIndex `protobuf:"bytes,1,opt,name=region_index,json=regionIndex,proto3" json:"region_index"`
+}
+
+func (m *QueryGetRegionIndexResponse) Reset()         { *m = QueryGetRegionIndexResponse{} }
+func (m *QueryGetRegionIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegionIndexResponse) ProtoMessage()    {}
+func (*QueryGetRegionIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{3}
+}
+func (m *QueryGetRegionIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegionIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegionIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRegionIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegionIndexResponse.Merge(m, src)
+}
+func (m *QueryGetRegionIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegionIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegionIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRegionIndexResponse proto.InternalMessageInfo
+
+func (m *QueryGetRegionIndexResponse) GetRegionIndex() RegionIndex {
+	if m != nil {
+		return m.RegionIndex
+	}
+	return RegionIndex{}
+}
+
+type QueryAllRegionIndexRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRegionIndexRequest) Reset()         { *m = QueryAllRegionIndexRequest{} }
+func (m *QueryAllRegionIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegionIndexRequest) ProtoMessage()    {}
+func (*QueryAllRegionIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{4}
+}
+func (m *QueryAllRegionIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegionIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegionIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRegionIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegionIndexRequest.Merge(m, src)
+}
+func (m *QueryAllRegionIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegionIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegionIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRegionIndexRequest proto.InternalMessageInfo
+
+func (m *QueryAllRegionIndexRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllRegionIndexResponse struct {
+	RegionIndex []RegionIndex       `protobuf:"bytes,1,rep,name=region_index,json=regionIndex,proto3" json:"region_index"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRegionIndexResponse) Reset()         { *m = QueryAllRegionIndexResponse{} }
+func (m *QueryAllRegionIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRegionIndexResponse) ProtoMessage()    {}
+func (*QueryAllRegionIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{5}
+}
+func (m *QueryAllRegionIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRegionIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRegionIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRegionIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRegionIndexResponse.Merge(m, src)
+}
+func (m *QueryAllRegionIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRegionIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRegionIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRegionIndexResponse proto.InternalMessageInfo
+
+func (m *QueryAllRegionIndexResponse) GetRegionIndex() []RegionIndex {
+	if m != nil {
+		return m.RegionIndex
+	}
+	return nil
+}
+
+func (m *QueryAllRegionIndexResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetPropertyValuationRequest struct {
+	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+}
+
+func (m *QueryGetPropertyValuationRequest) Reset()         { *m = QueryGetPropertyValuationRequest{} }
+func (m *QueryGetPropertyValuationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPropertyValuationRequest) ProtoMessage()    {}
+func (*QueryGetPropertyValuationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{6}
+}
+func (m *QueryGetPropertyValuationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPropertyValuationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPropertyValuationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPropertyValuationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPropertyValuationRequest.Merge(m, src)
+}
+func (m *QueryGetPropertyValuationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPropertyValuationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPropertyValuationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPropertyValuationRequest proto.InternalMessageInfo
+
+func (m *QueryGetPropertyValuationRequest) GetPropertyId() string {
+	if m != nil {
+		return m.PropertyId
+	}
+	return ""
+}
+
+type QueryGetPropertyValuationResponse struct {
+	PropertyValuation PropertyValuation `protobuf:"bytes,1,opt,name=property_valuation,json=propertyValuation,proto3" json:"property_valuation"`
+}
+
+func (m *QueryGetPropertyValuationResponse) Reset()         { *m = QueryGetPropertyValuationResponse{} }
+func (m *QueryGetPropertyValuationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPropertyValuationResponse) ProtoMessage()    {}
+func (*QueryGetPropertyValuationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{7}
+}
+func (m *QueryGetPropertyValuationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPropertyValuationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPropertyValuationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPropertyValuationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPropertyValuationResponse.Merge(m, src)
+}
+func (m *QueryGetPropertyValuationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPropertyValuationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPropertyValuationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPropertyValuationResponse proto.InternalMessageInfo
+
+func (m *QueryGetPropertyValuationResponse) GetPropertyValuation() PropertyValuation {
+	if m != nil {
+		return m.PropertyValuation
+	}
+	return PropertyValuation{}
+}
+
+type QueryAllPropertyValuationRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPropertyValuationRequest) Reset()         { *m = QueryAllPropertyValuationRequest{} }
+func (m *QueryAllPropertyValuationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPropertyValuationRequest) ProtoMessage()    {}
+func (*QueryAllPropertyValuationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{8}
+}
+func (m *QueryAllPropertyValuationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPropertyValuationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPropertyValuationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPropertyValuationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPropertyValuationRequest.Merge(m, src)
+}
+func (m *QueryAllPropertyValuationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPropertyValuationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPropertyValuationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPropertyValuationRequest proto.InternalMessageInfo
+
+func (m *QueryAllPropertyValuationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllPropertyValuationResponse struct {
+	PropertyValuation []PropertyValuation `protobuf:"bytes,1,rep,name=property_valuation,json=propertyValuation,proto3" json:"property_valuation"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllPropertyValuationResponse) Reset()         { *m = QueryAllPropertyValuationResponse{} }
+func (m *QueryAllPropertyValuationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPropertyValuationResponse) ProtoMessage()    {}
+func (*QueryAllPropertyValuationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd4392d4fe03f395, []int{9}
+}
+func (m *QueryAllPropertyValuationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPropertyValuationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPropertyValuationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPropertyValuationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPropertyValuationResponse.Merge(m, src)
+}
+func (m *QueryAllPropertyValuationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPropertyValuationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPropertyValuationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPropertyValuationResponse proto.InternalMessageInfo
+
+func (m *QueryAllPropertyValuationResponse) GetPropertyValuation() []PropertyValuation {
+	if m != nil {
+		return m.PropertyValuation
+	}
+	return nil
+}
+
+func (m *QueryAllPropertyValuationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "ardapoc.oracle.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ardapoc.oracle.QueryParamsResponse")
+	proto.RegisterType((*QueryGetRegionIndexRequest)(nil), "ardapoc.oracle.QueryGetRegionIndexRequest")
+	proto.RegisterType((*QueryGetRegionIndexResponse)(nil), "ardapoc.oracle.QueryGetRegionIndexResponse")
+	proto.RegisterType((*QueryAllRegionIndexRequest)(nil), "ardapoc.oracle.QueryAllRegionIndexRequest")
+	proto.RegisterType((*QueryAllRegionIndexResponse)(nil), "ardapoc.oracle.QueryAllRegionIndexResponse")
+	proto.RegisterType((*QueryGetPropertyValuationRequest)(nil), "ardapoc.oracle.QueryGetPropertyValuationRequest")
+	proto.RegisterType((*QueryGetPropertyValuationResponse)(nil), "ardapoc.oracle.QueryGetPropertyValuationResponse")
+	proto.RegisterType((*QueryAllPropertyValuationRequest)(nil), "ardapoc.oracle.QueryAllPropertyValuationRequest")
+	proto.RegisterType((*QueryAllPropertyValuationResponse)(nil), "ardapoc.oracle.QueryAllPropertyValuationResponse")
+}
+
+func init() { proto.RegisterFile("ardapoc/oracle/query.proto", fileDescriptor_cd4392d4fe03f395) }
+
+var fileDescriptor_cd4392d4fe03f395 = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xc7, 0x33, 0xed, 0xef, 0x17, 0xe8, 0x44, 0x0a, 0x1d, 0x4b, 0x91, 0x8d, 0x6c, 0xdb, 0x51,
+	0xb4, 0xa4, 0x74, 0x27, 0x69, 0x45, 0x28, 0x88, 0xd0, 0x5a, 0x2c, 0xbd, 0xc5, 0x3d, 0xf4, 0xe0,
+	0xa5, 0x4c, 0x92, 0x61, 0x5d, 0xd9, 0xec, 0x6c, 0x77, 0x37, 0xa5, 0x25, 0xe4, 0xe2, 0x2b, 0x10,
+	0x3c, 0x7a, 0xf2, 0xe6, 0xc1, 0x83, 0xe0, 0x5d, 0x2f, 0x1e, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92,
+	0x08, 0xbe, 0x0d, 0xd9, 0x99, 0x89, 0xdd, 0x64, 0xff, 0x34, 0xc1, 0x5e, 0xda, 0xd9, 0x79, 0xfe,
+	0x7d, 0xbe, 0xcf, 0x33, 0x33, 0x81, 0x1a, 0xf5, 0x5b, 0xd4, 0xe3, 0x4d, 0xc2, 0x7d, 0xda, 0x74,
+	0x18, 0x39, 0xee, 0x30, 0xff, 0xcc, 0xf0, 0x7c, 0x1e, 0x72, 0x34, 0xaf, 0x6c, 0x86, 0xb4, 0x69,
+	0x0b, 0xb4, 0x6d, 0xbb, 0x9c, 0x88, 0xbf, 0xd2, 0x45, 0x5b, 0xb4, 0xb8, 0xc5, 0xc5, 0x92, 0x44,
+	0x2b, 0xb5, 0x7b, 0xdb, 0xe2, 0xdc, 0x72, 0x18, 0xa1, 0x9e, 0x4d, 0xa8, 0xeb, 0xf2, 0x90, 0x86,
+	0x36, 0x77, 0x03, 0x65, 0xad, 0x34, 0x79, 0xd0, 0xe6, 0x01, 0x69, 0xd0, 0x40, 0xd5, 0x23, 0x27,
+	0xb5, 0x06, 0x0b, 0x69, 0x8d, 0x78, 0xd4, 0xb2, 0x5d, 0xe1, 0xac, 0x7c, 0xcb, 0x63, 0x78, 0x1e,
+	0xf5, 0x69, 0x3b, 0xc8, 0x30, 0xca, 0x7f, 0xd2, 0x88, 0x17, 0x21, 0x7a, 0x16, 0xe5, 0xae, 0x8b,
+	0x08, 0x93, 0x1d, 0x77, 0x58, 0x10, 0xe2, 0x3a, 0xbc, 0x39, 0xb2, 0x1b, 0x78, 0xdc, 0x0d, 0x18,
+	0xda, 0x86, 0x45, 0x99, 0xf9, 0x16, 0x58, 0x01, 0x6b, 0xa5, 0xcd, 0x25, 0x63, 0x54, 0xba, 0x21,
+	0xfd, 0x77, 0xe7, 0xce, 0x7f, 0x2c, 0x17, 0xde, 0xff, 0xfe, 0x58, 0x01, 0xa6, 0x0a, 0xc0, 0x0f,
+	0xa0, 0x26, 0x32, 0xee, 0xb3, 0xd0, 0x64, 0x96, 0xcd, 0xdd, 0x03, 0xb7, 0xc5, 0x4e, 0x55, 0x3d,
+	0xb4, 0x04, 0x8b, 0xbe, 0xd8, 0x15, 0x89, 0xe7, 0x4c, 0xf5, 0x85, 0x9b, 0xb0, 0x9c, 0x1a, 0xa5,
+	0x78, 0xf6, 0xe0, 0x0d, 0xe9, 0x78, 0x64, 0x47, 0xfb, 0x8a, 0xaa, 0x3c, 0x4e, 0x15, 0x0b, 0xdd,
+	0xfd, 0x2f, 0x42, 0x33, 0x4b, 0xfe, 0xe5, 0x16, 0x6e, 0x29, 0xb4, 0x1d, 0xc7, 0x49, 0x41, 0x7b,
+	0x0a, 0xe1, 0x65, 0xbb, 0x55, 0x85, 0x7b, 0x86, 0x9c, 0x8d, 0x11, 0xcd, 0xc6, 0x90, 0x67, 0x41,
+	0xcd, 0xc6, 0xa8, 0x53, 0x8b, 0xa9, 0x58, 0x33, 0x16, 0x89, 0x3f, 0x00, 0x58, 0x4e, 0x2d, 0x93,
+	0xa9, 0x65, 0x76, 0x7a, 0x2d, 0x68, 0x7f, 0x84, 0x76, 0x46, 0xd0, 0xde, 0xbf, 0x92, 0x56, 0x22,
+	0x8c, 0xe0, 0x3e, 0x81, 0x2b, 0xc3, 0xce, 0xd7, 0x7d, 0xee, 0x31, 0x3f, 0x3c, 0x3b, 0xa4, 0x4e,
+	0x47, 0x18, 0x87, 0xad, 0x59, 0x86, 0x25, 0x4f, 0xd9, 0x8e, 0xec, 0x96, 0x1a, 0x1d, 0x1c, 0x6e,
+	0x1d, 0xb4, 0x70, 0x17, 0xae, 0xe6, 0x24, 0x51, 0xc2, 0x0f, 0x21, 0xfa, 0x9b, 0xe5, 0x64, 0x68,
+	0x55, 0x8d, 0x5e, 0x4d, 0x1c, 0xb0, 0xf1, 0x34, 0xaa, 0x09, 0x0b, 0xde, 0xb8, 0x01, 0xbf, 0x54,
+	0x0a, 0x76, 0x1c, 0x27, 0x53, 0xc1, 0x75, 0x0d, 0xf7, 0x2b, 0x80, 0xab, 0x39, 0xc5, 0xae, 0x50,
+	0x3a, 0xfb, 0x6f, 0x4a, 0xaf, 0x6d, 0xe8, 0x9b, 0x5f, 0x8a, 0xf0, 0x7f, 0x21, 0x03, 0xf5, 0x60,
+	0x51, 0xde, 0x65, 0x84, 0xc7, 0xc1, 0x92, 0xcf, 0x85, 0x76, 0x27, 0xd7, 0x47, 0x16, 0xc2, 0x95,
+	0x57, 0xdf, 0x7e, 0xbd, 0x99, 0xb9, 0x8b, 0x30, 0x89, 0x9c, 0x2d, 0x87, 0x37, 0xa8, 0x23, 0x96,
+	0x1b, 0x89, 0x87, 0x0b, 0xbd, 0x03, 0xb0, 0x14, 0x3b, 0xe9, 0xa8, 0x92, 0x5a, 0x20, 0xf5, 0x2d,
+	0xd1, 0xd6, 0x27, 0xf2, 0x55, 0x50, 0xdb, 0x02, 0x6a, 0x0b, 0xd5, 0xf2, 0xa0, 0xe2, 0xf7, 0x92,
+	0x74, 0xe5, 0x57, 0x0f, 0xbd, 0x05, 0x70, 0x3e, 0x96, 0x72, 0xc7, 0x71, 0x32, 0x30, 0x53, 0xdf,
+	0x15, 0x6d, 0x7d, 0x22, 0x5f, 0x85, 0x59, 0x15, 0x98, 0x15, 0xb4, 0x36, 0x29, 0x26, 0xfa, 0x0c,
+	0xe0, 0x42, 0xe2, 0x08, 0xa1, 0x6a, 0x56, 0x6f, 0xb2, 0x6e, 0x88, 0x56, 0x9b, 0x22, 0x42, 0xc1,
+	0xee, 0x09, 0xd8, 0xc7, 0xe8, 0x51, 0xee, 0xa0, 0x13, 0x17, 0x81, 0x74, 0x63, 0x8f, 0x49, 0x0f,
+	0x7d, 0x02, 0x70, 0x31, 0x51, 0x23, 0x6a, 0x72, 0x35, 0xab, 0x71, 0x53, 0x6a, 0xc8, 0xbb, 0xaa,
+	0xf8, 0xa1, 0xd0, 0x50, 0x45, 0xc6, 0x74, 0x1a, 0x76, 0xf7, 0xcf, 0xfb, 0x3a, 0xb8, 0xe8, 0xeb,
+	0xe0, 0x67, 0x5f, 0x07, 0xaf, 0x07, 0x7a, 0xe1, 0x62, 0xa0, 0x17, 0xbe, 0x0f, 0xf4, 0xc2, 0xf3,
+	0x0d, 0xcb, 0x0e, 0x5f, 0x74, 0x1a, 0x46, 0x93, 0xb7, 0x53, 0x73, 0x9e, 0x0e, 0xb3, 0x86, 0x67,
+	0x1e, 0x0b, 0x1a, 0x45, 0xf1, 0xf3, 0xbc, 0xf5, 0x67, 0x00, 0x6f, 0xc8, 0x67, 0x3a, 0x79, 0x08,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries the price indexes of the regions.
+	RegionIndex(ctx context.Context, in *QueryGetRegionIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionIndexResponse, error)
+	RegionIndexAll(ctx context.Context, in *QueryAllRegionIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionIndexResponse, error)
+	// Queries the oracle valuations of properties.
+	PropertyValuation(ctx context.Context, in *QueryGetPropertyValuationRequest, opts ...grpc.CallOption) (*QueryGetPropertyValuationResponse, error)
+	PropertyValuationAll(ctx context.Context, in *QueryAllPropertyValuationRequest, opts ...grpc.CallOption) (*QueryAllPropertyValuationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.oracle.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegionIndex(ctx context.Context, in *QueryGetRegionIndexRequest, opts ...grpc.CallOption) (*QueryGetRegionIndexResponse, error) {
+	out := new(QueryGetRegionIndexResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.oracle.Query/RegionIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegionIndexAll(ctx context.Context, in *QueryAllRegionIndexRequest, opts ...grpc.CallOption) (*QueryAllRegionIndexResponse, error) {
+	out := new(QueryAllRegionIndexResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.oracle.Query/RegionIndexAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PropertyValuation(ctx context.Context, in *QueryGetPropertyValuationRequest, opts ...grpc.CallOption) (*QueryGetPropertyValuationResponse, error) {
+	out := new(QueryGetPropertyValuationResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.oracle.Query/PropertyValuation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PropertyValuationAll(ctx context.Context, in *QueryAllPropertyValuationRequest, opts ...grpc.CallOption) (*QueryAllPropertyValuationResponse, error) {
+	out := new(QueryAllPropertyValuationResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.oracle.Query/PropertyValuationAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries the price indexes of the regions.
+	RegionIndex(context.Context, *QueryGetRegionIndexRequest) (*QueryGetRegionIndexResponse, error)
+	RegionIndexAll(context.Context, *QueryAllRegionIndexRequest) (*QueryAllRegionIndexResponse, error)
+	// Queries the oracle valuations of properties.
+	PropertyValuation(context.Context, *QueryGetPropertyValuationRequest) (*QueryGetPropertyValuationResponse, error)
+	PropertyValuationAll(context.Context, *QueryAllPropertyValuationRequest) (*QueryAllPropertyValuationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RegionIndex(ctx context.Context, req *QueryGetRegionIndexRequest) (*QueryGetRegionIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionIndex not implemented")
+}
+func (*UnimplementedQueryServer) RegionIndexAll(ctx context.Context, req *QueryAllRegionIndexRequest) (*QueryAllRegionIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegionIndexAll not implemented")
+}
+func (*UnimplementedQueryServer) PropertyValuation(ctx context.Context, req *QueryGetPropertyValuationRequest) (*QueryGetPropertyValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyValuation not implemented")
+}
+func (*UnimplementedQueryServer) PropertyValuationAll(ctx context.Context, req *QueryAllPropertyValuationRequest) (*QueryAllPropertyValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyValuationAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.oracle.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegionIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRegionIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegionIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.oracle.Query/RegionIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegionIndex(ctx, req.(*QueryGetRegionIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegionIndexAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRegionIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegionIndexAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.oracle.Query/RegionIndexAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegionIndexAll(ctx, req.(*QueryAllRegionIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PropertyValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPropertyValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PropertyValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.oracle.Query/PropertyValuation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PropertyValuation(ctx, req.(*QueryGetPropertyValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PropertyValuationAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPropertyValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PropertyValuationAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.oracle.Query/PropertyValuationAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PropertyValuationAll(ctx, req.(*QueryAllPropertyValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.oracle.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RegionIndex",
+			Handler:    _Query_RegionIndex_Handler,
+		},
+		{
+			MethodName: "RegionIndexAll",
+			Handler:    _Query_RegionIndexAll_Handler,
+		},
+		{
+			MethodName: "PropertyValuation",
+			Handler:    _Query_PropertyValuation_Handler,
+		},
+		{
+			MethodName: "PropertyValuationAll",
+			Handler:    _Query_PropertyValuationAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/oracle/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegionIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegionIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegionIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegionIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegionIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegionIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RegionIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegionIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegionIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegionIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRegionIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRegionIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRegionIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegionIndex) > 0 {
+		for iNdEx := len(m.RegionIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegionIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPropertyValuationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPropertyValuationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPropertyValuationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PropertyId) > 0 {
+		i -= len(m.PropertyId)
+		copy(dAtA[i:], m.PropertyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PropertyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPropertyValuationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPropertyValuationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPropertyValuationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PropertyValuation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPropertyValuationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPropertyValuationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPropertyValuationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPropertyValuationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPropertyValuationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPropertyValuationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PropertyValuation) > 0 {
+		for iNdEx := len(m.PropertyValuation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PropertyValuation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRegionIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRegionIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RegionIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRegionIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRegionIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegionIndex) > 0 {
+		for _, e := range m.RegionIndex {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPropertyValuationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PropertyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPropertyValuationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PropertyValuation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPropertyValuationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPropertyValuationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PropertyValuation) > 0 {
+		for _, e := range m.PropertyValuation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegionIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegionIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegionIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRegionIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRegionIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRegionIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegionIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegionIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegionIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegionIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRegionIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRegionIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRegionIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionIndex = append(m.RegionIndex, RegionIndex{})
+			if err := m.RegionIndex[len(m.RegionIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPropertyValuationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPropertyValuationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPropertyValuationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPropertyValuationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPropertyValuationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPropertyValuationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyValuation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PropertyValuation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPropertyValuationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPropertyValuationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPropertyValuationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPropertyValuationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPropertyValuationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPropertyValuationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyValuation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyValuation = append(m.PropertyValuation, PropertyValuation{})
+			if err := m.PropertyValuation[len(m.PropertyValuation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
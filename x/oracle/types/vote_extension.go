@@ -0,0 +1,81 @@
+package types
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxObservations bounds the observations a single vote extension may carry.
+const MaxObservations = 256
+
+// ValidateBasic checks that the observation is for exactly one region or
+// property and has a positive value.
+func (o PriceObservation) ValidateBasic() error {
+	if (o.Region == "") == (o.PropertyId == "") {
+		return errorsmod.Wrap(ErrInvalidObservation, "exactly one of region and property id must be set")
+	}
+	value, err := math.LegacyNewDecFromStr(o.Value)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidObservation, "invalid value %q: %s", o.Value, err)
+	}
+	if !value.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidObservation, "value must be positive: %s", value)
+	}
+	return nil
+}
+
+// ValidateBasic checks every observation and that no region or property is
+// observed twice.
+func (ve OracleVoteExtension) ValidateBasic() error {
+	if len(ve.Observations) > MaxObservations {
+		return errorsmod.Wrapf(ErrInvalidVoteExtension, "%d observations exceed the maximum of %d", len(ve.Observations), MaxObservations)
+	}
+	regions := make(map[string]bool)
+	properties := make(map[string]bool)
+	for _, o := range ve.Observations {
+		if err := o.ValidateBasic(); err != nil {
+			return err
+		}
+		seen := regions
+		key := o.Region
+		if o.PropertyId != "" {
+			seen = properties
+			key = o.PropertyId
+		}
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "%s observed more than once", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// WeightedValue is a value observed by validators holding the given voting
+// power.
+type WeightedValue struct {
+	Value math.LegacyDec
+	Power int64
+}
+
+// WeightedMedian returns the lowest value that at least half of the voting
+// power observed at or below. It returns zero for no values.
+func WeightedMedian(values []WeightedValue) math.LegacyDec {
+	sorted := make([]WeightedValue, len(values))
+	copy(sorted, values)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value.LT(sorted[j].Value) })
+
+	var total int64
+	for _, v := range sorted {
+		total += v.Power
+	}
+	var cumulative int64
+	for _, v := range sorted {
+		cumulative += v.Power
+		if cumulative*2 >= total {
+			return v.Value
+		}
+	}
+	return math.LegacyZeroDec()
+}
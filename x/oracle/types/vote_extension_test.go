@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/x/oracle/types"
+)
+
+func TestOracleVoteExtensionValidateBasic(t *testing.T) {
+	tooMany := make([]types.PriceObservation, types.MaxObservations+1)
+	for i := range tooMany {
+		tooMany[i] = types.PriceObservation{Region: fmt.Sprintf("region-%d", i), Value: "100"}
+	}
+
+	for _, tc := range []struct {
+		name         string
+		observations []types.PriceObservation
+		err          error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "region and property",
+			observations: []types.PriceObservation{
+				{Region: "dubai", Value: "104.2"},
+				{PropertyId: "0", Value: "500000"},
+				{PropertyId: "dubai", Value: "500000"},
+			},
+		},
+		{
+			name:         "neither region nor property",
+			observations: []types.PriceObservation{{Value: "100"}},
+			err:          types.ErrInvalidObservation,
+		},
+		{
+			name:         "both region and property",
+			observations: []types.PriceObservation{{Region: "dubai", PropertyId: "0", Value: "100"}},
+			err:          types.ErrInvalidObservation,
+		},
+		{
+			name:         "invalid value",
+			observations: []types.PriceObservation{{Region: "dubai", Value: "high"}},
+			err:          types.ErrInvalidObservation,
+		},
+		{
+			name:         "zero value",
+			observations: []types.PriceObservation{{Region: "dubai", Value: "0"}},
+			err:          types.ErrInvalidObservation,
+		},
+		{
+			name: "region observed twice",
+			observations: []types.PriceObservation{
+				{Region: "dubai", Value: "104.2"},
+				{Region: "dubai", Value: "105"},
+			},
+			err: types.ErrInvalidVoteExtension,
+		},
+		{
+			name:         "too many observations",
+			observations: tooMany,
+			err:          types.ErrInvalidVoteExtension,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.OracleVoteExtension{Observations: tc.observations}.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWeightedMedian(t *testing.T) {
+	value := func(v string, power int64) types.WeightedValue {
+		return types.WeightedValue{Value: math.LegacyMustNewDecFromStr(v), Power: power}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		values []types.WeightedValue
+		median string
+	}{
+		{
+			name:   "none",
+			median: "0",
+		},
+		{
+			name:   "single",
+			values: []types.WeightedValue{value("104.2", 10)},
+			median: "104.2",
+		},
+		{
+			name:   "equal power ignores the outlier",
+			values: []types.WeightedValue{value("250", 1), value("100", 1), value("104", 1), value("102", 1)},
+			median: "102",
+		},
+		{
+			name:   "power outweighs count",
+			values: []types.WeightedValue{value("100", 1), value("101", 1), value("120", 5)},
+			median: "120",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.median), types.WeightedMedian(tc.values))
+		})
+	}
+}
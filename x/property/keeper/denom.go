@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// SetShareDenomMetadata registers the bank metadata of a property's share
+// denom so wallets can display it. Aliases already recorded on the metadata are
+// kept and the given aliases are added to them.
+func (k Keeper) SetShareDenomMetadata(ctx sdk.Context, property types.Property, aliases ...string) {
+	denom := types.PropertyShareDenom(property.Index)
+	if existing, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found && len(existing.DenomUnits) > 0 {
+		aliases = append(existing.DenomUnits[0].Aliases, aliases...)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("Ownership shares of %s (%s); 100 shares make up the whole property", property.Address, property.Region),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0, Aliases: aliases},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    fmt.Sprintf("%s shares", property.Address),
+		Symbol:  "ARDAPROP",
+		URI:     property.MetadataUri,
+	})
+}
+
+// SetDenomAlias records that a legacy share denom was replaced by denom.
+func (k Keeper) SetDenomAlias(ctx sdk.Context, alias types.DenomAlias) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixDenomAlias))
+	b := k.cdc.MustMarshal(&alias)
+	store.Set([]byte(alias.Alias), b)
+}
+
+// GetDenomAlias returns the alias record of a legacy share denom.
+func (k Keeper) GetDenomAlias(ctx sdk.Context, alias string) (val types.DenomAlias, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixDenomAlias))
+
+	b := store.Get([]byte(alias))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDenomAlias returns all recorded denom aliases
+func (k Keeper) GetAllDenomAlias(ctx sdk.Context) (list []types.DenomAlias) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixDenomAlias))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DenomAlias
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// holding is an account's balance of a legacy denom awaiting migration.
+type holding struct {
+	addr   sdk.AccAddress
+	amount math.Int
+}
+
+// holdingsByDenom collects the positive balances of the given denoms in a
+// single pass over all balances, grouped by denom. Balances cannot change while
+// iterating them, so migrations collect the holders first.
+func (k Keeper) holdingsByDenom(ctx sdk.Context, denoms map[string]bool) map[string][]holding {
+	holdings := make(map[string][]holding)
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) bool {
+		if denoms[coin.Denom] && coin.Amount.IsPositive() {
+			holdings[coin.Denom] = append(holdings[coin.Denom], holding{addr: addr, amount: coin.Amount})
+		}
+		return false
+	})
+	return holdings
+}
+
+// migrateShareDenom swaps the holdings of a property's legacy share denom for
+// the same amount of its current share denom and records the alias.
+func (k Keeper) migrateShareDenom(ctx sdk.Context, property types.Property, holdings []holding) error {
+	legacy := types.LegacyPropertyShareDenom(property.Index)
+	denom := types.PropertyShareDenom(property.Index)
+
+	for _, h := range holdings {
+		legacyCoins := sdk.NewCoins(sdk.NewCoin(legacy, h.amount))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, h.addr, types.ModuleName, legacyCoins); err != nil {
+			return fmt.Errorf("failed to collect %s from %s: %w", legacy, h.addr, err)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, legacyCoins); err != nil {
+			return fmt.Errorf("failed to burn %s: %w", legacy, err)
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, h.amount))
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return fmt.Errorf("failed to mint %s: %w", denom, err)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, h.addr, coins); err != nil {
+			return fmt.Errorf("failed to send %s to %s: %w", denom, h.addr, err)
+		}
+	}
+
+	k.SetDenomAlias(ctx, types.DenomAlias{Alias: legacy, Denom: denom})
+	k.SetShareDenomMetadata(ctx, property, legacy)
+	return nil
+}
+
+// migrateDenomAliasEncoding re-encodes the denom aliases stored as raw denom
+// strings before v5 as DenomAlias records.
+func (k Keeper) migrateDenomAliasEncoding(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixDenomAlias))
+
+	var aliases []types.DenomAlias
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		aliases = append(aliases, types.DenomAlias{Alias: string(iterator.Key()), Denom: string(iterator.Value())})
+	}
+	iterator.Close()
+
+	for _, alias := range aliases {
+		k.SetDenomAlias(ctx, alias)
+	}
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestPropertyShareDenom(t *testing.T) {
+	denom := types.PropertyShareDenom("123 Main St, Apt 4/B")
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.NotContains(t, denom, " ")
+	require.NotContains(t, denom, "/")
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := keepertest.PropertyKeeper(t)
+
+	k.SetProperty(ctx, types.Property{Index: "addr 1", Region: "dubai", Value: 100})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	legacy := types.LegacyPropertyShareDenom("addr 1")
+	require.Equal(t, "propaddr1", legacy)
+
+	resp, err := k.DenomAlias(ctx, &types.QueryDenomAliasRequest{Alias: legacy})
+	require.NoError(t, err)
+	require.Equal(t, types.PropertyShareDenom("addr 1"), resp.Denom)
+
+	_, err = k.DenomAlias(ctx, &types.QueryDenomAliasRequest{Alias: "propunknown"})
+	require.Error(t, err)
+}
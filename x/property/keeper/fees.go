@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// GetFeeTotal returns the fees collected in a region during a period
+func (k Keeper) GetFeeTotal(ctx context.Context, region string, period string) (val types.FeeTotal, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeeTotal))
+	b := store.Get(types.FeeTotalKey(region, period))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetFeeTotal set the fees collected in a region during a period
+func (k Keeper) SetFeeTotal(ctx context.Context, total types.FeeTotal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeeTotal))
+	store.Set(types.FeeTotalKey(total.Region, total.Period), k.cdc.MustMarshal(&total))
+}
+
+// TransferFee returns the transfer fee due in region on shares bought for value.
+func (k Keeper) TransferFee(ctx context.Context, region string, value uint64) uint64 {
+	schedule, found := k.GetParams(ctx).FeeSchedule(region)
+	if !found {
+		return 0
+	}
+	return schedule.TransferFee.Amount(value)
+}
+
+// RegistrationFee returns the registration fee due in region on a property of
+// the given value.
+func (k Keeper) RegistrationFee(ctx context.Context, region string, value uint64) uint64 {
+	schedule, found := k.GetParams(ctx).FeeSchedule(region)
+	if !found {
+		return 0
+	}
+	return schedule.RegistrationFee.Amount(value)
+}
+
+// CollectFee pays a fee from payer to the fee recipient of the region of the
+// property, adds it to the fees collected in the current period and emits a fee
+// event.
+func (k Keeper) CollectFee(ctx sdk.Context, property types.Property, feeType string, payer sdk.AccAddress, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	schedule, found := k.GetParams(ctx).FeeSchedule(property.Region)
+	if !found {
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(schedule.Recipient)
+	if err != nil {
+		return err
+	}
+
+	fee := sdk.NewCoins(sdk.NewCoin(usdtypes.USDArdaDenom, math.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.SendCoins(ctx, payer, recipient, fee); err != nil {
+		return err
+	}
+
+	region := types.NormalizeRegion(property.Region)
+	period := types.FeePeriod(ctx.BlockTime())
+	total, found := k.GetFeeTotal(ctx, region, period)
+	if !found {
+		total = types.FeeTotal{Region: region, Period: period}
+	}
+	switch feeType {
+	case types.FeeTypeTransfer:
+		total.TransferFees += amount
+	case types.FeeTypeRegistration:
+		total.RegistrationFees += amount
+	}
+	k.SetFeeTotal(ctx, total)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventPropertyFeeCollected{
+		PropertyId: property.Index,
+		Region:     region,
+		Period:     period,
+		FeeType:    feeType,
+		Payer:      payer.String(),
+		Recipient:  recipient.String(),
+		Amount:     amount,
+	})
+}
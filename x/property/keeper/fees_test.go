@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestCollectFee(t *testing.T) {
+	k, ctx := keepertest.PropertyKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC))
+
+	params := types.DefaultParams()
+	params.FeeSchedules = []types.RegionFeeSchedule{{
+		Region:          "Dubai",
+		Recipient:       sample.AccAddress(),
+		TransferFee:     types.FeeRate{Rate: "0.04"},
+		RegistrationFee: types.FeeRate{Flat: 100},
+	}}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.Equal(t, uint64(40), k.TransferFee(ctx, "dubai", 1000))
+	require.Equal(t, uint64(100), k.RegistrationFee(ctx, " DUBAI ", 1000))
+	require.Zero(t, k.TransferFee(ctx, "london", 1000))
+
+	dubai := types.Property{Index: "addr1", Region: "dubai", Value: 1000}
+	london := types.Property{Index: "addr2", Region: "london", Value: 1000}
+	payer := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	require.NoError(t, k.CollectFee(ctx, dubai, types.FeeTypeTransfer, payer, 40))
+	require.NoError(t, k.CollectFee(ctx, dubai, types.FeeTypeTransfer, payer, 20))
+	require.NoError(t, k.CollectFee(ctx, dubai, types.FeeTypeRegistration, payer, 100))
+	require.NoError(t, k.CollectFee(ctx.WithBlockTime(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)), dubai, types.FeeTypeTransfer, payer, 5))
+	require.NoError(t, k.CollectFee(ctx, london, types.FeeTypeTransfer, payer, 10))
+
+	fees, err := events.Filter[*types.EventPropertyFeeCollected](ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	require.Len(t, fees, 4)
+	require.Equal(t, &types.EventPropertyFeeCollected{PropertyId: "addr1", Region: "dubai", Period: "2025-07", FeeType: types.FeeTypeTransfer, Payer: payer.String(), Recipient: params.FeeSchedules[0].Recipient, Amount: 5}, fees[3])
+
+	resp, err := k.FeesCollected(ctx, &types.QueryFeesCollectedRequest{Region: "Dubai"})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeeTotal{
+		{Region: "dubai", Period: "2025-06", TransferFees: 60, RegistrationFees: 100},
+		{Region: "dubai", Period: "2025-07", TransferFees: 5},
+	}, resp.Fees)
+
+	resp, err = k.FeesCollected(ctx, &types.QueryFeesCollectedRequest{Region: "dubai", Period: "2025-05"})
+	require.NoError(t, err)
+	require.Equal(t, []types.FeeTotal{{Region: "dubai", Period: "2025-05"}}, resp.Fees)
+
+	// Regions without a schedule collect nothing
+	resp, err = k.FeesCollected(ctx, &types.QueryFeesCollectedRequest{Region: "london"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Fees)
+}
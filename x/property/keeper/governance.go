@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// SubmitProposal puts a decision to the shareholders of a property. The voting
+// weight of each holder is their share balance at the current height, out of
+// the supply of the shares. The proposer must hold shares of the property.
+func (k Keeper) SubmitProposal(ctx context.Context, proposer string, propertyId string, title string, summary string, msgs []sdk.Msg) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	property, found := k.GetProperty(sdkCtx, propertyId)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrInvalidProposal, "property not found: %s", propertyId)
+	}
+
+	if err := k.validateProposalMsgs(propertyId, msgs); err != nil {
+		return 0, err
+	}
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.shareBalance(ctx, propertyId, proposer) == 0 {
+		return 0, errorsmod.Wrapf(types.ErrNoVotingWeight, "%s holds no shares of %s", proposer, propertyId)
+	}
+	proposal := types.Proposal{
+		PropertyId: propertyId,
+		Proposer:   proposer,
+		Title:      title,
+		Summary:    summary,
+		Messages:   anys,
+		Status:     types.PROPOSAL_STATUS_VOTING_PERIOD,
+	}
+
+	params := k.GetParams(ctx)
+	proposal.SubmitHeight = sdkCtx.BlockHeight()
+	proposal.VotingEndHeight = proposal.SubmitHeight + params.VotingPeriod
+	proposal.Quorum = params.Quorum
+	proposal.Threshold = params.Threshold
+	proposal.TotalWeight = k.bankKeeper.GetSupply(ctx, types.PropertyShareDenom(property.Index)).Amount.Uint64()
+
+	proposal.Id = k.AppendProposal(ctx, proposal)
+	k.insertActiveProposal(ctx, proposal)
+
+	return proposal.Id, sdkCtx.EventManager().EmitTypedEvent(&types.EventProposalSubmitted{
+		ProposalId:      proposal.Id,
+		PropertyId:      propertyId,
+		Proposer:        proposer,
+		VotingEndHeight: proposal.VotingEndHeight,
+	})
+}
+
+// validateProposalMsgs checks that every message can be executed on behalf of
+// the shareholders of the property, i.e. that its only signer is the property's
+// governance account.
+func (k Keeper) validateProposalMsgs(propertyId string, msgs []sdk.Msg) error {
+	governance := types.PropertyGovernanceAddress(propertyId)
+	for i, msg := range msgs {
+		if !types.IsProposalMsg(msg) {
+			return errorsmod.Wrapf(types.ErrInvalidProposal, "message %d: %s cannot be executed by a proposal", i, sdk.MsgTypeURL(msg))
+		}
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(types.ErrInvalidProposal, "message %d: %s", i, err)
+			}
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+		if len(signers) != 1 || !governance.Equals(sdk.AccAddress(signers[0])) {
+			return errorsmod.Wrapf(types.ErrInvalidSigner, "message %d must be signed by the governance account %s", i, governance)
+		}
+
+		if k.router.Handler(msg) == nil {
+			return errorsmod.Wrapf(types.ErrInvalidProposal, "message %d: no handler for %s", i, sdk.MsgTypeURL(msg))
+		}
+	}
+	return nil
+}
+
+// shareBalance returns the current share balance of an account.
+func (k Keeper) shareBalance(ctx context.Context, propertyId string, account string) uint64 {
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return 0
+	}
+	return k.bankKeeper.GetBalance(ctx, addr, types.PropertyShareDenom(propertyId)).Amount.Uint64()
+}
+
+// votingWeight returns the voting weight of an account on a proposal, its
+// share balance when the proposal was submitted. Balances that changed since
+// were checkpointed before the change.
+func (k Keeper) votingWeight(ctx context.Context, proposal types.Proposal, account string) uint64 {
+	if balance, found := k.getVotingCheckpoint(ctx, proposal.Id, account); found {
+		return balance
+	}
+	return k.shareBalance(ctx, proposal.PropertyId, account)
+}
+
+// checkpointVotingWeights records the share balances of accounts on the
+// proposals of a property in their voting period, before a transfer of the
+// shares changes them. Balances checkpointed already are kept.
+func (k Keeper) checkpointVotingWeights(ctx context.Context, propertyId string, accounts ...sdk.AccAddress) {
+	for _, id := range k.getPropertyProposals(ctx, propertyId) {
+		for _, addr := range accounts {
+			account := addr.String()
+			if _, found := k.getVotingCheckpoint(ctx, id, account); found {
+				continue
+			}
+			k.setVotingCheckpoint(ctx, id, account, k.shareBalance(ctx, propertyId, account))
+		}
+	}
+}
+
+// CastVote records the vote of a shareholder on a proposal in its voting
+// period. A later vote replaces an earlier one.
+func (k Keeper) CastVote(ctx context.Context, proposalId uint64, voter string, option types.VoteOption) error {
+	proposal, found := k.GetProposal(ctx, proposalId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrProposalNotFound, "proposal %d", proposalId)
+	}
+	if proposal.Status != types.PROPOSAL_STATUS_VOTING_PERIOD {
+		return errorsmod.Wrapf(types.ErrInactiveProposal, "proposal %d is %s", proposalId, proposal.Status)
+	}
+
+	weight := k.votingWeight(ctx, proposal, voter)
+	if weight == 0 {
+		return errorsmod.Wrapf(types.ErrNoVotingWeight, "%s held no shares of %s at height %d", voter, proposal.PropertyId, proposal.SubmitHeight)
+	}
+
+	k.SetVote(ctx, types.Vote{
+		ProposalId: proposalId,
+		Voter:      voter,
+		Option:     option,
+		Weight:     weight,
+	})
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventVoteCast{
+		ProposalId: proposalId,
+		Voter:      voter,
+		Option:     option,
+		Weight:     weight,
+	})
+}
+
+// Tally counts the votes on a proposal, weighted by the voters' share balances
+// when it was submitted, and reports whether it reached both its quorum and its
+// threshold. The weights it counted are recorded on the proposal.
+func (k Keeper) Tally(ctx context.Context, proposal *types.Proposal) (bool, types.TallyResult) {
+	var tally types.TallyResult
+
+	proposal.VotingWeights = nil
+	for _, vote := range k.GetVotes(ctx, proposal.Id) {
+		proposal.VotingWeights = append(proposal.VotingWeights, types.VotingWeight{Address: vote.Voter, Weight: vote.Weight})
+		switch vote.Option {
+		case types.VOTE_OPTION_YES:
+			tally.Yes += vote.Weight
+		case types.VOTE_OPTION_ABSTAIN:
+			tally.Abstain += vote.Weight
+		case types.VOTE_OPTION_NO:
+			tally.No += vote.Weight
+		}
+	}
+
+	if proposal.TotalWeight == 0 {
+		return false, tally
+	}
+
+	turnout := math.LegacyNewDecFromInt(math.NewIntFromUint64(tally.Yes + tally.Abstain + tally.No)).
+		QuoInt(math.NewIntFromUint64(proposal.TotalWeight))
+	if turnout.LT(math.LegacyMustNewDecFromStr(proposal.Quorum)) {
+		return false, tally
+	}
+
+	if tally.Yes+tally.No == 0 {
+		return false, tally
+	}
+	yesRatio := math.LegacyNewDecFromInt(math.NewIntFromUint64(tally.Yes)).
+		QuoInt(math.NewIntFromUint64(tally.Yes + tally.No))
+	return yesRatio.GT(math.LegacyMustNewDecFromStr(proposal.Threshold)), tally
+}
+
+// EndBlocker tallies the proposals whose voting period ends at the current
+// height and executes the messages of those that passed.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, id := range k.getEndedProposals(ctx, sdkCtx.BlockHeight()) {
+		proposal, found := k.GetProposal(ctx, id)
+		if !found {
+			continue
+		}
+		k.removeActiveProposal(ctx, proposal)
+
+		passed, tally := k.Tally(ctx, &proposal)
+		proposal.FinalTally = tally
+		switch {
+		case !passed:
+			proposal.Status = types.PROPOSAL_STATUS_REJECTED
+		default:
+			if err := k.executeProposal(sdkCtx, proposal); err != nil {
+				proposal.Status = types.PROPOSAL_STATUS_FAILED
+				proposal.FailedReason = err.Error()
+			} else {
+				proposal.Status = types.PROPOSAL_STATUS_PASSED
+			}
+		}
+		k.SetProposal(ctx, proposal)
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventProposalTallied{
+			ProposalId:   proposal.Id,
+			PropertyId:   proposal.PropertyId,
+			Status:       proposal.Status,
+			Tally:        tally,
+			FailedReason: proposal.FailedReason,
+		}); err != nil {
+			return err
+		}
+
+		k.Logger().Info("property proposal tallied", "id", proposal.Id, "property", proposal.PropertyId, "status", proposal.Status.String())
+	}
+
+	return nil
+}
+
+// executeProposal runs the messages of a passed proposal in a cache context,
+// like x/gov. Their state changes are only committed if all of them succeed,
+// and a panicking message fails the proposal instead of halting the chain.
+func (k Keeper) executeProposal(ctx sdk.Context, proposal types.Proposal) error {
+	if err := proposal.UnpackInterfaces(k.cdc); err != nil {
+		return err
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrInvalidProposal, "message %d: no handler for %s", i, sdk.MsgTypeURL(msg))
+		}
+		if _, err := safeExecuteHandler(cacheCtx, msg, handler); err != nil {
+			return errorsmod.Wrapf(err, "message %d", i)
+		}
+	}
+	writeCache()
+
+	return nil
+}
+
+// safeExecuteHandler executes handler(ctx, msg) and recovers from a panic.
+func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrInvalidProposal, "handling %s panicked: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+	res, err = handler(ctx, msg)
+	return
+}
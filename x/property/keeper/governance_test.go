@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	ardakeeper "github.com/ardaglobal/arda-poc/x/arda/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// governanceMsgServer stands in for the property msg server when proposals are
+// executed. EditPropertyMetadata and SetPropertyManager are implemented and
+// RegisterProperty panics; every other message fails.
+type governanceMsgServer struct {
+	types.UnimplementedMsgServer
+	keeper keeper.Keeper
+}
+
+func (s *governanceMsgServer) SetPropertyManager(ctx context.Context, msg *types.MsgSetPropertyManager) (*types.MsgSetPropertyManagerResponse, error) {
+	return keeper.NewMsgServerImpl(s.keeper, ardakeeper.Keeper{}, nil).SetPropertyManager(ctx, msg)
+}
+
+func (s *governanceMsgServer) RegisterProperty(context.Context, *types.MsgRegisterProperty) (*types.MsgRegisterPropertyResponse, error) {
+	panic("register property")
+}
+
+func (s *governanceMsgServer) EditPropertyMetadata(ctx context.Context, msg *types.MsgEditPropertyMetadata) (*types.MsgEditPropertyMetadataResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	property, _ := s.keeper.GetProperty(sdkCtx, msg.PropertyId)
+	property.TenantId = msg.TenantId
+	s.keeper.SetProperty(sdkCtx, property)
+	return &types.MsgEditPropertyMetadataResponse{}, nil
+}
+
+type governanceFixture struct {
+	keepertest.PropertyFixture
+	majority, minority string
+	governance         string
+}
+
+func setupGovernance(t *testing.T) governanceFixture {
+	f := keepertest.NewPropertyFixture(t)
+	types.RegisterMsgServer(f.Router, &governanceMsgServer{keeper: f.Keeper})
+
+	gf := governanceFixture{
+		PropertyFixture: f,
+		majority:        sample.AccAddress(),
+		minority:        sample.AccAddress(),
+		governance:      types.PropertyGovernanceAddress("addr1").String(),
+	}
+	f.Keeper.SetProperty(f.Ctx, types.Property{
+		Index:  "addr1",
+		Region: "dubai",
+		Value:  100,
+		Owners: []string{gf.majority, gf.minority},
+		Shares: []uint64{60, 40},
+	})
+	denom := types.PropertyShareDenom("addr1")
+	f.BankKeeper.Balances[gf.majority] = sdk.NewCoins(sdk.NewInt64Coin(denom, 60))
+	f.BankKeeper.Balances[gf.minority] = sdk.NewCoins(sdk.NewInt64Coin(denom, 40))
+	return gf
+}
+
+func (f governanceFixture) editTenant(tenant string) sdk.Msg {
+	return &types.MsgEditPropertyMetadata{Creator: f.governance, PropertyId: "addr1", TenantId: tenant}
+}
+
+// endVoting runs the EndBlocker at the end of the voting period of a proposal
+// and returns it.
+func (f governanceFixture) endVoting(t *testing.T, id uint64) types.Proposal {
+	proposal, found := f.Keeper.GetProposal(f.Ctx, id)
+	require.True(t, found)
+	require.NoError(t, f.Keeper.EndBlocker(f.Ctx.WithBlockHeight(proposal.VotingEndHeight)))
+	proposal, _ = f.Keeper.GetProposal(f.Ctx, id)
+	return proposal
+}
+
+func TestSubmitProposalValidation(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+
+	_, err := k.SubmitProposal(ctx, f.majority, "unknown", "title", "", nil)
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	_, err = k.SubmitProposal(ctx, sample.AccAddress(), "addr1", "title", "", nil)
+	require.ErrorIs(t, err, types.ErrNoVotingWeight)
+
+	notGovernance := &types.MsgEditPropertyMetadata{Creator: f.majority, PropertyId: "addr1"}
+	_, err = k.SubmitProposal(ctx, f.majority, "addr1", "title", "", []sdk.Msg{notGovernance})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	vote := types.NewMsgVote(f.governance, 1, types.VOTE_OPTION_YES)
+	_, err = k.SubmitProposal(ctx, f.majority, "addr1", "title", "", []sdk.Msg{vote})
+	require.ErrorIs(t, err, types.ErrInvalidProposal)
+
+	// Messages of other modules qualify when the governance account signs them
+	send := banktypes.NewMsgSend(types.PropertyGovernanceAddress("addr1"), sdk.MustAccAddressFromBech32(f.majority), nil)
+	_, err = k.SubmitProposal(ctx, f.majority, "addr1", "title", "", []sdk.Msg{send})
+	require.ErrorIs(t, err, types.ErrInvalidProposal, "no bank handler is registered on the router")
+
+	id, err := k.SubmitProposal(ctx, f.majority, "addr1", "title", "", []sdk.Msg{f.editTenant("acme")})
+	require.NoError(t, err)
+	proposal, found := k.GetProposal(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.PROPOSAL_STATUS_VOTING_PERIOD, proposal.Status)
+	require.Equal(t, types.DefaultVotingPeriod, proposal.VotingEndHeight-proposal.SubmitHeight)
+}
+
+func TestProposalPasses(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+
+	manager := sample.AccAddress()
+	appoint := types.NewMsgSetPropertyManager(f.governance, "addr1", manager)
+	id, err := k.SubmitProposal(ctx, f.majority, "addr1", "Appoint a manager", "", []sdk.Msg{f.editTenant("acme"), appoint})
+	require.NoError(t, err)
+
+	require.NoError(t, k.CastVote(ctx, id, f.minority, types.VOTE_OPTION_NO))
+	require.ErrorIs(t, k.CastVote(ctx, id, sample.AccAddress(), types.VOTE_OPTION_YES), types.ErrNoVotingWeight)
+
+	// Weights are the balances when the proposal was submitted: shares sold
+	// during the voting period keep voting with the seller
+	buyer := sample.AccAddress()
+	denom := types.PropertyShareDenom("addr1")
+	_, err = k.SendRestriction(ctx, sdk.MustAccAddressFromBech32(f.majority), sdk.MustAccAddressFromBech32(buyer), sdk.NewCoins(sdk.NewInt64Coin(denom, 40)))
+	require.NoError(t, err)
+	f.BankKeeper.Balances[f.majority] = sdk.NewCoins(sdk.NewInt64Coin(denom, 20))
+	f.BankKeeper.Balances[buyer] = sdk.NewCoins(sdk.NewInt64Coin(denom, 40))
+	require.ErrorIs(t, k.CastVote(ctx, id, buyer, types.VOTE_OPTION_YES), types.ErrNoVotingWeight)
+	require.NoError(t, k.CastVote(ctx, id, f.majority, types.VOTE_OPTION_YES))
+
+	proposal := f.endVoting(t, id)
+	require.Equal(t, types.PROPOSAL_STATUS_PASSED, proposal.Status)
+	require.Equal(t, types.TallyResult{Yes: 60, No: 40}, proposal.FinalTally)
+	require.Equal(t, uint64(100), proposal.TotalWeight)
+	require.Equal(t, uint64(60), proposal.VotingWeightOf(f.majority))
+
+	property, _ := k.GetProperty(ctx, "addr1")
+	require.Equal(t, "acme", property.TenantId)
+	require.Equal(t, manager, property.Manager)
+
+	require.ErrorIs(t, k.CastVote(ctx, id, f.minority, types.VOTE_OPTION_YES), types.ErrInactiveProposal)
+}
+
+func TestProposalRejected(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		majority types.VoteOption // unspecified means no vote
+		minority types.VoteOption
+	}{
+		{
+			desc:     "quorum not reached",
+			minority: types.VOTE_OPTION_YES,
+		},
+		{
+			desc:     "threshold not reached",
+			majority: types.VOTE_OPTION_NO,
+			minority: types.VOTE_OPTION_YES,
+		},
+		{
+			desc:     "only abstentions",
+			majority: types.VOTE_OPTION_ABSTAIN,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := setupGovernance(t)
+			k, ctx := f.Keeper, f.Ctx
+
+			id, err := k.SubmitProposal(ctx, f.majority, "addr1", "Appoint a manager", "", []sdk.Msg{f.editTenant("acme")})
+			require.NoError(t, err)
+			if tc.majority != types.VOTE_OPTION_UNSPECIFIED {
+				require.NoError(t, k.CastVote(ctx, id, f.majority, tc.majority))
+			}
+			if tc.minority != types.VOTE_OPTION_UNSPECIFIED {
+				require.NoError(t, k.CastVote(ctx, id, f.minority, tc.minority))
+			}
+
+			proposal := f.endVoting(t, id)
+			require.Equal(t, types.PROPOSAL_STATUS_REJECTED, proposal.Status)
+
+			property, _ := k.GetProperty(ctx, "addr1")
+			require.Empty(t, property.TenantId)
+		})
+	}
+}
+
+func TestProposalExecutionFails(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+
+	transfer := types.NewMsgTransferShares(f.governance, "addr1", []string{f.majority}, []uint64{60}, []string{f.minority}, []uint64{60})
+	id, err := k.SubmitProposal(ctx, f.majority, "addr1", "Sell", "", []sdk.Msg{f.editTenant("acme"), transfer})
+	require.NoError(t, err)
+	require.NoError(t, k.CastVote(ctx, id, f.majority, types.VOTE_OPTION_YES))
+
+	proposal := f.endVoting(t, id)
+	require.Equal(t, types.PROPOSAL_STATUS_FAILED, proposal.Status)
+	require.NotEmpty(t, proposal.FailedReason)
+
+	// The messages executed before the failing one are rolled back
+	property, _ := k.GetProperty(ctx, "addr1")
+	require.Empty(t, property.TenantId)
+}
+
+func TestProposalExecutionPanics(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+
+	register := &types.MsgRegisterProperty{Creator: f.governance, Address: "addr2", Region: "dubai", Value: 100}
+	id, err := k.SubmitProposal(ctx, f.majority, "addr1", "Register", "", []sdk.Msg{f.editTenant("acme"), register})
+	require.NoError(t, err)
+	require.NoError(t, k.CastVote(ctx, id, f.majority, types.VOTE_OPTION_YES))
+
+	proposal := f.endVoting(t, id)
+	require.Equal(t, types.PROPOSAL_STATUS_FAILED, proposal.Status)
+	require.Contains(t, proposal.FailedReason, "panicked")
+
+	property, _ := k.GetProperty(ctx, "addr1")
+	require.Empty(t, property.TenantId)
+}
+
+func TestProposalTotalWeightIsShareSupply(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+
+	// Shares held outside the owner list, e.g. in escrow, count towards quorum
+	escrow := sample.AccAddress()
+	f.BankKeeper.Balances[escrow] = sdk.NewCoins(sdk.NewInt64Coin(types.PropertyShareDenom("addr1"), 100))
+	id, err := k.SubmitProposal(ctx, f.majority, "addr1", "title", "", []sdk.Msg{f.editTenant("acme")})
+	require.NoError(t, err)
+	require.NoError(t, k.CastVote(ctx, id, f.majority, types.VOTE_OPTION_YES))
+
+	proposal := f.endVoting(t, id)
+	require.Equal(t, uint64(200), proposal.TotalWeight)
+	require.Equal(t, types.PROPOSAL_STATUS_REJECTED, proposal.Status)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := setupGovernance(t)
+	k, ctx := f.Keeper, f.Ctx
+	k.SetProposal(ctx, types.Proposal{Id: 0, PropertyId: "addr1", Status: types.PROPOSAL_STATUS_VOTING_PERIOD, VotingEndHeight: 10})
+	k.SetProposal(ctx, types.Proposal{Id: 1, PropertyId: "addr1", Status: types.PROPOSAL_STATUS_PASSED, VotingEndHeight: 5})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate6to7(ctx))
+
+	open, _ := k.GetProposal(ctx, 0)
+	require.Equal(t, uint64(100), open.TotalWeight)
+	passed, _ := k.GetProposal(ctx, 1)
+	require.Zero(t, passed.TotalWeight)
+
+	// Transfers after the upgrade are checkpointed for the open proposal
+	denom := types.PropertyShareDenom("addr1")
+	_, err := k.SendRestriction(ctx, sdk.MustAccAddressFromBech32(f.minority), sdk.MustAccAddressFromBech32(f.majority), sdk.NewCoins(sdk.NewInt64Coin(denom, 40)))
+	require.NoError(t, err)
+	f.BankKeeper.Balances[f.majority] = sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	delete(f.BankKeeper.Balances, f.minority)
+	require.NoError(t, k.CastVote(ctx, 0, f.minority, types.VOTE_OPTION_YES))
+	vote, _ := k.GetVote(ctx, 0, f.minority)
+	require.Equal(t, uint64(40), vote.Weight)
+}
@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,11 +18,18 @@ import (
 
 type (
 	Keeper struct {
-		cdc          codec.BinaryCodec
+		cdc          codec.Codec
 		storeService store.KVStoreService
 		logger       log.Logger
 
-		bankKeeper types.BankKeeper
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		nftKeeper     types.NFTKeeper
+		lienKeeper    types.LienKeeper
+		oracleKeeper  types.OracleKeeper
+
+		// router executes the messages of passed shareholder proposals
+		router baseapp.MessageRouter
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -26,10 +38,15 @@ type (
 )
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	storeService store.KVStoreService,
 	logger log.Logger,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	nftKeeper types.NFTKeeper,
+	lienKeeper types.LienKeeper,
+	oracleKeeper types.OracleKeeper,
+	router baseapp.MessageRouter,
 	authority string,
 
 ) Keeper {
@@ -38,11 +55,16 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		authority:    authority,
-		logger:       logger,
-		bankKeeper:   bankKeeper,
+		cdc:           cdc,
+		storeService:  storeService,
+		authority:     authority,
+		logger:        logger,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		nftKeeper:     nftKeeper,
+		lienKeeper:    lienKeeper,
+		oracleKeeper:  oracleKeeper,
+		router:        router,
 	}
 }
 
@@ -102,6 +124,57 @@ func (k Keeper) UpdatePropertyFromOwnerMap(property *types.Property, ownerMap ma
 	}
 }
 
+// moveShares moves shares of a property from one owner to another in its
+// ownership record. The order of the owners is kept: an owner left without
+// shares is removed and a new owner is appended.
+func moveShares(property *types.Property, from string, to string, shares uint64) error {
+	i := slices.Index(property.Owners, from)
+	if i < 0 || i >= len(property.Shares) || property.Shares[i] < shares {
+		return fmt.Errorf("owner %s does not have enough shares", from)
+	}
+	property.Shares[i] -= shares
+	if j := slices.Index(property.Owners, to); j >= 0 {
+		property.Shares[j] += shares
+	} else {
+		property.Owners = append(property.Owners, to)
+		property.Shares = append(property.Shares, shares)
+	}
+	if property.Shares[i] == 0 {
+		property.Owners = slices.Delete(property.Owners, i, i+1)
+		property.Shares = slices.Delete(property.Shares, i, i+1)
+	}
+	return nil
+}
+
+// ReassignShares records that shares of a property changed hands outside of a
+// share transfer, e.g. when a lender forecloses on collateral. It only updates
+// the ownership record; moving the share tokens is up to the caller.
+func (k Keeper) ReassignShares(ctx sdk.Context, propertyId string, from string, to string, shares uint64) error {
+	property, found := k.GetProperty(ctx, propertyId)
+	if !found {
+		return fmt.Errorf("property not found: %s", propertyId)
+	}
+
+	if err := moveShares(&property, from, to, shares); err != nil {
+		return err
+	}
+
+	property.Transfers = append(property.Transfers, &types.Transfer{
+		From:      fmt.Sprintf("%s:%d", from, shares),
+		To:        fmt.Sprintf("%s:%d", to, shares),
+		Timestamp: ctx.BlockTime().UTC().Format(time.RFC3339),
+	})
+	k.SetProperty(ctx, property)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSharesTransferred{
+		PropertyId: propertyId,
+		FromOwners: []string{from},
+		FromShares: []uint64{shares},
+		ToOwners:   []string{to},
+		ToShares:   []uint64{shares},
+	})
+}
+
 func (k Keeper) SetProperty(ctx sdk.Context, property types.Property) {
 	kvStore := k.storeService.OpenKVStore(ctx)
 
@@ -110,6 +183,25 @@ func (k Keeper) SetProperty(ctx sdk.Context, property types.Property) {
 	propertyKey = append(propertyKey, []byte(property.Index)...)
 
 	kvStore.Set(propertyKey, k.cdc.MustMarshal(&property))
+
+	// Index the property by share denom for the bank send restriction
+	denomKey := types.KeyPrefix(types.KeyPrefixShareDenom)
+	denomKey = append(denomKey, []byte(types.PropertyShareDenom(property.Index))...)
+	kvStore.Set(denomKey, []byte(property.Index))
+}
+
+// GetShareDenomProperty returns the ID of the property a share denom belongs to.
+func (k Keeper) GetShareDenomProperty(ctx context.Context, denom string) (string, bool) {
+	kvStore := k.storeService.OpenKVStore(ctx)
+
+	denomKey := types.KeyPrefix(types.KeyPrefixShareDenom)
+	denomKey = append(denomKey, []byte(denom)...)
+
+	bz, err := kvStore.Get(denomKey)
+	if err != nil || bz == nil {
+		return "", false
+	}
+	return string(bz), true
 }
 
 // GetAllProperties returns all properties in the store
@@ -118,7 +210,7 @@ func (k Keeper) GetAllProperties(ctx sdk.Context) ([]types.Property, error) {
 	propertyPrefix := types.KeyPrefix(types.KeyPrefixProperty)
 
 	// Get an iterator over all property keys with the prefix
-	iterator, err := kvStore.Iterator(propertyPrefix, nil)
+	iterator, err := kvStore.Iterator(propertyPrefix, storetypes.PrefixEndBytes(propertyPrefix))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get iterator: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	"github.com/ardaglobal/arda-poc/x/property/types"
 )
 
@@ -95,3 +96,28 @@ func TestGetAllProperties(t *testing.T) {
 		require.Equal(t, expected.Owners, retrieved.Owners)
 	}
 }
+
+func TestReassignSharesKeepsOwnerOrder(t *testing.T) {
+	k, ctx := keepertest.PropertyKeeper(t)
+	k.SetProperty(ctx, types.Property{Index: "p1", Owners: []string{"a", "b", "c"}, Shares: []uint64{50, 30, 20}})
+
+	require.NoError(t, k.ReassignShares(ctx, "p1", "b", "d", 10))
+	require.NoError(t, k.ReassignShares(ctx, "p1", "a", "c", 50))
+	require.Error(t, k.ReassignShares(ctx, "p1", "a", "d", 1))
+
+	property, _ := k.GetProperty(ctx, "p1")
+	require.Equal(t, []string{"b", "c", "d"}, property.Owners)
+	require.Equal(t, []uint64{20, 70, 10}, property.Shares)
+}
+
+func TestSellSharesKeepsOwnerOrder(t *testing.T) {
+	k, ctx := keepertest.PropertyKeeper(t)
+	a, b, c, buyer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetProperty(ctx, types.Property{Index: "p1", Region: "dubai", Owners: []string{a, b, c}, Shares: []uint64{50, 30, 20}})
+
+	require.NoError(t, k.SellShares(ctx, "p1", []string{a, c}, []uint64{50, 10}, buyer, 0))
+
+	property, _ := k.GetProperty(ctx, "p1")
+	require.Equal(t, []string{b, c, buyer}, property.Owners)
+	require.Equal(t, []uint64{30, 10, 60}, property.Shares)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 mints the title NFTs of properties registered before properties
+// were represented in x/nft.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	properties, err := m.keeper.GetAllProperties(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, property := range properties {
+		if property.NftId != "" {
+			continue
+		}
+		if err := m.keeper.MintPropertyNFT(ctx, &property); err != nil {
+			return err
+		}
+		m.keeper.SetProperty(ctx, property)
+	}
+
+	return nil
+}
+
+// Migrate2to3 moves the share balances of every property from the legacy
+// "prop<id>" denom to its current denom, registers the denom metadata and
+// records the legacy denom as an alias.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	properties, err := m.keeper.GetAllProperties(ctx)
+	if err != nil {
+		return err
+	}
+
+	legacy := make(map[string]bool, len(properties))
+	for _, property := range properties {
+		legacy[types.LegacyPropertyShareDenom(property.Index)] = true
+	}
+	holdings := m.keeper.holdingsByDenom(ctx, legacy)
+
+	for _, property := range properties {
+		if err := m.keeper.migrateShareDenom(ctx, property, holdings[types.LegacyPropertyShareDenom(property.Index)]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 sets the shareholder governance params introduced in v4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate4to5 re-encodes the denom aliases as DenomAlias records so they are
+// exported in genesis like the mortgage module's.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.migrateDenomAliasEncoding(ctx)
+	return nil
+}
+
+// Migrate5to6 indexes the properties by share denom for the bank send
+// restriction that enforces liens.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	properties, err := m.keeper.GetAllProperties(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, property := range properties {
+		m.keeper.SetProperty(ctx, property)
+	}
+
+	return nil
+}
+
+// Migrate6to7 indexes the proposals in their voting period by property and
+// sets their total weight to the supply of the property's shares, so that
+// transfers during the rest of the voting period are checkpointed.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, proposal := range m.keeper.GetAllProposal(ctx) {
+		if proposal.Status != types.PROPOSAL_STATUS_VOTING_PERIOD {
+			continue
+		}
+		proposal.TotalWeight = m.keeper.bankKeeper.GetSupply(ctx, types.PropertyShareDenom(proposal.PropertyId)).Amount.Uint64()
+		m.keeper.SetProposal(ctx, proposal)
+		m.keeper.insertActiveProposal(ctx, proposal)
+	}
+	return nil
+}
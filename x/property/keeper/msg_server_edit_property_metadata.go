@@ -27,8 +27,21 @@ func (k msgServer) EditPropertyMetadata(goCtx context.Context, msg *types.MsgEdi
 	property.OwnerInformation = msg.OwnerInformation
 	property.TenantId = msg.TenantId
 	property.UnitNumber = msg.UnitNumber
+	if msg.MetadataUri != "" {
+		property.MetadataUri = msg.MetadataUri
+	}
+
+	// Keep the title NFT in sync with the edited metadata
+	if property.NftId == "" {
+		if err := k.MintPropertyNFT(ctx, &property); err != nil {
+			return nil, err
+		}
+	} else if err := k.UpdatePropertyNFT(ctx, property); err != nil {
+		return nil, err
+	}
 
 	k.SetProperty(ctx, property)
+	k.SetShareDenomMetadata(ctx, property)
 
 	hash, err := hashProperty(property)
 	if err != nil {
@@ -43,5 +56,13 @@ func (k msgServer) EditPropertyMetadata(goCtx context.Context, msg *types.MsgEdi
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPropertyMetadataEdited{
+		PropertyId:  property.Index,
+		Editor:      msg.Creator,
+		MetadataUri: property.MetadataUri,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgEditPropertyMetadataResponse{}, nil
 }
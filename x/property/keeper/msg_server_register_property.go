@@ -55,14 +55,34 @@ func (k msgServer) RegisterProperty(goCtx context.Context, msg *types.MsgRegiste
 		return nil, fmt.Errorf("ownership shares must total 100, got %d", total)
 	}
 
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create and store property
 	property := types.Property{
-		Index:   id,
-		Address: msg.Address,
-		Region:  msg.Region,
-		Value:   msg.Value,
-		Owners:  msg.Owners,
-		Shares:  msg.Shares,
+		Index:       id,
+		Address:     msg.Address,
+		Region:      msg.Region,
+		Value:       msg.Value,
+		Owners:      msg.Owners,
+		Shares:      msg.Shares,
+		MetadataUri: msg.MetadataUri,
+	}
+	// Anchor the value to the region's price index so that it follows the index
+	if index, found := k.oracleKeeper.GetRegionIndex(ctx, msg.Region); found {
+		property.ValuationIndex = index.Index
+	}
+
+	// Collect the registration fee of the region from the registrant
+	if err := k.CollectFee(ctx, property, types.FeeTypeRegistration, creator, k.RegistrationFee(ctx, property.Region, property.Value)); err != nil {
+		return nil, err
+	}
+
+	// Mint the title NFT; the share tokens minted below are fractions of it
+	if err := k.MintPropertyNFT(ctx, &property); err != nil {
+		return nil, err
 	}
 	k.SetProperty(ctx, property)
 
@@ -83,6 +103,7 @@ func (k msgServer) RegisterProperty(goCtx context.Context, msg *types.MsgRegiste
 
 	// Mint property share tokens to owners using x/bank
 	denom := types.PropertyShareDenom(id)
+	k.SetShareDenomMetadata(ctx, property)
 	for i, owner := range msg.Owners {
 		if i >= len(msg.Shares) {
 			break
@@ -104,5 +125,18 @@ func (k msgServer) RegisterProperty(goCtx context.Context, msg *types.MsgRegiste
 		}
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPropertyRegistered{
+		PropertyId: property.Index,
+		Address:    property.Address,
+		Region:     property.Region,
+		Value:      property.Value,
+		Owners:     property.Owners,
+		Shares:     property.Shares,
+		NftId:      property.NftId,
+		Creator:    msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgRegisterPropertyResponse{}, nil
 }
@@ -16,8 +16,7 @@ func setupMsgServerRegister(t testing.TB) (propertykeeper.Keeper, types.MsgServe
 	pk, ctx := keeper.PropertyKeeper(t)
 	ak, _ := keeper.ArdaKeeper(t)
 	bk := keeper.BankKeeperMock{}
-	uk, _ := keeper.UsdardaKeeper(t)
-	return pk, propertykeeper.NewMsgServerImpl(pk, ak, bk, uk), ctx
+	return pk, propertykeeper.NewMsgServerImpl(pk, ak, bk), ctx
 }
 
 func TestRegisterPropertyLengthMismatch(t *testing.T) {
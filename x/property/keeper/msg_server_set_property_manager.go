@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func (k msgServer) SetPropertyManager(goCtx context.Context, msg *types.MsgSetPropertyManager) (*types.MsgSetPropertyManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	property, found := k.GetProperty(ctx, msg.PropertyId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "property not found: %s", msg.PropertyId)
+	}
+	if governance := types.PropertyGovernanceAddress(property.Index).String(); msg.Authority != governance {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "the manager is appointed by the governance account %s", governance)
+	}
+
+	property.Manager = msg.Manager
+	k.SetProperty(ctx, property)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPropertyManagerSet{
+		PropertyId: property.Index,
+		Manager:    msg.Manager,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPropertyManagerResponse{}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.SubmitProposal(goCtx, msg.Proposer, msg.PropertyId, msg.Title, msg.Summary, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitProposalResponse{ProposalId: id}, nil
+}
@@ -17,8 +17,7 @@ func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, context.Conte
 	pk, ctx := keepertest.PropertyKeeper(t)
 	ak, _ := keepertest.ArdaKeeper(t)
 	bk := keepertest.BankKeeperMock{}
-	uk, _ := keepertest.UsdardaKeeper(t)
-	return pk, keeper.NewMsgServerImpl(pk, ak, bk, uk), ctx
+	return pk, keeper.NewMsgServerImpl(pk, ak, bk), ctx
 }
 
 func TestMsgServer(t *testing.T) {
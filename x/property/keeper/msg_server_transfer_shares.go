@@ -32,6 +32,13 @@ func (k msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferS
 		}
 	}()
 
+	// Encumbered shares cannot change hands
+	for _, owner := range msg.FromOwners {
+		if err := k.lienKeeper.AssertUnencumbered(ctx, property.Index, owner); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate input
 	if len(msg.FromOwners) != len(msg.FromShares) {
 		return nil, fmt.Errorf("mismatch in from_owners and from_shares")
@@ -58,16 +65,19 @@ func (k msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferS
 	ownerMap := k.ConvertPropertyOwnersToMap(property)
 	denom := types.PropertyShareDenom(property.Index)
 	usdDenom := usdtypes.USDArdaDenom
+	value := k.CurrentValue(ctx, property)
 
-	// Ensure all recipients have sufficient USDArda to cover the purchase
+	// Ensure all recipients have sufficient USDArda to cover the purchase and
+	// the transfer fee of the region
 	for i, newOwner := range msg.ToOwners {
 		addr, err := sdk.AccAddressFromBech32(newOwner)
 		if err != nil {
 			return nil, err
 		}
-		usdAmt := property.Value * msg.ToShares[i] / 100
+		usdAmt := value * msg.ToShares[i] / 100
+		fee := k.TransferFee(ctx, property.Region, usdAmt)
 		balance := k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(usdDenom)
-		if balance.LT(math.NewIntFromUint64(usdAmt)) {
+		if balance.LT(math.NewIntFromUint64(usdAmt + fee)) {
 			return nil, fmt.Errorf("owner %s has insufficient usdarda", newOwner)
 		}
 	}
@@ -114,12 +124,14 @@ func (k msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferS
 	}
 
 	// Move USDArda from buyers to sellers via the module account
+	var price uint64
 	for i, newOwner := range msg.ToOwners {
 		addr, err := sdk.AccAddressFromBech32(newOwner)
 		if err != nil {
 			return nil, err
 		}
-		usdAmt := property.Value * msg.ToShares[i] / 100
+		usdAmt := value * msg.ToShares[i] / 100
+		price += usdAmt
 		coin := sdk.NewCoin(usdDenom, math.NewInt(int64(usdAmt)))
 		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 			return nil, err
@@ -130,13 +142,25 @@ func (k msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferS
 		if err != nil {
 			return nil, err
 		}
-		usdAmt := property.Value * msg.FromShares[i] / 100
+		usdAmt := value * msg.FromShares[i] / 100
 		coin := sdk.NewCoin(usdDenom, math.NewInt(int64(usdAmt)))
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(coin)); err != nil {
 			return nil, err
 		}
 	}
 
+	// Collect the transfer fee of the region from the buyers
+	for i, newOwner := range msg.ToOwners {
+		addr, err := sdk.AccAddressFromBech32(newOwner)
+		if err != nil {
+			return nil, err
+		}
+		usdAmt := value * msg.ToShares[i] / 100
+		if err := k.CollectFee(ctx, property, types.FeeTypeTransfer, addr, k.TransferFee(ctx, property.Region, usdAmt)); err != nil {
+			return nil, err
+		}
+	}
+
 	k.UpdatePropertyFromOwnerMap(&property, ownerMap)
 
 	// Append transfer history
@@ -162,5 +186,16 @@ func (k msgServer) TransferShares(goCtx context.Context, msg *types.MsgTransferS
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSharesTransferred{
+		PropertyId: property.Index,
+		FromOwners: msg.FromOwners,
+		FromShares: msg.FromShares,
+		ToOwners:   msg.ToOwners,
+		ToShares:   msg.ToShares,
+		Price:      price,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgTransferSharesResponse{}, nil
 }
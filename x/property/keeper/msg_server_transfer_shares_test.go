@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	propertykeeper "github.com/ardaglobal/arda-poc/x/property/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestTransferSharesEncumbered(t *testing.T) {
+	pk, ctx, lk := keeper.PropertyKeeperWithLiens(t)
+	ak, _ := keeper.ArdaKeeper(t)
+	ms := propertykeeper.NewMsgServerImpl(pk, ak, keeper.BankKeeperMock{})
+
+	seller, buyer := sample.AccAddress(), sample.AccAddress()
+	pk.SetProperty(ctx, types.Property{Index: "addr1", Region: "dubai", Value: 100, Owners: []string{seller}, Shares: []uint64{100}})
+	_, err := lk.PlaceLien(ctx, lientypes.Lien{PropertyId: "addr1", Module: "mortgage", Holder: sample.AccAddress(), Owner: seller})
+	require.NoError(t, err)
+
+	_, err = ms.TransferShares(ctx, &types.MsgTransferShares{
+		Creator:    seller,
+		PropertyId: "addr1",
+		FromOwners: []string{seller},
+		FromShares: []uint64{100},
+		ToOwners:   []string{buyer},
+		ToShares:   []uint64{100},
+	})
+	require.ErrorIs(t, err, lientypes.ErrPropertyEncumbered)
+}
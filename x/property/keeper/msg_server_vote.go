@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	if err := k.CastVote(goCtx, msg.ProposalId, msg.Voter, msg.Option); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteResponse{}, nil
+}
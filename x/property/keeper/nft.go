@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// ensureRegionClass creates the x/nft class for a region if it does not exist yet
+// and returns its id.
+func (k Keeper) ensureRegionClass(ctx sdk.Context, region string) (string, error) {
+	classID := types.PropertyNFTClassID(region)
+	if k.nftKeeper.HasClass(ctx, classID) {
+		return classID, nil
+	}
+
+	class := nft.Class{
+		Id:          classID,
+		Name:        fmt.Sprintf("Arda property titles (%s)", region),
+		Symbol:      "ARDATITLE",
+		Description: fmt.Sprintf("Property titles registered in region %s", region),
+	}
+	if err := k.nftKeeper.SaveClass(ctx, class); err != nil {
+		return "", fmt.Errorf("failed to create nft class %s: %w", classID, err)
+	}
+	return classID, nil
+}
+
+// MintPropertyNFT mints the title NFT for a property into the property module
+// account, which holds it in custody while the share tokens circulate. The NFT
+// ids are recorded on the property, which must be stored by the caller.
+func (k Keeper) MintPropertyNFT(ctx sdk.Context, property *types.Property) error {
+	classID, err := k.ensureRegionClass(ctx, property.Region)
+	if err != nil {
+		return err
+	}
+	property.NftClassId = classID
+	property.NftId = types.PropertyNFTID(property.Index)
+
+	hash, err := hashProperty(*property)
+	if err != nil {
+		return err
+	}
+
+	token := nft.NFT{
+		ClassId: property.NftClassId,
+		Id:      property.NftId,
+		Uri:     property.MetadataUri,
+		UriHash: hash,
+	}
+	if err := k.nftKeeper.Mint(ctx, token, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+		return fmt.Errorf("failed to mint nft for property %s: %w", property.Index, err)
+	}
+	return nil
+}
+
+// UpdatePropertyNFT refreshes the URI and hash carried by a property's title NFT.
+func (k Keeper) UpdatePropertyNFT(ctx sdk.Context, property types.Property) error {
+	token, found := k.nftKeeper.GetNFT(ctx, property.NftClassId, property.NftId)
+	if !found {
+		return fmt.Errorf("nft not found for property %s", property.Index)
+	}
+
+	hash, err := hashProperty(property)
+	if err != nil {
+		return err
+	}
+	token.Uri = property.MetadataUri
+	token.UriHash = hash
+
+	return k.nftKeeper.Update(ctx, token)
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/keeper"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestMintPropertyNFT(t *testing.T) {
+	k, ctx, nk := keepertest.PropertyKeeperWithNFT(t)
+
+	property := types.Property{
+		Index:       "123 main st",
+		Address:     "123 Main St",
+		Region:      "New York",
+		Value:       1000,
+		Owners:      []string{"cosmos1abcdefg"},
+		Shares:      []uint64{100},
+		MetadataUri: "ipfs://title",
+	}
+	require.NoError(t, k.MintPropertyNFT(ctx, &property))
+	require.Equal(t, "property-new-york", property.NftClassId)
+	require.Equal(t, types.PropertyNFTID(property.Index), property.NftId)
+
+	token, found := nk.GetNFT(ctx, property.NftClassId, property.NftId)
+	require.True(t, found)
+	require.Equal(t, "ipfs://title", token.Uri)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName), nk.GetOwner(ctx, property.NftClassId, property.NftId))
+
+	// A second property in the same region reuses the class
+	other := types.Property{Index: "456 main st", Region: "New York", Value: 500}
+	require.NoError(t, k.MintPropertyNFT(ctx, &other))
+	require.Len(t, nk.Classes, 1)
+
+	// Updating the metadata is reflected on the NFT
+	property.MetadataUri = "ipfs://title-v2"
+	require.NoError(t, k.UpdatePropertyNFT(ctx, property))
+	token, _ = nk.GetNFT(ctx, property.NftClassId, property.NftId)
+	require.Equal(t, "ipfs://title-v2", token.Uri)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, nk := keepertest.PropertyKeeperWithNFT(t)
+
+	k.SetProperty(ctx, types.Property{Index: "addr1", Region: "dubai", Value: 100})
+	k.SetProperty(ctx, types.Property{Index: "addr2", Region: "london", Value: 200})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	for _, index := range []string{"addr1", "addr2"} {
+		property, found := k.GetProperty(ctx, index)
+		require.True(t, found)
+		require.NotEmpty(t, property.NftId)
+		require.True(t, nk.HasNFT(ctx, property.NftClassId, property.NftId))
+	}
+
+	// Running the migration again is a no-op
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Len(t, nk.NFTs, 2)
+}
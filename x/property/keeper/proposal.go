@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// GetProposalCount get the total number of proposals
+func (k Keeper) GetProposalCount(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.KeyPrefix(types.KeyProposalCount))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetProposalCount set the total number of proposals
+func (k Keeper) SetProposalCount(ctx context.Context, count uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyPrefix(types.KeyProposalCount), sdk.Uint64ToBigEndian(count))
+}
+
+// AppendProposal appends a proposal in the store with a new id and update the count
+func (k Keeper) AppendProposal(ctx context.Context, proposal types.Proposal) uint64 {
+	count := k.GetProposalCount(ctx)
+	proposal.Id = count
+	k.SetProposal(ctx, proposal)
+	k.SetProposalCount(ctx, count+1)
+	return count
+}
+
+// SetProposal set a specific proposal in the store
+func (k Keeper) SetProposal(ctx context.Context, proposal types.Proposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixProposal))
+	store.Set(types.ProposalKey(proposal.Id), k.cdc.MustMarshal(&proposal))
+}
+
+// GetProposal returns a proposal from its id
+func (k Keeper) GetProposal(ctx context.Context, id uint64) (val types.Proposal, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixProposal))
+	b := store.Get(types.ProposalKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProposal returns all proposals
+func (k Keeper) GetAllProposal(ctx context.Context) (list []types.Proposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixProposal))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// insertActiveProposal queues a proposal to be tallied at the end of its voting
+// period and indexes it by property.
+func (k Keeper) insertActiveProposal(ctx context.Context, proposal types.Proposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixActiveProposal))
+	store.Set(types.ActiveProposalKey(proposal.VotingEndHeight, proposal.Id), []byte{})
+
+	propertyStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPropertyProposal))
+	propertyStore.Set(types.PropertyProposalKey(proposal.PropertyId, proposal.Id), []byte{})
+}
+
+// removeActiveProposal removes a proposal from the active proposal queue and
+// the index by property, and deletes its voting checkpoints.
+func (k Keeper) removeActiveProposal(ctx context.Context, proposal types.Proposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixActiveProposal))
+	store.Delete(types.ActiveProposalKey(proposal.VotingEndHeight, proposal.Id))
+
+	propertyStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPropertyProposal))
+	propertyStore.Delete(types.PropertyProposalKey(proposal.PropertyId, proposal.Id))
+
+	checkpointStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVotingCheckpoint))
+	iterator := storetypes.KVStorePrefixIterator(checkpointStore, types.VotingCheckpointsKey(proposal.Id))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		checkpointStore.Delete(key)
+	}
+}
+
+// getPropertyProposals returns the ids of the proposals of a property in their
+// voting period.
+func (k Keeper) getPropertyProposals(ctx context.Context, propertyId string) (ids []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPropertyProposal))
+	iterator := storetypes.KVStorePrefixIterator(store, types.PropertyProposalsKey(propertyId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, binary.BigEndian.Uint64(key[len(key)-8:]))
+	}
+
+	return
+}
+
+// setVotingCheckpoint records the share balance an account held when a
+// proposal was submitted.
+func (k Keeper) setVotingCheckpoint(ctx context.Context, proposalId uint64, account string, balance uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVotingCheckpoint))
+	store.Set(types.VotingCheckpointKey(proposalId, account), sdk.Uint64ToBigEndian(balance))
+}
+
+// getVotingCheckpoint returns the share balance an account held when a
+// proposal was submitted, if it changed since.
+func (k Keeper) getVotingCheckpoint(ctx context.Context, proposalId uint64, account string) (uint64, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVotingCheckpoint))
+	bz := store.Get(types.VotingCheckpointKey(proposalId, account))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// getEndedProposals returns the ids of the proposals whose voting period ends
+// at or before height, in the order they end.
+func (k Keeper) getEndedProposals(ctx context.Context, height int64) (ids []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixActiveProposal))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, binary.BigEndian.Uint64(key[8:]))
+	}
+
+	return
+}
+
+// SetVote set a specific vote in the store
+func (k Keeper) SetVote(ctx context.Context, vote types.Vote) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVote))
+	store.Set(types.VoteKey(vote.ProposalId, vote.Voter), k.cdc.MustMarshal(&vote))
+}
+
+// GetVote returns the vote of voter on a proposal
+func (k Keeper) GetVote(ctx context.Context, proposalId uint64, voter string) (val types.Vote, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVote))
+	b := store.Get(types.VoteKey(proposalId, voter))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetVotes returns the votes cast on a proposal
+func (k Keeper) GetVotes(ctx context.Context, proposalId uint64) (list []types.Vote) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixVote))
+	iterator := storetypes.KVStorePrefixIterator(store, types.VotesKey(proposalId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Vote
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
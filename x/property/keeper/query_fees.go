@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func (k Keeper) FeesCollected(ctx context.Context, req *types.QueryFeesCollectedRequest) (*types.QueryFeesCollectedResponse, error) {
+	if req == nil || types.NormalizeRegion(req.Region) == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Period != "" {
+		total, found := k.GetFeeTotal(ctx, req.Region, req.Period)
+		if !found {
+			total = types.FeeTotal{Region: types.NormalizeRegion(req.Region), Period: req.Period}
+		}
+		return &types.QueryFeesCollectedResponse{Fees: []types.FeeTotal{total}}, nil
+	}
+
+	var fees []types.FeeTotal
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	feeStore := prefix.NewStore(store, append(types.KeyPrefix(types.KeyPrefixFeeTotal), types.FeeTotalRegionKey(req.Region)...))
+
+	pageRes, err := query.Paginate(feeStore, req.Pagination, func(key []byte, value []byte) error {
+		var total types.FeeTotal
+		if err := k.cdc.Unmarshal(value, &total); err != nil {
+			return err
+		}
+
+		fees = append(fees, total)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeesCollectedResponse{Fees: fees, Pagination: pageRes}, nil
+}
@@ -112,3 +112,18 @@ func (k Keeper) Property(goCtx context.Context, req *propertyTypes.QueryGetPrope
 
 	return &propertyTypes.QueryGetPropertyResponse{Property: &property}, nil
 }
+
+func (k Keeper) DenomAlias(goCtx context.Context, req *propertyTypes.QueryDenomAliasRequest) (*propertyTypes.QueryDenomAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	val, found := k.GetDenomAlias(ctx, req.Alias)
+	if !found {
+		return nil, status.Error(codes.NotFound, "denom alias not found")
+	}
+
+	return &propertyTypes.QueryDenomAliasResponse{Denom: val.Denom}, nil
+}
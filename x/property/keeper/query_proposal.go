@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func (k Keeper) GovernanceAddress(_ context.Context, req *types.QueryGovernanceAddressRequest) (*types.QueryGovernanceAddressResponse, error) {
+	if req == nil || req.PropertyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryGovernanceAddressResponse{Address: types.PropertyGovernanceAddress(req.PropertyId).String()}, nil
+}
+
+func (k Keeper) ProposalAll(ctx context.Context, req *types.QueryAllProposalRequest) (*types.QueryAllProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var proposals []types.Proposal
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	proposalStore := prefix.NewStore(store, types.KeyPrefix(types.KeyPrefixProposal))
+
+	pageRes, err := query.Paginate(proposalStore, req.Pagination, func(key []byte, value []byte) error {
+		var proposal types.Proposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return err
+		}
+
+		proposals = append(proposals, proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProposalResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Proposal(ctx context.Context, req *types.QueryGetProposalRequest) (*types.QueryGetProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetProposalResponse{Proposal: proposal}, nil
+}
+
+func (k Keeper) Votes(ctx context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var votes []types.Vote
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	voteStore := prefix.NewStore(store, append(types.KeyPrefix(types.KeyPrefixVote), types.VotesKey(req.ProposalId)...))
+
+	pageRes, err := query.Paginate(voteStore, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.Unmarshal(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesResponse{Votes: votes, Pagination: pageRes}, nil
+}
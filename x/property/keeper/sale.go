@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// SellShares sells shares of a property to buyer for price. The price is split
+// between the sellers in proportion to the shares each one sells and the buyer
+// also pays the transfer fee of the region. Unlike a share transfer the price
+// is agreed by the parties instead of following the property value. SellShares
+// does not ask the sellers: callers must only sell with their consent, as the
+// mortgage module does once every seller accepted a purchase.
+func (k Keeper) SellShares(ctx sdk.Context, propertyId string, sellers []string, shares []uint64, buyer string, price uint64) error {
+	property, found := k.GetProperty(ctx, propertyId)
+	if !found {
+		return fmt.Errorf("property not found: %s", propertyId)
+	}
+	if len(sellers) == 0 || len(sellers) != len(shares) {
+		return fmt.Errorf("mismatch in sellers and shares")
+	}
+
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	for _, share := range shares {
+		total += share
+	}
+	if total == 0 {
+		return fmt.Errorf("no shares to sell")
+	}
+
+	denom := types.PropertyShareDenom(property.Index)
+	fromSharesAndOwners := make([]string, 0, len(sellers))
+	var paid uint64
+	for i, seller := range sellers {
+		if seller == buyer {
+			return fmt.Errorf("buyer %s cannot sell to itself", buyer)
+		}
+		if err := k.lienKeeper.AssertUnencumbered(ctx, property.Index, seller); err != nil {
+			return err
+		}
+		if err := moveShares(&property, seller, buyer, shares[i]); err != nil {
+			return err
+		}
+		fromSharesAndOwners = append(fromSharesAndOwners, fmt.Sprintf("%s:%d", seller, shares[i]))
+
+		sellerAddr, err := sdk.AccAddressFromBech32(seller)
+		if err != nil {
+			return err
+		}
+		shareCoins := sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromUint64(shares[i])))
+		if err := k.bankKeeper.SendCoins(ctx, sellerAddr, buyerAddr, shareCoins); err != nil {
+			return err
+		}
+
+		// The last seller gets what rounding left over
+		amount := price * shares[i] / total
+		if i == len(sellers)-1 {
+			amount = price - paid
+		}
+		paid += amount
+		if amount > 0 {
+			payment := sdk.NewCoins(sdk.NewCoin(usdtypes.USDArdaDenom, math.NewIntFromUint64(amount)))
+			if err := k.bankKeeper.SendCoins(ctx, buyerAddr, sellerAddr, payment); err != nil {
+				return fmt.Errorf("buyer %s cannot pay seller %s: %w", buyer, seller, err)
+			}
+		}
+	}
+	if err := k.CollectFee(ctx, property, types.FeeTypeTransfer, buyerAddr, k.TransferFee(ctx, property.Region, price)); err != nil {
+		return err
+	}
+
+	property.Transfers = append(property.Transfers, &types.Transfer{
+		From:      strings.Join(fromSharesAndOwners, ","),
+		To:        fmt.Sprintf("%s:%d", buyer, total),
+		Timestamp: ctx.BlockTime().UTC().Format(time.RFC3339),
+	})
+	k.SetProperty(ctx, property)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSharesTransferred{
+		PropertyId: property.Index,
+		FromOwners: sellers,
+		FromShares: shares,
+		ToOwners:   []string{buyer},
+		ToShares:   []uint64{total},
+		Price:      price,
+	})
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SendRestriction is the bank send restriction that keeps encumbered shares in
+// place: shares under a lien cannot be sent by their owner, whether through
+// TransferShares, a bank MsgSend or an authz exec. The shares can still move
+// into the account of a module holding a lien on the property, e.g. to be
+// escrowed as the collateral of a mortgage or taken in a liquidation. Shares
+// held by module accounts are released by the modules themselves, so sends out
+// of them are not restricted.
+// Before any shares move, the balances of the sender and the recipient are
+// checkpointed for the proposals on the property in their voting period.
+func (k Keeper) SendRestriction(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	moduleSender := k.isModuleAccount(ctx, from)
+	for _, coin := range amt {
+		propertyId, found := k.GetShareDenomProperty(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		k.checkpointVotingWeights(ctx, propertyId, from, to)
+		if moduleSender || k.holdsLien(ctx, propertyId, to) {
+			continue
+		}
+		if err := k.lienKeeper.AssertUnencumbered(ctx, propertyId, from.String()); err != nil {
+			return to, err
+		}
+	}
+	return to, nil
+}
+
+// holdsLien reports whether addr is the account of a module holding a lien on
+// the property.
+func (k Keeper) holdsLien(ctx context.Context, propertyId string, addr sdk.AccAddress) bool {
+	for _, lien := range k.lienKeeper.GetPropertyLiens(ctx, propertyId) {
+		if addr.Equals(authtypes.NewModuleAddress(lien.Module)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isModuleAccount reports whether addr is the account of a module.
+func (k Keeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
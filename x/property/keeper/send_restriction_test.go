@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestSendRestriction(t *testing.T) {
+	pk, ctx, lk := keeper.PropertyKeeperWithLiens(t)
+
+	lendee, coOwner, buyer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	pk.SetProperty(ctx, types.Property{Index: "addr1", Region: "dubai", Value: 100, Owners: []string{lendee, coOwner}, Shares: []uint64{60, 40}})
+	_, err := lk.PlaceLien(ctx, lientypes.Lien{PropertyId: "addr1", Module: "mortgage", Holder: sample.AccAddress(), Owner: lendee})
+	require.NoError(t, err)
+
+	shares := sdk.NewCoins(sdk.NewInt64Coin(types.PropertyShareDenom("addr1"), 10))
+	tests := []struct {
+		desc string
+		from string
+		to   sdk.AccAddress
+		amt  sdk.Coins
+		err  error
+	}{
+		{
+			desc: "encumbered shares",
+			from: lendee,
+			to:   sdk.MustAccAddressFromBech32(buyer),
+			amt:  shares,
+			err:  lientypes.ErrPropertyEncumbered,
+		},
+		{
+			desc: "shares of another owner",
+			from: coOwner,
+			to:   sdk.MustAccAddressFromBech32(buyer),
+			amt:  shares,
+		},
+		{
+			desc: "escrowed with the lien holder",
+			from: lendee,
+			to:   authtypes.NewModuleAddress("mortgage"),
+			amt:  shares,
+		},
+		{
+			desc: "into a module without a lien",
+			from: lendee,
+			to:   authtypes.NewModuleAddress("usdarda"),
+			amt:  shares,
+			err:  lientypes.ErrPropertyEncumbered,
+		},
+		{
+			desc: "released by a module",
+			from: authtypes.NewModuleAddress("mortgage").String(),
+			to:   sdk.MustAccAddressFromBech32(buyer),
+			amt:  shares,
+		},
+		{
+			desc: "other denom",
+			from: lendee,
+			to:   sdk.MustAccAddressFromBech32(buyer),
+			amt:  sdk.NewCoins(sdk.NewInt64Coin("uarda", 10)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			to, err := pk.SendRestriction(ctx, sdk.MustAccAddressFromBech32(tc.from), tc.to, tc.amt)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.to, to)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+// CurrentValue returns the value of a property from the oracle: its own
+// valuation when validators observe the property, otherwise its registered
+// value moved by the change in its region's price index since registration.
+// Properties registered before their region had an index keep their
+// registered value until they are valued directly.
+func (k Keeper) CurrentValue(ctx context.Context, property types.Property) uint64 {
+	if valuation, found := k.oracleKeeper.GetPropertyValuation(ctx, property.Index); found {
+		return valuation.Value
+	}
+	if property.ValuationIndex == "" {
+		return property.Value
+	}
+	index, found := k.oracleKeeper.GetRegionIndex(ctx, property.Region)
+	if !found {
+		return property.Value
+	}
+
+	base, err := math.LegacyNewDecFromStr(property.ValuationIndex)
+	if err != nil || !base.IsPositive() {
+		return property.Value
+	}
+	value := math.LegacyNewDecFromInt(math.NewIntFromUint64(property.Value)).
+		Mul(math.LegacyMustNewDecFromStr(index.Index)).
+		Quo(base).
+		TruncateInt()
+	if !value.IsUint64() {
+		return property.Value
+	}
+	return value.Uint64()
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	oracletypes "github.com/ardaglobal/arda-poc/x/oracle/types"
+	"github.com/ardaglobal/arda-poc/x/property/types"
+)
+
+func TestCurrentValue(t *testing.T) {
+	f := keepertest.NewPropertyFixture(t)
+	k, ok, ctx := f.Keeper, f.OracleKeeper, f.Ctx
+
+	indexed := types.Property{Index: "1 main st", Region: "dubai", Value: 1000, ValuationIndex: "100"}
+	unindexed := types.Property{Index: "2 main st", Region: "dubai", Value: 1000}
+
+	// Without oracle data the registered value holds
+	require.Equal(t, uint64(1000), k.CurrentValue(ctx, indexed))
+
+	// The value follows the region index from the index at registration
+	ok.SetRegionIndex(ctx, oracletypes.RegionIndex{Region: "dubai", Index: "104.5"})
+	require.Equal(t, uint64(1045), k.CurrentValue(ctx, indexed))
+	ok.SetRegionIndex(ctx, oracletypes.RegionIndex{Region: "dubai", Index: "70"})
+	require.Equal(t, uint64(700), k.CurrentValue(ctx, indexed))
+	require.Equal(t, uint64(1000), k.CurrentValue(ctx, unindexed))
+
+	// A valuation of the property itself takes precedence
+	ok.SetPropertyValuation(ctx, oracletypes.PropertyValuation{PropertyId: "2 main st", Value: 1300})
+	require.Equal(t, uint64(1300), k.CurrentValue(ctx, unindexed))
+	require.Equal(t, uint64(700), k.CurrentValue(ctx, indexed))
+}
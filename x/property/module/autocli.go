@@ -1,13 +1,16 @@
 package property
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	modulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/property"
@@ -40,6 +43,41 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:           "Query a registered property by its index with formatted display of owners and shares",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "index"}},
 				},
+				{
+					RpcMethod:      "DenomAlias",
+					Use:            "denom-alias [alias]",
+					Short:          "Resolve a legacy share denom to its current denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "alias"}},
+				},
+				{
+					RpcMethod:      "GovernanceAddress",
+					Use:            "governance-address [property-id]",
+					Short:          "Shows the account that must sign the messages of a property's proposals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod: "ProposalAll",
+					Use:       "list-proposal",
+					Short:     "List all shareholder proposals",
+				},
+				{
+					RpcMethod:      "Proposal",
+					Use:            "show-proposal [proposal-id]",
+					Short:          "Shows a shareholder proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "Votes",
+					Use:            "votes [proposal-id]",
+					Short:          "Shows the votes cast on a shareholder proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "FeesCollected",
+					Use:            "fees-collected [region]",
+					Short:          "Shows the transfer and registration fees collected in a region per month",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "region"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -63,6 +101,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a transfer-shares tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "propertyId"}, {ProtoField: "fromOwners"}, {ProtoField: "fromShares"}, {ProtoField: "toOwners"}, {ProtoField: "toShares"}},
 				},
+				{
+					RpcMethod: "SubmitProposal",
+					Skip:      true, // custom command reading the messages from a file
+				},
+				{
+					RpcMethod:      "Vote",
+					Use:            "vote [proposal-id] [option]",
+					Short:          "Vote on a shareholder proposal (yes, no or abstain)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}, {ProtoField: "option"}},
+				},
+				{
+					RpcMethod: "SetPropertyManager",
+					Skip:      true, // only executed by shareholder proposals
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -256,6 +308,7 @@ func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdRegisterProperty(),
 		GetCmdTransferShares(),
+		GetCmdSubmitProposal(),
 	)
 
 	return cmd
@@ -352,6 +405,11 @@ func GetCmdRegisterProperty() *cobra.Command {
 				ownerAddresses[i] = addr
 			}
 
+			metadataURI, err := cmd.Flags().GetString("metadata-uri")
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRegisterProperty(
 				clientCtx.GetFromAddress().String(),
 				strings.TrimSpace(args[0]), // address
@@ -360,6 +418,7 @@ func GetCmdRegisterProperty() *cobra.Command {
 				ownerAddresses,
 				shares,
 			)
+			msg.MetadataUri = metadataURI
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -367,6 +426,7 @@ func GetCmdRegisterProperty() *cobra.Command {
 
 	cmd.Flags().StringSlice("owners", []string{}, "Comma-separated list of owner names from the keyring")
 	cmd.Flags().StringSlice("shares", []string{}, "Comma-separated list of shares (must match number of owners)")
+	cmd.Flags().String("metadata-uri", "", "URI of the title metadata document carried by the property NFT")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -444,3 +504,74 @@ func GetCmdTransferShares() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// proposalFile is the content of the file passed to submit-proposal
+type proposalFile struct {
+	Title    string            `json:"title"`
+	Summary  string            `json:"summary"`
+	Messages []json.RawMessage `json:"messages"`
+}
+
+// GetCmdSubmitProposal implements the submit-proposal command
+func GetCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [property-id] [proposal-file]",
+		Short: "Submit a proposal to the shareholders of a property",
+		Long: `Submit a proposal to the shareholders of a property. The file holds the title,
+summary and the messages to execute once the proposal passes. The messages
+must be signed by the governance account of the property, see the
+governance-address query. Example file:
+
+{
+  "title": "Appoint a property manager",
+  "summary": "Appoint Acme Management to manage the property",
+  "messages": [
+    {
+      "@type": "/ardapoc.property.MsgSetPropertyManager",
+      "authority": "<governance address>",
+      "property_id": "<property id>",
+      "manager": "<manager address>"
+    }
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var file proposalFile
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return fmt.Errorf("invalid proposal file: %w", err)
+			}
+
+			msgs := make([]sdk.Msg, len(file.Messages))
+			for i, raw := range file.Messages {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+					return fmt.Errorf("invalid message %d: %w", i, err)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitProposal(
+				clientCtx.GetFromAddress().String(),
+				strings.TrimSpace(args[0]), // property-id
+				file.Title,
+				file.Summary,
+				msgs,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
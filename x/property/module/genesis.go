@@ -10,6 +10,10 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the denomAlias
+	for _, elem := range genState.DenomAliasList {
+		k.SetDenomAlias(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -21,6 +25,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.DenomAliasList = k.GetAllDenomAlias(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
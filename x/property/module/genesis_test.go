@@ -16,7 +16,9 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
-
+		DenomAliasList: []types.DenomAlias{
+			{Alias: "propaddr1", Denom: types.PropertyShareDenom("addr 1")},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -28,5 +30,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.DenomAliasList, got.DenomAliasList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
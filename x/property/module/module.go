@@ -9,12 +9,14 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -22,7 +24,6 @@ import (
 
 	modulev1 "github.com/ardaglobal/arda-poc/api/ardapoc/property/module"
 	ardamodulekeeper "github.com/ardaglobal/arda-poc/x/arda/keeper"
-	usdardakeeper "github.com/ardaglobal/arda-poc/x/usdarda/keeper"
 
 	"github.com/ardaglobal/arda-poc/x/property/keeper"
 
@@ -103,7 +104,6 @@ type AppModule struct {
 	ardaKeeper    ardamodulekeeper.Keeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
-	usdardaKeeper usdardakeeper.Keeper
 }
 
 func NewAppModule(
@@ -112,7 +112,6 @@ func NewAppModule(
 	ardaKeeper ardamodulekeeper.Keeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
-	usdardaKeeper usdardakeeper.Keeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
@@ -120,14 +119,33 @@ func NewAppModule(
 		ardaKeeper:     ardaKeeper,
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
-		usdardaKeeper:  usdardaKeeper,
 	}
 }
 
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper, am.ardaKeeper, am.bankKeeper, am.usdardaKeeper))
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper, am.ardaKeeper, am.bankKeeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -151,7 +169,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -160,9 +178,9 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+// It tallies the shareholder proposals whose voting period ended.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -189,18 +207,22 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	Config       *modulev1.Module
 	Logger       log.Logger
+	Router       baseapp.MessageRouter
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	NFTKeeper     types.NFTKeeper
+	LienKeeper    types.LienKeeper
+	OracleKeeper  types.OracleKeeper
 	ArdaKeeper    ardamodulekeeper.Keeper
-	UsdardaKeeper usdardakeeper.Keeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	PropertyKeeper keeper.Keeper
-	Module         appmodule.AppModule
+	PropertyKeeper  keeper.Keeper
+	Module          appmodule.AppModule
+	SendRestriction banktypes.SendRestrictionFn
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -213,7 +235,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.StoreService,
 		in.Logger,
+		in.AccountKeeper,
 		in.BankKeeper,
+		in.NFTKeeper,
+		in.LienKeeper,
+		in.OracleKeeper,
+		in.Router,
 		authority.String(),
 	)
 	m := NewAppModule(
@@ -222,8 +249,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.ArdaKeeper,
 		in.AccountKeeper,
 		in.BankKeeper,
-		in.UsdardaKeeper,
 	)
 
-	return ModuleOutputs{PropertyKeeper: k, Module: m}
+	return ModuleOutputs{PropertyKeeper: k, Module: m, SendRestriction: k.SendRestriction}
 }
@@ -10,4 +10,10 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrInvalidProposal  = sdkerrors.Register(ModuleName, 1102, "invalid proposal")
+	ErrProposalNotFound = sdkerrors.Register(ModuleName, 1103, "proposal not found")
+	ErrInactiveProposal = sdkerrors.Register(ModuleName, 1104, "proposal is not in its voting period")
+	ErrNoVotingWeight   = sdkerrors.Register(ModuleName, 1105, "no voting weight on proposal")
+	ErrInvalidVote      = sdkerrors.Register(ModuleName, 1106, "invalid vote")
 )
@@ -3,22 +3,55 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	oracletypes "github.com/ardaglobal/arda-poc/x/oracle/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
 type AccountKeeper interface {
-	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
-	// Methods imported from account should be defined here
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
 }
 
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	GetBalance(context.Context, sdk.AccAddress, string) sdk.Coin
+	GetSupply(context.Context, string) sdk.Coin
 	MintCoins(context.Context, string, sdk.Coins) error
+	BurnCoins(context.Context, string, sdk.Coins) error
 	SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error
 	SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error
 	SendCoins(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
+	IterateAllBalances(context.Context, func(sdk.AccAddress, sdk.Coin) bool)
+	GetDenomMetaData(context.Context, string) (banktypes.Metadata, bool)
+	SetDenomMetaData(context.Context, banktypes.Metadata)
+}
+
+// NFTKeeper defines the expected interface for the NFT module.
+type NFTKeeper interface {
+	SaveClass(context.Context, nft.Class) error
+	HasClass(context.Context, string) bool
+	Mint(context.Context, nft.NFT, sdk.AccAddress) error
+	Update(context.Context, nft.NFT) error
+	GetNFT(context.Context, string, string) (nft.NFT, bool)
+	HasNFT(context.Context, string, string) bool
+}
+
+// LienKeeper defines the expected interface for the Lien module.
+type LienKeeper interface {
+	AssertUnencumbered(ctx context.Context, propertyId string, owner string) error
+	OwnerLiens(ctx context.Context, propertyId string, owner string) []lientypes.Lien
+	GetPropertyLiens(ctx context.Context, propertyId string) []lientypes.Lien
+}
+
+// OracleKeeper defines the expected interface for the Oracle module.
+type OracleKeeper interface {
+	GetRegionIndex(context.Context, string) (oracletypes.RegionIndex, bool)
+	GetPropertyValuation(context.Context, string) (oracletypes.PropertyValuation, bool)
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
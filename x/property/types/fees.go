@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeTypeTransfer is the fee charged on TransferShares
+	FeeTypeTransfer = "transfer"
+	// FeeTypeRegistration is the fee charged on RegisterProperty
+	FeeTypeRegistration = "registration"
+
+	// FeePeriodLayout formats the period fees are totalled over
+	FeePeriodLayout = "2006-01"
+)
+
+// NormalizeRegion returns the form of a region name fee schedules and fee
+// totals are keyed by.
+func NormalizeRegion(region string) string {
+	return strings.ToLower(strings.TrimSpace(region))
+}
+
+// FeePeriod returns the period fees collected at t are totalled in.
+func FeePeriod(t time.Time) string {
+	return t.UTC().Format(FeePeriodLayout)
+}
+
+// FeeSchedule returns the fee schedule of a region.
+func (p Params) FeeSchedule(region string) (RegionFeeSchedule, bool) {
+	region = NormalizeRegion(region)
+	for _, schedule := range p.FeeSchedules {
+		if NormalizeRegion(schedule.Region) == region {
+			return schedule, true
+		}
+	}
+	return RegionFeeSchedule{}, false
+}
+
+// Amount returns the fee due on a transaction of the given value, rounded down.
+func (r FeeRate) Amount(value uint64) uint64 {
+	fee := r.Flat
+	if r.Rate != "" {
+		rate := math.LegacyMustNewDecFromStr(r.Rate)
+		fee += rate.MulInt(math.NewIntFromUint64(value)).TruncateInt().Uint64()
+	}
+	return fee
+}
+
+// Validate checks the fee rate is well formed.
+func (r FeeRate) Validate() error {
+	if r.Rate == "" {
+		return nil
+	}
+	return validateFraction(r.Rate)
+}
+
+// Validate checks the fee schedule is well formed.
+func (s RegionFeeSchedule) Validate() error {
+	if NormalizeRegion(s.Region) == "" {
+		return fmt.Errorf("region cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient of region %s: %w", s.Region, err)
+	}
+	if err := s.TransferFee.Validate(); err != nil {
+		return fmt.Errorf("invalid transfer fee of region %s: %w", s.Region, err)
+	}
+	if err := s.RegistrationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid registration fee of region %s: %w", s.Region, err)
+	}
+	return nil
+}
+
+// FeeTotalKey returns the store key of the fees collected in a region during a
+// period, relative to KeyPrefixFeeTotal.
+func FeeTotalKey(region string, period string) []byte {
+	return append(FeeTotalRegionKey(region), []byte(period)...)
+}
+
+// FeeTotalRegionKey returns the prefix of the fee totals of a region, relative
+// to KeyPrefixFeeTotal.
+func FeeTotalRegionKey(region string) []byte {
+	return append([]byte(NormalizeRegion(region)), 0)
+}
@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/property/fees.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeTotal is the amount of fees collected in a region during a period.
+type FeeTotal struct {
+	Region           string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	Period           string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	TransferFees     uint64 `protobuf:"varint,3,opt,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees,omitempty"`
+	RegistrationFees uint64 `protobuf:"varint,4,opt,name=registration_fees,json=registrationFees,proto3" json:"registration_fees,omitempty"`
+}
+
+func (m *FeeTotal) Reset()         { *m = FeeTotal{} }
+func (m *FeeTotal) String() string { return proto.CompactTextString(m) }
+func (*FeeTotal) ProtoMessage()    {}
+func (*FeeTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fb89be91da8575a, []int{0}
+}
+func (m *FeeTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTotal.Merge(m, src)
+}
+func (m *FeeTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTotal proto.InternalMessageInfo
+
+func (m *FeeTotal) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *FeeTotal) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *FeeTotal) GetTransferFees() uint64 {
+	if m != nil {
+		return m.TransferFees
+	}
+	return 0
+}
+
+func (m *FeeTotal) GetRegistrationFees() uint64 {
+	if m != nil {
+		return m.RegistrationFees
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeTotal)(nil), "ardapoc.property.FeeTotal")
+}
+
+func init() { proto.RegisterFile("ardapoc/property/fees.proto", fileDescriptor_9fb89be91da8575a) }
+
+var fileDescriptor_9fb89be91da8575a = []byte{
+	// 222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x2c, 0x4a, 0x49,
+	0x2c, 0xc8, 0x4f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0x48, 0x2d, 0x2a, 0xa9, 0xd4, 0x4f, 0x4b, 0x4d,
+	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xea, 0xc1, 0x24, 0x95, 0x7a,
+	0x18, 0xb9, 0x38, 0xdc, 0x52, 0x53, 0x43, 0xf2, 0x4b, 0x12, 0x73, 0x84, 0xc4, 0xb8, 0xd8, 0x8a,
+	0x52, 0xd3, 0x33, 0xf3, 0xf3, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xa0, 0x3c, 0x90, 0x78,
+	0x41, 0x6a, 0x51, 0x66, 0x7e, 0x8a, 0x04, 0x13, 0x44, 0x1c, 0xc2, 0x13, 0x52, 0xe6, 0xe2, 0x2d,
+	0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0x8a, 0x07, 0xd9, 0x22, 0xc1, 0xac, 0xc0, 0xa8, 0xc1,
+	0x12, 0xc4, 0x03, 0x13, 0x74, 0x4b, 0x4d, 0x2d, 0x16, 0xd2, 0xe6, 0x12, 0x04, 0x19, 0x53, 0x5c,
+	0x52, 0x94, 0x58, 0x92, 0x99, 0x9f, 0x07, 0x51, 0xc8, 0x02, 0x56, 0x28, 0x80, 0x2c, 0x01, 0x52,
+	0xec, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
+	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99,
+	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x20, 0x5f, 0xa4, 0xe7, 0xe4, 0x27, 0x25,
+	0xe6, 0x80, 0x99, 0xba, 0x20, 0xef, 0x56, 0x20, 0x3c, 0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
+	0x06, 0xf6, 0xb2, 0x31, 0x60, 0x00, 0x84, 0x29, 0xda, 0x11, 0x11, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationFees != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.RegistrationFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TransferFees != 0 {
+		i = encodeVarintFees(dAtA, i, uint64(m.TransferFees))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintFees(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovFees(uint64(l))
+	}
+	if m.TransferFees != 0 {
+		n += 1 + sovFees(uint64(m.TransferFees))
+	}
+	if m.RegistrationFees != 0 {
+		n += 1 + sovFees(uint64(m.RegistrationFees))
+	}
+	return n
+}
+
+func sovFees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFees(x uint64) (n int) {
+	return sovFees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			m.TransferFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFees", wireType)
+			}
+			m.RegistrationFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFees(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFees
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFees
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFees
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFees
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFees        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFees          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFees = fmt.Errorf("proto: unexpected end of group")
+)
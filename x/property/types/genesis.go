@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +10,7 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		DenomAliasList: []DenomAlias{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,6 +19,15 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated denom aliases
+	denomAliasMap := make(map[string]struct{})
+
+	for _, elem := range gs.DenomAliasList {
+		if _, ok := denomAliasMap[elem.Alias]; ok {
+			return fmt.Errorf("duplicated denom alias %s", elem.Alias)
+		}
+		denomAliasMap[elem.Alias] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	"github.com/ardaglobal/arda-poc/x/property/types"
 
 	"github.com/stretchr/testify/require"
@@ -20,13 +21,60 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
+				DenomAliasList: []types.DenomAlias{
+					{Alias: "propaddr1", Denom: types.PropertyShareDenom("addr 1")},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid quorum",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultVotingPeriod, "1.5", types.DefaultThreshold, nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "fee schedule without recipient",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultVotingPeriod, types.DefaultQuorum, types.DefaultThreshold, []types.RegionFeeSchedule{
+					{Region: "dubai", TransferFee: types.FeeRate{Rate: "0.04"}},
+				}),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated fee schedule",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultVotingPeriod, types.DefaultQuorum, types.DefaultThreshold, []types.RegionFeeSchedule{
+					{Region: "dubai", Recipient: sample.AccAddress(), TransferFee: types.FeeRate{Rate: "0.04"}},
+					{Region: "Dubai", Recipient: sample.AccAddress(), RegistrationFee: types.FeeRate{Flat: 100}},
+				}),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid voting period",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, types.DefaultQuorum, types.DefaultThreshold, nil),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated denom alias",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				DenomAliasList: []types.DenomAlias{
+					{Alias: "propaddr1", Denom: types.PropertyShareDenom("addr 1")},
+					{Alias: "propaddr1", Denom: types.PropertyShareDenom("addr 1")},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,49 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &Proposal{}
+
+// PropertyGovernanceAddress returns the account of a property that signs the
+// messages of its passed proposals. It holds no keys, so messages signed by it
+// can only be executed through a proposal.
+func PropertyGovernanceAddress(propertyId string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("governance"), []byte(propertyId))
+}
+
+// IsProposalMsg reports whether msg can be attached to a proposal. Any message
+// the governance account can sign qualifies, e.g. appointing a property manager
+// or accepting the refinance of a loan the owners took out together, except
+// the messages of shareholder governance itself.
+func IsProposalMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *MsgSubmitProposal, *MsgVote, *MsgUpdateParams:
+		return false
+	}
+	return true
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.Messages, "property proposal")
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+}
+
+// VotingWeightOf returns the voting weight of voter on the proposal.
+func (p Proposal) VotingWeightOf(voter string) uint64 {
+	for _, w := range p.VotingWeights {
+		if w.Address == voter {
+			return w.Weight
+		}
+	}
+	return 0
+}
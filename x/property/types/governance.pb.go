@@ -0,0 +1,1801 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/property/governance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProposalStatus enumerates the states of a shareholder proposal.
+type ProposalStatus int32
+
+const (
+	PROPOSAL_STATUS_UNSPECIFIED   ProposalStatus = 0
+	PROPOSAL_STATUS_VOTING_PERIOD ProposalStatus = 1
+	PROPOSAL_STATUS_PASSED        ProposalStatus = 2
+	PROPOSAL_STATUS_REJECTED      ProposalStatus = 3
+	PROPOSAL_STATUS_FAILED        ProposalStatus = 4
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_STATUS_UNSPECIFIED",
+	1: "PROPOSAL_STATUS_VOTING_PERIOD",
+	2: "PROPOSAL_STATUS_PASSED",
+	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_FAILED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_STATUS_UNSPECIFIED":   0,
+	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
+	"PROPOSAL_STATUS_PASSED":        2,
+	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_FAILED":        4,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{0}
+}
+
+// VoteOption enumerates the options a shareholder can vote for.
+type VoteOption int32
+
+const (
+	VOTE_OPTION_UNSPECIFIED VoteOption = 0
+	VOTE_OPTION_YES         VoteOption = 1
+	VOTE_OPTION_ABSTAIN     VoteOption = 2
+	VOTE_OPTION_NO          VoteOption = 3
+)
+
+var VoteOption_name = map[int32]string{
+	0: "VOTE_OPTION_UNSPECIFIED",
+	1: "VOTE_OPTION_YES",
+	2: "VOTE_OPTION_ABSTAIN",
+	3: "VOTE_OPTION_NO",
+}
+
+var VoteOption_value = map[string]int32{
+	"VOTE_OPTION_UNSPECIFIED": 0,
+	"VOTE_OPTION_YES":         1,
+	"VOTE_OPTION_ABSTAIN":     2,
+	"VOTE_OPTION_NO":          3,
+}
+
+func (x VoteOption) String() string {
+	return proto.EnumName(VoteOption_name, int32(x))
+}
+
+func (VoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{1}
+}
+
+// Proposal is a decision put to the shareholders of a property.
+type Proposal struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PropertyId string `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Proposer   string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title      string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Summary    string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// messages are executed by the property's governance account once the
+	// proposal passes
+	Messages        []*types.Any   `protobuf:"bytes,6,rep,name=messages,proto3" json:"messages,omitempty"`
+	Status          ProposalStatus `protobuf:"varint,7,opt,name=status,proto3,enum=ardapoc.property.ProposalStatus" json:"status,omitempty"`
+	SubmitHeight    int64          `protobuf:"varint,8,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	VotingEndHeight int64          `protobuf:"varint,9,opt,name=voting_end_height,json=votingEndHeight,proto3" json:"voting_end_height,omitempty"`
+	// quorum and threshold in effect when the proposal was submitted
+	Quorum    string `protobuf:"bytes,10,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Threshold string `protobuf:"bytes,11,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// share balances of the voters when the proposal was submitted
+	VotingWeights []VotingWeight `protobuf:"bytes,12,rep,name=voting_weights,json=votingWeights,proto3" json:"voting_weights"`
+	// supply of the property's shares when the proposal was submitted
+	TotalWeight  uint64      `protobuf:"varint,13,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
+	FinalTally   TallyResult `protobuf:"bytes,14,opt,name=final_tally,json=finalTally,proto3" json:"final_tally"`
+	FailedReason string      `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{0}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetPropertyId() string {
+	if m != nil {
+		return m.PropertyId
+	}
+	return ""
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Proposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Proposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *Proposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *Proposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetVotingEndHeight() int64 {
+	if m != nil {
+		return m.VotingEndHeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *Proposal) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *Proposal) GetVotingWeights() []VotingWeight {
+	if m != nil {
+		return m.VotingWeights
+	}
+	return nil
+}
+
+func (m *Proposal) GetTotalWeight() uint64 {
+	if m != nil {
+		return m.TotalWeight
+	}
+	return 0
+}
+
+func (m *Proposal) GetFinalTally() TallyResult {
+	if m != nil {
+		return m.FinalTally
+	}
+	return TallyResult{}
+}
+
+func (m *Proposal) GetFailedReason() string {
+	if m != nil {
+		return m.FailedReason
+	}
+	return ""
+}
+
+// VotingWeight is the voting weight of a shareholder on a proposal.
+type VotingWeight struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *VotingWeight) Reset()         { *m = VotingWeight{} }
+func (m *VotingWeight) String() string { return proto.CompactTextString(m) }
+func (*VotingWeight) ProtoMessage()    {}
+func (*VotingWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{1}
+}
+func (m *VotingWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingWeight.Merge(m, src)
+}
+func (m *VotingWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingWeight proto.InternalMessageInfo
+
+func (m *VotingWeight) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VotingWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// TallyResult is the weight of the votes cast for each option.
+type TallyResult struct {
+	Yes     uint64 `protobuf:"varint,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	Abstain uint64 `protobuf:"varint,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	No      uint64 `protobuf:"varint,3,opt,name=no,proto3" json:"no,omitempty"`
+}
+
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{2}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+func (m *TallyResult) GetYes() uint64 {
+	if m != nil {
+		return m.Yes
+	}
+	return 0
+}
+
+func (m *TallyResult) GetAbstain() uint64 {
+	if m != nil {
+		return m.Abstain
+	}
+	return 0
+}
+
+func (m *TallyResult) GetNo() uint64 {
+	if m != nil {
+		return m.No
+	}
+	return 0
+}
+
+// Vote is the vote of a shareholder on a proposal. The weight is the voter's
+// share balance when the vote was cast; the tally reads the balance again at
+// the end of the voting period.
+type Vote struct {
+	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=ardapoc.property.VoteOption" json:"option,omitempty"`
+	Weight     uint64     `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_706959dd1f0a1317, []int{3}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Vote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *Vote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *Vote) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ardapoc.property.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("ardapoc.property.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterType((*Proposal)(nil), "ardapoc.property.Proposal")
+	proto.RegisterType((*VotingWeight)(nil), "ardapoc.property.VotingWeight")
+	proto.RegisterType((*TallyResult)(nil), "ardapoc.property.TallyResult")
+	proto.RegisterType((*Vote)(nil), "ardapoc.property.Vote")
+}
+
+func init() { proto.RegisterFile("ardapoc/property/governance.proto", fileDescriptor_706959dd1f0a1317) }
+
+var fileDescriptor_706959dd1f0a1317 = []byte{
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x6e, 0xe3, 0x44,
+	0x18, 0x8f, 0x93, 0x34, 0xdb, 0x7e, 0x49, 0x53, 0x33, 0x5b, 0x6d, 0x67, 0xd3, 0xdd, 0x34, 0x2d,
+	0x12, 0x8a, 0x2a, 0xea, 0x68, 0x0b, 0x07, 0x8e, 0x24, 0x1b, 0x2f, 0x18, 0x76, 0xe3, 0xc8, 0xce,
+	0x06, 0xc1, 0xc5, 0x9a, 0xc4, 0x53, 0xc7, 0x92, 0xe3, 0xc9, 0x7a, 0xc6, 0x81, 0xbc, 0x01, 0x12,
+	0x17, 0xc4, 0x2b, 0xf0, 0x02, 0x1c, 0xf6, 0x21, 0x56, 0x9c, 0x56, 0x9c, 0x38, 0x21, 0xd4, 0xbe,
+	0x08, 0xf2, 0xd8, 0x6e, 0x4d, 0xb6, 0x37, 0xff, 0xfe, 0xcc, 0x7c, 0xdf, 0x37, 0xfe, 0xcd, 0xc0,
+	0x29, 0x89, 0x5c, 0xb2, 0x62, 0xf3, 0xde, 0x2a, 0x62, 0x2b, 0x1a, 0x89, 0x4d, 0xcf, 0x63, 0x6b,
+	0x1a, 0x85, 0x24, 0x9c, 0x53, 0x6d, 0x15, 0x31, 0xc1, 0x90, 0x9a, 0x59, 0xb4, 0xdc, 0xd2, 0x7a,
+	0x3c, 0x67, 0x7c, 0xc9, 0xb8, 0x23, 0xf5, 0x5e, 0x0a, 0x52, 0x73, 0xeb, 0xd0, 0x63, 0x1e, 0x4b,
+	0xf9, 0xe4, 0x2b, 0x63, 0x1f, 0x7b, 0x8c, 0x79, 0x01, 0xed, 0x49, 0x34, 0x8b, 0xaf, 0x7a, 0x24,
+	0xdc, 0xa4, 0xd2, 0xd9, 0x6f, 0x3b, 0xb0, 0x3b, 0x8e, 0xd8, 0x8a, 0x71, 0x12, 0xa0, 0x26, 0x94,
+	0x7d, 0x17, 0x2b, 0x1d, 0xa5, 0x5b, 0xb5, 0xca, 0xbe, 0x8b, 0x4e, 0xa0, 0x9e, 0x17, 0x75, 0x7c,
+	0x17, 0x97, 0x3b, 0x4a, 0x77, 0xcf, 0x82, 0x9c, 0x32, 0x5c, 0xd4, 0x82, 0xdd, 0x95, 0x5c, 0x4c,
+	0x23, 0x5c, 0x91, 0xea, 0x2d, 0x46, 0x87, 0xb0, 0x23, 0x7c, 0x11, 0x50, 0x5c, 0x95, 0x42, 0x0a,
+	0x10, 0x86, 0x07, 0x3c, 0x5e, 0x2e, 0x49, 0xb4, 0xc1, 0x3b, 0x92, 0xcf, 0x21, 0x7a, 0x05, 0xbb,
+	0x4b, 0xca, 0x39, 0xf1, 0x28, 0xc7, 0xb5, 0x4e, 0xa5, 0x5b, 0xbf, 0x3c, 0xd4, 0xd2, 0xbe, 0xb5,
+	0xbc, 0x6f, 0xad, 0x1f, 0x6e, 0x06, 0xc7, 0x7f, 0xbe, 0xbd, 0x38, 0xca, 0x86, 0x9e, 0x11, 0x4e,
+	0xb5, 0xf5, 0xb3, 0x19, 0x15, 0xe4, 0x99, 0xf6, 0x8a, 0x7b, 0xd6, 0xed, 0x16, 0xe8, 0x0b, 0xa8,
+	0x71, 0x41, 0x44, 0xcc, 0xf1, 0x83, 0x8e, 0xd2, 0x6d, 0x5e, 0x76, 0xb4, 0xed, 0x73, 0xd4, 0xf2,
+	0xb9, 0x6d, 0xe9, 0xb3, 0x32, 0x3f, 0xfa, 0x18, 0xf6, 0x79, 0x3c, 0x5b, 0xfa, 0xc2, 0x59, 0x50,
+	0xdf, 0x5b, 0x08, 0xbc, 0xdb, 0x51, 0xba, 0x15, 0xab, 0x91, 0x92, 0x5f, 0x4b, 0x0e, 0x9d, 0xc3,
+	0x47, 0x6b, 0x26, 0xfc, 0xd0, 0x73, 0x68, 0xe8, 0xe6, 0xc6, 0x3d, 0x69, 0x3c, 0x48, 0x05, 0x3d,
+	0x74, 0x33, 0xef, 0x27, 0x50, 0x7b, 0x13, 0xb3, 0x28, 0x5e, 0x62, 0x48, 0x46, 0x1e, 0x34, 0xff,
+	0x7a, 0x7b, 0x01, 0xd9, 0x04, 0x43, 0x3a, 0xb7, 0x32, 0x15, 0x7d, 0x0a, 0x7b, 0x62, 0x11, 0x51,
+	0xbe, 0x60, 0x81, 0x8b, 0xeb, 0xf7, 0x5a, 0xef, 0x0c, 0xe8, 0x5b, 0x68, 0x66, 0x1d, 0xfc, 0x28,
+	0xcb, 0x70, 0xdc, 0x90, 0xa7, 0xd6, 0xfe, 0x70, 0xd0, 0xa9, 0xf4, 0x7d, 0x27, 0x6d, 0x83, 0xea,
+	0xbb, 0x7f, 0x4e, 0x4a, 0xd6, 0xfe, 0xba, 0xc0, 0x71, 0x74, 0x0a, 0x0d, 0xc1, 0x04, 0x09, 0xb2,
+	0xbd, 0xf0, 0xbe, 0xcc, 0x40, 0x5d, 0x72, 0xa9, 0x07, 0x0d, 0xa1, 0x7e, 0xe5, 0x87, 0x24, 0x70,
+	0x04, 0x09, 0x82, 0x0d, 0x6e, 0x76, 0x94, 0x6e, 0xfd, 0xf2, 0xe9, 0x87, 0xc5, 0x26, 0x89, 0x6c,
+	0x51, 0x1e, 0x07, 0x79, 0x2d, 0x90, 0xeb, 0x24, 0x9f, 0x1c, 0xee, 0x15, 0xf1, 0x03, 0xea, 0x3a,
+	0x11, 0x25, 0x9c, 0x85, 0xf8, 0x40, 0xa6, 0xa0, 0x91, 0x92, 0x96, 0xe4, 0xce, 0xbe, 0x84, 0x46,
+	0xb1, 0xe5, 0x24, 0x34, 0xc4, 0x75, 0x23, 0xca, 0xb9, 0x0c, 0xe7, 0x9e, 0x95, 0x43, 0xf4, 0x08,
+	0x6a, 0x59, 0xc7, 0x65, 0xd9, 0x71, 0x86, 0xce, 0x0c, 0xa8, 0x17, 0xfa, 0x40, 0x2a, 0x54, 0x36,
+	0x94, 0x67, 0xc9, 0x4e, 0x3e, 0xe5, 0x96, 0x33, 0x2e, 0x88, 0x1f, 0x66, 0x2b, 0x73, 0x98, 0x5c,
+	0x82, 0x90, 0xc9, 0x34, 0x57, 0xad, 0x72, 0xc8, 0xce, 0x7e, 0x51, 0xa0, 0x3a, 0x65, 0x82, 0xe6,
+	0xb7, 0x21, 0x49, 0x8c, 0x73, 0x7b, 0x4d, 0x20, 0xa7, 0x0c, 0x37, 0x49, 0xfc, 0x9a, 0x09, 0x1a,
+	0x65, 0x17, 0x25, 0x05, 0xe8, 0x73, 0xa8, 0xb1, 0x95, 0xf0, 0x59, 0x28, 0xf7, 0x6c, 0x5e, 0x3e,
+	0xb9, 0xf7, 0xff, 0x50, 0x53, 0x7a, 0xac, 0xcc, 0x5b, 0x18, 0xac, 0x5a, 0x1c, 0xec, 0xfc, 0x0f,
+	0x05, 0x9a, 0xff, 0xcf, 0x2d, 0x3a, 0x81, 0xe3, 0xb1, 0x65, 0x8e, 0x4d, 0xbb, 0xff, 0xd2, 0xb1,
+	0x27, 0xfd, 0xc9, 0x6b, 0xdb, 0x79, 0x3d, 0xb2, 0xc7, 0xfa, 0x73, 0xe3, 0x85, 0xa1, 0x0f, 0xd5,
+	0x12, 0x3a, 0x85, 0xa7, 0xdb, 0x86, 0xa9, 0x39, 0x31, 0x46, 0x5f, 0x39, 0x63, 0xdd, 0x32, 0xcc,
+	0xa1, 0xaa, 0xa0, 0x16, 0x3c, 0xda, 0xb6, 0x8c, 0xfb, 0xb6, 0xad, 0x0f, 0xd5, 0x32, 0x7a, 0x02,
+	0x78, 0x5b, 0xb3, 0xf4, 0x6f, 0xf4, 0xe7, 0x13, 0x7d, 0xa8, 0x56, 0xee, 0x5b, 0xf9, 0xa2, 0x6f,
+	0xbc, 0xd4, 0x87, 0x6a, 0xb5, 0x55, 0xfd, 0xf9, 0xf7, 0x76, 0xe9, 0xfc, 0x0d, 0xc0, 0xdd, 0x80,
+	0xe8, 0x18, 0x8e, 0xa6, 0xe6, 0x44, 0x77, 0xcc, 0xf1, 0xc4, 0x30, 0x47, 0x5b, 0x9d, 0x3e, 0x84,
+	0x83, 0xa2, 0xf8, 0xbd, 0x6e, 0xab, 0x0a, 0x3a, 0x82, 0x87, 0x45, 0xb2, 0x3f, 0xb0, 0x27, 0x7d,
+	0x63, 0xa4, 0x96, 0x11, 0x82, 0x66, 0x51, 0x18, 0x99, 0x6a, 0x25, 0x2d, 0x39, 0x30, 0xde, 0x5d,
+	0xb7, 0x95, 0xf7, 0xd7, 0x6d, 0xe5, 0xdf, 0xeb, 0xb6, 0xf2, 0xeb, 0x4d, 0xbb, 0xf4, 0xfe, 0xa6,
+	0x5d, 0xfa, 0xfb, 0xa6, 0x5d, 0xfa, 0xa1, 0xe7, 0xf9, 0x62, 0x11, 0xcf, 0xb4, 0x39, 0x5b, 0xf6,
+	0x92, 0xff, 0xe0, 0x05, 0x6c, 0x46, 0x02, 0xf9, 0x79, 0x91, 0xbc, 0xc3, 0x3f, 0xdd, 0xbd, 0xc4,
+	0x62, 0xb3, 0xa2, 0x7c, 0x56, 0x93, 0x8f, 0xcf, 0x67, 0xff, 0x0d, 0x00, 0xd7, 0x4d, 0xb6, 0x8b,
+	0xaa, 0x05, 0x00, 0x00,
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size, err := m.FinalTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGovernance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.TotalWeight != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.TotalWeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.VotingWeights) > 0 {
+		for iNdEx := len(m.VotingWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndHeight != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.VotingEndHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PropertyId) > 0 {
+		i -= len(m.PropertyId)
+		copy(dAtA[i:], m.PropertyId)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.PropertyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.No != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.No))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Abstain != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Abstain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Yes != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Yes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Option != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovernance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGovernance(uint64(m.Id))
+	}
+	l = len(m.PropertyId)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGovernance(uint64(m.Status))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGovernance(uint64(m.SubmitHeight))
+	}
+	if m.VotingEndHeight != 0 {
+		n += 1 + sovGovernance(uint64(m.VotingEndHeight))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if len(m.VotingWeights) > 0 {
+		for _, e := range m.VotingWeights {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	if m.TotalWeight != 0 {
+		n += 1 + sovGovernance(uint64(m.TotalWeight))
+	}
+	l = m.FinalTally.Size()
+	n += 1 + l + sovGovernance(uint64(l))
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovGovernance(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Yes != 0 {
+		n += 1 + sovGovernance(uint64(m.Yes))
+	}
+	if m.Abstain != 0 {
+		n += 1 + sovGovernance(uint64(m.Abstain))
+	}
+	if m.No != 0 {
+		n += 1 + sovGovernance(uint64(m.No))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGovernance(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovGovernance(uint64(m.Option))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovGovernance(uint64(m.Weight))
+	}
+	return n
+}
+
+func sovGovernance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovernance(x uint64) (n int) {
+	return sovGovernance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndHeight", wireType)
+			}
+			m.VotingEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingWeights = append(m.VotingWeights, VotingWeight{})
+			if err := m.VotingWeights[len(m.VotingWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			m.TotalWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			m.Yes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Yes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			m.Abstain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			m.No = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.No |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovernance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovernance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovernance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovernance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovernance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovernance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovernance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,13 @@
 package types
 
-import "strings"
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -15,8 +22,46 @@ const (
 	// KeyPrefixProperty is the prefix used to store properties by ID
 	KeyPrefixProperty = "Property/value/"
 
-	// PropertyShareDenomPrefix defines the prefix for property share denoms
-	PropertyShareDenomPrefix = "prop"
+	// KeyPrefixDenomAlias is the prefix used to map legacy share denoms to their replacement
+	KeyPrefixDenomAlias = "DenomAlias/value/"
+
+	// KeyPrefixShareDenom is the prefix used to map share denoms to their property
+	KeyPrefixShareDenom = "ShareDenom/value/"
+
+	// KeyPrefixProposal is the prefix used to store shareholder proposals by ID
+	KeyPrefixProposal = "Proposal/value/"
+
+	// KeyProposalCount is the key of the number of proposals submitted
+	KeyProposalCount = "Proposal/count/"
+
+	// KeyPrefixActiveProposal is the prefix of the queue of proposals in their
+	// voting period, ordered by the height their voting period ends
+	KeyPrefixActiveProposal = "Proposal/active/"
+
+	// KeyPrefixPropertyProposal is the prefix of the index of the proposals in
+	// their voting period by property
+	KeyPrefixPropertyProposal = "Proposal/property/"
+
+	// KeyPrefixVotingCheckpoint is the prefix used to store the share balance an
+	// account held when a proposal was submitted, recorded before the balance
+	// first changes during the voting period
+	KeyPrefixVotingCheckpoint = "Proposal/checkpoint/"
+
+	// KeyPrefixVote is the prefix used to store votes by proposal ID and voter
+	KeyPrefixVote = "Vote/value/"
+
+	// KeyPrefixFeeTotal is the prefix used to store fees collected by region and period
+	KeyPrefixFeeTotal = "FeeTotal/value/"
+
+	// LegacyPropertyShareDenomPrefix defines the prefix of the share denoms used
+	// before denoms were derived from the title NFT id
+	LegacyPropertyShareDenomPrefix = "prop"
+
+	// PropertyNFTClassPrefix defines the prefix for the per-region x/nft classes
+	PropertyNFTClassPrefix = "property-"
+
+	// PropertyNFTIDPrefix defines the prefix for property title NFT ids
+	PropertyNFTIDPrefix = "prop-"
 )
 
 var (
@@ -27,8 +72,78 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
-// PropertyShareDenom returns the bank denom used for the given property ID.
+// ProposalKey returns the store key of a proposal, relative to KeyPrefixProposal.
+func ProposalKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// ActiveProposalKey returns the key of a proposal in the active proposal queue,
+// relative to KeyPrefixActiveProposal.
+func ActiveProposalKey(votingEndHeight int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(votingEndHeight)), sdk.Uint64ToBigEndian(id)...)
+}
+
+// PropertyProposalsKey returns the prefix of the proposals of a property in
+// the index of proposals by property, relative to KeyPrefixPropertyProposal.
+// Property IDs are length-prefixed so that no property's entries share a prefix
+// with another's.
+func PropertyProposalsKey(propertyId string) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(propertyId))), []byte(propertyId)...)
+}
+
+// PropertyProposalKey returns the key of a proposal in the index of proposals
+// by property, relative to KeyPrefixPropertyProposal.
+func PropertyProposalKey(propertyId string, id uint64) []byte {
+	return append(PropertyProposalsKey(propertyId), sdk.Uint64ToBigEndian(id)...)
+}
+
+// VotingCheckpointsKey returns the prefix of the checkpoints of a proposal,
+// relative to KeyPrefixVotingCheckpoint.
+func VotingCheckpointsKey(proposalId uint64) []byte {
+	return sdk.Uint64ToBigEndian(proposalId)
+}
+
+// VotingCheckpointKey returns the store key of the checkpoint of an account on
+// a proposal, relative to KeyPrefixVotingCheckpoint.
+func VotingCheckpointKey(proposalId uint64, account string) []byte {
+	return append(VotingCheckpointsKey(proposalId), []byte(account)...)
+}
+
+// VotesKey returns the prefix of the votes on a proposal, relative to
+// KeyPrefixVote.
+func VotesKey(proposalId uint64) []byte {
+	return sdk.Uint64ToBigEndian(proposalId)
+}
+
+// VoteKey returns the store key of a vote, relative to KeyPrefixVote.
+func VoteKey(proposalId uint64, voter string) []byte {
+	return append(VotesKey(proposalId), []byte(voter)...)
+}
+
+// PropertyShareDenom returns the bank denom used for the given property ID. It
+// matches the id of the property's title NFT, so it only contains characters
+// that are safe in denoms and URLs.
 func PropertyShareDenom(id string) string {
+	return PropertyNFTID(id)
+}
+
+// LegacyPropertyShareDenom returns the share denom used for the given property
+// ID before v0.3.0. It is kept to migrate balances and resolve aliases.
+func LegacyPropertyShareDenom(id string) string {
 	id = strings.ReplaceAll(id, " ", "")
-	return PropertyShareDenomPrefix + id
+	return LegacyPropertyShareDenomPrefix + id
+}
+
+// PropertyNFTClassID returns the x/nft class id holding the titles of a region.
+func PropertyNFTClassID(region string) string {
+	region = strings.ToLower(strings.TrimSpace(region))
+	return PropertyNFTClassPrefix + strings.Join(strings.Fields(region), "-")
+}
+
+// PropertyNFTID returns the x/nft token id of the title for the given property ID.
+// Property IDs are free-form addresses, so the id is derived from their hash to
+// stay within the character set accepted by x/nft.
+func PropertyNFTID(id string) string {
+	h := sha256.Sum256([]byte(id))
+	return PropertyNFTIDPrefix + hex.EncodeToString(h[:16])
 }
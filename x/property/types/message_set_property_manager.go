@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetPropertyManager{}
+
+func NewMsgSetPropertyManager(authority string, propertyId string, manager string) *MsgSetPropertyManager {
+	return &MsgSetPropertyManager{
+		Authority:  authority,
+		PropertyId: propertyId,
+		Manager:    manager,
+	}
+}
+
+func (msg *MsgSetPropertyManager) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if msg.PropertyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "property id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ sdk.Msg                            = &MsgSubmitProposal{}
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}
+)
+
+func NewMsgSubmitProposal(proposer string, propertyId string, title string, summary string, msgs []sdk.Msg) (*MsgSubmitProposal, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitProposal{
+		Proposer:   proposer,
+		PropertyId: propertyId,
+		Title:      title,
+		Summary:    summary,
+		Messages:   anys,
+	}, nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (msg *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "property proposal")
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg *MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+func (msg *MsgSubmitProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+	if msg.PropertyId == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "property id cannot be empty")
+	}
+	if msg.Title == "" {
+		return errorsmod.Wrap(ErrInvalidProposal, "title cannot be empty")
+	}
+	return nil
+}
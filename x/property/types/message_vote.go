@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgVote{}
+
+func NewMsgVote(voter string, proposalId uint64, option VoteOption) *MsgVote {
+	return &MsgVote{
+		Voter:      voter,
+		ProposalId: proposalId,
+		Option:     option,
+	}
+}
+
+func (msg *MsgVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	if _, ok := VoteOption_name[int32(msg.Option)]; !ok || msg.Option == VOTE_OPTION_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidVote, "invalid vote option %d", msg.Option)
+	}
+	return nil
+}
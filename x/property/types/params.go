@@ -1,32 +1,132 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyVotingPeriod = []byte("VotingPeriod")
+	// DefaultVotingPeriod is roughly a day at five second blocks
+	DefaultVotingPeriod int64 = 17280
+
+	KeyQuorum     = []byte("Quorum")
+	DefaultQuorum = "0.5"
+
+	KeyThreshold     = []byte("Threshold")
+	DefaultThreshold = "0.5"
+
+	KeyFeeSchedules = []byte("FeeSchedules")
+	// DefaultFeeSchedules is empty: regions collect no fees until governance
+	// sets their schedule and recipient
+	DefaultFeeSchedules []RegionFeeSchedule
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	votingPeriod int64,
+	quorum string,
+	threshold string,
+	feeSchedules []RegionFeeSchedule,
+) Params {
+	return Params{
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+		FeeSchedules: feeSchedules,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultVotingPeriod,
+		DefaultQuorum,
+		DefaultThreshold,
+		DefaultFeeSchedules,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVotingPeriod, &p.VotingPeriod, validateVotingPeriod),
+		paramtypes.NewParamSetPair(KeyQuorum, &p.Quorum, validateFraction),
+		paramtypes.NewParamSetPair(KeyThreshold, &p.Threshold, validateFraction),
+		paramtypes.NewParamSetPair(KeyFeeSchedules, &p.FeeSchedules, validateFeeSchedules),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateVotingPeriod(p.VotingPeriod); err != nil {
+		return err
+	}
+	if err := validateFraction(p.Quorum); err != nil {
+		return fmt.Errorf("invalid quorum: %w", err)
+	}
+	if err := validateFraction(p.Threshold); err != nil {
+		return fmt.Errorf("invalid threshold: %w", err)
+	}
+	if err := validateFeeSchedules(p.FeeSchedules); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateVotingPeriod validates the VotingPeriod param
+func validateVotingPeriod(v interface{}) error {
+	votingPeriod, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if votingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive: %d", votingPeriod)
+	}
+	return nil
+}
+
+// validateFraction validates a decimal param between 0 and 1
+func validateFraction(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	dec, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return err
+	}
+	if dec.IsNegative() || dec.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("must be between 0 and 1: %s", s)
+	}
+	return nil
+}
+
+// validateFeeSchedules validates the FeeSchedules param
+func validateFeeSchedules(v interface{}) error {
+	schedules, ok := v.([]RegionFeeSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	regions := make(map[string]struct{})
+	for _, schedule := range schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		region := NormalizeRegion(schedule.Region)
+		if _, ok := regions[region]; ok {
+			return fmt.Errorf("duplicated fee schedule for region %s", region)
+		}
+		regions[region] = struct{}{}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +27,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// voting_period is the number of blocks shareholders can vote on a proposal.
+	VotingPeriod int64 `protobuf:"varint,1,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	// quorum is the minimum fraction of a property's shares that must vote for
+	// a proposal to be valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum fraction of yes votes, excluding abstentions, for
+	// a proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// fee_schedules lists the fees collected on property transactions, per
+	// region. Regions without a schedule collect no fees.
+	FeeSchedules []RegionFeeSchedule `protobuf:"bytes,4,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,26 +73,203 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetVotingPeriod() int64 {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *Params) GetFeeSchedules() []RegionFeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+// RegionFeeSchedule defines the fees charged by the land department of a
+// region, paid in usdarda.
+type RegionFeeSchedule struct {
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// recipient is the account the fees of the region are paid to.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// transfer_fee is paid by the buyers of shares on the value they buy.
+	TransferFee FeeRate `protobuf:"bytes,3,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee"`
+	// registration_fee is paid by the registrant on the value of the property.
+	RegistrationFee FeeRate `protobuf:"bytes,4,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee"`
+}
+
+func (m *RegionFeeSchedule) Reset()         { *m = RegionFeeSchedule{} }
+func (m *RegionFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*RegionFeeSchedule) ProtoMessage()    {}
+func (*RegionFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737c9117f4ce3c46, []int{1}
+}
+func (m *RegionFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegionFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionFeeSchedule.Merge(m, src)
+}
+func (m *RegionFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionFeeSchedule proto.InternalMessageInfo
+
+func (m *RegionFeeSchedule) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *RegionFeeSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RegionFeeSchedule) GetTransferFee() FeeRate {
+	if m != nil {
+		return m.TransferFee
+	}
+	return FeeRate{}
+}
+
+func (m *RegionFeeSchedule) GetRegistrationFee() FeeRate {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return FeeRate{}
+}
+
+// FeeRate is a fee made of a percentage of the transaction value plus a flat
+// amount.
+type FeeRate struct {
+	// rate is the fraction of the transaction value charged, e.g. 0.04 for 4%.
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// flat is a fixed amount charged on every transaction.
+	Flat uint64 `protobuf:"varint,2,opt,name=flat,proto3" json:"flat,omitempty"`
+}
+
+func (m *FeeRate) Reset()         { *m = FeeRate{} }
+func (m *FeeRate) String() string { return proto.CompactTextString(m) }
+func (*FeeRate) ProtoMessage()    {}
+func (*FeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_737c9117f4ce3c46, []int{2}
+}
+func (m *FeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRate.Merge(m, src)
+}
+func (m *FeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRate proto.InternalMessageInfo
+
+func (m *FeeRate) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *FeeRate) GetFlat() uint64 {
+	if m != nil {
+		return m.Flat
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ardapoc.property.Params")
+	proto.RegisterType((*RegionFeeSchedule)(nil), "ardapoc.property.RegionFeeSchedule")
+	proto.RegisterType((*FeeRate)(nil), "ardapoc.property.FeeRate")
 }
 
 func init() { proto.RegisterFile("ardapoc/property/params.proto", fileDescriptor_737c9117f4ce3c46) }
 
 var fileDescriptor_737c9117f4ce3c46 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2c, 0x4a, 0x49,
-	0x2c, 0xc8, 0x4f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0x48, 0x2d, 0x2a, 0xa9, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x34, 0xb9, 0xd8, 0x02, 0xc0, 0x46, 0x59,
-	0xc9, 0xbf, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x18, 0xc8, 0x34, 0xfd, 0x0a, 0x84,
-	0x5d, 0x10, 0x05, 0x4e, 0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5,
-	0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xd2, 0x9c, 0x9e, 0x93,
-	0x9f, 0x94, 0x98, 0x03, 0x66, 0xea, 0x82, 0x5c, 0x8d, 0x64, 0x56, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0xd8, 0x72, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x0d, 0xe1, 0xd3, 0xd8,
-	0x00, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x8d, 0x16, 0x93, 0x61, 0x25, 0xdd, 0x5a, 0x51, 0x8a, 0x5b, 0x98, 0x13, 0x52, 0x18, 0xa1,
+	0x2c, 0x36, 0x74, 0xb0, 0x43, 0x6f, 0x33, 0x23, 0x63, 0x3b, 0x15, 0xe5, 0xb6, 0x4b, 0x50, 0xec,
+	0xcf, 0x8e, 0xc0, 0xb6, 0x3c, 0x49, 0x19, 0xeb, 0x5f, 0xd8, 0x69, 0x3f, 0x61, 0xc7, 0x1d, 0x7b,
+	0xe8, 0x8f, 0xe8, 0xb1, 0xf4, 0xb4, 0xd3, 0x18, 0xc9, 0xa1, 0xfb, 0x0b, 0xbb, 0x0d, 0xcb, 0x2a,
+	0x29, 0x6b, 0x0f, 0xbb, 0x88, 0xef, 0x7b, 0xef, 0xe9, 0x49, 0xef, 0x93, 0xf0, 0x33, 0x26, 0x13,
+	0x56, 0x89, 0x38, 0xac, 0xa4, 0xa8, 0x40, 0xea, 0xb3, 0xb0, 0x62, 0x92, 0x15, 0x2a, 0xa8, 0xa4,
+	0xd0, 0x82, 0x6c, 0x5b, 0x3a, 0xb8, 0xa5, 0x0f, 0x76, 0x58, 0xc1, 0x4b, 0x11, 0x9a, 0xb5, 0x11,
+	0x1d, 0xec, 0xc7, 0x42, 0x15, 0x42, 0xcd, 0x4c, 0x17, 0x36, 0x8d, 0xa5, 0x76, 0x33, 0x91, 0x89,
+	0x06, 0xaf, 0xab, 0x06, 0x1d, 0xfe, 0x41, 0xb8, 0x73, 0x6a, 0x8e, 0x21, 0x87, 0x78, 0xeb, 0x93,
+	0xd0, 0xbc, 0xcc, 0x66, 0x15, 0x48, 0x2e, 0x12, 0x0f, 0x0d, 0xd0, 0xa8, 0x4d, 0x7b, 0x0d, 0x78,
+	0x6a, 0x30, 0xf2, 0x1c, 0x77, 0x3e, 0x2e, 0x85, 0x5c, 0x16, 0xde, 0xa3, 0x01, 0x1a, 0xb9, 0xd1,
+	0x93, 0xeb, 0x8b, 0x31, 0xb6, 0xe7, 0xbc, 0x81, 0x98, 0x5a, 0x96, 0xbc, 0xc0, 0xae, 0x5e, 0x48,
+	0x50, 0x0b, 0x91, 0x27, 0x5e, 0xfb, 0x41, 0xe9, 0x46, 0x40, 0xa6, 0x78, 0x2b, 0x05, 0x98, 0xa9,
+	0x78, 0x01, 0xc9, 0x32, 0x07, 0xe5, 0x39, 0x83, 0xf6, 0xa8, 0x7b, 0x7c, 0x18, 0xfc, 0x9b, 0x39,
+	0xa0, 0x90, 0x71, 0x51, 0x4e, 0x00, 0xa6, 0x56, 0x1b, 0xb9, 0x97, 0x3f, 0xfb, 0xad, 0xef, 0x37,
+	0xe7, 0x47, 0x88, 0xf6, 0xd2, 0x0d, 0xae, 0x4e, 0xfa, 0xbf, 0xbf, 0xf5, 0xd1, 0x97, 0x9b, 0xf3,
+	0xa3, 0xbd, 0xda, 0x25, 0xfc, 0xbc, 0x99, 0x6b, 0x13, 0xb8, 0xce, 0xbe, 0x73, 0xcf, 0x8f, 0xec,
+	0xe1, 0x8e, 0x34, 0xa0, 0xc9, 0xef, 0x52, 0xdb, 0x91, 0x57, 0xd8, 0x95, 0x10, 0xf3, 0x8a, 0x43,
+	0xa9, 0x6d, 0x78, 0xef, 0xfa, 0x62, 0xbc, 0x6b, 0x13, 0xbd, 0x4e, 0x12, 0x09, 0x4a, 0x4d, 0xb5,
+	0xe4, 0x65, 0x46, 0x37, 0x52, 0x12, 0xe1, 0x9e, 0x96, 0xac, 0x54, 0x29, 0xc8, 0x59, 0x0a, 0x60,
+	0x86, 0xd1, 0x3d, 0xde, 0xbf, 0x1f, 0x6d, 0x02, 0x40, 0x99, 0x86, 0xc8, 0xa9, 0x03, 0xd1, 0xee,
+	0xed, 0xa6, 0x09, 0x00, 0x79, 0x8f, 0xb7, 0xeb, 0x5b, 0x28, 0x2d, 0x99, 0xe6, 0xa2, 0x34, 0x3e,
+	0xce, 0xff, 0xf9, 0x3c, 0xbd, 0xbb, 0x71, 0x02, 0x70, 0xe2, 0xd4, 0x63, 0x19, 0xbe, 0xc5, 0x8f,
+	0xad, 0x8e, 0x0c, 0xb1, 0x23, 0x99, 0x06, 0x0f, 0x3d, 0xf8, 0x4a, 0x86, 0x23, 0x04, 0x3b, 0x69,
+	0xce, 0x9a, 0xdc, 0x0e, 0x35, 0x75, 0x63, 0x14, 0xbd, 0xbb, 0x5c, 0xf9, 0xe8, 0x6a, 0xe5, 0xa3,
+	0x5f, 0x2b, 0x1f, 0x7d, 0x5d, 0xfb, 0xad, 0xab, 0xb5, 0xdf, 0xfa, 0xb1, 0xf6, 0x5b, 0x1f, 0xc2,
+	0x8c, 0xeb, 0xc5, 0x72, 0x1e, 0xc4, 0xa2, 0x08, 0xeb, 0x4b, 0x66, 0xb9, 0x98, 0xb3, 0xdc, 0x94,
+	0xe3, 0xfa, 0x9b, 0xdf, 0x79, 0x10, 0x7d, 0x56, 0x81, 0x9a, 0x77, 0xcc, 0x97, 0x7c, 0xf9, 0x77,
+	0x00, 0xd9, 0x24, 0xc7, 0x52, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +291,83 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.VotingPeriod != that1.VotingPeriod {
+		return false
+	}
+	if this.Quorum != that1.Quorum {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if len(this.FeeSchedules) != len(that1.FeeSchedules) {
+		return false
+	}
+	for i := range this.FeeSchedules {
+		if !this.FeeSchedules[i].Equal(&that1.FeeSchedules[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegionFeeSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegionFeeSchedule)
+	if !ok {
+		that2, ok := that.(RegionFeeSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.TransferFee.Equal(&that1.TransferFee) {
+		return false
+	}
+	if !this.RegistrationFee.Equal(&that1.RegistrationFee) {
+		return false
+	}
+	return true
+}
+func (this *FeeRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeRate)
+	if !ok {
+		that2, ok := that.(FeeRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Rate != that1.Rate {
+		return false
+	}
+	if this.Flat != that1.Flat {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +390,131 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSchedules) > 0 {
+		for iNdEx := len(m.FeeSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VotingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotingPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Flat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Flat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +535,60 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.VotingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.VotingPeriod))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.FeeSchedules) > 0 {
+		for _, e := range m.FeeSchedules {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegionFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TransferFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Flat != 0 {
+		n += 1 + sovParams(uint64(m.Flat))
+	}
 	return n
 }
 
@@ -182,6 +627,404 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedules = append(m.FeeSchedules, RegionFeeSchedule{})
+			if err := m.FeeSchedules[len(m.FeeSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flat", wireType)
+			}
+			m.Flat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -41,6 +41,16 @@ type Property struct {
 	OwnerInformation        string `protobuf:"bytes,15,opt,name=owner_information,json=ownerInformation,proto3" json:"owner_information,omitempty"`
 	TenantId                string `protobuf:"bytes,16,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	UnitNumber              string `protobuf:"bytes,17,opt,name=unit_number,json=unitNumber,proto3" json:"unit_number,omitempty"`
+	// title NFT representing the property in x/nft
+	MetadataUri string `protobuf:"bytes,18,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+	NftClassId  string `protobuf:"bytes,19,opt,name=nft_class_id,json=nftClassId,proto3" json:"nft_class_id,omitempty"`
+	NftId       string `protobuf:"bytes,20,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// oracle price index of the region when the property was registered; the
+	// property's value moves with the region index from there
+	ValuationIndex string `protobuf:"bytes,21,opt,name=valuation_index,json=valuationIndex,proto3" json:"valuation_index,omitempty"`
+	// manager runs the property on behalf of its owners, who choose it through a
+	// shareholder proposal
+	Manager string `protobuf:"bytes,22,opt,name=manager,proto3" json:"manager,omitempty"`
 }
 
 func (m *Property) Reset()         { *m = Property{} }
@@ -195,6 +205,41 @@ func (m *Property) GetUnitNumber() string {
 	return ""
 }
 
+func (m *Property) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
+	}
+	return ""
+}
+
+func (m *Property) GetNftClassId() string {
+	if m != nil {
+		return m.NftClassId
+	}
+	return ""
+}
+
+func (m *Property) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+func (m *Property) GetValuationIndex() string {
+	if m != nil {
+		return m.ValuationIndex
+	}
+	return ""
+}
+
+func (m *Property) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
 type Transfer struct {
 	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
@@ -263,37 +308,42 @@ func init() {
 func init() { proto.RegisterFile("ardapoc/property/property.proto", fileDescriptor_57fe1e2c2afba894) }
 
 var fileDescriptor_57fe1e2c2afba894 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0x36, 0xeb, 0x9a, 0xb7, 0xdb, 0xe8, 0xac, 0x01, 0x16, 0xa0, 0x2c, 0x1a, 0x97,
-	0x4a, 0x88, 0x54, 0x62, 0x17, 0xb8, 0xc2, 0xa9, 0x12, 0x9a, 0x50, 0xd9, 0x89, 0x4b, 0xe5, 0x26,
-	0x6e, 0x67, 0x29, 0xb1, 0x23, 0xdb, 0x81, 0xb5, 0x9f, 0x82, 0x8f, 0xc5, 0x71, 0x47, 0x4e, 0x08,
-	0xb5, 0x5f, 0x04, 0xf9, 0x4f, 0xd6, 0x86, 0xdb, 0xfb, 0x3c, 0xcf, 0xef, 0x4d, 0xfc, 0x26, 0xaf,
-	0xe1, 0x92, 0xc8, 0x9c, 0x54, 0x22, 0x9b, 0x54, 0x52, 0x54, 0x54, 0xea, 0xf5, 0x63, 0x91, 0x56,
-	0x52, 0x68, 0x81, 0x46, 0x1e, 0x48, 0x1b, 0xff, 0xea, 0x4f, 0x08, 0x83, 0x2f, 0x5e, 0xa0, 0x0b,
-	0x38, 0x62, 0x3c, 0xa7, 0xf7, 0x38, 0x48, 0x82, 0x71, 0x34, 0x73, 0x02, 0x61, 0x38, 0x26, 0x79,
-	0x2e, 0xa9, 0x52, 0xb8, 0x6b, 0xfd, 0x46, 0xa2, 0x67, 0xd0, 0x97, 0x74, 0xc5, 0x04, 0xc7, 0x3d,
-	0x1b, 0x78, 0x65, 0x9e, 0xf3, 0x9d, 0x14, 0x35, 0xc5, 0x61, 0x12, 0x8c, 0xc3, 0x99, 0x13, 0x86,
-	0x16, 0x3f, 0x38, 0x95, 0x0a, 0x1f, 0x25, 0x3d, 0x43, 0x3b, 0x65, 0x7c, 0x75, 0x47, 0x24, 0x55,
-	0xb8, 0x9f, 0xf4, 0xc6, 0xe1, 0xcc, 0x2b, 0xf4, 0x1e, 0x22, 0x2d, 0x09, 0x57, 0x4b, 0xd3, 0x72,
-	0x9c, 0xf4, 0xc6, 0xc3, 0x77, 0x2f, 0xd2, 0xff, 0x07, 0x48, 0x6f, 0x3d, 0x32, 0xdb, 0xc3, 0xe8,
-	0x12, 0x86, 0x4d, 0x3e, 0x67, 0x39, 0x1e, 0xd8, 0xc3, 0x41, 0x63, 0x4d, 0x73, 0xf4, 0x1a, 0x4e,
-	0x1f, 0x01, 0x4e, 0x4a, 0x8a, 0x23, 0x8b, 0x9c, 0x34, 0xe6, 0x0d, 0x29, 0x69, 0x0b, 0xd2, 0xeb,
-	0x8a, 0x62, 0x68, 0x43, 0xb7, 0xeb, 0xca, 0x41, 0x44, 0x66, 0xb4, 0x98, 0xf3, 0xba, 0x5c, 0x50,
-	0x89, 0x87, 0x1e, 0xb2, 0xe6, 0x8d, 0xf5, 0xec, 0x79, 0x1c, 0xa4, 0xd8, 0x86, 0xe2, 0x13, 0x7f,
-	0x1e, 0x6b, 0x7d, 0x65, 0x1b, 0x8a, 0x3e, 0x00, 0xce, 0x04, 0x57, 0x5a, 0xd6, 0x99, 0x66, 0x82,
-	0xcf, 0x19, 0x5f, 0x0a, 0x59, 0x12, 0x53, 0xe3, 0x53, 0x4b, 0x3f, 0x3f, 0xcc, 0xa7, 0xfb, 0x18,
-	0x5d, 0xc3, 0xd3, 0x8d, 0xe0, 0x8c, 0xaf, 0xe6, 0x59, 0x41, 0x94, 0x62, 0x4b, 0x96, 0xb9, 0xbe,
-	0x33, 0xdb, 0x77, 0xe1, 0xc2, 0x4f, 0xad, 0x0c, 0xbd, 0x81, 0x73, 0xfb, 0xf1, 0x5b, 0x2f, 0x7a,
-	0x62, 0x1b, 0x46, 0x36, 0x38, 0x7c, 0xc3, 0x4b, 0x88, 0x34, 0xe5, 0x84, 0x6b, 0xf3, 0x2d, 0x47,
-	0x16, 0x1a, 0x38, 0x63, 0x9a, 0x9b, 0xd1, 0x6a, 0xce, 0x74, 0x33, 0xfd, 0xb9, 0x1b, 0xcd, 0x58,
-	0x6e, 0xf6, 0xab, 0xcf, 0x30, 0x68, 0x7e, 0x11, 0x42, 0x10, 0x2e, 0xa5, 0x28, 0xfd, 0x7a, 0xd9,
-	0x1a, 0x9d, 0x41, 0x57, 0x0b, 0xbf, 0x58, 0x5d, 0x2d, 0xd0, 0x2b, 0x88, 0x34, 0x2b, 0xa9, 0xd2,
-	0xa4, 0xac, 0xfc, 0x5a, 0xed, 0x8d, 0x8f, 0xd3, 0x5f, 0xdb, 0x38, 0x78, 0xd8, 0xc6, 0xc1, 0xdf,
-	0x6d, 0x1c, 0xfc, 0xdc, 0xc5, 0x9d, 0x87, 0x5d, 0xdc, 0xf9, 0xbd, 0x8b, 0x3b, 0xdf, 0x26, 0x2b,
-	0xa6, 0xef, 0xea, 0x45, 0x9a, 0x89, 0x72, 0x62, 0x96, 0x64, 0x55, 0x88, 0x05, 0x29, 0x6c, 0xf9,
-	0xd6, 0x5c, 0x89, 0xfb, 0xfd, 0xa5, 0x30, 0x3f, 0x53, 0x2d, 0xfa, 0xf6, 0x4a, 0x5c, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x98, 0x23, 0x78, 0xfc, 0x35, 0x03, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xcd, 0x6e, 0x13, 0x3f,
+	0x14, 0xc5, 0x3b, 0xcd, 0x47, 0x93, 0x9b, 0x34, 0x4d, 0xfd, 0x4f, 0xfa, 0xb7, 0x00, 0x4d, 0x87,
+	0xb2, 0x20, 0x12, 0x22, 0x91, 0xe8, 0x06, 0xb6, 0xb0, 0x1a, 0x09, 0x55, 0x28, 0x94, 0x0d, 0x9b,
+	0xc8, 0x99, 0xf1, 0xa4, 0x96, 0x32, 0xf6, 0xc8, 0xf6, 0x40, 0x93, 0xa7, 0xe0, 0xb1, 0x58, 0x76,
+	0xc9, 0x12, 0x25, 0xcf, 0x81, 0x84, 0xfc, 0x31, 0x49, 0xc3, 0xce, 0xf7, 0x9c, 0x9f, 0xed, 0x7b,
+	0xad, 0x63, 0xb8, 0x24, 0x32, 0x25, 0x85, 0x48, 0x26, 0x85, 0x14, 0x05, 0x95, 0x7a, 0xb5, 0x5b,
+	0x8c, 0x0b, 0x29, 0xb4, 0x40, 0x7d, 0x0f, 0x8c, 0x2b, 0xfd, 0xea, 0x4f, 0x03, 0x5a, 0x9f, 0x7c,
+	0x81, 0x06, 0xd0, 0x60, 0x3c, 0xa5, 0xf7, 0x38, 0x88, 0x82, 0x51, 0x7b, 0xea, 0x0a, 0x84, 0xe1,
+	0x84, 0xa4, 0xa9, 0xa4, 0x4a, 0xe1, 0x63, 0xab, 0x57, 0x25, 0xba, 0x80, 0xa6, 0xa4, 0x0b, 0x26,
+	0x38, 0xae, 0x59, 0xc3, 0x57, 0xe6, 0x9c, 0x6f, 0x64, 0x59, 0x52, 0x5c, 0x8f, 0x82, 0x51, 0x7d,
+	0xea, 0x0a, 0x43, 0x8b, 0xef, 0x9c, 0x4a, 0x85, 0x1b, 0x51, 0xcd, 0xd0, 0xae, 0x32, 0xba, 0xba,
+	0x23, 0x92, 0x2a, 0xdc, 0x8c, 0x6a, 0xa3, 0xfa, 0xd4, 0x57, 0xe8, 0x2d, 0xb4, 0xb5, 0x24, 0x5c,
+	0x65, 0x66, 0xcb, 0x49, 0x54, 0x1b, 0x75, 0xde, 0x3c, 0x19, 0xff, 0x3b, 0xc0, 0xf8, 0xd6, 0x23,
+	0xd3, 0x3d, 0x8c, 0x2e, 0xa1, 0x53, 0xf9, 0x33, 0x96, 0xe2, 0x96, 0x6d, 0x0e, 0x2a, 0x29, 0x4e,
+	0xd1, 0x0b, 0x38, 0xdd, 0x01, 0x9c, 0xe4, 0x14, 0xb7, 0x2d, 0xd2, 0xad, 0xc4, 0x1b, 0x92, 0xd3,
+	0x03, 0x48, 0xaf, 0x0a, 0x8a, 0xe1, 0x10, 0xba, 0x5d, 0x15, 0x0e, 0x22, 0x32, 0xa1, 0xcb, 0x19,
+	0x2f, 0xf3, 0x39, 0x95, 0xb8, 0xe3, 0x21, 0x2b, 0xde, 0x58, 0xcd, 0xf6, 0xe3, 0x20, 0xc5, 0xd6,
+	0x14, 0x77, 0x7d, 0x3f, 0x56, 0xfa, 0xcc, 0xd6, 0x14, 0xbd, 0x03, 0x9c, 0x08, 0xae, 0xb4, 0x2c,
+	0x13, 0xcd, 0x04, 0x9f, 0x31, 0x9e, 0x09, 0x99, 0x13, 0xb3, 0xc6, 0xa7, 0x96, 0xfe, 0xff, 0xb1,
+	0x1f, 0xef, 0x6d, 0x74, 0x0d, 0xc3, 0xb5, 0xe0, 0x8c, 0x2f, 0x66, 0xc9, 0x92, 0x28, 0xc5, 0x32,
+	0x96, 0xb8, 0x7d, 0x3d, 0xbb, 0x6f, 0xe0, 0xcc, 0x0f, 0x07, 0x1e, 0x7a, 0x05, 0xe7, 0xf6, 0xf1,
+	0x0f, 0x2e, 0x3a, 0xb3, 0x1b, 0xfa, 0xd6, 0x78, 0x7c, 0xc3, 0x53, 0x68, 0x6b, 0xca, 0x09, 0xd7,
+	0xe6, 0x2d, 0xfb, 0x16, 0x6a, 0x39, 0x21, 0x4e, 0xcd, 0x68, 0x25, 0x67, 0xba, 0x9a, 0xfe, 0xdc,
+	0x8d, 0x66, 0x24, 0x3f, 0xfb, 0x73, 0xe8, 0xe6, 0x54, 0x93, 0x94, 0x68, 0x32, 0x2b, 0x25, 0xc3,
+	0xc8, 0x12, 0x9d, 0x4a, 0xfb, 0x22, 0x19, 0x8a, 0xa0, 0xcb, 0x33, 0xed, 0xfa, 0x37, 0x77, 0xfc,
+	0xe7, 0x0e, 0xe1, 0x99, 0xb6, 0x6d, 0xc7, 0x29, 0x1a, 0x42, 0xd3, 0x10, 0x2c, 0xc5, 0x03, 0x97,
+	0x4c, 0x9e, 0x99, 0xcb, 0x5f, 0xc2, 0x99, 0x89, 0x16, 0xf1, 0x6f, 0x66, 0x92, 0x3b, 0xb4, 0x7e,
+	0x6f, 0x27, 0xc7, 0x55, 0x84, 0x73, 0xc2, 0xc9, 0x82, 0x4a, 0x7c, 0xe1, 0x22, 0xec, 0xcb, 0xab,
+	0x8f, 0xd0, 0xaa, 0x12, 0x84, 0x10, 0xd4, 0x33, 0x29, 0x72, 0x9f, 0x7e, 0xbb, 0x46, 0x3d, 0x38,
+	0xd6, 0xc2, 0xe7, 0xfe, 0x58, 0x0b, 0xf4, 0x0c, 0xda, 0x9a, 0xe5, 0x54, 0x69, 0x92, 0x17, 0x3e,
+	0xf5, 0x7b, 0xe1, 0x7d, 0xfc, 0x73, 0x13, 0x06, 0x0f, 0x9b, 0x30, 0xf8, 0xbd, 0x09, 0x83, 0x1f,
+	0xdb, 0xf0, 0xe8, 0x61, 0x1b, 0x1e, 0xfd, 0xda, 0x86, 0x47, 0x5f, 0x27, 0x0b, 0xa6, 0xef, 0xca,
+	0xf9, 0x38, 0x11, 0xf9, 0xc4, 0x64, 0x78, 0xb1, 0x14, 0x73, 0xb2, 0xb4, 0xcb, 0xd7, 0xe6, 0xc7,
+	0xde, 0xef, 0xff, 0xac, 0xc9, 0x9a, 0x9a, 0x37, 0xed, 0x8f, 0xbd, 0xfe, 0x3b, 0x00, 0xde, 0xd7,
+	0x83, 0x0c, 0xd4, 0x03, 0x00, 0x00,
 }
 
 func (m *Property) Marshal() (dAtA []byte, err error) {
@@ -316,6 +366,51 @@ func (m *Property) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ValuationIndex) > 0 {
+		i -= len(m.ValuationIndex)
+		copy(dAtA[i:], m.ValuationIndex)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.ValuationIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.NftClassId) > 0 {
+		i -= len(m.NftClassId)
+		copy(dAtA[i:], m.NftClassId)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.NftClassId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintProperty(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.UnitNumber) > 0 {
 		i -= len(m.UnitNumber)
 		copy(dAtA[i:], m.UnitNumber)
@@ -595,6 +690,26 @@ func (m *Property) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovProperty(uint64(l))
 	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 2 + l + sovProperty(uint64(l))
+	}
+	l = len(m.NftClassId)
+	if l > 0 {
+		n += 2 + l + sovProperty(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 2 + l + sovProperty(uint64(l))
+	}
+	l = len(m.ValuationIndex)
+	if l > 0 {
+		n += 2 + l + sovProperty(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 2 + l + sovProperty(uint64(l))
+	}
 	return n
 }
 
@@ -1231,6 +1346,166 @@ func (m *Property) Unmarshal(dAtA []byte) error {
 			}
 			m.UnitNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuationIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuationIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProperty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProperty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProperty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProperty(dAtA[iNdEx:])
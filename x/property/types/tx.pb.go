@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,12 +127,13 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 type MsgRegisterProperty struct {
-	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Address string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Region  string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
-	Value   uint64   `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
-	Owners  []string `protobuf:"bytes,5,rep,name=owners,proto3" json:"owners,omitempty"`
-	Shares  []uint64 `protobuf:"varint,6,rep,packed,name=shares,proto3" json:"shares,omitempty"`
+	Creator     string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Address     string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Region      string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Value       uint64   `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Owners      []string `protobuf:"bytes,5,rep,name=owners,proto3" json:"owners,omitempty"`
+	Shares      []uint64 `protobuf:"varint,6,rep,packed,name=shares,proto3" json:"shares,omitempty"`
+	MetadataUri string   `protobuf:"bytes,7,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
 }
 
 func (m *MsgRegisterProperty) Reset()         { *m = MsgRegisterProperty{} }
@@ -209,6 +211,13 @@ func (m *MsgRegisterProperty) GetShares() []uint64 {
 	return nil
 }
 
+func (m *MsgRegisterProperty) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
+	}
+	return ""
+}
+
 type MsgRegisterPropertyResponse struct {
 }
 
@@ -377,6 +386,7 @@ type MsgEditPropertyMetadata struct {
 	OwnerInformation        string `protobuf:"bytes,9,opt,name=owner_information,json=ownerInformation,proto3" json:"owner_information,omitempty"`
 	TenantId                string `protobuf:"bytes,10,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	UnitNumber              string `protobuf:"bytes,11,opt,name=unit_number,json=unitNumber,proto3" json:"unit_number,omitempty"`
+	MetadataUri             string `protobuf:"bytes,12,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
 }
 
 func (m *MsgEditPropertyMetadata) Reset()         { *m = MsgEditPropertyMetadata{} }
@@ -489,6 +499,13 @@ func (m *MsgEditPropertyMetadata) GetUnitNumber() string {
 	return ""
 }
 
+func (m *MsgEditPropertyMetadata) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
+	}
+	return ""
+}
+
 type MsgEditPropertyMetadataResponse struct {
 }
 
@@ -525,6 +542,321 @@ func (m *MsgEditPropertyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditPropertyMetadataResponse proto.InternalMessageInfo
 
+// MsgSubmitProposal submits a proposal to the shareholders of a property. The
+// messages must be signed by the governance account of the property.
+type MsgSubmitProposal struct {
+	Proposer   string       `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	PropertyId string       `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Title      string       `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary    string       `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Messages   []*types.Any `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
+func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProposal) ProtoMessage()    {}
+func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{8}
+}
+func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProposal.Merge(m, src)
+}
+func (m *MsgSubmitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgSubmitProposal) GetPropertyId() string {
+	if m != nil {
+		return m.PropertyId
+	}
+	return ""
+}
+
+func (m *MsgSubmitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgSubmitProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *MsgSubmitProposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type MsgSubmitProposalResponse struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResponse{} }
+func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{9}
+}
+func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type MsgVote struct {
+	Voter      string     `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64     `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=ardapoc.property.VoteOption" json:"option,omitempty"`
+}
+
+func (m *MsgVote) Reset()         { *m = MsgVote{} }
+func (m *MsgVote) String() string { return proto.CompactTextString(m) }
+func (*MsgVote) ProtoMessage()    {}
+func (*MsgVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{10}
+}
+func (m *MsgVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVote.Merge(m, src)
+}
+func (m *MsgVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVote proto.InternalMessageInfo
+
+func (m *MsgVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+type MsgVoteResponse struct {
+}
+
+func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
+func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteResponse) ProtoMessage()    {}
+func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{11}
+}
+func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteResponse.Merge(m, src)
+}
+func (m *MsgVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
+
+type MsgSetPropertyManager struct {
+	// authority is the governance account of the property
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PropertyId string `protobuf:"bytes,2,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Manager    string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (m *MsgSetPropertyManager) Reset()         { *m = MsgSetPropertyManager{} }
+func (m *MsgSetPropertyManager) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPropertyManager) ProtoMessage()    {}
+func (*MsgSetPropertyManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{12}
+}
+func (m *MsgSetPropertyManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPropertyManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPropertyManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPropertyManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPropertyManager.Merge(m, src)
+}
+func (m *MsgSetPropertyManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPropertyManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPropertyManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPropertyManager proto.InternalMessageInfo
+
+func (m *MsgSetPropertyManager) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPropertyManager) GetPropertyId() string {
+	if m != nil {
+		return m.PropertyId
+	}
+	return ""
+}
+
+func (m *MsgSetPropertyManager) GetManager() string {
+	if m != nil {
+		return m.Manager
+	}
+	return ""
+}
+
+type MsgSetPropertyManagerResponse struct {
+}
+
+func (m *MsgSetPropertyManagerResponse) Reset()         { *m = MsgSetPropertyManagerResponse{} }
+func (m *MsgSetPropertyManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPropertyManagerResponse) ProtoMessage()    {}
+func (*MsgSetPropertyManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f04653f7920feaa8, []int{13}
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPropertyManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPropertyManagerResponse.Merge(m, src)
+}
+func (m *MsgSetPropertyManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPropertyManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPropertyManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPropertyManagerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ardapoc.property.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ardapoc.property.MsgUpdateParamsResponse")
@@ -534,66 +866,93 @@ func init() {
 	proto.RegisterType((*MsgTransferSharesResponse)(nil), "ardapoc.property.MsgTransferSharesResponse")
 	proto.RegisterType((*MsgEditPropertyMetadata)(nil), "ardapoc.property.MsgEditPropertyMetadata")
 	proto.RegisterType((*MsgEditPropertyMetadataResponse)(nil), "ardapoc.property.MsgEditPropertyMetadataResponse")
+	proto.RegisterType((*MsgSubmitProposal)(nil), "ardapoc.property.MsgSubmitProposal")
+	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "ardapoc.property.MsgSubmitProposalResponse")
+	proto.RegisterType((*MsgVote)(nil), "ardapoc.property.MsgVote")
+	proto.RegisterType((*MsgVoteResponse)(nil), "ardapoc.property.MsgVoteResponse")
+	proto.RegisterType((*MsgSetPropertyManager)(nil), "ardapoc.property.MsgSetPropertyManager")
+	proto.RegisterType((*MsgSetPropertyManagerResponse)(nil), "ardapoc.property.MsgSetPropertyManagerResponse")
 }
 
 func init() { proto.RegisterFile("ardapoc/property/tx.proto", fileDescriptor_f04653f7920feaa8) }
 
 var fileDescriptor_f04653f7920feaa8 = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x1c, 0xcd, 0xd6, 0x8e, 0x1b, 0x4f, 0x02, 0x24, 0x8b, 0x21, 0x9b, 0x0d, 0x71, 0x1c, 0x57, 0x20,
-	0xe3, 0x12, 0xaf, 0x9a, 0x4a, 0x48, 0x84, 0x13, 0x41, 0x1c, 0x7c, 0x70, 0x5b, 0xad, 0xcb, 0x05,
-	0x21, 0x59, 0xe3, 0xdd, 0xf1, 0x66, 0x24, 0xef, 0xcc, 0x6a, 0x66, 0x5c, 0xea, 0x9c, 0x50, 0x8f,
-	0x9c, 0x2a, 0x21, 0x71, 0xe4, 0xcc, 0x31, 0x07, 0x10, 0x12, 0x9f, 0xa0, 0x17, 0xa4, 0x8a, 0x5e,
-	0x38, 0x21, 0x94, 0x20, 0xe5, 0x6b, 0xa0, 0xf9, 0xb3, 0xeb, 0xf5, 0xda, 0x0d, 0xe1, 0x62, 0xef,
-	0xef, 0xbd, 0xf7, 0xdb, 0x79, 0x6f, 0x66, 0x67, 0x06, 0xec, 0x40, 0x16, 0xc2, 0x84, 0x06, 0x5e,
-	0xc2, 0x68, 0x82, 0x98, 0x98, 0x7a, 0xe2, 0x69, 0x27, 0x61, 0x54, 0x50, 0x7b, 0xd3, 0x50, 0x9d,
-	0x94, 0x72, 0xb7, 0x60, 0x8c, 0x09, 0xf5, 0xd4, 0xaf, 0x16, 0xb9, 0xdb, 0x01, 0xe5, 0x31, 0xe5,
-	0x5e, 0xcc, 0x23, 0xef, 0xc9, 0x3d, 0xf9, 0x67, 0x88, 0x1d, 0x4d, 0x0c, 0x54, 0xe5, 0xe9, 0xc2,
-	0x50, 0xb5, 0x88, 0x46, 0x54, 0xe3, 0xf2, 0xc9, 0xa0, 0x7b, 0x0b, 0x4e, 0x12, 0xc8, 0x60, 0x9c,
-	0x36, 0xbd, 0x17, 0x51, 0x1a, 0x8d, 0x91, 0x07, 0x13, 0xec, 0x41, 0x42, 0xa8, 0x80, 0x02, 0x53,
-	0x62, 0xd8, 0xe6, 0x6f, 0x16, 0x78, 0xab, 0xc7, 0xa3, 0x2f, 0x93, 0x10, 0x0a, 0xf4, 0x48, 0xf5,
-	0xd9, 0x1f, 0x83, 0x2a, 0x9c, 0x88, 0x53, 0xca, 0xb0, 0x98, 0x3a, 0x56, 0xc3, 0x6a, 0x55, 0x4f,
-	0x9c, 0x3f, 0x7e, 0x3e, 0xac, 0x19, 0x2f, 0x9f, 0x85, 0x21, 0x43, 0x9c, 0xf7, 0x05, 0xc3, 0x24,
-	0xf2, 0x67, 0x52, 0xfb, 0x53, 0x50, 0xd1, 0x23, 0x3b, 0xb7, 0x1a, 0x56, 0x6b, 0xfd, 0xc8, 0xe9,
-	0x14, 0x27, 0xa2, 0xa3, 0x47, 0x38, 0xa9, 0xbe, 0xf8, 0x6b, 0x7f, 0xe5, 0xa7, 0xab, 0xf3, 0xb6,
-	0xe5, 0x9b, 0x96, 0xe3, 0xa3, 0x67, 0x57, 0xe7, 0xed, 0xd9, 0xcb, 0xbe, 0xbb, 0x3a, 0x6f, 0xef,
-	0xcb, 0x76, 0xef, 0xe9, 0x2c, 0x57, 0xc1, 0x68, 0x73, 0x07, 0x6c, 0x17, 0x20, 0x1f, 0xf1, 0x84,
-	0x12, 0x8e, 0x9a, 0xbf, 0x58, 0xe0, 0xed, 0x1e, 0x8f, 0x7c, 0x14, 0x61, 0x2e, 0x10, 0x7b, 0x64,
-	0x5e, 0x61, 0x3b, 0xe0, 0x76, 0xc0, 0x10, 0x14, 0x94, 0xe9, 0x64, 0x7e, 0x5a, 0x4a, 0x06, 0xea,
-	0x64, 0xca, 0x7e, 0xd5, 0x4f, 0x4b, 0xfb, 0x5d, 0x50, 0x61, 0x28, 0xc2, 0x94, 0x38, 0x25, 0x45,
-	0x98, 0xca, 0xae, 0x81, 0xd5, 0x27, 0x70, 0x3c, 0x41, 0x4e, 0xb9, 0x61, 0xb5, 0xca, 0xbe, 0x2e,
-	0xa4, 0x9a, 0x7e, 0x43, 0x10, 0xe3, 0xce, 0x6a, 0xa3, 0x24, 0xd5, 0xba, 0x92, 0x38, 0x3f, 0x85,
-	0x0c, 0x71, 0xa7, 0xd2, 0x28, 0xb5, 0xca, 0xbe, 0xa9, 0x8e, 0x37, 0x64, 0xf0, 0xd4, 0x45, 0x73,
-	0x0f, 0xec, 0x2e, 0xb1, 0x9d, 0xc5, 0x7a, 0x65, 0x81, 0xad, 0x1e, 0x8f, 0x1e, 0x33, 0x48, 0xf8,
-	0x08, 0xb1, 0xbe, 0x7a, 0xc5, 0x35, 0xa1, 0xea, 0x00, 0xa4, 0xb3, 0xd7, 0x0d, 0x4d, 0xae, 0x1c,
-	0x22, 0xf9, 0x11, 0xa3, 0xf1, 0x43, 0x6d, 0xb8, 0xa4, 0x0c, 0xe7, 0x90, 0x94, 0xd7, 0xe3, 0x38,
-	0x65, 0x65, 0x3c, 0x87, 0xd8, 0x2e, 0x58, 0x13, 0xf4, 0x61, 0x3e, 0x6e, 0x56, 0x6b, 0xae, 0x9f,
-	0x8f, 0x9c, 0xd5, 0x85, 0xd0, 0xbb, 0x60, 0x67, 0x21, 0x54, 0x16, 0xf9, 0xf7, 0x92, 0x5a, 0xe5,
-	0x2f, 0x42, 0x2c, 0xd2, 0xe9, 0xe8, 0x21, 0x01, 0x43, 0x28, 0xe0, 0x35, 0xc1, 0xf7, 0xc1, 0x7a,
-	0x1a, 0x73, 0x80, 0x97, 0x25, 0xbf, 0x03, 0xde, 0xc8, 0x04, 0x04, 0xc6, 0xc8, 0xac, 0xed, 0x46,
-	0x0a, 0x3e, 0x80, 0x31, 0x9a, 0x13, 0x89, 0x69, 0xa2, 0x57, 0x3a, 0x27, 0x7a, 0x3c, 0x4d, 0xb4,
-	0x08, 0xb2, 0x00, 0x8d, 0x07, 0x64, 0x12, 0x0f, 0x11, 0x73, 0x56, 0x8d, 0x48, 0x81, 0x0f, 0x14,
-	0xa6, 0xfc, 0x68, 0x11, 0xc7, 0x67, 0xc8, 0xa9, 0x18, 0x3f, 0x0a, 0xea, 0xe3, 0x33, 0x64, 0x7f,
-	0x02, 0x9c, 0x80, 0x12, 0x2e, 0xd8, 0x24, 0x90, 0xfb, 0x73, 0x80, 0xc9, 0x88, 0xb2, 0x58, 0xed,
-	0x55, 0xe7, 0xb6, 0x52, 0x6f, 0xe7, 0xf9, 0xee, 0x8c, 0xb6, 0xef, 0x83, 0x77, 0xce, 0x28, 0xc1,
-	0x24, 0x1a, 0x04, 0x63, 0xc8, 0x39, 0x1e, 0xe1, 0x40, 0xf7, 0xad, 0xa9, 0xbe, 0x9a, 0x26, 0x3f,
-	0x9f, 0xe3, 0xec, 0xbb, 0x60, 0x4b, 0x7d, 0x98, 0x73, 0x03, 0x55, 0x55, 0xc3, 0xa6, 0x22, 0xf2,
-	0x23, 0xec, 0x82, 0xaa, 0x40, 0x04, 0x12, 0x21, 0xe7, 0x12, 0x28, 0xd1, 0x9a, 0x06, 0xba, 0xa1,
-	0x8c, 0x36, 0x21, 0x58, 0xa4, 0xe9, 0xd7, 0x75, 0x34, 0x09, 0xe9, 0xec, 0x85, 0xc5, 0x3e, 0x00,
-	0xfb, 0xaf, 0x59, 0xce, 0x74, 0xc9, 0x8f, 0x7e, 0x2d, 0x83, 0x52, 0x8f, 0x47, 0xf6, 0xd7, 0x60,
-	0x63, 0xee, 0x60, 0x3a, 0x58, 0x3c, 0x50, 0x0a, 0xfb, 0xdf, 0xfd, 0xf0, 0x3f, 0x25, 0xe9, 0x28,
-	0xf6, 0x0f, 0x16, 0xd8, 0x5c, 0x38, 0x1f, 0xde, 0x5f, 0xda, 0x5f, 0x94, 0xb9, 0x87, 0x37, 0x92,
-	0x65, 0xdf, 0xf0, 0x47, 0xcf, 0x5e, 0xfd, 0xf3, 0xfd, 0xad, 0x0f, 0x8e, 0xad, 0x76, 0xf3, 0x40,
-	0x1f, 0xe9, 0x04, 0x4e, 0x84, 0xa7, 0xce, 0xb7, 0xec, 0x74, 0x63, 0xa6, 0xdb, 0x7e, 0x6e, 0x81,
-	0x37, 0x0b, 0x3b, 0xfc, 0xce, 0xd2, 0xf1, 0xe6, 0x45, 0xee, 0xdd, 0x1b, 0x88, 0xfe, 0x9f, 0x25,
-	0x61, 0x7a, 0xed, 0x1f, 0x2d, 0x50, 0x5b, 0xba, 0x03, 0x97, 0xcf, 0xf7, 0x32, 0xa9, 0x7b, 0xef,
-	0xc6, 0xd2, 0xcc, 0x64, 0x4b, 0x99, 0x6c, 0x4a, 0x93, 0x7b, 0xaf, 0x35, 0x89, 0x42, 0x2c, 0xdc,
-	0xd5, 0x6f, 0xe5, 0x6d, 0x72, 0xd2, 0x7d, 0x71, 0x51, 0xb7, 0x5e, 0x5e, 0xd4, 0xad, 0xbf, 0x2f,
-	0xea, 0xd6, 0xf3, 0xcb, 0xfa, 0xca, 0xcb, 0xcb, 0xfa, 0xca, 0x9f, 0x97, 0xf5, 0x95, 0xaf, 0xbc,
-	0x08, 0x8b, 0xd3, 0xc9, 0xb0, 0x13, 0xd0, 0x58, 0xf5, 0x47, 0x63, 0x3a, 0x84, 0x63, 0xf5, 0x78,
-	0x28, 0x2f, 0xcf, 0xdc, 0x35, 0x23, 0x77, 0x3a, 0x1f, 0x56, 0xd4, 0x05, 0x79, 0xff, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x12, 0xfc, 0x91, 0x27, 0xe9, 0x07, 0x00, 0x00,
+	// 1192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0x63, 0x30, 0x78, 0x20, 0x7c, 0xc3, 0x7e, 0x9d, 0xb2, 0x36, 0xc1, 0x60, 0x47, 0x2d,
+	0x14, 0x82, 0x57, 0x38, 0x55, 0xa5, 0xba, 0xbd, 0x84, 0xaa, 0x07, 0x0e, 0x0e, 0x91, 0x49, 0x7a,
+	0xa8, 0x2a, 0x59, 0x63, 0x7b, 0x58, 0x56, 0xf2, 0xce, 0xac, 0x66, 0x66, 0x29, 0xe6, 0x54, 0x71,
+	0xec, 0x29, 0x55, 0xa5, 0x1e, 0x7b, 0xee, 0x91, 0x03, 0xbd, 0xf4, 0x2f, 0x88, 0x7a, 0x8a, 0x9a,
+	0x4b, 0x4e, 0x51, 0x05, 0x95, 0xb8, 0xf6, 0x4f, 0xa8, 0xe6, 0xd7, 0x62, 0xaf, 0x97, 0x9a, 0xf6,
+	0x62, 0xef, 0x7b, 0xef, 0x33, 0xf3, 0xde, 0xe7, 0xcd, 0x9b, 0xf7, 0x06, 0x14, 0x20, 0xed, 0xc2,
+	0x90, 0x74, 0xdc, 0x90, 0x92, 0x10, 0x51, 0xde, 0x77, 0xf9, 0x71, 0x35, 0xa4, 0x84, 0x13, 0xfb,
+	0x9e, 0x36, 0x55, 0x8d, 0xa9, 0xb8, 0x00, 0x03, 0x1f, 0x13, 0x57, 0xfe, 0x2a, 0x50, 0x71, 0xb1,
+	0x43, 0x58, 0x40, 0x98, 0x1b, 0x30, 0xcf, 0x3d, 0xda, 0x16, 0x7f, 0xda, 0x50, 0x50, 0x86, 0x96,
+	0x94, 0x5c, 0x25, 0x68, 0x53, 0xde, 0x23, 0x1e, 0x51, 0x7a, 0xf1, 0xa5, 0xb5, 0xe5, 0x91, 0x48,
+	0x3c, 0x72, 0x84, 0x28, 0x86, 0xb8, 0x83, 0x34, 0x64, 0x79, 0x04, 0x12, 0x42, 0x0a, 0x03, 0xb3,
+	0xef, 0x03, 0x8f, 0x10, 0xaf, 0x87, 0x5c, 0x18, 0xfa, 0x2e, 0xc4, 0x98, 0x70, 0xc8, 0x7d, 0x82,
+	0x8d, 0xb5, 0xa0, 0xad, 0x52, 0x6a, 0x47, 0x07, 0x2e, 0xc4, 0x7d, 0x65, 0xaa, 0xfc, 0x6a, 0x81,
+	0xff, 0x35, 0x98, 0xf7, 0x22, 0xec, 0x42, 0x8e, 0x9e, 0xc9, 0x2d, 0xed, 0x8f, 0x41, 0x0e, 0x46,
+	0xfc, 0x90, 0x50, 0x9f, 0xf7, 0x1d, 0x6b, 0xd5, 0x5a, 0xcf, 0xed, 0x38, 0xbf, 0x9f, 0x6f, 0xe5,
+	0x35, 0x93, 0x27, 0xdd, 0x2e, 0x45, 0x8c, 0xed, 0x73, 0xea, 0x63, 0xaf, 0x79, 0x0d, 0xb5, 0x3f,
+	0x05, 0x59, 0x15, 0x94, 0x73, 0x67, 0xd5, 0x5a, 0x9f, 0xad, 0x39, 0xd5, 0x64, 0x1a, 0xab, 0xca,
+	0xc3, 0x4e, 0xee, 0xd5, 0xbb, 0x95, 0x89, 0x9f, 0xaf, 0xce, 0x36, 0xac, 0xa6, 0x5e, 0x52, 0xaf,
+	0x9d, 0x5e, 0x9d, 0x6d, 0x5c, 0x6f, 0xf6, 0xdd, 0xd5, 0xd9, 0xc6, 0x8a, 0x58, 0xee, 0x1e, 0x5f,
+	0x53, 0x4e, 0x04, 0x5a, 0x29, 0x80, 0xc5, 0x84, 0xaa, 0x89, 0x58, 0x48, 0x30, 0x43, 0x95, 0xb7,
+	0x16, 0xf8, 0x7f, 0x83, 0x79, 0x4d, 0xe4, 0xf9, 0x8c, 0x23, 0xfa, 0x4c, 0x6f, 0x61, 0x3b, 0x60,
+	0xba, 0x43, 0x11, 0xe4, 0x84, 0x2a, 0x66, 0x4d, 0x23, 0x0a, 0x0b, 0x54, 0xcc, 0x64, 0xf8, 0xb9,
+	0xa6, 0x11, 0xed, 0xf7, 0x40, 0x96, 0x22, 0xcf, 0x27, 0xd8, 0xc9, 0x48, 0x83, 0x96, 0xec, 0x3c,
+	0x98, 0x3a, 0x82, 0xbd, 0x08, 0x39, 0x93, 0xab, 0xd6, 0xfa, 0x64, 0x53, 0x09, 0x02, 0x4d, 0xbe,
+	0xc1, 0x88, 0x32, 0x67, 0x6a, 0x35, 0x23, 0xd0, 0x4a, 0x12, 0x7a, 0x76, 0x08, 0x29, 0x62, 0x4e,
+	0x76, 0x35, 0xb3, 0x3e, 0xd9, 0xd4, 0x92, 0x5d, 0x06, 0x73, 0x01, 0xe2, 0xb0, 0x0b, 0x39, 0x6c,
+	0x45, 0xd4, 0x77, 0xa6, 0xa5, 0x8f, 0x59, 0xa3, 0x7b, 0x41, 0xfd, 0xfa, 0x9c, 0xc8, 0x8d, 0x09,
+	0xb4, 0xb2, 0x0c, 0x96, 0x52, 0x98, 0xc5, 0xcc, 0xdf, 0x58, 0x60, 0xa1, 0xc1, 0xbc, 0xe7, 0x14,
+	0x62, 0x76, 0x80, 0xe8, 0xbe, 0xf2, 0x72, 0x33, 0xef, 0x12, 0x00, 0x26, 0xc1, 0xbb, 0x5d, 0x4d,
+	0x7d, 0x40, 0x23, 0xec, 0x07, 0x94, 0x04, 0x7b, 0x8a, 0x53, 0x46, 0x72, 0x1a, 0xd0, 0x18, 0xbb,
+	0xf2, 0xe3, 0x4c, 0x4a, 0x6e, 0x03, 0x1a, 0xbb, 0x08, 0x66, 0x38, 0xd9, 0x1b, 0xcc, 0x48, 0x2c,
+	0x2b, 0xdb, 0xfe, 0x60, 0x56, 0x62, 0x39, 0x41, 0x7a, 0x09, 0x14, 0x46, 0x48, 0xc5, 0x94, 0xff,
+	0xca, 0xc8, 0x42, 0xf8, 0xa2, 0xeb, 0x73, 0x93, 0x8e, 0x86, 0x4e, 0xdf, 0x3f, 0x10, 0x5f, 0x01,
+	0xb3, 0x86, 0x66, 0xcb, 0x4f, 0x63, 0xfe, 0x10, 0xdc, 0x8d, 0x01, 0x18, 0x06, 0x48, 0x1f, 0xff,
+	0x9c, 0x51, 0x3e, 0x85, 0x01, 0x1a, 0x02, 0xf1, 0x7e, 0xa8, 0x8a, 0x61, 0x00, 0xf4, 0xbc, 0x1f,
+	0x2a, 0x10, 0xa4, 0x1d, 0xd4, 0x6b, 0xe1, 0x28, 0x68, 0x23, 0xea, 0x4c, 0x69, 0x90, 0x54, 0x3e,
+	0x95, 0x3a, 0x19, 0x8f, 0x02, 0x31, 0xff, 0x04, 0x39, 0x59, 0x1d, 0x8f, 0x54, 0xed, 0xfb, 0x27,
+	0xc8, 0xfe, 0x04, 0x38, 0x1d, 0x82, 0x19, 0xa7, 0x51, 0x47, 0xdc, 0xee, 0x96, 0x8f, 0x0f, 0x08,
+	0x0d, 0xe4, 0x4d, 0xd7, 0x55, 0xb3, 0x38, 0x68, 0xdf, 0xbd, 0x36, 0xdb, 0x8f, 0xc1, 0xfd, 0x13,
+	0x82, 0x7d, 0xec, 0xb5, 0x3a, 0x3d, 0xc8, 0x98, 0x7f, 0xe0, 0x77, 0xd4, 0xba, 0x19, 0xb9, 0x2e,
+	0xaf, 0x8c, 0x9f, 0x0f, 0xd9, 0xec, 0x4d, 0xb0, 0x20, 0x6b, 0x77, 0xc8, 0x51, 0x4e, 0x2e, 0xb8,
+	0x27, 0x0d, 0x83, 0x1e, 0x96, 0x40, 0x8e, 0x23, 0x0c, 0x31, 0x17, 0xb9, 0x04, 0x12, 0x34, 0xa3,
+	0x14, 0xbb, 0x5d, 0x41, 0x2d, 0xc2, 0x3e, 0x37, 0xec, 0x67, 0x15, 0x35, 0xa1, 0xd2, 0xdc, 0x93,
+	0x97, 0x60, 0x6e, 0xdc, 0x25, 0x28, 0x83, 0x95, 0x1b, 0x4e, 0x3c, 0xae, 0x8a, 0x77, 0xea, 0x22,
+	0xec, 0x47, 0xed, 0x40, 0xa1, 0x08, 0x83, 0x3d, 0x51, 0x72, 0xa1, 0xfc, 0x46, 0xa6, 0x20, 0x62,
+	0x79, 0x7c, 0x45, 0xe4, 0xc1, 0x14, 0xf7, 0x79, 0xcf, 0x54, 0x82, 0x12, 0x44, 0x89, 0xb1, 0x28,
+	0x08, 0x20, 0xed, 0xeb, 0xc3, 0x37, 0xa2, 0xdd, 0x00, 0x33, 0x01, 0x62, 0x0c, 0x7a, 0x48, 0xd5,
+	0xfe, 0x6c, 0x2d, 0x5f, 0x55, 0xbd, 0xb8, 0x6a, 0x7a, 0x71, 0xf5, 0x09, 0xee, 0xef, 0x2c, 0xfd,
+	0x76, 0xbe, 0xa5, 0xc7, 0x49, 0xb5, 0x0d, 0x19, 0xaa, 0x1e, 0x6d, 0xb7, 0x11, 0x87, 0xdb, 0x55,
+	0x71, 0xd1, 0xe3, 0x2d, 0xea, 0x77, 0x45, 0x0a, 0xe2, 0x70, 0x2b, 0x9f, 0x81, 0xc2, 0x08, 0x3f,
+	0xc3, 0xde, 0x70, 0x11, 0x3a, 0xc1, 0xc5, 0x92, 0x2d, 0x0a, 0x18, 0xd5, 0x6e, 0xb7, 0x72, 0x6a,
+	0x81, 0xe9, 0x06, 0xf3, 0xbe, 0x24, 0x1c, 0xc9, 0x4e, 0x46, 0x78, 0x9c, 0x11, 0x25, 0x24, 0xb7,
+	0xb8, 0x93, 0xdc, 0xc2, 0xfe, 0x08, 0x64, 0x49, 0xc8, 0x4d, 0x63, 0x9c, 0xaf, 0x3d, 0x18, 0x6d,
+	0xf8, 0x62, 0xfb, 0x3d, 0x89, 0x69, 0x6a, 0x6c, 0x1d, 0x08, 0x16, 0xca, 0x45, 0x65, 0x41, 0x4e,
+	0x1f, 0x01, 0x8a, 0x8f, 0xed, 0xdc, 0x02, 0xf7, 0x05, 0x2d, 0x74, 0x7d, 0xb2, 0x10, 0x43, 0x0f,
+	0xd1, 0xff, 0x3c, 0x97, 0xc6, 0x1e, 0x6b, 0x0d, 0x4c, 0x07, 0xca, 0x87, 0x93, 0x19, 0xb3, 0xad,
+	0x01, 0xd6, 0xe7, 0x87, 0xe7, 0x55, 0x65, 0x05, 0x2c, 0xa7, 0x46, 0x6d, 0x78, 0xd5, 0x7e, 0x99,
+	0x06, 0x99, 0x06, 0xf3, 0xec, 0xaf, 0xc1, 0xdc, 0xd0, 0xb4, 0x2d, 0x8f, 0x26, 0x2d, 0x31, 0xd4,
+	0x8a, 0x1f, 0x8e, 0x85, 0xc4, 0xc7, 0xfe, 0xa3, 0x05, 0xee, 0x8d, 0x0c, 0xbd, 0xf7, 0x53, 0xd7,
+	0x27, 0x61, 0xc5, 0xad, 0x5b, 0xc1, 0xe2, 0x83, 0x7a, 0x74, 0xfa, 0xe6, 0xcf, 0x1f, 0xee, 0x7c,
+	0x50, 0xb7, 0x36, 0x2a, 0x65, 0xf5, 0xca, 0xc1, 0x30, 0xe2, 0xae, 0x1c, 0xda, 0xf1, 0xc8, 0xa6,
+	0x7a, 0xb5, 0xfd, 0xd2, 0x02, 0xf3, 0x89, 0x99, 0xf4, 0x30, 0xd5, 0xdf, 0x30, 0xa8, 0xb8, 0x79,
+	0x0b, 0xd0, 0xbf, 0x0b, 0x89, 0xeb, 0xb5, 0xf6, 0x4f, 0x16, 0xc8, 0xa7, 0xce, 0x8c, 0xf4, 0x7c,
+	0xa7, 0x41, 0x8b, 0xdb, 0xb7, 0x86, 0xc6, 0x41, 0xae, 0xcb, 0x20, 0x2b, 0x22, 0xc8, 0xe5, 0x1b,
+	0x83, 0x44, 0x5d, 0x9f, 0xdb, 0xdf, 0x5b, 0x60, 0x3e, 0xd1, 0xbe, 0xd2, 0x73, 0x36, 0x0c, 0x2a,
+	0x6e, 0xde, 0x02, 0x14, 0x87, 0xb3, 0x25, 0xc3, 0x59, 0x13, 0xe1, 0x54, 0x6e, 0x0c, 0xc7, 0x5c,
+	0x7a, 0x66, 0x1f, 0x83, 0x49, 0xd9, 0x32, 0x0a, 0xa9, 0x3e, 0x84, 0xa9, 0x58, 0xbe, 0xd1, 0x14,
+	0x3b, 0xad, 0x49, 0xa7, 0x8f, 0x84, 0xd3, 0xb5, 0xf1, 0x4e, 0x5d, 0xd1, 0x2c, 0x6c, 0x0c, 0xec,
+	0x94, 0xa6, 0xb0, 0x96, 0xce, 0x75, 0x04, 0x58, 0x74, 0x6f, 0x09, 0x34, 0x31, 0x16, 0xa7, 0xbe,
+	0x15, 0x0f, 0xd4, 0x9d, 0xdd, 0x57, 0x17, 0x25, 0xeb, 0xf5, 0x45, 0xc9, 0xfa, 0xe3, 0xa2, 0x64,
+	0xbd, 0xbc, 0x2c, 0x4d, 0xbc, 0xbe, 0x2c, 0x4d, 0xbc, 0xbd, 0x2c, 0x4d, 0x7c, 0xe5, 0x7a, 0x3e,
+	0x3f, 0x8c, 0xda, 0xd5, 0x0e, 0x09, 0x64, 0xe4, 0x5e, 0x8f, 0xb4, 0x61, 0x4f, 0x7e, 0x6e, 0x89,
+	0xa7, 0xfa, 0xc0, 0xcb, 0x55, 0xbc, 0x0c, 0x58, 0x3b, 0x2b, 0x9b, 0xfe, 0xe3, 0xbf, 0x07, 0x00,
+	0xf4, 0x4c, 0x36, 0xb4, 0x7a, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -614,6 +973,14 @@ type MsgClient interface {
 	RegisterProperty(ctx context.Context, in *MsgRegisterProperty, opts ...grpc.CallOption) (*MsgRegisterPropertyResponse, error)
 	TransferShares(ctx context.Context, in *MsgTransferShares, opts ...grpc.CallOption) (*MsgTransferSharesResponse, error)
 	EditPropertyMetadata(ctx context.Context, in *MsgEditPropertyMetadata, opts ...grpc.CallOption) (*MsgEditPropertyMetadataResponse, error)
+	// SubmitProposal puts a decision to the shareholders of a property.
+	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
+	// Vote casts the vote of a shareholder on a proposal.
+	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// SetPropertyManager appoints the manager of a property. It is signed by the
+	// property's governance account, so the owners choose the manager through a
+	// proposal.
+	SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error)
 }
 
 type msgClient struct {
@@ -660,6 +1027,33 @@ func (c *msgClient) EditPropertyMetadata(ctx context.Context, in *MsgEditPropert
 	return out, nil
 }
 
+func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
+	out := new(MsgSubmitProposalResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.property.Msg/SubmitProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error) {
+	out := new(MsgVoteResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.property.Msg/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPropertyManager(ctx context.Context, in *MsgSetPropertyManager, opts ...grpc.CallOption) (*MsgSetPropertyManagerResponse, error) {
+	out := new(MsgSetPropertyManagerResponse)
+	err := c.cc.Invoke(ctx, "/ardapoc.property.Msg/SetPropertyManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -668,6 +1062,14 @@ type MsgServer interface {
 	RegisterProperty(context.Context, *MsgRegisterProperty) (*MsgRegisterPropertyResponse, error)
 	TransferShares(context.Context, *MsgTransferShares) (*MsgTransferSharesResponse, error)
 	EditPropertyMetadata(context.Context, *MsgEditPropertyMetadata) (*MsgEditPropertyMetadataResponse, error)
+	// SubmitProposal puts a decision to the shareholders of a property.
+	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
+	// Vote casts the vote of a shareholder on a proposal.
+	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// SetPropertyManager appoints the manager of a property. It is signed by the
+	// property's governance account, so the owners choose the manager through a
+	// proposal.
+	SetPropertyManager(context.Context, *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -686,6 +1088,15 @@ func (*UnimplementedMsgServer) TransferShares(ctx context.Context, req *MsgTrans
 func (*UnimplementedMsgServer) EditPropertyMetadata(ctx context.Context, req *MsgEditPropertyMetadata) (*MsgEditPropertyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditPropertyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
+}
+func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedMsgServer) SetPropertyManager(ctx context.Context, req *MsgSetPropertyManager) (*MsgSetPropertyManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPropertyManager not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -763,44 +1174,110 @@ func _Msg_EditPropertyMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ardapoc.property.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterProperty",
-			Handler:    _Msg_RegisterProperty_Handler,
-		},
-		{
-			MethodName: "TransferShares",
-			Handler:    _Msg_TransferShares_Handler,
-		},
-		{
-			MethodName: "EditPropertyMetadata",
-			Handler:    _Msg_EditPropertyMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ardapoc/property/tx.proto",
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProposal)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.property.Msg/SubmitProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.property.Msg/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Vote(ctx, req.(*MsgVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPropertyManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPropertyManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPropertyManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ardapoc.property.Msg/SetPropertyManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPropertyManager(ctx, req.(*MsgSetPropertyManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ardapoc.property.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterProperty",
+			Handler:    _Msg_RegisterProperty_Handler,
+		},
+		{
+			MethodName: "TransferShares",
+			Handler:    _Msg_TransferShares_Handler,
+		},
+		{
+			MethodName: "EditPropertyMetadata",
+			Handler:    _Msg_EditPropertyMetadata_Handler,
+		},
+		{
+			MethodName: "SubmitProposal",
+			Handler:    _Msg_SubmitProposal_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Msg_Vote_Handler,
+		},
+		{
+			MethodName: "SetPropertyManager",
+			Handler:    _Msg_SetPropertyManager_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ardapoc/property/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -872,6 +1349,13 @@ func (m *MsgRegisterProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Shares) > 0 {
 		dAtA3 := make([]byte, len(m.Shares)*10)
 		var j2 int
@@ -1085,6 +1569,13 @@ func (m *MsgEditPropertyMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.UnitNumber) > 0 {
 		i -= len(m.UnitNumber)
 		copy(dAtA[i:], m.UnitNumber)
@@ -1188,6 +1679,229 @@ func (m *MsgEditPropertyMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PropertyId) > 0 {
+		i -= len(m.PropertyId)
+		copy(dAtA[i:], m.PropertyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PropertyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPropertyManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPropertyManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPropertyManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PropertyId) > 0 {
+		i -= len(m.PropertyId)
+		copy(dAtA[i:], m.PropertyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PropertyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPropertyManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPropertyManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPropertyManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1257,6 +1971,10 @@ func (m *MsgRegisterProperty) Size() (n int) {
 		}
 		n += 1 + sovTx(uint64(l)) + l
 	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1371,6 +2089,10 @@ func (m *MsgEditPropertyMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1383,6 +2105,107 @@ func (m *MsgEditPropertyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PropertyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *MsgVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPropertyManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PropertyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPropertyManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1806,6 +2629,38 @@ func (m *MsgRegisterProperty) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2638,6 +3493,38 @@ func (m *MsgEditPropertyMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.UnitNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2709,6 +3596,653 @@ func (m *MsgEditPropertyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPropertyManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPropertyManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPropertyManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPropertyManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPropertyManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPropertyManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
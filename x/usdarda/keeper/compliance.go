@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// GetMinter returns the minter with the given address.
+func (k Keeper) GetMinter(ctx context.Context, address string) (types.Minter, bool) {
+	minter, err := k.MinterRoles.Get(ctx, address)
+	if err != nil {
+		return types.Minter{}, false
+	}
+	return minter, true
+}
+
+// SetMinter stores a minter.
+func (k Keeper) SetMinter(ctx context.Context, minter types.Minter) {
+	if err := k.MinterRoles.Set(ctx, minter.Address, minter); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMinters returns every minter.
+func (k Keeper) GetAllMinters(ctx context.Context) []types.Minter {
+	iterator, err := k.MinterRoles.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	minters, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return minters
+}
+
+// IsFrozen reports whether an address is frozen.
+func (k Keeper) IsFrozen(ctx context.Context, address string) bool {
+	frozen, err := k.Frozen.Has(ctx, address)
+	if err != nil {
+		panic(err)
+	}
+	return frozen
+}
+
+// SetFrozen freezes or unfreezes an address.
+func (k Keeper) SetFrozen(ctx context.Context, address string, frozen bool) {
+	var err error
+	if frozen {
+		err = k.Frozen.Set(ctx, address)
+	} else {
+		err = k.Frozen.Remove(ctx, address)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetAllFrozen returns every frozen address.
+func (k Keeper) GetAllFrozen(ctx context.Context) []string {
+	iterator, err := k.Frozen.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	addresses, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+	return addresses
+}
+
+// IsPaused reports whether usdarda transfers are halted.
+func (k Keeper) IsPaused(ctx context.Context) bool {
+	paused, err := k.Pause.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false
+	}
+	if err != nil {
+		panic(err)
+	}
+	return paused
+}
+
+// SetPaused halts or resumes usdarda transfers.
+func (k Keeper) SetPaused(ctx context.Context, paused bool) {
+	if err := k.Pause.Set(ctx, paused); err != nil {
+		panic(err)
+	}
+}
+
+// SendRestriction is the bank send restriction that enforces the usdarda
+// compliance controls: while paused no usdarda moves, and frozen addresses can
+// neither send nor receive it. Sends out of module accounts are exempt so that
+// mortgage and liquidation settlements in the block hooks cannot be halted by
+// them. Bank mints and burns are not sends and are never restricted, so
+// MintToAddress checks its recipient itself.
+func (k Keeper) SendRestriction(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if amt.AmountOf(types.USDArdaDenom).IsZero() || k.isModuleAccount(ctx, from) {
+		return to, nil
+	}
+	return to, k.assertTransferable(ctx, from, to)
+}
+
+// assertTransferable returns an error if usdarda is paused or any of the
+// addresses is frozen.
+func (k Keeper) assertTransferable(ctx context.Context, addrs ...sdk.AccAddress) error {
+	if k.IsPaused(ctx) {
+		return types.ErrPaused
+	}
+	for _, addr := range addrs {
+		if k.IsFrozen(ctx, addr.String()) {
+			return errorsmod.Wrap(types.ErrAddressFrozen, addr.String())
+		}
+	}
+	return nil
+}
+
+// isModuleAccount reports whether addr is the account of a module.
+func (k Keeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// MintByMinter mints usdarda to a recipient out of a minter's mint allowance
+// and returns what is left of it.
+func (k Keeper) MintByMinter(ctx sdk.Context, address string, recipient sdk.AccAddress, amount uint64) (uint64, error) {
+	minter, found := k.GetMinter(ctx, address)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrNotMinter, address)
+	}
+	if amount > minter.MintAllowance {
+		return 0, errorsmod.Wrapf(types.ErrAllowanceExceeded, "%d left to mint", minter.MintAllowance)
+	}
+	if err := k.assertReserveCoverage(ctx); err != nil {
+		return 0, err
+	}
+	if err := k.MintToAddress(ctx, recipient, amount); err != nil {
+		return 0, err
+	}
+	minter.MintAllowance -= amount
+	k.SetMinter(ctx, minter)
+	return minter.MintAllowance, nil
+}
+
+// BurnByMinter burns a minter's usdarda out of its burn allowance and returns
+// what is left of it.
+func (k Keeper) BurnByMinter(ctx sdk.Context, address sdk.AccAddress, amount uint64) (uint64, error) {
+	minter, found := k.GetMinter(ctx, address.String())
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrNotMinter, address.String())
+	}
+	if amount > minter.BurnAllowance {
+		return 0, errorsmod.Wrapf(types.ErrAllowanceExceeded, "%d left to burn", minter.BurnAllowance)
+	}
+	if err := k.BurnFromAddress(ctx, address, amount); err != nil {
+		return 0, err
+	}
+	minter.BurnAllowance -= amount
+	k.SetMinter(ctx, minter)
+	return minter.BurnAllowance, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// SetDenomMetadata registers the bank metadata of the USDArda denom so wallets
+// can display it.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context) {
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "USDArda stablecoin minted against registered property value",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: usdtypes.USDArdaDenom, Exponent: 0},
+		},
+		Base:    usdtypes.USDArdaDenom,
+		Display: usdtypes.USDArdaDenom,
+		Name:    "USDArda",
+		Symbol:  "USDARDA",
+	})
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,7 +19,26 @@ type (
 		storeService store.KVStoreService
 		logger       log.Logger
 
-		bankKeeper types.BankKeeper
+		accountKeeper  types.AccountKeeper
+		bankKeeper     types.BankKeeper
+		lienKeeper     types.LienKeeper
+		propertyKeeper types.PropertyKeeper
+
+		Schema      collections.Schema
+		MintInfos   collections.Map[string, types.MintInfo]
+		MintReviews collections.KeySet[collections.Pair[time.Time, string]]
+		MinterRoles collections.Map[string, types.Minter]
+		Frozen      collections.KeySet[string]
+		Pause       collections.Item[bool]
+
+		AttestorRoles       collections.KeySet[string]
+		ReserveAttestations collections.Map[uint64, types.ReserveAttestation]
+		AttestationCount    collections.Sequence
+
+		SavingsVault    collections.Item[types.Vault]
+		Withdrawals     collections.Map[uint64, types.WithdrawalRequest]
+		WithdrawalCount collections.Sequence
+		BorrowerLoans   collections.Map[string, types.VaultLoan]
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -29,7 +50,10 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	logger log.Logger,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	lienKeeper types.LienKeeper,
+	propertyKeeper types.PropertyKeeper,
 	authority string,
 
 ) Keeper {
@@ -37,13 +61,40 @@ func NewKeeper(
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		authority:    authority,
-		logger:       logger,
-		bankKeeper:   bankKeeper,
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		authority:      authority,
+		logger:         logger,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		lienKeeper:     lienKeeper,
+		propertyKeeper: propertyKeeper,
+
+		MintInfos:   collections.NewMap(sb, types.MintInfoPrefix, "mint_info", collections.StringKey, codec.CollValue[types.MintInfo](cdc)),
+		MintReviews: collections.NewKeySet(sb, types.MintReviewPrefix, "mint_reviews", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		MinterRoles: collections.NewMap(sb, types.MinterPrefix, "minters", collections.StringKey, codec.CollValue[types.Minter](cdc)),
+		Frozen:      collections.NewKeySet(sb, types.FrozenPrefix, "frozen", collections.StringKey),
+		Pause:       collections.NewItem(sb, types.PausedPrefix, "paused", collections.BoolValue),
+
+		AttestorRoles:       collections.NewKeySet(sb, types.AttestorPrefix, "attestors", collections.StringKey),
+		ReserveAttestations: collections.NewMap(sb, types.AttestationPrefix, "attestations", collections.Uint64Key, codec.CollValue[types.ReserveAttestation](cdc)),
+		AttestationCount:    collections.NewSequence(sb, types.AttestationCountPrefix, "attestation_count"),
+
+		SavingsVault:    collections.NewItem(sb, types.VaultPrefix, "vault", codec.CollValue[types.Vault](cdc)),
+		Withdrawals:     collections.NewMap(sb, types.WithdrawalPrefix, "withdrawals", collections.Uint64Key, codec.CollValue[types.WithdrawalRequest](cdc)),
+		WithdrawalCount: collections.NewSequence(sb, types.WithdrawalCountPrefix, "withdrawal_count"),
+		BorrowerLoans:   collections.NewMap(sb, types.VaultLoanPrefix, "vault_loans", collections.StringKey, codec.CollValue[types.VaultLoan](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
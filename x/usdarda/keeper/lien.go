@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// propertyLien returns the lien usdarda holds on a property.
+func (k Keeper) propertyLien(ctx context.Context, propertyId string) (lientypes.Lien, bool) {
+	for _, lien := range k.lienKeeper.GetPropertyLiens(ctx, propertyId) {
+		if lien.Module == types.ModuleName && lien.Reference == propertyId {
+			return lien, true
+		}
+	}
+	return lientypes.Lien{}, false
+}
+
+// syncPropertyLien keeps the lien usdarda holds on a property in line with the
+// usdarda owed against it, stability fees included. The lien is placed on the
+// first mint and released once everything owed has been burned.
+func (k Keeper) syncPropertyLien(ctx context.Context, propertyId string, outstanding uint64) error {
+	lien, found := k.propertyLien(ctx, propertyId)
+	switch {
+	case !found && outstanding > 0:
+		_, err := k.lienKeeper.PlaceLien(ctx, lientypes.Lien{
+			PropertyId: propertyId,
+			Module:     types.ModuleName,
+			Holder:     authtypes.NewModuleAddress(types.ModuleName).String(),
+			Amount:     outstanding,
+			Reference:  propertyId,
+		})
+		return err
+	case found && outstanding == 0:
+		return k.lienKeeper.ReleaseLien(ctx, types.ModuleName, lien.Id)
+	case found:
+		return k.lienKeeper.UpdateLienAmount(ctx, types.ModuleName, lien.Id, outstanding)
+	}
+	return nil
+}
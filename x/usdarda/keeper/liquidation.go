@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// Liquidate burns up to amount of the liquidator's usdarda to repay what is
+// owed against a property in liquidation. In return the liquidator receives
+// shares of the other owners, priced at the property value less the
+// liquidation discount and taken from each owner in proportion to their
+// holding. Only whole shares are bought, so less than amount may be repaid.
+func (k Keeper) Liquidate(ctx sdk.Context, property propertytypes.Property, liquidator sdk.AccAddress, amount uint64) (repaid uint64, shares uint64, err error) {
+	info, found := k.GetMintInfo(ctx, property.Index)
+	if !found || !info.Liquidating {
+		return 0, 0, errorsmod.Wrap(types.ErrNotLiquidating, property.Index)
+	}
+	discount, err := math.LegacyNewDecFromStr(k.GetParams(ctx).LiquidationDiscount)
+	if err != nil {
+		return 0, 0, err
+	}
+	price := math.LegacyNewDecFromInt(math.NewIntFromUint64(k.propertyKeeper.CurrentValue(ctx, property))).
+		QuoInt64(100).
+		Mul(math.LegacyOneDec().Sub(discount))
+	if !price.IsPositive() {
+		return 0, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "property %s has no value", property.Index)
+	}
+
+	var owners []string
+	var holdings []uint64
+	var held uint64
+	for i, owner := range property.Owners {
+		if owner == liquidator.String() || i >= len(property.Shares) {
+			continue
+		}
+		owners = append(owners, owner)
+		holdings = append(holdings, property.Shares[i])
+		held += property.Shares[i]
+	}
+
+	amount = min(amount, info.Debt())
+	shares = min(math.LegacyNewDecFromInt(math.NewIntFromUint64(amount)).Quo(price).TruncateInt().Uint64(), held)
+	if shares == 0 {
+		return 0, 0, errorsmod.Wrapf(types.ErrLiquidationTooSmall, "%d usdarda at %s a share of %s", amount, price, property.Index)
+	}
+	repaid = min(price.MulInt64(int64(shares)).Ceil().TruncateInt().Uint64(), amount)
+
+	if err := k.collect(ctx, liquidator, info, repaid); err != nil {
+		return 0, 0, err
+	}
+	for i, taken := range proportionalShares(holdings, shares) {
+		if taken == 0 {
+			continue
+		}
+		owner, err := sdk.AccAddressFromBech32(owners[i])
+		if err != nil {
+			return 0, 0, err
+		}
+		// The shares pass through the module account, which holds the lien on
+		// the property
+		coins := sdk.NewCoins(sdk.NewCoin(propertytypes.PropertyShareDenom(property.Index), math.NewIntFromUint64(taken)))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+			return 0, 0, errorsmod.Wrap(err, "failed to take shares")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, liquidator, coins); err != nil {
+			return 0, 0, errorsmod.Wrap(err, "failed to transfer shares")
+		}
+		if err := k.propertyKeeper.ReassignShares(ctx, property.Index, owners[i], liquidator.String(), taken); err != nil {
+			return 0, 0, err
+		}
+	}
+	if err := k.repay(ctx, info, repaid); err != nil {
+		return 0, 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventLiquidated{
+		PropertyId: property.Index,
+		Liquidator: liquidator.String(),
+		Repaid:     repaid,
+		Shares:     shares,
+	}); err != nil {
+		return 0, 0, err
+	}
+	return repaid, shares, nil
+}
+
+// proportionalShares divides amount shares among holdings in proportion to
+// their size, rounding so that the parts add up to amount.
+func proportionalShares(holdings []uint64, amount uint64) []uint64 {
+	var total uint64
+	for _, held := range holdings {
+		total += held
+	}
+
+	parts := make([]uint64, len(holdings))
+	if total == 0 {
+		return parts
+	}
+	var cumulative, allotted uint64
+	for i, held := range holdings {
+		cumulative += held
+		upTo := cumulative * amount / total
+		parts[i] = upTo - allotted
+		allotted = upTo
+	}
+	return parts
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	oracletypes "github.com/ardaglobal/arda-poc/x/oracle/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestStabilityFeeAndLiquidation(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, bk := f.Keeper, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	owner, coOwner, liquidator := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.Ctx.WithBlockTime(start)
+
+	property := propertytypes.Property{
+		Index:  "p1",
+		Value:  1000,
+		Owners: []string{owner, coOwner},
+		Shares: []uint64{60, 40},
+	}
+	f.PropertyKeeper.SetProperty(ctx, property)
+	shareDenom := propertytypes.PropertyShareDenom("p1")
+	bk.Fund(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 60)))
+	bk.Fund(sdk.MustAccAddressFromBech32(coOwner), sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 40)))
+	lien := func() uint64 {
+		liens := f.LienKeeper.GetPropertyLiens(ctx, "p1")
+		require.Len(t, liens, 1)
+		return liens[0].Amount
+	}
+
+	// The property must be worth the min collateral ratio of what is owed
+	_, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 667, []string{coOwner}))
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 600, []string{coOwner}))
+	require.NoError(t, err)
+
+	// A year of the stability fee is owed on top and held by the lien
+	ctx = ctx.WithBlockTime(start.AddDate(1, 0, 0))
+	require.NoError(t, k.BeginBlocker(ctx))
+	info, found := k.GetMintInfo(ctx, "p1")
+	require.True(t, found)
+	require.Equal(t, uint64(12), info.StabilityFees)
+	require.False(t, info.Liquidating)
+	require.Equal(t, uint64(612), lien())
+	_, err = srv.Liquidate(ctx, types.NewMsgLiquidate(liquidator, "p1", 100))
+	require.ErrorIs(t, err, types.ErrNotLiquidating)
+
+	// A drop in value below the liquidation threshold opens a liquidation
+	property.Value = 700
+	f.PropertyKeeper.SetProperty(ctx, property)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MintReviewPeriod))
+	require.NoError(t, k.BeginBlocker(ctx))
+	liquidations, err := k.Liquidations(ctx, &types.QueryLiquidationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, liquidations.MintInfo, 1)
+	require.Equal(t, "p1", liquidations.MintInfo[0].PropertyId)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 1, []string{coOwner}))
+	require.ErrorIs(t, err, types.ErrLiquidating)
+
+	// Shares go for 7 usdarda less the 10% discount and are taken pro-rata
+	bk.Fund(sdk.MustAccAddressFromBech32(liquidator), sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, 300)))
+	_, err = srv.Liquidate(ctx, types.NewMsgLiquidate(liquidator, "p1", 6))
+	require.ErrorIs(t, err, types.ErrLiquidationTooSmall)
+	res, err := srv.Liquidate(ctx, types.NewMsgLiquidate(liquidator, "p1", 100))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgLiquidateResponse{Repaid: 95, Shares: 15}, res)
+	require.Equal(t, int64(205), bk.Balances[liquidator].AmountOf(types.USDArdaDenom).Int64())
+	require.Equal(t, int64(15), bk.Balances[liquidator].AmountOf(shareDenom).Int64())
+	require.Equal(t, int64(51), bk.Balances[owner].AmountOf(shareDenom).Int64())
+	require.Equal(t, int64(34), bk.Balances[coOwner].AmountOf(shareDenom).Int64())
+	property, _ = f.PropertyKeeper.GetProperty(ctx, "p1")
+	require.ElementsMatch(t, []string{owner, coOwner, liquidator}, property.Owners)
+
+	// The fees are repaid first, and the liquidation closes once the property
+	// is back above the threshold
+	info, _ = k.GetMintInfo(ctx, "p1")
+	require.Zero(t, info.StabilityFees)
+	require.Equal(t, uint64(83), info.Burned)
+	require.Equal(t, uint64(517), lien())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MintReviewPeriod))
+	require.NoError(t, k.BeginBlocker(ctx))
+	info, _ = k.GetMintInfo(ctx, "p1")
+	require.False(t, info.Liquidating)
+}
+
+func TestOracleValuation(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, ok := f.Keeper, f.OracleKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	owner := sample.AccAddress()
+	ctx := f.Ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{
+		Index:          "p1",
+		Region:         "dubai",
+		Value:          1000,
+		ValuationIndex: "100",
+		Owners:         []string{owner},
+		Shares:         []uint64{100},
+	})
+	_, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 600, nil))
+	require.NoError(t, err)
+
+	// A fall in the region index takes the property below the liquidation threshold,
+	// only once the property's review is due
+	ok.SetRegionIndex(ctx, oracletypes.RegionIndex{Region: "dubai", Index: "70"})
+	require.NoError(t, k.BeginBlocker(ctx))
+	info, _ := k.GetMintInfo(ctx, "p1")
+	require.False(t, info.Liquidating)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MintReviewPeriod))
+	require.NoError(t, k.BeginBlocker(ctx))
+	info, _ = k.GetMintInfo(ctx, "p1")
+	require.True(t, info.Liquidating)
+
+	// A higher valuation of the property closes the liquidation and raises the mint limit
+	ok.SetPropertyValuation(ctx, oracletypes.PropertyValuation{PropertyId: "p1", Value: 1500})
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.MintReviewPeriod))
+	require.NoError(t, k.BeginBlocker(ctx))
+	info, _ = k.GetMintInfo(ctx, "p1")
+	require.False(t, info.Liquidating)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 401, nil))
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 400, nil))
+	require.NoError(t, err)
+}
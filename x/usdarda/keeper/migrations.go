@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 registers the bank metadata of the USDArda denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetDenomMetadata(ctx)
+	return nil
+}
+
+// Migrate2to3 places a lien on every property with usdarda outstanding against
+// it.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	infos, err := m.legacyMintInfos(ctx)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info.Minted <= info.Burned {
+			continue
+		}
+		if err := m.keeper.syncPropertyLien(ctx, info.PropertyId, info.Minted-info.Burned); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyMintInfo is the JSON encoding mint info was stored with before v4.
+type legacyMintInfo struct {
+	PropertyId string
+	Minted     uint64
+	Burned     uint64
+}
+
+// legacyMintInfos reads the mint info stored as JSON before v4.
+func (m Migrator) legacyMintInfos(ctx sdk.Context) ([]types.MintInfo, error) {
+	storeAdapter := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte(types.MintInfoKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var infos []types.MintInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var legacy legacyMintInfo
+		if err := json.Unmarshal(iterator.Value(), &legacy); err != nil {
+			return nil, err
+		}
+		infos = append(infos, types.MintInfo{
+			PropertyId: legacy.PropertyId,
+			Minted:     legacy.Minted,
+			Burned:     legacy.Burned,
+		})
+	}
+	return infos, nil
+}
+
+// Migrate3to4 re-encodes the mint info stored as JSON in protobuf. The keys do
+// not change.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	infos, err := m.legacyMintInfos(ctx)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		m.keeper.SetMintInfo(ctx, info)
+	}
+	return nil
+}
+
+// Migrate4to5 sets the collateral, stability fee and liquidation params and
+// starts the stability fee on the usdarda outstanding.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if err := m.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+	for _, info := range m.keeper.GetAllMintInfo(ctx) {
+		info.FeesAccruedAt = ctx.BlockTime()
+		m.keeper.SetMintInfo(ctx, info)
+	}
+	return nil
+}
+
+// Migrate5to6 sets the min reserve coverage param.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MinReserveCoverage = types.DefaultMinReserveCoverage
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate6to7 queues the review of every property with usdarda owed against
+// it, which used to happen every block.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, info := range m.keeper.GetAllMintInfo(ctx) {
+		info.ReviewTime = ctx.BlockTime()
+		m.keeper.SetMintInfo(ctx, info)
+	}
+	return nil
+}
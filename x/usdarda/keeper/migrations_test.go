@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	store := f.StoreService.OpenKVStore(f.Ctx)
+	for _, legacy := range []map[string]any{
+		{"PropertyId": "p1", "Minted": 500, "Burned": 200},
+		{"PropertyId": "p2", "Minted": 100, "Burned": 0},
+	} {
+		bz, err := json.Marshal(legacy)
+		require.NoError(t, err)
+		require.NoError(t, store.Set([]byte(types.MintInfoKeyPrefix+legacy["PropertyId"].(string)), bz))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.Keeper).Migrate3to4(f.Ctx))
+	require.Equal(t, []types.MintInfo{
+		{PropertyId: "p1", Minted: 500, Burned: 200},
+		{PropertyId: "p2", Minted: 100},
+	}, f.Keeper.GetAllMintInfo(f.Ctx))
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	ctx := f.Ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.Keeper.SetParams(ctx, types.Params{}))
+	f.Keeper.SetMintInfo(ctx, types.MintInfo{PropertyId: "p1", Minted: 500, Burned: 200})
+
+	require.NoError(t, keeper.NewMigrator(f.Keeper).Migrate4to5(ctx))
+	require.Equal(t, types.DefaultParams(), f.Keeper.GetParams(ctx))
+	require.Equal(t, []types.MintInfo{
+		{PropertyId: "p1", Minted: 500, Burned: 200, FeesAccruedAt: ctx.BlockTime()},
+	}, f.Keeper.GetAllMintInfo(ctx))
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	params := types.DefaultParams()
+	params.MinReserveCoverage = ""
+	require.NoError(t, f.Keeper.SetParams(f.Ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.Keeper).Migrate5to6(f.Ctx))
+	require.Equal(t, types.DefaultParams(), f.Keeper.GetParams(f.Ctx))
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	ctx := f.Ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	f.Keeper.SetMintInfo(ctx, types.MintInfo{PropertyId: "p1", Minted: 100})
+
+	require.NoError(t, keeper.NewMigrator(f.Keeper).Migrate6to7(ctx))
+	info, found := f.Keeper.GetMintInfo(ctx, "p1")
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), info.ReviewTime)
+	has, err := f.Keeper.MintReviews.Has(ctx, collections.Join(ctx.BlockTime(), "p1"))
+	require.NoError(t, err)
+	require.True(t, has)
+}
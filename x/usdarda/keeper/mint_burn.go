@@ -1,9 +1,8 @@
 package keeper
 
 import (
-	"encoding/json"
-	"fmt"
-
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
 	usdtypes "github.com/ardaglobal/arda-poc/x/usdarda/types"
@@ -12,42 +11,87 @@ import (
 
 // GetMintInfo retrieves mint info for a property
 func (k Keeper) GetMintInfo(ctx sdk.Context, propertyId string) (usdtypes.MintInfo, bool) {
-	store := k.storeService.OpenKVStore(ctx)
-	key := append([]byte(usdtypes.MintInfoKeyPrefix), []byte(propertyId)...)
-	bz, err := store.Get(key)
-	if err != nil || bz == nil {
+	info, err := k.MintInfos.Get(ctx, propertyId)
+	if err != nil {
 		return usdtypes.MintInfo{}, false
 	}
-	var info usdtypes.MintInfo
-	if err := json.Unmarshal(bz, &info); err != nil {
-		panic(err)
-	}
 	return info, true
 }
 
-// setMintInfo stores mint info
-func (k Keeper) setMintInfo(ctx sdk.Context, info usdtypes.MintInfo) {
-	store := k.storeService.OpenKVStore(ctx)
-	key := append([]byte(usdtypes.MintInfoKeyPrefix), []byte(info.PropertyId)...)
-	bz, err := json.Marshal(&info)
-	if err != nil {
+// SetMintInfo stores mint info and moves its entry in the review queue to its
+// review time
+func (k Keeper) SetMintInfo(ctx sdk.Context, info usdtypes.MintInfo) {
+	k.dequeueMintReview(ctx, info.PropertyId)
+	if !info.ReviewTime.IsZero() {
+		if err := k.MintReviews.Set(ctx, collections.Join(info.ReviewTime, info.PropertyId)); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.MintInfos.Set(ctx, info.PropertyId, info); err != nil {
 		panic(err)
 	}
-	store.Set(key, bz)
 }
 
-// deleteMintInfo removes mint info
+// deleteMintInfo removes mint info and its review
 func (k Keeper) deleteMintInfo(ctx sdk.Context, propertyId string) {
-	store := k.storeService.OpenKVStore(ctx)
-	key := append([]byte(usdtypes.MintInfoKeyPrefix), []byte(propertyId)...)
-	store.Delete(key)
+	k.dequeueMintReview(ctx, propertyId)
+	if err := k.MintInfos.Remove(ctx, propertyId); err != nil {
+		panic(err)
+	}
+}
+
+// dequeueMintReview removes the stored mint info of a property from the review
+// queue
+func (k Keeper) dequeueMintReview(ctx sdk.Context, propertyId string) {
+	stored, found := k.GetMintInfo(ctx, propertyId)
+	if !found || stored.ReviewTime.IsZero() {
+		return
+	}
+	if err := k.MintReviews.Remove(ctx, collections.Join(stored.ReviewTime, propertyId)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllMintInfo returns the mint info of every property with usdarda minted
+// against it
+func (k Keeper) GetAllMintInfo(ctx sdk.Context) (list []usdtypes.MintInfo) {
+	iterator, err := k.MintInfos.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	list, err = iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return list
 }
 
 // Mint mints usdarda for the given property and amount, distributing to owners by share
 func (k Keeper) Mint(ctx sdk.Context, property propertytypes.Property, amount uint64) error {
 	info, _ := k.GetMintInfo(ctx, property.Index)
-	if info.Minted-info.Burned+amount > property.Value {
-		return fmt.Errorf("mint exceeds allowed limit")
+	if info.Liquidating {
+		return errorsmod.Wrapf(usdtypes.ErrLiquidating, "cannot mint against %s", property.Index)
+	}
+	if err := k.assertReserveCoverage(ctx); err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	ratio, err := math.LegacyNewDecFromStr(params.MinCollateralRatio)
+	if err != nil {
+		return err
+	}
+	fee, err := math.LegacyNewDecFromStr(params.StabilityFee)
+	if err != nil {
+		return err
+	}
+	// The fee on the new amount starts now
+	info.AccrueStabilityFee(fee, ctx.BlockTime())
+	info.FeesAccruedAt = ctx.BlockTime()
+	after := info
+	after.Minted += amount
+	value := k.propertyKeeper.CurrentValue(ctx, property)
+	if after.Undercollateralized(value, ratio) {
+		return errorsmod.Wrapf(usdtypes.ErrMintLimitExceeded, "%d owed against %s worth %d", after.Debt(), property.Index, value)
 	}
 	denom := usdtypes.USDArdaDenom
 	for i, owner := range property.Owners {
@@ -73,8 +117,19 @@ func (k Keeper) Mint(ctx sdk.Context, property propertytypes.Property, amount ui
 	}
 	info.PropertyId = property.Index
 	info.Minted += amount
-	k.setMintInfo(ctx, info)
-	return nil
+	if info.ReviewTime.IsZero() {
+		info.ReviewTime = ctx.BlockTime().Add(usdtypes.MintReviewPeriod)
+	}
+	k.SetMintInfo(ctx, info)
+	if err := k.syncPropertyLien(ctx, property.Index, info.Debt()); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&usdtypes.EventUSDArdaMinted{
+		PropertyId: property.Index,
+		Amount:     amount,
+		Debt:       info.Debt(),
+	})
 }
 
 // MintToAddress mints amount of usdarda to a single address
@@ -82,6 +137,9 @@ func (k Keeper) MintToAddress(ctx sdk.Context, addr sdk.AccAddress, amount uint6
 	if amount == 0 {
 		return nil
 	}
+	if err := k.assertTransferable(ctx, addr); err != nil {
+		return err
+	}
 	denom := usdtypes.USDArdaDenom
 	coin := sdk.NewCoin(denom, math.NewInt(int64(amount)))
 	if err := k.bankKeeper.MintCoins(ctx, usdtypes.ModuleName, sdk.NewCoins(coin)); err != nil {
@@ -106,22 +164,50 @@ func (k Keeper) BurnFromAddress(ctx sdk.Context, addr sdk.AccAddress, amount uin
 // Burn burns usdarda from an account for a property
 func (k Keeper) Burn(ctx sdk.Context, property propertytypes.Property, burner sdk.AccAddress, amount uint64) error {
 	info, found := k.GetMintInfo(ctx, property.Index)
-	if !found || info.Minted-info.Burned < amount {
-		return fmt.Errorf("insufficient minted amount")
+	if !found || info.Debt() < amount {
+		return errorsmod.Wrapf(usdtypes.ErrInsufficientMinted, "%d owed against %s", info.Debt(), property.Index)
 	}
-	denom := usdtypes.USDArdaDenom
-	coin := sdk.NewCoin(denom, math.NewInt(int64(amount)))
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burner, usdtypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+	if err := k.collect(ctx, burner, info, amount); err != nil {
 		return err
 	}
-	if err := k.bankKeeper.BurnCoins(ctx, usdtypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+	if err := k.repay(ctx, info, amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&usdtypes.EventUSDArdaBurned{
+		PropertyId:    property.Index,
+		Burner:        burner.String(),
+		Amount:        amount,
+		StabilityFees: min(amount, info.StabilityFees),
+		Debt:          info.Debt() - amount,
+	})
+}
+
+// collect takes usdarda repaid against a property from the payer. The part
+// paying stability fees goes to the savings vault as yield and the rest is
+// burned. Without vault shares the fees are burned too.
+func (k Keeper) collect(ctx sdk.Context, payer sdk.AccAddress, info usdtypes.MintInfo, amount uint64) error {
+	fees := min(amount, info.StabilityFees)
+	paid, err := k.AddVaultYield(ctx, payer, fees)
+	if err != nil {
 		return err
 	}
-	info.Burned += amount
-	if info.Burned >= info.Minted {
-		k.deleteMintInfo(ctx, property.Index)
-	} else {
-		k.setMintInfo(ctx, info)
+	if paid {
+		amount -= fees
+	}
+	return k.BurnFromAddress(ctx, payer, amount)
+}
+
+// repay books burned usdarda against what is owed on a property, stability
+// fees first, and releases the lien once nothing is owed.
+func (k Keeper) repay(ctx sdk.Context, info usdtypes.MintInfo, amount uint64) error {
+	fees := min(amount, info.StabilityFees)
+	info.StabilityFees -= fees
+	info.Burned += amount - fees
+	if info.Debt() == 0 {
+		k.deleteMintInfo(ctx, info.PropertyId)
+		return k.syncPropertyLien(ctx, info.PropertyId, 0)
 	}
-	return nil
+	k.SetMintInfo(ctx, info)
+	return k.syncPropertyLien(ctx, info.PropertyId, info.Debt())
 }
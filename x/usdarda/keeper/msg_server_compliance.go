@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// checkAuthority returns an error unless the signer is the module's authority.
+func (k msgServer) checkAuthority(signer string) error {
+	if k.GetAuthority() != signer {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), signer)
+	}
+	return nil
+}
+
+func (k msgServer) ConfigureMinter(goCtx context.Context, msg *types.MsgConfigureMinter) (*types.MsgConfigureMinterResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetMinter(ctx, types.Minter{
+		Address:       msg.Address,
+		MintAllowance: msg.MintAllowance,
+		BurnAllowance: msg.BurnAllowance,
+	})
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMinterConfigured{
+		Authority:     msg.Authority,
+		Minter:        msg.Address,
+		MintAllowance: msg.MintAllowance,
+		BurnAllowance: msg.BurnAllowance,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgConfigureMinterResponse{}, nil
+}
+
+func (k msgServer) RemoveMinter(goCtx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetMinter(ctx, msg.Address); !found {
+		return nil, errorsmod.Wrap(types.ErrNotMinter, msg.Address)
+	}
+	if err := k.MinterRoles.Remove(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Authority: msg.Authority,
+		Minter:    msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveMinterResponse{}, nil
+}
+
+func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	allowance, err := k.MintByMinter(ctx, msg.Minter, recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMinterMinted{
+		Minter:        msg.Minter,
+		Recipient:     msg.Recipient,
+		Amount:        msg.Amount,
+		MintAllowance: allowance,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgMintResponse{MintAllowance: allowance}, nil
+}
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+	allowance, err := k.BurnByMinter(ctx, minter, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMinterBurned{
+		Minter:        msg.Minter,
+		Amount:        msg.Amount,
+		BurnAllowance: allowance,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgBurnResponse{BurnAllowance: allowance}, nil
+}
+
+func (k msgServer) FreezeAddress(goCtx context.Context, msg *types.MsgFreezeAddress) (*types.MsgFreezeAddressResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetFrozen(ctx, msg.Address, true)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddressFrozen{
+		Authority: msg.Authority,
+		Address:   msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgFreezeAddressResponse{}, nil
+}
+
+func (k msgServer) UnfreezeAddress(goCtx context.Context, msg *types.MsgUnfreezeAddress) (*types.MsgUnfreezeAddressResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsFrozen(ctx, msg.Address) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not frozen", msg.Address)
+	}
+	k.SetFrozen(ctx, msg.Address, false)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddressUnfrozen{
+		Authority: msg.Authority,
+		Address:   msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnfreezeAddressResponse{}, nil
+}
+
+func (k msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.Keeper.SetPaused(ctx, msg.Paused)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventPausedSet{
+		Authority: msg.Authority,
+		Paused:    msg.Paused,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetPausedResponse{}, nil
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/pkg/events"
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	mortgagetypes "github.com/ardaglobal/arda-poc/x/mortgage/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestMinterAllowances(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	minter, recipient := sample.AccAddress(), sample.AccAddress()
+	usdarda := func(addr string) int64 { return bk.Balances[addr].AmountOf(types.USDArdaDenom).Int64() }
+
+	_, err := srv.ConfigureMinter(ctx, types.NewMsgConfigureMinter(minter, minter, 100, 50))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, recipient, 10))
+	require.ErrorIs(t, err, types.ErrNotMinter)
+
+	_, err = srv.ConfigureMinter(ctx, types.NewMsgConfigureMinter(authority, minter, 100, 50))
+	require.NoError(t, err)
+	mintRes, err := srv.Mint(ctx, types.NewMsgMint(minter, recipient, 80))
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), mintRes.MintAllowance)
+	require.Equal(t, int64(80), usdarda(recipient))
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, minter, 21))
+	require.ErrorIs(t, err, types.ErrAllowanceExceeded)
+
+	bk.Fund(sdk.MustAccAddressFromBech32(minter), sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, 60)))
+	_, err = srv.Burn(ctx, types.NewMsgBurn(minter, 51))
+	require.ErrorIs(t, err, types.ErrAllowanceExceeded)
+	burnRes, err := srv.Burn(ctx, types.NewMsgBurn(minter, 50))
+	require.NoError(t, err)
+	require.Zero(t, burnRes.BurnAllowance)
+	require.Equal(t, int64(10), usdarda(minter))
+
+	minters, err := k.Minters(ctx, &types.QueryMintersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Minter{{Address: minter, MintAllowance: 20}}, minters.Minters)
+
+	_, err = srv.RemoveMinter(ctx, types.NewMsgRemoveMinter(authority, minter))
+	require.NoError(t, err)
+	_, err = k.Minter(ctx, &types.QueryMinterRequest{Address: minter})
+	require.Error(t, err)
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, recipient, 10))
+	require.ErrorIs(t, err, types.ErrNotMinter)
+}
+
+func TestFreezeAndPause(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	alice, bob := sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bk.Fund(alice, sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, 100), sdk.NewInt64Coin("stake", 100)))
+	usdarda := sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, 10))
+
+	// Frozen addresses can neither send nor receive usdarda
+	_, err := srv.FreezeAddress(ctx, types.NewMsgFreezeAddress(alice.String(), bob.String()))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.FreezeAddress(ctx, types.NewMsgFreezeAddress(authority, bob.String()))
+	require.NoError(t, err)
+	require.ErrorIs(t, bk.SendCoins(ctx, alice, bob, usdarda), types.ErrAddressFrozen)
+	require.NoError(t, bk.SendCoins(ctx, alice, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	frozen, err := k.FrozenAddresses(ctx, &types.QueryFrozenAddressesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{bob.String()}, frozen.Addresses)
+
+	_, err = srv.UnfreezeAddress(ctx, types.NewMsgUnfreezeAddress(authority, bob.String()))
+	require.NoError(t, err)
+	require.NoError(t, bk.SendCoins(ctx, alice, bob, usdarda))
+	_, err = srv.UnfreezeAddress(ctx, types.NewMsgUnfreezeAddress(authority, bob.String()))
+	require.Error(t, err)
+
+	// Pausing halts every usdarda transfer, mints included
+	_, err = srv.SetPaused(ctx, types.NewMsgSetPaused(authority, true))
+	require.NoError(t, err)
+	paused, err := k.Paused(ctx, &types.QueryPausedRequest{})
+	require.NoError(t, err)
+	require.True(t, paused.Paused)
+	require.ErrorIs(t, bk.SendCoins(ctx, alice, bob, usdarda), types.ErrPaused)
+	require.ErrorIs(t, k.MintToAddress(ctx, alice, 10), types.ErrPaused)
+
+	// but not payouts from module accounts, which settle in the block hooks
+	bk.Fund(authtypes.NewModuleAddress(mortgagetypes.ModuleName), usdarda)
+	require.NoError(t, bk.SendCoinsFromModuleToAccount(ctx, mortgagetypes.ModuleName, bob, usdarda))
+
+	_, err = srv.SetPaused(ctx, types.NewMsgSetPaused(authority, false))
+	require.NoError(t, err)
+	require.NoError(t, bk.SendCoins(ctx, alice, bob, usdarda))
+
+	emitted := ctx.EventManager().ABCIEvents()
+	freeze, found, err := events.Find[*types.EventAddressFrozen](emitted)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, &types.EventAddressFrozen{Authority: authority, Address: bob.String()}, freeze)
+	_, found, err = events.Find[*types.EventAddressUnfrozen](emitted)
+	require.NoError(t, err)
+	require.True(t, found)
+	pauses, err := events.Filter[*types.EventPausedSet](emitted)
+	require.NoError(t, err)
+	require.Equal(t, []*types.EventPausedSet{{Authority: authority, Paused: true}, {Authority: authority}}, pauses)
+}
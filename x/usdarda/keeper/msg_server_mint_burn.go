@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k msgServer) MintAgainstProperty(goCtx context.Context, msg *types.MsgMintAgainstProperty) (*types.MsgMintAgainstPropertyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	property, found := k.propertyKeeper.GetProperty(ctx, msg.PropertyId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "property %s not found", msg.PropertyId)
+	}
+	// The lien placed by the mint encumbers the shares of every owner, so every
+	// owner has to sign
+	signers := append([]string{msg.Owner}, msg.CoOwners...)
+	for _, signer := range signers {
+		if !slices.Contains(property.Owners, signer) {
+			return nil, errorsmod.Wrapf(types.ErrNotPropertyOwner, "%s does not own %s", signer, msg.PropertyId)
+		}
+	}
+	for _, owner := range property.Owners {
+		if !slices.Contains(signers, owner) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "co-owner %s of %s must sign the mint", owner, msg.PropertyId)
+		}
+	}
+
+	if err := k.Keeper.Mint(ctx, property, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	info, _ := k.GetMintInfo(ctx, msg.PropertyId)
+	return &types.MsgMintAgainstPropertyResponse{Outstanding: info.Outstanding()}, nil
+}
+
+func (k msgServer) BurnForProperty(goCtx context.Context, msg *types.MsgBurnForProperty) (*types.MsgBurnForPropertyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	property, found := k.propertyKeeper.GetProperty(ctx, msg.PropertyId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "property %s not found", msg.PropertyId)
+	}
+	burner, err := sdk.AccAddressFromBech32(msg.Burner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid burner address (%s)", err)
+	}
+
+	if err := k.Keeper.Burn(ctx, property, burner, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	info, _ := k.GetMintInfo(ctx, msg.PropertyId)
+	return &types.MsgBurnForPropertyResponse{Outstanding: info.Outstanding()}, nil
+}
+
+func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*types.MsgLiquidateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	property, found := k.propertyKeeper.GetProperty(ctx, msg.PropertyId)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "property %s not found", msg.PropertyId)
+	}
+	liquidator, err := sdk.AccAddressFromBech32(msg.Liquidator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidator address (%s)", err)
+	}
+
+	repaid, shares, err := k.Keeper.Liquidate(ctx, property, liquidator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgLiquidateResponse{Repaid: repaid, Shares: shares}, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestMintAndBurnForProperty(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, ctx, bk := f.Keeper, f.Ctx, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	owner, coOwner, buyer := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{
+		Index:  "p1",
+		Value:  1000,
+		Owners: []string{owner, coOwner},
+		Shares: []uint64{60, 40},
+	})
+	bk.Fund(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin(propertytypes.PropertyShareDenom("p1"), 60)))
+	usdarda := func(addr string) int64 { return bk.Balances[addr].AmountOf(types.USDArdaDenom).Int64() }
+
+	_, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(buyer, "p1", 500, nil))
+	require.ErrorIs(t, err, types.ErrNotPropertyOwner)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 500, []string{buyer}))
+	require.ErrorIs(t, err, types.ErrNotPropertyOwner)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p2", 500, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// The lien encumbers the co-owner's shares too, so they must sign
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 500, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The owners are credited by share and the shares are locked
+	mintRes, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 500, []string{coOwner}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), mintRes.Outstanding)
+	require.Equal(t, int64(300), usdarda(owner))
+	require.Equal(t, int64(200), usdarda(coOwner))
+	err = f.PropertyKeeper.SellShares(ctx, "p1", []string{owner}, []uint64{10}, buyer, 0)
+	require.ErrorIs(t, err, lientypes.ErrPropertyEncumbered)
+
+	// No more than the property value can be outstanding
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(coOwner, "p1", 501, []string{owner}))
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+
+	info, err := k.MintInfo(ctx, &types.QueryMintInfoRequest{PropertyId: "p1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMintInfoResponse{PropertyId: "p1", Minted: 500, Outstanding: 500}, info)
+	total, err := k.TotalOutstanding(ctx, &types.QueryTotalOutstandingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryTotalOutstandingResponse{Outstanding: 500, Properties: 1}, total)
+
+	// Anyone holding usdarda can burn it for the property
+	_, err = srv.BurnForProperty(ctx, types.NewMsgBurnForProperty(owner, "p1", 501))
+	require.ErrorIs(t, err, types.ErrInsufficientMinted)
+	burnRes, err := srv.BurnForProperty(ctx, types.NewMsgBurnForProperty(owner, "p1", 300))
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), burnRes.Outstanding)
+	info, err = k.MintInfo(ctx, &types.QueryMintInfoRequest{PropertyId: "p1"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), info.Burned)
+
+	// Burning the rest releases the lock
+	burnRes, err = srv.BurnForProperty(ctx, types.NewMsgBurnForProperty(coOwner, "p1", 200))
+	require.NoError(t, err)
+	require.Zero(t, burnRes.Outstanding)
+	require.Zero(t, usdarda(owner))
+	require.Zero(t, usdarda(coOwner))
+	_, err = k.MintInfo(ctx, &types.QueryMintInfoRequest{PropertyId: "p1"})
+	require.Error(t, err)
+	total, err = k.TotalOutstanding(ctx, &types.QueryTotalOutstandingRequest{})
+	require.NoError(t, err)
+	require.Zero(t, total.Outstanding)
+	require.NoError(t, f.PropertyKeeper.SellShares(ctx, "p1", []string{owner}, []uint64{10}, buyer, 0))
+}
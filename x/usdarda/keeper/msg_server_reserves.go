@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k msgServer) RegisterAttestor(goCtx context.Context, msg *types.MsgRegisterAttestor) (*types.MsgRegisterAttestorResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.AttestorRoles.Set(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttestorRegistered{
+		Authority: msg.Authority,
+		Attestor:  msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterAttestorResponse{}, nil
+}
+
+func (k msgServer) RemoveAttestor(goCtx context.Context, msg *types.MsgRemoveAttestor) (*types.MsgRemoveAttestorResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAttestor(ctx, msg.Address) {
+		return nil, errorsmod.Wrap(types.ErrNotAttestor, msg.Address)
+	}
+	if err := k.AttestorRoles.Remove(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAttestorRemoved{
+		Authority: msg.Authority,
+		Attestor:  msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveAttestorResponse{}, nil
+}
+
+func (k msgServer) AttestReserves(goCtx context.Context, msg *types.MsgAttestReserves) (*types.MsgAttestReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attestation, err := k.RecordAttestation(ctx, msg.Attestor, msg.Reserves, msg.ReportHash, msg.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReservesAttested{Attestation: attestation}); err != nil {
+		return nil, err
+	}
+	return &types.MsgAttestReservesResponse{Id: attestation.Id}, nil
+}
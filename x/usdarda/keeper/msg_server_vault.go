@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k msgServer) DepositToVault(goCtx context.Context, msg *types.MsgDepositToVault) (*types.MsgDepositToVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+
+	shares, err := k.Keeper.DepositToVault(ctx, depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositToVaultResponse{Shares: shares}, nil
+}
+
+func (k msgServer) WithdrawFromVault(goCtx context.Context, msg *types.MsgWithdrawFromVault) (*types.MsgWithdrawFromVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	return k.Keeper.WithdrawFromVault(ctx, owner, msg.Shares)
+}
+
+func (k msgServer) LendFromVault(goCtx context.Context, msg *types.MsgLendFromVault) (*types.MsgLendFromVaultResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address (%s)", err)
+	}
+
+	if err := k.Keeper.LendFromVault(ctx, borrower, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgLendFromVaultResponse{}, nil
+}
+
+func (k msgServer) RepayVaultLoan(goCtx context.Context, msg *types.MsgRepayVaultLoan) (*types.MsgRepayVaultLoanResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	borrower, err := sdk.AccAddressFromBech32(msg.Borrower)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address (%s)", err)
+	}
+
+	principal, err := k.Keeper.RepayVaultLoan(ctx, borrower, msg.Principal, msg.Interest)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRepayVaultLoanResponse{Principal: principal}, nil
+}
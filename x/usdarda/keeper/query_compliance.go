@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k Keeper) Minter(ctx context.Context, req *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	minter, found := k.GetMinter(ctx, req.Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMinterResponse{Minter: minter}, nil
+}
+
+func (k Keeper) Minters(ctx context.Context, req *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	minters, pageRes, err := query.CollectionPaginate(ctx, k.MinterRoles, req.Pagination,
+		func(_ string, minter types.Minter) (types.Minter, error) { return minter, nil },
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintersResponse{Minters: minters, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(ctx, k.Frozen, req.Pagination,
+		func(address string, _ collections.NoValue) (string, error) { return address, nil },
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Paused(ctx context.Context, req *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryPausedResponse{Paused: k.IsPaused(ctx)}, nil
+}
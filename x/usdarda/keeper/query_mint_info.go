@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k Keeper) MintInfo(goCtx context.Context, req *types.QueryMintInfoRequest) (*types.QueryMintInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	info, found := k.GetMintInfo(sdk.UnwrapSDKContext(goCtx), req.PropertyId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMintInfoResponse{
+		PropertyId:    info.PropertyId,
+		Minted:        info.Minted,
+		Burned:        info.Burned,
+		Outstanding:   info.Outstanding(),
+		StabilityFees: info.StabilityFees,
+		Liquidating:   info.Liquidating,
+	}, nil
+}
+
+func (k Keeper) TotalOutstanding(goCtx context.Context, req *types.QueryTotalOutstandingRequest) (*types.QueryTotalOutstandingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res := &types.QueryTotalOutstandingResponse{}
+	for _, info := range k.GetAllMintInfo(sdk.UnwrapSDKContext(goCtx)) {
+		res.Outstanding += info.Outstanding()
+		res.StabilityFees += info.StabilityFees
+		res.Properties++
+	}
+	return res, nil
+}
+
+func (k Keeper) Liquidations(ctx context.Context, req *types.QueryLiquidationsRequest) (*types.QueryLiquidationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	infos, pageRes, err := query.CollectionFilteredPaginate(ctx, k.MintInfos, req.Pagination,
+		func(_ string, info types.MintInfo) (bool, error) { return info.Liquidating, nil },
+		func(_ string, info types.MintInfo) (types.MintInfo, error) { return info, nil },
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidationsResponse{MintInfo: infos, Pagination: pageRes}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k Keeper) Attestors(ctx context.Context, req *types.QueryAttestorsRequest) (*types.QueryAttestorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryAttestorsResponse{Attestors: k.GetAllAttestors(ctx)}, nil
+}
+
+func (k Keeper) Attestations(ctx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestations, pageRes, err := query.CollectionPaginate(ctx, k.ReserveAttestations, req.Pagination,
+		func(_ uint64, attestation types.ReserveAttestation) (types.ReserveAttestation, error) {
+			return attestation, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ReserveCoverage(goCtx context.Context, req *types.QueryReserveCoverageRequest) (*types.QueryReserveCoverageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := k.reserveCoverage(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func (k Keeper) Vault(ctx context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	vault := k.GetVault(ctx)
+	shares := k.VaultShares(ctx)
+	return &types.QueryVaultResponse{
+		Vault:       vault,
+		TotalAssets: vault.TotalAssets(),
+		Shares:      shares,
+		SharePrice:  vault.SharePrice(shares).String(),
+	}, nil
+}
+
+func (k Keeper) VaultApy(goCtx context.Context, req *types.QueryVaultApyRequest) (*types.QueryVaultApyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault := k.GetVault(ctx)
+	price := vault.SharePrice(k.VaultShares(ctx))
+	apy := vault.Apy
+	if apy == "" {
+		apy = "0"
+	}
+	return &types.QueryVaultApyResponse{
+		Apy:        apy,
+		CurrentApy: vault.GrowthSince(price, ctx.BlockTime()).String(),
+		SharePrice: price.String(),
+		Since:      vault.ReferenceTime,
+	}, nil
+}
+
+func (k Keeper) VaultBalance(ctx context.Context, req *types.QueryVaultBalanceRequest) (*types.QueryVaultBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	shares := k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(types.VaultShareDenom).Uint64()
+	res := &types.QueryVaultBalanceResponse{
+		Shares: shares,
+		Amount: k.GetVault(ctx).AssetsFor(shares, k.VaultShares(ctx)),
+	}
+	for _, request := range k.GetAllWithdrawalRequests(ctx) {
+		if request.Owner == req.Address {
+			res.Queued += request.Amount
+		}
+	}
+	return res, nil
+}
+
+func (k Keeper) WithdrawalRequests(ctx context.Context, req *types.QueryWithdrawalRequestsRequest) (*types.QueryWithdrawalRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	requests, pageRes, err := query.CollectionPaginate(ctx, k.Withdrawals, req.Pagination,
+		func(_ uint64, request types.WithdrawalRequest) (types.WithdrawalRequest, error) {
+			return request, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawalRequestsResponse{Requests: requests, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VaultLoans(ctx context.Context, req *types.QueryVaultLoansRequest) (*types.QueryVaultLoansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	loans, pageRes, err := query.CollectionPaginate(ctx, k.BorrowerLoans, req.Pagination,
+		func(_ string, loan types.VaultLoan) (types.VaultLoan, error) {
+			return loan, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVaultLoansResponse{Loans: loans, Pagination: pageRes}, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// IsAttestor reports whether an address may attest reserves.
+func (k Keeper) IsAttestor(ctx context.Context, address string) bool {
+	registered, err := k.AttestorRoles.Has(ctx, address)
+	if err != nil {
+		panic(err)
+	}
+	return registered
+}
+
+// GetAllAttestors returns every registered attestor.
+func (k Keeper) GetAllAttestors(ctx context.Context) []string {
+	iterator, err := k.AttestorRoles.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	attestors, err := iterator.Keys()
+	if err != nil {
+		panic(err)
+	}
+	return attestors
+}
+
+// SetAttestation stores a reserve attestation.
+func (k Keeper) SetAttestation(ctx context.Context, attestation types.ReserveAttestation) {
+	if err := k.ReserveAttestations.Set(ctx, attestation.Id, attestation); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllAttestations returns every reserve attestation, oldest first.
+func (k Keeper) GetAllAttestations(ctx context.Context) []types.ReserveAttestation {
+	iterator, err := k.ReserveAttestations.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	attestations, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return attestations
+}
+
+// LatestAttestation returns the most recent reserve attestation.
+func (k Keeper) LatestAttestation(ctx context.Context) (types.ReserveAttestation, bool) {
+	iterator, err := k.ReserveAttestations.IterateRaw(ctx, nil, nil, collections.OrderDescending)
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.ReserveAttestation{}, false
+	}
+	attestation, err := iterator.Value()
+	if err != nil {
+		panic(err)
+	}
+	return attestation, true
+}
+
+// RecordAttestation records the reserves an attestor measured at asOf. Reserves
+// cannot be attested for a time before the latest attestation or after the
+// current block.
+func (k Keeper) RecordAttestation(ctx sdk.Context, attestor string, reserves uint64, reportHash string, asOf time.Time) (types.ReserveAttestation, error) {
+	if !k.IsAttestor(ctx, attestor) {
+		return types.ReserveAttestation{}, errorsmod.Wrap(types.ErrNotAttestor, attestor)
+	}
+	if asOf.After(ctx.BlockTime()) {
+		return types.ReserveAttestation{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reserves as of %s are in the future", asOf)
+	}
+	if latest, found := k.LatestAttestation(ctx); found && asOf.Before(latest.AsOf) {
+		return types.ReserveAttestation{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reserves as of %s predate the latest attestation as of %s", asOf, latest.AsOf)
+	}
+
+	id, err := k.AttestationCount.Next(ctx)
+	if err != nil {
+		return types.ReserveAttestation{}, err
+	}
+	attestation := types.ReserveAttestation{
+		Id:         id,
+		Attestor:   attestor,
+		Reserves:   reserves,
+		ReportHash: reportHash,
+		AsOf:       asOf,
+		RecordedAt: ctx.BlockTime(),
+	}
+	k.SetAttestation(ctx, attestation)
+	return attestation, nil
+}
+
+// reserveCoverage compares the usdarda supply with what backs it: the reserves
+// of the latest attestation and the usdarda outstanding against property. With
+// no supply the coverage is one.
+func (k Keeper) reserveCoverage(ctx sdk.Context) (*types.QueryReserveCoverageResponse, error) {
+	minCoverage, err := math.LegacyNewDecFromStr(k.GetParams(ctx).MinReserveCoverage)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryReserveCoverageResponse{
+		Supply:      k.bankKeeper.GetSupply(ctx, types.USDArdaDenom).Amount.Uint64(),
+		MinCoverage: minCoverage.String(),
+	}
+	backing := math.ZeroInt()
+	if latest, found := k.LatestAttestation(ctx); found {
+		res.Latest = &latest
+		backing = backing.Add(math.NewIntFromUint64(latest.Reserves))
+	}
+	for _, info := range k.GetAllMintInfo(ctx) {
+		res.Collateralized += info.Outstanding()
+	}
+	backing = backing.Add(math.NewIntFromUint64(res.Collateralized))
+
+	coverage := math.LegacyOneDec()
+	if res.Supply > 0 {
+		coverage = math.LegacyNewDecFromInt(backing).QuoInt(math.NewIntFromUint64(res.Supply))
+	}
+	res.Coverage = coverage.String()
+	res.MintsBlocked = coverage.LT(minCoverage)
+	return res, nil
+}
+
+// assertReserveCoverage returns an error while the reserve coverage is below
+// the minimum, which blocks new mints.
+func (k Keeper) assertReserveCoverage(ctx sdk.Context) error {
+	coverage, err := k.reserveCoverage(ctx)
+	if err != nil {
+		return err
+	}
+	if coverage.MintsBlocked {
+		return errorsmod.Wrapf(types.ErrReserveShortfall, "coverage %s is below %s", coverage.Coverage, coverage.MinCoverage)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestReserveAttestations(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k := f.Keeper
+	srv := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	attestor, minter, owner := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.Ctx.WithBlockTime(now)
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{Index: "p1", Value: 1000, Owners: []string{owner}, Shares: []uint64{100}})
+	_, err := srv.ConfigureMinter(ctx, types.NewMsgConfigureMinter(authority, minter, 1000, 0))
+	require.NoError(t, err)
+
+	// Only registered attestors attest, and not ahead of time or out of order
+	_, err = srv.AttestReserves(ctx, types.NewMsgAttestReserves(attestor, 300, "hash1", now.AddDate(0, -1, 0)))
+	require.ErrorIs(t, err, types.ErrNotAttestor)
+	_, err = srv.RegisterAttestor(ctx, types.NewMsgRegisterAttestor(authority, attestor))
+	require.NoError(t, err)
+	_, err = srv.AttestReserves(ctx, types.NewMsgAttestReserves(attestor, 300, "hash1", now.Add(time.Hour)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	res, err := srv.AttestReserves(ctx, types.NewMsgAttestReserves(attestor, 300, "hash1", now.AddDate(0, -1, 0)))
+	require.NoError(t, err)
+	require.Zero(t, res.Id)
+	_, err = srv.AttestReserves(ctx, types.NewMsgAttestReserves(attestor, 300, "hash0", now.AddDate(0, -2, 0)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Reserves and property collateral together cover the supply
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, minter, 300))
+	require.NoError(t, err)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 200, nil))
+	require.NoError(t, err)
+	coverage, err := k.ReserveCoverage(ctx, &types.QueryReserveCoverageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), coverage.Supply)
+	require.Equal(t, uint64(200), coverage.Collateralized)
+	require.Equal(t, "hash1", coverage.Latest.ReportHash)
+	require.Equal(t, "1.000000000000000000", coverage.Coverage)
+	require.False(t, coverage.MintsBlocked)
+
+	// Unbacked supply blocks new mints until more reserves are attested
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, minter, 100))
+	require.NoError(t, err)
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, minter, 1))
+	require.ErrorIs(t, err, types.ErrReserveShortfall)
+	_, err = srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 1, nil))
+	require.ErrorIs(t, err, types.ErrReserveShortfall)
+	coverage, err = k.ReserveCoverage(ctx, &types.QueryReserveCoverageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0.833333333333333333", coverage.Coverage)
+	require.True(t, coverage.MintsBlocked)
+
+	_, err = srv.AttestReserves(ctx, types.NewMsgAttestReserves(attestor, 400, "hash2", now))
+	require.NoError(t, err)
+	_, err = srv.Mint(ctx, types.NewMsgMint(minter, minter, 1))
+	require.NoError(t, err)
+
+	attestations, err := k.Attestations(ctx, &types.QueryAttestationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, attestations.Attestations, 2)
+	require.Equal(t, now, attestations.Attestations[1].RecordedAt)
+	require.Equal(t, int64(601), f.BankKeeper.GetSupply(ctx, types.USDArdaDenom).Amount.Int64())
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// BeginBlocker reviews the properties whose review fell due: it accrues the
+// stability fee on the usdarda minted against each and opens or closes a
+// liquidation whenever the property's value crossed the liquidation threshold
+// of what is owed against it. Each property is reviewed once every review
+// period. It also pays queued vault withdrawals that could not be paid before
+// and rolls the vault APY.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if vault := k.GetVault(ctx); vault.Queued > 0 && vault.Liquid > 0 {
+		k.fillWithdrawals(sdkCtx, &vault)
+		k.SetVault(ctx, vault)
+	}
+	k.rollVaultApy(sdkCtx)
+
+	due, err := k.dueMintReviews(sdkCtx)
+	if err != nil || len(due) == 0 {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	fee, err := math.LegacyNewDecFromStr(params.StabilityFee)
+	if err != nil {
+		return err
+	}
+	threshold, err := math.LegacyNewDecFromStr(params.LiquidationThreshold)
+	if err != nil {
+		return err
+	}
+
+	for _, propertyId := range due {
+		info, found := k.GetMintInfo(sdkCtx, propertyId)
+		if !found {
+			continue
+		}
+		accrued := info.AccrueStabilityFee(fee, sdkCtx.BlockTime())
+
+		property, _ := k.propertyKeeper.GetProperty(sdkCtx, info.PropertyId)
+		value := k.propertyKeeper.CurrentValue(ctx, property)
+		if liquidating := info.Undercollateralized(value, threshold); liquidating != info.Liquidating {
+			info.Liquidating = liquidating
+			var event proto.Message = &types.EventLiquidationClosed{PropertyId: info.PropertyId, Debt: info.Debt(), Value: value}
+			if liquidating {
+				event = &types.EventLiquidationOpened{PropertyId: info.PropertyId, Debt: info.Debt(), Value: value}
+			}
+			if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+				return err
+			}
+		}
+
+		info.ReviewTime = sdkCtx.BlockTime().Add(types.MintReviewPeriod)
+		k.SetMintInfo(sdkCtx, info)
+		if accrued {
+			if err := k.syncPropertyLien(ctx, info.PropertyId, info.Debt()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// dueMintReviews returns the properties whose review is due at the block time,
+// in the order they fell due.
+func (k Keeper) dueMintReviews(ctx sdk.Context) ([]string, error) {
+	iterator, err := k.MintReviews.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var due []string
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.Key()
+		if err != nil {
+			return nil, err
+		}
+		if key.K1().After(ctx.BlockTime()) {
+			break
+		}
+		due = append(due, key.K2())
+	}
+	return due, nil
+}
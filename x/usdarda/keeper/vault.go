@@ -0,0 +1,350 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+// GetVault returns the savings vault.
+func (k Keeper) GetVault(ctx context.Context) types.Vault {
+	vault, err := k.SavingsVault.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Vault{}
+	}
+	if err != nil {
+		panic(err)
+	}
+	return vault
+}
+
+// SetVault stores the savings vault.
+func (k Keeper) SetVault(ctx context.Context, vault types.Vault) {
+	if err := k.SavingsVault.Set(ctx, vault); err != nil {
+		panic(err)
+	}
+}
+
+// SetWithdrawalRequest stores a queued vault withdrawal.
+func (k Keeper) SetWithdrawalRequest(ctx context.Context, request types.WithdrawalRequest) {
+	if err := k.Withdrawals.Set(ctx, request.Id, request); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllWithdrawalRequests returns the queued vault withdrawals, oldest first.
+func (k Keeper) GetAllWithdrawalRequests(ctx context.Context) []types.WithdrawalRequest {
+	iterator, err := k.Withdrawals.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	requests, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return requests
+}
+
+// GetVaultLoan returns the vault loan of a borrower.
+func (k Keeper) GetVaultLoan(ctx context.Context, borrower string) (types.VaultLoan, bool) {
+	loan, err := k.BorrowerLoans.Get(ctx, borrower)
+	if err != nil {
+		return types.VaultLoan{}, false
+	}
+	return loan, true
+}
+
+// SetVaultLoan stores a vault loan.
+func (k Keeper) SetVaultLoan(ctx context.Context, loan types.VaultLoan) {
+	if err := k.BorrowerLoans.Set(ctx, loan.Borrower, loan); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllVaultLoans returns every vault loan.
+func (k Keeper) GetAllVaultLoans(ctx context.Context) []types.VaultLoan {
+	iterator, err := k.BorrowerLoans.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	loans, err := iterator.Values()
+	if err != nil {
+		panic(err)
+	}
+	return loans
+}
+
+// VaultShares returns the vault shares issued.
+func (k Keeper) VaultShares(ctx context.Context) uint64 {
+	return k.bankKeeper.GetSupply(ctx, types.VaultShareDenom).Amount.Uint64()
+}
+
+// SetVaultShareMetadata registers the bank metadata of the vault share denom so
+// wallets can display it.
+func (k Keeper) SetVaultShareMetadata(ctx context.Context) {
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "Shares of the USDArda savings vault",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: types.VaultShareDenom, Exponent: 0},
+		},
+		Base:    types.VaultShareDenom,
+		Display: types.VaultShareDenom,
+		Name:    "Savings USDArda",
+		Symbol:  "SUSDARDA",
+	})
+}
+
+// DepositToVault moves amount of the depositor's usdarda into the savings
+// vault and issues the depositor vault shares at the current share price.
+func (k Keeper) DepositToVault(ctx sdk.Context, depositor sdk.AccAddress, amount uint64) (uint64, error) {
+	vault := k.GetVault(ctx)
+	shares, err := vault.SharesFor(amount, k.VaultShares(ctx))
+	if err != nil {
+		return 0, err
+	}
+	if shares == 0 {
+		return 0, errorsmod.Wrapf(types.ErrTooFewShares, "%d usdarda", amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.VaultAccountName, usdardaCoins(amount)); err != nil {
+		return 0, err
+	}
+	shareCoins := sdk.NewCoins(sdk.NewCoin(types.VaultShareDenom, math.NewIntFromUint64(shares)))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, shareCoins); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, shareCoins); err != nil {
+		return 0, err
+	}
+
+	vault.Liquid += amount
+	k.fillWithdrawals(ctx, &vault)
+	k.SetVault(ctx, vault)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVaultDeposit{
+		Depositor: depositor.String(),
+		Amount:    amount,
+		Shares:    shares,
+	}); err != nil {
+		return 0, err
+	}
+	return shares, nil
+}
+
+// WithdrawFromVault burns vault shares of the owner for the usdarda they are
+// worth. What the vault holds beyond its queued withdrawals is paid out right
+// away and the rest is queued behind them.
+func (k Keeper) WithdrawFromVault(ctx sdk.Context, owner sdk.AccAddress, shares uint64) (*types.MsgWithdrawFromVaultResponse, error) {
+	vault := k.GetVault(ctx)
+	amount := vault.AssetsFor(shares, k.VaultShares(ctx))
+	if amount == 0 {
+		return nil, errorsmod.Wrapf(types.ErrTooFewShares, "%d shares are worth no usdarda", shares)
+	}
+
+	shareCoins := sdk.NewCoins(sdk.NewCoin(types.VaultShareDenom, math.NewIntFromUint64(shares)))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, shareCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareCoins); err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgWithdrawFromVaultResponse{Amount: min(amount, vault.Available())}
+	if res.Amount > 0 {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.VaultAccountName, owner, usdardaCoins(res.Amount)); err != nil {
+			return nil, err
+		}
+		vault.Liquid -= res.Amount
+	}
+	if res.Queued = amount - res.Amount; res.Queued > 0 {
+		id, err := k.WithdrawalCount.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		k.SetWithdrawalRequest(ctx, types.WithdrawalRequest{
+			Id:          id,
+			Owner:       owner.String(),
+			Amount:      res.Queued,
+			RequestedAt: ctx.BlockTime(),
+		})
+		vault.Queued += res.Queued
+		res.RequestId = id
+	}
+	k.SetVault(ctx, vault)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVaultWithdrawal{
+		Owner:     owner.String(),
+		Shares:    shares,
+		Amount:    res.Amount,
+		Queued:    res.Queued,
+		RequestId: res.RequestId,
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// fillWithdrawals pays the queued withdrawals out of the vault's liquid
+// usdarda, oldest first. A withdrawal that cannot be paid in full is paid in
+// part and stays at the front of the queue. A withdrawal whose owner cannot
+// receive usdarda, e.g. because it is frozen, is skipped until it can.
+func (k Keeper) fillWithdrawals(ctx sdk.Context, vault *types.Vault) {
+	for _, request := range k.GetAllWithdrawalRequests(ctx) {
+		if vault.Liquid == 0 {
+			return
+		}
+		paid := min(vault.Liquid, request.Amount)
+		owner, err := sdk.AccAddressFromBech32(request.Owner)
+		if err != nil {
+			panic(err)
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.VaultAccountName, owner, usdardaCoins(paid)); err != nil {
+			k.Logger().Info("cannot pay queued vault withdrawal", "id", request.Id, "error", err)
+			continue
+		}
+		write()
+
+		vault.Liquid -= paid
+		vault.Queued -= paid
+		request.Amount -= paid
+		if request.Amount == 0 {
+			if err := k.Withdrawals.Remove(ctx, request.Id); err != nil {
+				panic(err)
+			}
+		} else {
+			k.SetWithdrawalRequest(ctx, request)
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawalPaid{
+			RequestId: request.Id,
+			Owner:     request.Owner,
+			Amount:    paid,
+			Remaining: request.Amount,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LendFromVault lends amount of the vault's usdarda beyond its queued
+// withdrawals to a borrower.
+func (k Keeper) LendFromVault(ctx sdk.Context, borrower sdk.AccAddress, amount uint64) error {
+	vault := k.GetVault(ctx)
+	if amount > vault.Available() {
+		return errorsmod.Wrapf(types.ErrVaultLiquidity, "%d available to lend", vault.Available())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.VaultAccountName, borrower, usdardaCoins(amount)); err != nil {
+		return err
+	}
+
+	loan, found := k.GetVaultLoan(ctx, borrower.String())
+	if !found {
+		loan = types.VaultLoan{Borrower: borrower.String(), LentAt: ctx.BlockTime()}
+	}
+	loan.Principal += amount
+	k.SetVaultLoan(ctx, loan)
+	vault.Liquid -= amount
+	vault.Lent += amount
+	k.SetVault(ctx, vault)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventVaultLoan{
+		Borrower: borrower.String(),
+		Amount:   amount,
+	})
+}
+
+// RepayVaultLoan pays principal and interest of a borrower's vault loan into
+// the vault and returns the principal still owed. The interest is yield of the
+// vault. A loan repaid in full is closed.
+func (k Keeper) RepayVaultLoan(ctx sdk.Context, borrower sdk.AccAddress, principal uint64, interest uint64) (uint64, error) {
+	loan, found := k.GetVaultLoan(ctx, borrower.String())
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrNoVaultLoan, borrower.String())
+	}
+	if principal > loan.Principal {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "repaying %d exceeds the %d principal owed", principal, loan.Principal)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, borrower, types.VaultAccountName, usdardaCoins(principal+interest)); err != nil {
+		return 0, err
+	}
+
+	loan.Principal -= principal
+	loan.InterestPaid += interest
+	if loan.Principal == 0 {
+		if err := k.BorrowerLoans.Remove(ctx, loan.Borrower); err != nil {
+			return 0, err
+		}
+	} else {
+		k.SetVaultLoan(ctx, loan)
+	}
+
+	vault := k.GetVault(ctx)
+	vault.Liquid += principal + interest
+	vault.Lent -= principal
+	vault.Yield += interest
+	k.fillWithdrawals(ctx, &vault)
+	k.SetVault(ctx, vault)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVaultLoanRepaid{
+		Borrower:  borrower.String(),
+		Principal: principal,
+		Interest:  interest,
+		Remaining: loan.Principal,
+	}); err != nil {
+		return 0, err
+	}
+	return loan.Principal, nil
+}
+
+// AddVaultYield pays amount of usdarda from an account into the savings vault
+// as yield, raising the share price. A vault without shares takes no yield,
+// which would otherwise go to its first depositor; it reports whether the
+// yield was paid.
+func (k Keeper) AddVaultYield(ctx context.Context, from sdk.AccAddress, amount uint64) (bool, error) {
+	if amount == 0 || k.VaultShares(ctx) == 0 {
+		return false, nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.VaultAccountName, usdardaCoins(amount)); err != nil {
+		return false, err
+	}
+
+	vault := k.GetVault(ctx)
+	vault.Liquid += amount
+	vault.Yield += amount
+	k.fillWithdrawals(sdkCtx, &vault)
+	k.SetVault(ctx, vault)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventVaultYield{
+		Payer:  from.String(),
+		Amount: amount,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// rollVaultApy closes the vault's APY window once it has run its course. The
+// first window starts with the first shares.
+func (k Keeper) rollVaultApy(ctx sdk.Context) {
+	shares := k.VaultShares(ctx)
+	if shares == 0 {
+		return
+	}
+	vault := k.GetVault(ctx)
+	if vault.RollApy(vault.SharePrice(shares), ctx.BlockTime()) {
+		k.SetVault(ctx, vault)
+	}
+}
+
+func usdardaCoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.USDArdaDenom, math.NewIntFromUint64(amount)))
+}
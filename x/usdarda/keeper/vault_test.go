@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
+	"github.com/ardaglobal/arda-poc/x/usdarda/keeper"
+	"github.com/ardaglobal/arda-poc/x/usdarda/types"
+)
+
+func TestSavingsVault(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, bk := f.Keeper, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	owner, alice, bob, borrower := sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.Ctx.WithBlockTime(start)
+	usdarda := func(addr string) int64 {
+		return bk.Balances[addr].AmountOf(types.USDArdaDenom).Int64()
+	}
+	fund := func(addr string, amount int64) {
+		bk.Fund(sdk.MustAccAddressFromBech32(addr), sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, amount)))
+	}
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{Index: "p1", Value: 1000, Owners: []string{owner}, Shares: []uint64{100}})
+	_, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 600, nil))
+	require.NoError(t, err)
+
+	// The first deposit buys a share per usdarda and starts the APY window
+	fund(alice, 1000)
+	deposit, err := srv.DepositToVault(ctx, types.NewMsgDepositToVault(alice, 1000))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), deposit.Shares)
+	require.Equal(t, int64(1000), bk.Balances[alice].AmountOf(types.VaultShareDenom).Int64())
+	require.NoError(t, k.BeginBlocker(ctx))
+
+	// Only the authority lends, and only what the vault holds
+	_, err = srv.LendFromVault(ctx, types.NewMsgLendFromVault(alice, borrower, 800))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.LendFromVault(ctx, types.NewMsgLendFromVault(authority, borrower, 1001))
+	require.ErrorIs(t, err, types.ErrVaultLiquidity)
+	_, err = srv.LendFromVault(ctx, types.NewMsgLendFromVault(authority, borrower, 800))
+	require.NoError(t, err)
+	require.Equal(t, int64(800), usdarda(borrower))
+
+	// Stability fees paid on a burn go to the vault instead of being burned
+	ctx = ctx.WithBlockTime(start.AddDate(1, 0, 0))
+	require.NoError(t, k.BeginBlocker(ctx))
+	fund(owner, 12)
+	_, err = srv.BurnForProperty(ctx, types.NewMsgBurnForProperty(owner, "p1", 612))
+	require.NoError(t, err)
+	require.Equal(t, int64(1012), bk.GetSupply(ctx, types.USDArdaDenom).Amount.Int64())
+	vault, err := k.Vault(ctx, &types.QueryVaultRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.Vault{Liquid: 212, Lent: 800, Yield: 12, ReferencePrice: "1.000000000000000000", ReferenceTime: start.AddDate(1, 0, 0), Apy: "0.000000000000000000"}, vault.Vault)
+	require.Equal(t, uint64(1012), vault.TotalAssets)
+	require.Equal(t, "1.012000000000000000", vault.SharePrice)
+
+	// The next window closes with 1.2% of growth over 30 days
+	ctx = ctx.WithBlockTime(start.AddDate(1, 0, 0).Add(types.VaultApyWindow))
+	require.NoError(t, k.BeginBlocker(ctx))
+	apy, err := k.VaultApy(ctx, &types.QueryVaultApyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0.146000000000000000", apy.Apy)
+	require.Equal(t, "0.000000000000000000", apy.CurrentApy)
+	require.Equal(t, "1.012000000000000000", apy.SharePrice)
+	require.Equal(t, ctx.BlockTime(), apy.Since)
+
+	// With most of the vault lent out, the rest of a withdrawal is queued
+	withdrawal, err := srv.WithdrawFromVault(ctx, types.NewMsgWithdrawFromVault(alice, 500))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgWithdrawFromVaultResponse{Amount: 212, Queued: 294, RequestId: 0}, withdrawal)
+	require.Equal(t, int64(212), usdarda(alice))
+	balance, err := k.VaultBalance(ctx, &types.QueryVaultBalanceRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryVaultBalanceResponse{Shares: 500, Amount: 506, Queued: 294}, balance)
+	_, err = srv.LendFromVault(ctx, types.NewMsgLendFromVault(authority, borrower, 1))
+	require.ErrorIs(t, err, types.ErrVaultLiquidity)
+
+	// Deposits pay the queue first, at the unchanged share price
+	fund(bob, 100)
+	deposit, err = srv.DepositToVault(ctx, types.NewMsgDepositToVault(bob, 100))
+	require.NoError(t, err)
+	require.Equal(t, uint64(98), deposit.Shares)
+	require.Equal(t, int64(312), usdarda(alice))
+	requests, err := k.WithdrawalRequests(ctx, &types.QueryWithdrawalRequestsRequest{})
+	require.NoError(t, err)
+	require.Len(t, requests.Requests, 1)
+	require.Equal(t, uint64(194), requests.Requests[0].Amount)
+
+	// Repaying the loan brings the liquidity to pay the rest, and its interest
+	// is yield
+	_, err = srv.RepayVaultLoan(ctx, types.NewMsgRepayVaultLoan(borrower, 801, 0))
+	require.Error(t, err)
+	fund(borrower, 40)
+	repaid, err := srv.RepayVaultLoan(ctx, types.NewMsgRepayVaultLoan(borrower, 800, 40))
+	require.NoError(t, err)
+	require.Zero(t, repaid.Principal)
+	require.Equal(t, int64(506), usdarda(alice))
+	vault, err = k.Vault(ctx, &types.QueryVaultRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(646), vault.Vault.Liquid)
+	require.Zero(t, vault.Vault.Lent)
+	require.Zero(t, vault.Vault.Queued)
+	require.Equal(t, uint64(52), vault.Vault.Yield)
+	require.Equal(t, uint64(598), vault.Shares)
+	requests, err = k.WithdrawalRequests(ctx, &types.QueryWithdrawalRequestsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, requests.Requests)
+	loans, err := k.VaultLoans(ctx, &types.QueryVaultLoansRequest{})
+	require.NoError(t, err)
+	require.Empty(t, loans.Loans)
+	_, err = srv.RepayVaultLoan(ctx, types.NewMsgRepayVaultLoan(borrower, 0, 1))
+	require.ErrorIs(t, err, types.ErrNoVaultLoan)
+}
+
+func TestVaultYieldWithoutShares(t *testing.T) {
+	f := keepertest.NewUsdardaFixture(t)
+	k, bk := f.Keeper, f.BankKeeper
+	srv := keeper.NewMsgServerImpl(k)
+	owner := sample.AccAddress()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := f.Ctx.WithBlockTime(start)
+
+	f.PropertyKeeper.SetProperty(ctx, propertytypes.Property{Index: "p1", Value: 1000, Owners: []string{owner}, Shares: []uint64{100}})
+	_, err := srv.MintAgainstProperty(ctx, types.NewMsgMintAgainstProperty(owner, "p1", 600, nil))
+	require.NoError(t, err)
+
+	// Without depositors the stability fees are burned
+	ctx = ctx.WithBlockTime(start.AddDate(1, 0, 0))
+	require.NoError(t, k.BeginBlocker(ctx))
+	bk.Fund(sdk.MustAccAddressFromBech32(owner), sdk.NewCoins(sdk.NewInt64Coin(types.USDArdaDenom, 12)))
+	_, err = srv.BurnForProperty(ctx, types.NewMsgBurnForProperty(owner, "p1", 612))
+	require.NoError(t, err)
+	require.Zero(t, bk.GetSupply(ctx, types.USDArdaDenom).Amount.Int64())
+	require.Equal(t, types.Vault{}, k.GetVault(ctx))
+}
@@ -17,6 +17,84 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "MintInfo",
+					Use:            "mint-info [property-id]",
+					Short:          "Shows the usdarda minted and burned against a property",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}},
+				},
+				{
+					RpcMethod: "TotalOutstanding",
+					Use:       "total-outstanding",
+					Short:     "Shows the usdarda outstanding against all properties",
+				},
+				{
+					RpcMethod: "Liquidations",
+					Use:       "liquidations",
+					Short:     "Lists the properties in liquidation",
+				},
+				{
+					RpcMethod:      "Minter",
+					Use:            "minter [address]",
+					Short:          "Shows the allowances of a minter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Minters",
+					Use:       "minters",
+					Short:     "Lists the minters",
+				},
+				{
+					RpcMethod: "FrozenAddresses",
+					Use:       "frozen-addresses",
+					Short:     "Lists the addresses that cannot send or receive usdarda",
+				},
+				{
+					RpcMethod: "Paused",
+					Use:       "paused",
+					Short:     "Shows whether usdarda transfers are paused",
+				},
+				{
+					RpcMethod: "Attestors",
+					Use:       "attestors",
+					Short:     "Lists the addresses allowed to attest reserves",
+				},
+				{
+					RpcMethod: "Attestations",
+					Use:       "attestations",
+					Short:     "Lists the reserve attestations",
+				},
+				{
+					RpcMethod: "ReserveCoverage",
+					Use:       "reserve-coverage",
+					Short:     "Compares the latest reserve attestation and the usdarda minted against property with the usdarda supply",
+				},
+				{
+					RpcMethod: "Vault",
+					Use:       "vault",
+					Short:     "Shows the balance of the savings vault and its share price",
+				},
+				{
+					RpcMethod: "VaultApy",
+					Use:       "vault-apy",
+					Short:     "Shows the annualized yield of the savings vault",
+				},
+				{
+					RpcMethod:      "VaultBalance",
+					Use:            "vault-balance [address]",
+					Short:          "Shows the vault shares of an address and the usdarda they are worth",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "WithdrawalRequests",
+					Use:       "withdrawal-requests",
+					Short:     "Lists the vault withdrawals waiting for liquidity",
+				},
+				{
+					RpcMethod: "VaultLoans",
+					Use:       "vault-loans",
+					Short:     "Lists the loans out of the savings vault",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +106,97 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "MintAgainstProperty",
+					Use:            "mint-against-property [property-id] [amount]",
+					Short:          "Mint usdarda against the value of a property you own",
+					Long:           "Mint usdarda against the value of a property you own. Every other owner of the property must be listed with --co-owners and sign the transaction too. The owners are credited in proportion to their shares, and the shares cannot be transferred until everything minted against the property has been burned. The property must stay worth at least the min collateral ratio of what is owed against it, and a stability fee accrues on the amount minted.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "BurnForProperty",
+					Use:            "burn-for-property [property-id] [amount]",
+					Short:          "Burn usdarda to pay down what was minted against a property",
+					Long:           "Burn usdarda to pay down what was minted against a property. The stability fees owed are paid first.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Liquidate",
+					Use:            "liquidate [property-id] [amount]",
+					Short:          "Repay usdarda owed against a property in liquidation for its shares",
+					Long:           "Repay up to amount of the usdarda owed against a property in liquidation. You receive shares of the owners, in proportion to their holdings, priced at the property value less the liquidation discount. Only whole shares are bought, so less than amount may be repaid.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "property_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "ConfigureMinter",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveMinter",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [recipient] [amount]",
+					Short:          "Mint usdarda to a recipient out of your minter allowance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount]",
+					Short:          "Burn your usdarda out of your minter allowance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "FreezeAddress",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UnfreezeAddress",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetPaused",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterAttestor",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveAttestor",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "AttestReserves",
+					Use:            "attest-reserves [reserves] [report-hash] [as-of]",
+					Short:          "Attest the reserves backing usdarda as of an RFC 3339 time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "reserves"}, {ProtoField: "report_hash"}, {ProtoField: "as_of"}},
+				},
+				{
+					RpcMethod:      "DepositToVault",
+					Use:            "deposit-to-vault [amount]",
+					Short:          "Deposit usdarda in the savings vault for vault shares",
+					Long:           "Deposit usdarda in the savings vault. You receive vault shares at the current share price; the share price grows with the stability fees and mortgage interest paid into the vault.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "WithdrawFromVault",
+					Use:            "withdraw-from-vault [shares]",
+					Short:          "Redeem vault shares for usdarda",
+					Long:           "Redeem vault shares for the usdarda they are worth. What the vault cannot pay out right away, because its liquidity is lent out, is queued and paid as repayments, yield or deposits come in.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "shares"}},
+				},
+				{
+					RpcMethod: "LendFromVault",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "RepayVaultLoan",
+					Use:            "repay-vault-loan [principal] [interest]",
+					Short:          "Repay principal and pay interest on your vault loan",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "principal"}, {ProtoField: "interest"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -9,10 +9,48 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the mint info; mint info exported without a review is reviewed
+	// in the first block
+	for _, elem := range genState.MintInfoList {
+		if elem.ReviewTime.IsZero() {
+			elem.ReviewTime = ctx.BlockTime()
+		}
+		k.SetMintInfo(ctx, elem)
+	}
+	for _, minter := range genState.Minters {
+		k.SetMinter(ctx, minter)
+	}
+	for _, address := range genState.FrozenAddresses {
+		k.SetFrozen(ctx, address, true)
+	}
+	k.SetPaused(ctx, genState.Paused)
+	for _, attestor := range genState.Attestors {
+		if err := k.AttestorRoles.Set(ctx, attestor); err != nil {
+			panic(err)
+		}
+	}
+	for _, attestation := range genState.Attestations {
+		k.SetAttestation(ctx, attestation)
+	}
+	if err := k.AttestationCount.Set(ctx, genState.AttestationCount); err != nil {
+		panic(err)
+	}
+	k.SetVault(ctx, genState.Vault)
+	for _, request := range genState.WithdrawalRequests {
+		k.SetWithdrawalRequest(ctx, request)
+	}
+	if err := k.WithdrawalCount.Set(ctx, genState.WithdrawalCount); err != nil {
+		panic(err)
+	}
+	for _, loan := range genState.VaultLoans {
+		k.SetVaultLoan(ctx, loan)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	k.SetDenomMetadata(ctx)
+	k.SetVaultShareMetadata(ctx)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -20,6 +58,25 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.MintInfoList = k.GetAllMintInfo(ctx)
+	genesis.Minters = k.GetAllMinters(ctx)
+	genesis.FrozenAddresses = k.GetAllFrozen(ctx)
+	genesis.Paused = k.IsPaused(ctx)
+	genesis.Attestors = k.GetAllAttestors(ctx)
+	genesis.Attestations = k.GetAllAttestations(ctx)
+	count, err := k.AttestationCount.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.AttestationCount = count
+	genesis.Vault = k.GetVault(ctx)
+	genesis.WithdrawalRequests = k.GetAllWithdrawalRequests(ctx)
+	withdrawals, err := k.WithdrawalCount.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.WithdrawalCount = withdrawals
+	genesis.VaultLoans = k.GetAllVaultLoans(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -5,15 +5,40 @@ import (
 
 	keepertest "github.com/ardaglobal/arda-poc/testutil/keeper"
 	"github.com/ardaglobal/arda-poc/testutil/nullify"
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	usdarda "github.com/ardaglobal/arda-poc/x/usdarda/module"
 	"github.com/ardaglobal/arda-poc/x/usdarda/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	minter, frozen := sample.AccAddress(), sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		MintInfoList: []types.MintInfo{
+			{PropertyId: "p1", Minted: 500, Burned: 200},
+			{PropertyId: "p2", Minted: 100},
+		},
+		Minters: []types.Minter{
+			{Address: minter, MintAllowance: 100, BurnAllowance: 50},
+		},
+		FrozenAddresses: []string{frozen},
+		Paused:          true,
+		Attestors:       []string{minter},
+		Attestations: []types.ReserveAttestation{
+			{Id: 0, Attestor: minter, Reserves: 100, ReportHash: "hash"},
+		},
+		AttestationCount: 1,
+		Vault:            types.Vault{Liquid: 100, Lent: 300, Queued: 40, Yield: 12},
+		WithdrawalRequests: []types.WithdrawalRequest{
+			{Id: 2, Owner: minter, Amount: 40},
+		},
+		WithdrawalCount: 3,
+		VaultLoans: []types.VaultLoan{
+			{Borrower: frozen, Principal: 300, InterestPaid: 12},
+		},
+
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -25,5 +50,16 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.MintInfoList, got.MintInfoList)
+	require.ElementsMatch(t, genesisState.Minters, got.Minters)
+	require.ElementsMatch(t, genesisState.FrozenAddresses, got.FrozenAddresses)
+	require.True(t, got.Paused)
+	require.ElementsMatch(t, genesisState.Attestors, got.Attestors)
+	require.ElementsMatch(t, genesisState.Attestations, got.Attestations)
+	require.Equal(t, genesisState.AttestationCount, got.AttestationCount)
+	require.Equal(t, genesisState.Vault, got.Vault)
+	require.ElementsMatch(t, genesisState.WithdrawalRequests, got.WithdrawalRequests)
+	require.Equal(t, genesisState.WithdrawalCount, got.WithdrawalCount)
+	require.ElementsMatch(t, genesisState.VaultLoans, got.VaultLoans)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -8,6 +8,37 @@ import (
 )
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMintAgainstProperty{},
+		&MsgBurnForProperty{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLiquidate{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgConfigureMinter{},
+		&MsgRemoveMinter{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgFreezeAddress{},
+		&MsgUnfreezeAddress{},
+		&MsgSetPaused{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterAttestor{},
+		&MsgRemoveAttestor{},
+		&MsgAttestReserves{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositToVault{},
+		&MsgWithdrawFromVault{},
+		&MsgLendFromVault{},
+		&MsgRepayVaultLoan{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -10,4 +10,21 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrNotPropertyOwner    = sdkerrors.Register(ModuleName, 1102, "signer does not own the property")
+	ErrMintLimitExceeded   = sdkerrors.Register(ModuleName, 1103, "mint leaves the property below the min collateral ratio")
+	ErrInsufficientMinted  = sdkerrors.Register(ModuleName, 1104, "burn exceeds the amount outstanding")
+	ErrNotLiquidating      = sdkerrors.Register(ModuleName, 1105, "property is not in liquidation")
+	ErrLiquidating         = sdkerrors.Register(ModuleName, 1106, "property is in liquidation")
+	ErrLiquidationTooSmall = sdkerrors.Register(ModuleName, 1107, "amount buys no share of the property")
+	ErrNotMinter           = sdkerrors.Register(ModuleName, 1108, "address is not a minter")
+	ErrAllowanceExceeded   = sdkerrors.Register(ModuleName, 1109, "amount exceeds the minter allowance")
+	ErrAddressFrozen       = sdkerrors.Register(ModuleName, 1110, "address is frozen")
+	ErrPaused              = sdkerrors.Register(ModuleName, 1111, "usdarda transfers are paused")
+	ErrNotAttestor         = sdkerrors.Register(ModuleName, 1112, "address is not an attestor")
+	ErrReserveShortfall    = sdkerrors.Register(ModuleName, 1113, "reserve coverage is below the minimum")
+	ErrVaultInsolvent      = sdkerrors.Register(ModuleName, 1114, "vault shares are worth nothing")
+	ErrVaultLiquidity      = sdkerrors.Register(ModuleName, 1115, "vault lacks the liquidity")
+	ErrNoVaultLoan         = sdkerrors.Register(ModuleName, 1116, "borrower has no vault loan")
+	ErrTooFewShares        = sdkerrors.Register(ModuleName, 1117, "amount is worth no vault shares")
 )
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lientypes "github.com/ardaglobal/arda-poc/x/lien/types"
+	propertytypes "github.com/ardaglobal/arda-poc/x/property/types"
 )
 
 // AccountKeeper defines the expected interface for the Account module.
 type AccountKeeper interface {
-	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
-	// Methods imported from account should be defined here
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
 }
 
 // BankKeeper defines the expected interface for the Bank module.
@@ -20,6 +23,23 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error
 	SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error
 	SendCoins(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
+	SetDenomMetaData(context.Context, banktypes.Metadata)
+	GetSupply(context.Context, string) sdk.Coin
+}
+
+// LienKeeper defines the expected interface for the Lien module.
+type LienKeeper interface {
+	GetPropertyLiens(context.Context, string) []lientypes.Lien
+	PlaceLien(context.Context, lientypes.Lien) (lientypes.Lien, error)
+	UpdateLienAmount(context.Context, string, uint64, uint64) error
+	ReleaseLien(context.Context, string, uint64) error
+}
+
+// PropertyKeeper defines the expected interface for the Property module.
+type PropertyKeeper interface {
+	GetProperty(ctx sdk.Context, id string) (propertytypes.Property, bool)
+	ReassignShares(ctx sdk.Context, propertyId string, from string, to string, shares uint64) error
+	CurrentValue(ctx context.Context, property propertytypes.Property) uint64
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +15,13 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		MintInfoList:       []MintInfo{},
+		Minters:            []Minter{},
+		FrozenAddresses:    []string{},
+		Attestors:          []string{},
+		Attestations:       []ReserveAttestation{},
+		WithdrawalRequests: []WithdrawalRequest{},
+		VaultLoans:         []VaultLoan{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,7 +30,119 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated property in mint info
+	mintInfoMap := make(map[string]bool)
+	for _, elem := range gs.MintInfoList {
+		if elem.PropertyId == "" {
+			return fmt.Errorf("mint info without property")
+		}
+		if _, ok := mintInfoMap[elem.PropertyId]; ok {
+			return fmt.Errorf("duplicated mint info for property %s", elem.PropertyId)
+		}
+		if elem.Burned > elem.Minted {
+			return fmt.Errorf("mint info of property %s burns more than was minted", elem.PropertyId)
+		}
+		mintInfoMap[elem.PropertyId] = true
+	}
+	minters := make(map[string]bool)
+	for _, minter := range gs.Minters {
+		if _, err := sdk.AccAddressFromBech32(minter.Address); err != nil {
+			return fmt.Errorf("invalid minter address %s: %w", minter.Address, err)
+		}
+		if minters[minter.Address] {
+			return fmt.Errorf("duplicated minter %s", minter.Address)
+		}
+		minters[minter.Address] = true
+	}
+	frozen := make(map[string]bool)
+	for _, address := range gs.FrozenAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid frozen address %s: %w", address, err)
+		}
+		if frozen[address] {
+			return fmt.Errorf("duplicated frozen address %s", address)
+		}
+		frozen[address] = true
+	}
+	attestors := make(map[string]bool)
+	for _, attestor := range gs.Attestors {
+		if _, err := sdk.AccAddressFromBech32(attestor); err != nil {
+			return fmt.Errorf("invalid attestor address %s: %w", attestor, err)
+		}
+		if attestors[attestor] {
+			return fmt.Errorf("duplicated attestor %s", attestor)
+		}
+		attestors[attestor] = true
+	}
+	attestations := make(map[uint64]bool)
+	for _, attestation := range gs.Attestations {
+		if attestation.Id >= gs.AttestationCount {
+			return fmt.Errorf("attestation id %d should be lower than the attestation count", attestation.Id)
+		}
+		if attestations[attestation.Id] {
+			return fmt.Errorf("duplicated attestation %d", attestation.Id)
+		}
+		attestations[attestation.Id] = true
+	}
+	if err := gs.validateVault(); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
 }
+
+// validateVault checks that the queued withdrawals and the vault loans add up
+// to what the vault owes and has lent.
+func (gs GenesisState) validateVault() error {
+	for _, dec := range []string{gs.Vault.ReferencePrice, gs.Vault.Apy} {
+		if dec == "" {
+			continue
+		}
+		if _, err := math.LegacyNewDecFromStr(dec); err != nil {
+			return fmt.Errorf("invalid vault decimal %q: %w", dec, err)
+		}
+	}
+
+	requests := make(map[uint64]bool)
+	var queued uint64
+	for _, request := range gs.WithdrawalRequests {
+		if request.Id >= gs.WithdrawalCount {
+			return fmt.Errorf("withdrawal request id %d should be lower than the withdrawal count", request.Id)
+		}
+		if requests[request.Id] {
+			return fmt.Errorf("duplicated withdrawal request %d", request.Id)
+		}
+		if _, err := sdk.AccAddressFromBech32(request.Owner); err != nil {
+			return fmt.Errorf("invalid withdrawal request owner %s: %w", request.Owner, err)
+		}
+		if request.Amount == 0 {
+			return fmt.Errorf("withdrawal request %d owes nothing", request.Id)
+		}
+		requests[request.Id] = true
+		queued += request.Amount
+	}
+	if queued != gs.Vault.Queued {
+		return fmt.Errorf("withdrawal requests owe %d but the vault owes %d", queued, gs.Vault.Queued)
+	}
+
+	borrowers := make(map[string]bool)
+	var lent uint64
+	for _, loan := range gs.VaultLoans {
+		if _, err := sdk.AccAddressFromBech32(loan.Borrower); err != nil {
+			return fmt.Errorf("invalid vault loan borrower %s: %w", loan.Borrower, err)
+		}
+		if borrowers[loan.Borrower] {
+			return fmt.Errorf("duplicated vault loan of %s", loan.Borrower)
+		}
+		if loan.Principal == 0 {
+			return fmt.Errorf("vault loan of %s owes nothing", loan.Borrower)
+		}
+		borrowers[loan.Borrower] = true
+		lent += loan.Principal
+	}
+	if lent != gs.Vault.Lent {
+		return fmt.Errorf("vault loans owe %d but the vault lent %d", lent, gs.Vault.Lent)
+	}
+	return nil
+}
@@ -27,7 +27,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the usdarda module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params       Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	MintInfoList []MintInfo `protobuf:"bytes,2,rep,name=mintInfoList,proto3" json:"mintInfoList"`
+	Minters      []Minter   `protobuf:"bytes,3,rep,name=minters,proto3" json:"minters"`
+	// frozen_addresses can neither send nor receive usdarda.
+	FrozenAddresses []string `protobuf:"bytes,4,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty"`
+	// paused halts all usdarda transfers.
+	Paused             bool                 `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	Attestors          []string             `protobuf:"bytes,6,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	Attestations       []ReserveAttestation `protobuf:"bytes,7,rep,name=attestations,proto3" json:"attestations"`
+	AttestationCount   uint64               `protobuf:"varint,8,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	Vault              Vault                `protobuf:"bytes,9,opt,name=vault,proto3" json:"vault"`
+	WithdrawalRequests []WithdrawalRequest  `protobuf:"bytes,10,rep,name=withdrawal_requests,json=withdrawalRequests,proto3" json:"withdrawal_requests"`
+	WithdrawalCount    uint64               `protobuf:"varint,11,opt,name=withdrawal_count,json=withdrawalCount,proto3" json:"withdrawal_count,omitempty"`
+	VaultLoans         []VaultLoan          `protobuf:"bytes,12,rep,name=vault_loans,json=vaultLoans,proto3" json:"vault_loans"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +83,83 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintInfoList() []MintInfo {
+	if m != nil {
+		return m.MintInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationCount() uint64 {
+	if m != nil {
+		return m.AttestationCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetVault() Vault {
+	if m != nil {
+		return m.Vault
+	}
+	return Vault{}
+}
+
+func (m *GenesisState) GetWithdrawalRequests() []WithdrawalRequest {
+	if m != nil {
+		return m.WithdrawalRequests
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawalCount() uint64 {
+	if m != nil {
+		return m.WithdrawalCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetVaultLoans() []VaultLoan {
+	if m != nil {
+		return m.VaultLoans
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ardapoc.usdarda.GenesisState")
 }
@@ -77,21 +167,39 @@ func init() {
 func init() { proto.RegisterFile("ardapoc/usdarda/genesis.proto", fileDescriptor_dcb478b947b6d8cc) }
 
 var fileDescriptor_dcb478b947b6d8cc = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2c, 0x4a, 0x49,
-	0x2c, 0xc8, 0x4f, 0xd6, 0x2f, 0x2d, 0x4e, 0x01, 0x31, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33,
-	0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0xa1, 0xd2, 0x7a, 0x50, 0x69, 0x29, 0xc1,
-	0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x19, 0x74, 0x83, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0xe6,
-	0x2a, 0x79, 0x71, 0xf1, 0xb8, 0x43, 0x2c, 0x0a, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe2, 0x62,
-	0x83, 0xc8, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xeb, 0xa1, 0x59, 0xac, 0x17, 0x00,
-	0x96, 0x76, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x1d,
-	0x4e, 0x1e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x97, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x32, 0x24, 0x3d, 0x27, 0x3f, 0x29, 0x31,
-	0x07, 0xcc, 0xd4, 0x05, 0x39, 0xad, 0x02, 0xee, 0xb8, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0xb0, 0xe3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x46, 0x5a, 0xe7, 0x15, 0x01, 0x00,
-	0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xbf, 0xfc, 0xb4, 0x99, 0x44, 0x4a, 0x3b, 0x1f, 0x2a, 0x43, 0x28, 0xae, 0x55, 0x36,
+	0x06, 0x84, 0x2d, 0x85, 0x05, 0x12, 0xbb, 0xa4, 0x0b, 0x40, 0x6a, 0x24, 0x64, 0x24, 0x10, 0x6c,
+	0xac, 0x49, 0x3c, 0x71, 0x2d, 0x39, 0x9e, 0x30, 0x33, 0x4e, 0x80, 0xa7, 0xe0, 0x19, 0x58, 0xb1,
+	0xe4, 0x31, 0xba, 0xec, 0x92, 0x15, 0x42, 0xc9, 0x82, 0xd7, 0x40, 0xf3, 0xe3, 0x26, 0xd8, 0x65,
+	0x63, 0xdd, 0x39, 0xe7, 0xdc, 0x33, 0xe7, 0x8e, 0x2f, 0xb8, 0x87, 0x59, 0x84, 0x17, 0x74, 0xea,
+	0xe7, 0x3c, 0x92, 0xa5, 0x1f, 0x93, 0x8c, 0xf0, 0x84, 0x7b, 0x0b, 0x46, 0x05, 0x85, 0x3d, 0x43,
+	0x7b, 0x86, 0xee, 0x1f, 0xe2, 0x79, 0x92, 0x51, 0x5f, 0x7d, 0xb5, 0xa6, 0x7f, 0x2b, 0xa6, 0x31,
+	0x55, 0xa5, 0x2f, 0x2b, 0x83, 0x1e, 0x97, 0x8d, 0x17, 0x98, 0xe1, 0xb9, 0xf1, 0xed, 0x9f, 0x94,
+	0xd9, 0x79, 0x92, 0x89, 0x30, 0xc9, 0x66, 0xff, 0x6c, 0x97, 0x02, 0xc2, 0x0c, 0x5b, 0x49, 0xcd,
+	0x08, 0x27, 0x6c, 0x49, 0x0c, 0x7d, 0xb7, 0x4c, 0x2f, 0x71, 0x9e, 0x0a, 0x4d, 0x9e, 0x7e, 0x6d,
+	0x82, 0xee, 0x73, 0x3d, 0xe4, 0x6b, 0x81, 0x05, 0x81, 0xcf, 0x40, 0x4b, 0x67, 0x43, 0x96, 0x63,
+	0xb9, 0x9d, 0xc1, 0x6d, 0xaf, 0x34, 0xb4, 0xf7, 0x4a, 0xd1, 0xa3, 0xf6, 0xe5, 0xcf, 0x93, 0xda,
+	0xb7, 0xdf, 0xdf, 0x1f, 0x5a, 0x81, 0xe9, 0x80, 0x67, 0xa0, 0x2b, 0x83, 0xbd, 0xcc, 0x66, 0xf4,
+	0x3c, 0xe1, 0x02, 0xfd, 0xe7, 0xd4, 0xdd, 0xce, 0xe0, 0x4e, 0xc5, 0x61, 0x6c, 0x44, 0xa3, 0x86,
+	0xf4, 0x08, 0xfe, 0x6a, 0x82, 0x4f, 0xc1, 0x9e, 0x9e, 0x8e, 0xa3, 0xba, 0x53, 0xbf, 0x31, 0xc1,
+	0x58, 0xf1, 0xa6, 0xbb, 0x50, 0xc3, 0x07, 0xe0, 0x60, 0xc6, 0xe8, 0x67, 0x92, 0x85, 0x38, 0x8a,
+	0x18, 0xe1, 0x9c, 0x70, 0xd4, 0x70, 0xea, 0x6e, 0x3b, 0xe8, 0x69, 0x7c, 0x58, 0xc0, 0xf0, 0x48,
+	0x0e, 0x99, 0x73, 0x12, 0xa1, 0xa6, 0x63, 0xb9, 0xfb, 0x81, 0x39, 0xc1, 0x63, 0xd0, 0xc6, 0x42,
+	0x10, 0x2e, 0x28, 0xe3, 0xa8, 0xa5, 0x7a, 0xb7, 0x00, 0x1c, 0x83, 0xae, 0x3e, 0x60, 0x91, 0xd0,
+	0x8c, 0xa3, 0x3d, 0x15, 0xef, 0x7e, 0x25, 0x5e, 0xa0, 0x9f, 0x7f, 0xb8, 0xd5, 0x16, 0x83, 0xee,
+	0xb6, 0xc3, 0x47, 0xe0, 0x70, 0xe7, 0x1c, 0x4e, 0x69, 0x9e, 0x09, 0xb4, 0xef, 0x58, 0x6e, 0x23,
+	0x38, 0xd8, 0x21, 0xce, 0x24, 0x0e, 0x07, 0xa0, 0xa9, 0x7e, 0x1b, 0x6a, 0xab, 0xbf, 0x72, 0x54,
+	0xb9, 0xf4, 0x8d, 0x64, 0xcd, 0x3d, 0x5a, 0x0a, 0xdf, 0x81, 0xff, 0x57, 0x89, 0xb8, 0x88, 0x18,
+	0x5e, 0xe1, 0x34, 0x64, 0xe4, 0x43, 0x4e, 0xb8, 0xe0, 0x08, 0xa8, 0xd8, 0xa7, 0x15, 0x87, 0xb7,
+	0xd7, 0xda, 0x40, 0x4b, 0x8d, 0x1b, 0x5c, 0x95, 0x09, 0xf5, 0xd6, 0x3b, 0xd6, 0x3a, 0x7a, 0x47,
+	0x45, 0xef, 0x6d, 0x71, 0x9d, 0x7c, 0x08, 0x3a, 0x2a, 0x4e, 0x98, 0x52, 0x9c, 0x71, 0xd4, 0x55,
+	0xb7, 0xf7, 0x6f, 0xce, 0x7f, 0x4e, 0x71, 0xf1, 0x56, 0x60, 0x59, 0x00, 0x7c, 0xf4, 0xe2, 0x72,
+	0x6d, 0x5b, 0x57, 0x6b, 0xdb, 0xfa, 0xb5, 0xb6, 0xad, 0x2f, 0x1b, 0xbb, 0x76, 0xb5, 0xb1, 0x6b,
+	0x3f, 0x36, 0x76, 0xed, 0xbd, 0x17, 0x27, 0xe2, 0x22, 0x9f, 0x78, 0x53, 0x3a, 0xf7, 0xa5, 0x4d,
+	0x9c, 0xd2, 0x09, 0x4e, 0x55, 0xf9, 0x58, 0xae, 0xfc, 0xc7, 0xeb, 0xa5, 0x17, 0x9f, 0x16, 0x84,
+	0x4f, 0x5a, 0x6a, 0xeb, 0x9f, 0xfc, 0x19, 0x00, 0x02, 0xd0, 0x59, 0x64, 0xe9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +222,124 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VaultLoans) > 0 {
+		for iNdEx := len(m.VaultLoans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultLoans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.WithdrawalCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WithdrawalCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.WithdrawalRequests) > 0 {
+		for iNdEx := len(m.WithdrawalRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.AttestationCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MintInfoList) > 0 {
+		for iNdEx := len(m.MintInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +372,59 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintInfoList) > 0 {
+		for _, e := range m.MintInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AttestationCount != 0 {
+		n += 1 + sovGenesis(uint64(m.AttestationCount))
+	}
+	l = m.Vault.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WithdrawalRequests) > 0 {
+		for _, e := range m.WithdrawalRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.WithdrawalCount != 0 {
+		n += 1 + sovGenesis(uint64(m.WithdrawalCount))
+	}
+	if len(m.VaultLoans) > 0 {
+		for _, e := range m.VaultLoans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +496,331 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintInfoList = append(m.MintInfoList, MintInfo{})
+			if err := m.MintInfoList[len(m.MintInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, ReserveAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationCount", wireType)
+			}
+			m.AttestationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalRequests = append(m.WithdrawalRequests, WithdrawalRequest{})
+			if err := m.WithdrawalRequests[len(m.WithdrawalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCount", wireType)
+			}
+			m.WithdrawalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultLoans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultLoans = append(m.VaultLoans, VaultLoan{})
+			if err := m.VaultLoans[len(m.VaultLoans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
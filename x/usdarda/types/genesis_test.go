@@ -3,11 +3,13 @@ package types_test
 import (
 	"testing"
 
+	"github.com/ardaglobal/arda-poc/testutil/sample"
 	"github.com/ardaglobal/arda-poc/x/usdarda/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	minter := sample.AccAddress()
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -19,13 +21,112 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
+				MintInfoList: []types.MintInfo{
+					{PropertyId: "p1", Minted: 500, Burned: 200},
+					{PropertyId: "p2", Minted: 100},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated mint info",
+			genState: &types.GenesisState{
+				MintInfoList: []types.MintInfo{
+					{PropertyId: "p1", Minted: 500},
+					{PropertyId: "p1", Minted: 100},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "more burned than minted",
+			genState: &types.GenesisState{
+				MintInfoList: []types.MintInfo{
+					{PropertyId: "p1", Minted: 100, Burned: 200},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "liquidation threshold above the min collateral ratio",
+			genState: &types.GenesisState{
+				Params: types.NewParams("1.2", "1.5", types.DefaultStabilityFee, types.DefaultLiquidationDiscount, types.DefaultMinReserveCoverage),
+			},
+			valid: false,
+		},
+		{
+			desc: "collateral ratio below one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultMinCollateralRatio, "0.9", types.DefaultStabilityFee, types.DefaultLiquidationDiscount, types.DefaultMinReserveCoverage),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid liquidation discount",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultMinCollateralRatio, types.DefaultLiquidationThreshold, types.DefaultStabilityFee, "1", types.DefaultMinReserveCoverage),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated minter",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Minters: []types.Minter{{Address: minter}, {Address: minter, MintAllowance: 10}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid frozen address",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FrozenAddresses: []string{"invalid_address"},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation id not below the count",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Attestations:     []types.ReserveAttestation{{Id: 1}},
+				AttestationCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "vault with its withdrawal requests and loans",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				Vault:              types.Vault{Liquid: 100, Lent: 300, Queued: 50},
+				WithdrawalRequests: []types.WithdrawalRequest{{Id: 0, Owner: minter, Amount: 20}, {Id: 1, Owner: minter, Amount: 30}},
+				WithdrawalCount:    2,
+				VaultLoans:         []types.VaultLoan{{Borrower: minter, Principal: 300}},
+			},
+			valid: true,
+		},
+		{
+			desc: "withdrawal requests not adding up to the queued usdarda",
+			genState: &types.GenesisState{
+				Params:             types.DefaultParams(),
+				Vault:              types.Vault{Liquid: 100, Queued: 50},
+				WithdrawalRequests: []types.WithdrawalRequest{{Id: 0, Owner: minter, Amount: 20}},
+				WithdrawalCount:    1,
+			},
+			valid: false,
+		},
+		{
+			desc: "vault loans not adding up to the lent usdarda",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Vault:      types.Vault{Lent: 200},
+				VaultLoans: []types.VaultLoan{{Borrower: minter, Principal: 300}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "usdarda"
@@ -13,12 +15,68 @@ const (
 	// USDArdaDenom defines the bank denom for USDArda tokens
 	USDArdaDenom = "usdarda"
 
+	// VaultShareDenom defines the bank denom of savings vault shares
+	VaultShareDenom = "susdarda"
+
+	// VaultAccountName is the module account holding the savings vault's usdarda
+	VaultAccountName = "usdarda_vault"
+
 	// MintInfoKeyPrefix stores mint info by property id
 	MintInfoKeyPrefix = "MintInfo/value/"
+
+	// MintReviewKeyPrefix queues the mint info reviews by time and property id
+	MintReviewKeyPrefix = "MintReview/value/"
+
+	// MinterKeyPrefix stores minters by address
+	MinterKeyPrefix = "Minter/value/"
+
+	// FrozenKeyPrefix stores the frozen addresses
+	FrozenKeyPrefix = "Frozen/value/"
+
+	// PausedKey stores whether usdarda transfers are halted
+	PausedKey = "Paused/value/"
+
+	// AttestorKeyPrefix stores the registered attestors
+	AttestorKeyPrefix = "Attestor/value/"
+
+	// AttestationKeyPrefix stores reserve attestations by id
+	AttestationKeyPrefix = "Attestation/value/"
+
+	// AttestationCountKey stores the number of reserve attestations
+	AttestationCountKey = "Attestation/count/"
+
+	// VaultKey stores the savings vault
+	VaultKey = "Vault/value/"
+
+	// WithdrawalKeyPrefix stores queued vault withdrawals by id
+	WithdrawalKeyPrefix = "Withdrawal/value/"
+
+	// WithdrawalCountKey stores the number of vault withdrawals queued
+	WithdrawalCountKey = "Withdrawal/count/"
+
+	// VaultLoanKeyPrefix stores vault loans by borrower
+	VaultLoanKeyPrefix = "VaultLoan/value/"
 )
 
 var (
 	ParamsKey = []byte("p_usdarda")
+
+	// MintInfoPrefix keys the MintInfo collection by property id
+	MintInfoPrefix = collections.NewPrefix(MintInfoKeyPrefix)
+	// MintReviewPrefix keys the MintReviews queue by review time and property id
+	MintReviewPrefix = collections.NewPrefix(MintReviewKeyPrefix)
+	MinterPrefix     = collections.NewPrefix(MinterKeyPrefix)
+	FrozenPrefix     = collections.NewPrefix(FrozenKeyPrefix)
+	PausedPrefix     = collections.NewPrefix(PausedKey)
+
+	AttestorPrefix         = collections.NewPrefix(AttestorKeyPrefix)
+	AttestationPrefix      = collections.NewPrefix(AttestationKeyPrefix)
+	AttestationCountPrefix = collections.NewPrefix(AttestationCountKey)
+
+	VaultPrefix           = collections.NewPrefix(VaultKey)
+	WithdrawalPrefix      = collections.NewPrefix(WithdrawalKeyPrefix)
+	WithdrawalCountPrefix = collections.NewPrefix(WithdrawalCountKey)
+	VaultLoanPrefix       = collections.NewPrefix(VaultLoanKeyPrefix)
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,143 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgConfigureMinter{}
+
+func NewMsgConfigureMinter(authority string, address string, mintAllowance uint64, burnAllowance uint64) *MsgConfigureMinter {
+	return &MsgConfigureMinter{
+		Authority:     authority,
+		Address:       address,
+		MintAllowance: mintAllowance,
+		BurnAllowance: burnAllowance,
+	}
+}
+
+func (msg *MsgConfigureMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveMinter{}
+
+func NewMsgRemoveMinter(authority string, address string) *MsgRemoveMinter {
+	return &MsgRemoveMinter{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgRemoveMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgMint{}
+
+func NewMsgMint(minter string, recipient string, amount uint64) *MsgMint {
+	return &MsgMint{
+		Minter:    minter,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgBurn{}
+
+func NewMsgBurn(minter string, amount uint64) *MsgBurn {
+	return &MsgBurn{
+		Minter: minter,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Minter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", err)
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgFreezeAddress{}
+
+func NewMsgFreezeAddress(authority string, address string) *MsgFreezeAddress {
+	return &MsgFreezeAddress{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgFreezeAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnfreezeAddress{}
+
+func NewMsgUnfreezeAddress(authority string, address string) *MsgUnfreezeAddress {
+	return &MsgUnfreezeAddress{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgUnfreezeAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPaused{}
+
+func NewMsgSetPaused(authority string, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Authority: authority,
+		Paused:    paused,
+	}
+}
+
+func (msg *MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+)
+
+func TestMsgConfigureMinter_ValidateBasic(t *testing.T) {
+	require.Error(t, NewMsgConfigureMinter("invalid_address", sample.AccAddress(), 10, 10).ValidateBasic())
+	require.ErrorIs(t, NewMsgConfigureMinter(sample.AccAddress(), "invalid_address", 10, 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgConfigureMinter(sample.AccAddress(), sample.AccAddress(), 0, 0).ValidateBasic())
+}
+
+func TestMsgMint_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgMint("invalid_address", sample.AccAddress(), 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgMint(sample.AccAddress(), "invalid_address", 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgMint(sample.AccAddress(), sample.AccAddress(), 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgMint(sample.AccAddress(), sample.AccAddress(), 10).ValidateBasic())
+}
+
+func TestMsgBurn_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgBurn("invalid_address", 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgBurn(sample.AccAddress(), 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgBurn(sample.AccAddress(), 10).ValidateBasic())
+}
+
+func TestMsgFreezeAddress_ValidateBasic(t *testing.T) {
+	require.Error(t, NewMsgFreezeAddress("invalid_address", sample.AccAddress()).ValidateBasic())
+	require.ErrorIs(t, NewMsgFreezeAddress(sample.AccAddress(), "invalid_address").ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.NoError(t, NewMsgFreezeAddress(sample.AccAddress(), sample.AccAddress()).ValidateBasic())
+}
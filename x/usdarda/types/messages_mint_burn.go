@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgMintAgainstProperty{}
+
+func NewMsgMintAgainstProperty(owner string, propertyId string, amount uint64, coOwners []string) *MsgMintAgainstProperty {
+	return &MsgMintAgainstProperty{
+		Owner:      owner,
+		PropertyId: propertyId,
+		Amount:     amount,
+		CoOwners:   coOwners,
+	}
+}
+
+func (msg *MsgMintAgainstProperty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if msg.PropertyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "property id cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	seen := map[string]bool{msg.Owner: true}
+	for _, coOwner := range msg.CoOwners {
+		if _, err := sdk.AccAddressFromBech32(coOwner); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid co-owner address (%s)", err)
+		}
+		if seen[coOwner] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate owner %s", coOwner)
+		}
+		seen[coOwner] = true
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgBurnForProperty{}
+
+func NewMsgBurnForProperty(burner string, propertyId string, amount uint64) *MsgBurnForProperty {
+	return &MsgBurnForProperty{
+		Burner:     burner,
+		PropertyId: propertyId,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgBurnForProperty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Burner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid burner address (%s)", err)
+	}
+	if msg.PropertyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "property id cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgLiquidate{}
+
+func NewMsgLiquidate(liquidator string, propertyId string, amount uint64) *MsgLiquidate {
+	return &MsgLiquidate{
+		Liquidator: liquidator,
+		PropertyId: propertyId,
+		Amount:     amount,
+	}
+}
+
+func (msg *MsgLiquidate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Liquidator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid liquidator address (%s)", err)
+	}
+	if msg.PropertyId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "property id cannot be empty")
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
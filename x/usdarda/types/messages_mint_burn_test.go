@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+)
+
+func TestMsgMintAgainstProperty_ValidateBasic(t *testing.T) {
+	owner, coOwner := sample.AccAddress(), sample.AccAddress()
+	require.ErrorIs(t, NewMsgMintAgainstProperty("invalid_address", "p1", 10, nil).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgMintAgainstProperty(owner, "", 10, nil).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgMintAgainstProperty(owner, "p1", 0, nil).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgMintAgainstProperty(owner, "p1", 10, []string{"invalid_address"}).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgMintAgainstProperty(owner, "p1", 10, []string{owner}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgMintAgainstProperty(owner, "p1", 10, []string{coOwner, coOwner}).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgMintAgainstProperty(owner, "p1", 10, nil).ValidateBasic())
+	require.NoError(t, NewMsgMintAgainstProperty(owner, "p1", 10, []string{coOwner}).ValidateBasic())
+}
+
+func TestMsgMintAgainstProperty_Signers(t *testing.T) {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	require.NoError(t, err)
+	owner, coOwner := sample.AccAddress(), sample.AccAddress()
+
+	// The co-owners sign the mint too
+	signers, _, err := codec.NewProtoCodec(registry).GetMsgV1Signers(NewMsgMintAgainstProperty(owner, "p1", 10, []string{coOwner}))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{sdk.MustAccAddressFromBech32(owner), sdk.MustAccAddressFromBech32(coOwner)}, signers)
+}
+
+func TestMsgBurnForProperty_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgBurnForProperty("invalid_address", "p1", 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgBurnForProperty(sample.AccAddress(), "", 10).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgBurnForProperty(sample.AccAddress(), "p1", 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgBurnForProperty(sample.AccAddress(), "p1", 10).ValidateBasic())
+}
+
+func TestMsgLiquidate_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgLiquidate("invalid_address", "p1", 10).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgLiquidate(sample.AccAddress(), "", 10).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, NewMsgLiquidate(sample.AccAddress(), "p1", 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgLiquidate(sample.AccAddress(), "p1", 10).ValidateBasic())
+}
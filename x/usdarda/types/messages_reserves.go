@@ -0,0 +1,71 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterAttestor{}
+
+func NewMsgRegisterAttestor(authority string, address string) *MsgRegisterAttestor {
+	return &MsgRegisterAttestor{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgRegisterAttestor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attestor address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRemoveAttestor{}
+
+func NewMsgRemoveAttestor(authority string, address string) *MsgRemoveAttestor {
+	return &MsgRemoveAttestor{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func (msg *MsgRemoveAttestor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attestor address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgAttestReserves{}
+
+func NewMsgAttestReserves(attestor string, reserves uint64, reportHash string, asOf time.Time) *MsgAttestReserves {
+	return &MsgAttestReserves{
+		Attestor:   attestor,
+		Reserves:   reserves,
+		ReportHash: reportHash,
+		AsOf:       asOf,
+	}
+}
+
+func (msg *MsgAttestReserves) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attestor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attestor address (%s)", err)
+	}
+	if msg.ReportHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "report hash cannot be empty")
+	}
+	if msg.AsOf.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "as of time cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+)
+
+func TestMsgAttestReserves_ValidateBasic(t *testing.T) {
+	asOf := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		msg  MsgAttestReserves
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAttestReserves{
+				Attestor:   "invalid_address",
+				Reserves:   100,
+				ReportHash: "hash",
+				AsOf:       asOf,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty report hash",
+			msg: MsgAttestReserves{
+				Attestor: sample.AccAddress(),
+				Reserves: 100,
+				AsOf:     asOf,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no attestation time",
+			msg: MsgAttestReserves{
+				Attestor:   sample.AccAddress(),
+				Reserves:   100,
+				ReportHash: "hash",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no reserves",
+			msg: MsgAttestReserves{
+				Attestor:   sample.AccAddress(),
+				ReportHash: "hash",
+				AsOf:       asOf,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDepositToVault{}
+
+func NewMsgDepositToVault(depositor string, amount uint64) *MsgDepositToVault {
+	return &MsgDepositToVault{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgDepositToVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgWithdrawFromVault{}
+
+func NewMsgWithdrawFromVault(owner string, shares uint64) *MsgWithdrawFromVault {
+	return &MsgWithdrawFromVault{
+		Owner:  owner,
+		Shares: shares,
+	}
+}
+
+func (msg *MsgWithdrawFromVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if msg.Shares == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "shares must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgLendFromVault{}
+
+func NewMsgLendFromVault(authority string, borrower string, amount uint64) *MsgLendFromVault {
+	return &MsgLendFromVault{
+		Authority: authority,
+		Borrower:  borrower,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgLendFromVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address (%s)", err)
+	}
+	if msg.Amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgRepayVaultLoan{}
+
+func NewMsgRepayVaultLoan(borrower string, principal uint64, interest uint64) *MsgRepayVaultLoan {
+	return &MsgRepayVaultLoan{
+		Borrower:  borrower,
+		Principal: principal,
+		Interest:  interest,
+	}
+}
+
+func (msg *MsgRepayVaultLoan) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Borrower); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid borrower address (%s)", err)
+	}
+	if msg.Principal == 0 && msg.Interest == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nothing to repay")
+	}
+	return nil
+}
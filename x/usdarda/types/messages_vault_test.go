@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ardaglobal/arda-poc/testutil/sample"
+)
+
+func TestMsgDepositToVault_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgDepositToVault("invalid_address", 100).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgDepositToVault(sample.AccAddress(), 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgDepositToVault(sample.AccAddress(), 100).ValidateBasic())
+}
+
+func TestMsgWithdrawFromVault_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgWithdrawFromVault("invalid_address", 100).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgWithdrawFromVault(sample.AccAddress(), 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgWithdrawFromVault(sample.AccAddress(), 100).ValidateBasic())
+}
+
+func TestMsgLendFromVault_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgLendFromVault(sample.AccAddress(), "invalid_address", 100).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgLendFromVault(sample.AccAddress(), sample.AccAddress(), 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgLendFromVault(sample.AccAddress(), sample.AccAddress(), 100).ValidateBasic())
+}
+
+func TestMsgRepayVaultLoan_ValidateBasic(t *testing.T) {
+	require.ErrorIs(t, NewMsgRepayVaultLoan("invalid_address", 100, 0).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, NewMsgRepayVaultLoan(sample.AccAddress(), 0, 0).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, NewMsgRepayVaultLoan(sample.AccAddress(), 0, 5).ValidateBasic())
+}
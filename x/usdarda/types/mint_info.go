@@ -1,11 +1,56 @@
 package types
 
-// MintInfo stores minted and burned amounts for a property
-// If Minted > Burned, the property is considered locked
-// Outstanding minted amount is Minted - Burned
-
-type MintInfo struct {
-	PropertyId string
-	Minted     uint64
-	Burned     uint64
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// secondsPerYear is the length of the year the stability fee is quoted for.
+const secondsPerYear = 365 * 24 * 60 * 60
+
+// MintReviewPeriod is how often the stability fee owed against a property
+// accrues and its value is checked against the liquidation threshold.
+const MintReviewPeriod = time.Hour
+
+// Outstanding returns the usdarda minted against the property and not yet
+// burned.
+func (m MintInfo) Outstanding() uint64 {
+	if m.Burned >= m.Minted {
+		return 0
+	}
+	return m.Minted - m.Burned
+}
+
+// Debt returns everything owed against the property: the usdarda outstanding
+// and the stability fees.
+func (m MintInfo) Debt() uint64 {
+	return m.Outstanding() + m.StabilityFees
+}
+
+// AccrueStabilityFee charges the annual rate on the usdarda outstanding for the
+// time since the fee last accrued. A fee below one usdarda is not charged and
+// keeps accruing until it reaches one. It reports whether a fee was charged.
+func (m *MintInfo) AccrueStabilityFee(rate math.LegacyDec, now time.Time) bool {
+	elapsed := now.Sub(m.FeesAccruedAt)
+	if m.FeesAccruedAt.IsZero() || elapsed <= 0 {
+		return false
+	}
+	fee := rate.MulInt(math.NewIntFromUint64(m.Outstanding())).
+		MulInt64(int64(elapsed / time.Second)).
+		QuoInt64(secondsPerYear).
+		TruncateInt()
+	if fee.IsZero() {
+		return false
+	}
+	m.StabilityFees += fee.Uint64()
+	m.FeesAccruedAt = now
+	return true
+}
+
+// Undercollateralized reports whether a property worth value falls below the
+// given ratio of what is owed against it.
+func (m MintInfo) Undercollateralized(value uint64, ratio math.LegacyDec) bool {
+	debt := m.Debt()
+	return debt > 0 && ratio.MulInt(math.NewIntFromUint64(debt)).GT(math.LegacyNewDecFromInt(math.NewIntFromUint64(value)))
 }
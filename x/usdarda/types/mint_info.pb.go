@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/usdarda/mint_info.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintInfo records the usdarda minted and burned against a property. While
+// anything is owed, usdarda holds a lien on the property in x/lien for it.
+type MintInfo struct {
+	PropertyId string `protobuf:"bytes,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Minted     uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	Burned     uint64 `protobuf:"varint,3,opt,name=burned,proto3" json:"burned,omitempty"`
+	// stability_fees is the stability fee accrued and not yet paid. Burns pay
+	// it before the amount minted.
+	StabilityFees uint64 `protobuf:"varint,4,opt,name=stability_fees,json=stabilityFees,proto3" json:"stability_fees,omitempty"`
+	// fees_accrued_at is when the stability fee last accrued.
+	FeesAccruedAt time.Time `protobuf:"bytes,5,opt,name=fees_accrued_at,json=feesAccruedAt,proto3,stdtime" json:"fees_accrued_at"`
+	// liquidating is set while the property's value is below the liquidation
+	// threshold of what is owed, so that liquidators may repay the debt for
+	// shares.
+	Liquidating bool `protobuf:"varint,6,opt,name=liquidating,proto3" json:"liquidating,omitempty"`
+	// review_time is when the BeginBlocker next accrues the stability fee and
+	// checks the property's value against what is owed.
+	ReviewTime time.Time `protobuf:"bytes,7,opt,name=review_time,json=reviewTime,proto3,stdtime" json:"review_time"`
+}
+
+func (m *MintInfo) Reset()         { *m = MintInfo{} }
+func (m *MintInfo) String() string { return proto.CompactTextString(m) }
+func (*MintInfo) ProtoMessage()    {}
+func (*MintInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bb86954b0d6f1449, []int{0}
+}
+func (m *MintInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintInfo.Merge(m, src)
+}
+func (m *MintInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintInfo proto.InternalMessageInfo
+
+func (m *MintInfo) GetPropertyId() string {
+	if m != nil {
+		return m.PropertyId
+	}
+	return ""
+}
+
+func (m *MintInfo) GetMinted() uint64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
+func (m *MintInfo) GetBurned() uint64 {
+	if m != nil {
+		return m.Burned
+	}
+	return 0
+}
+
+func (m *MintInfo) GetStabilityFees() uint64 {
+	if m != nil {
+		return m.StabilityFees
+	}
+	return 0
+}
+
+func (m *MintInfo) GetFeesAccruedAt() time.Time {
+	if m != nil {
+		return m.FeesAccruedAt
+	}
+	return time.Time{}
+}
+
+func (m *MintInfo) GetLiquidating() bool {
+	if m != nil {
+		return m.Liquidating
+	}
+	return false
+}
+
+func (m *MintInfo) GetReviewTime() time.Time {
+	if m != nil {
+		return m.ReviewTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MintInfo)(nil), "ardapoc.usdarda.MintInfo")
+}
+
+func init() { proto.RegisterFile("ardapoc/usdarda/mint_info.proto", fileDescriptor_bb86954b0d6f1449) }
+
+var fileDescriptor_bb86954b0d6f1449 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x4f, 0x4b, 0xf3, 0x30,
+	0x1c, 0x6e, 0xf6, 0xee, 0xdd, 0xbb, 0x37, 0x65, 0x0e, 0x8a, 0x48, 0xd9, 0xa1, 0x2d, 0x82, 0xd0,
+	0x8b, 0x29, 0xe8, 0x27, 0xd8, 0x40, 0x71, 0xa0, 0x97, 0xe2, 0xc9, 0x4b, 0x49, 0x9b, 0x34, 0x06,
+	0xda, 0xa6, 0xa6, 0xa9, 0xba, 0x6f, 0xb1, 0xaf, 0xe3, 0x37, 0xd8, 0x71, 0x47, 0x4f, 0x2a, 0xdb,
+	0x17, 0x91, 0x34, 0xdb, 0xf0, 0xea, 0xed, 0xf7, 0xfc, 0x23, 0x0f, 0x79, 0xa0, 0x8f, 0x25, 0xc1,
+	0xb5, 0xc8, 0xa2, 0xb6, 0x21, 0xfa, 0x8c, 0x4a, 0x5e, 0xa9, 0x84, 0x57, 0xb9, 0x40, 0xb5, 0x14,
+	0x4a, 0x38, 0xe3, 0x9d, 0x01, 0xed, 0x0c, 0x93, 0x63, 0x26, 0x98, 0xe8, 0xb4, 0x48, 0x5f, 0xc6,
+	0x36, 0xf1, 0x99, 0x10, 0xac, 0xa0, 0x51, 0x87, 0xd2, 0x36, 0x8f, 0x14, 0x2f, 0x69, 0xa3, 0x70,
+	0x59, 0x1b, 0xc3, 0xe9, 0x5b, 0x0f, 0x0e, 0xef, 0x78, 0xa5, 0xe6, 0x55, 0x2e, 0x1c, 0x1f, 0xda,
+	0xb5, 0x14, 0x35, 0x95, 0x6a, 0x91, 0x70, 0xe2, 0x82, 0x00, 0x84, 0xff, 0x63, 0xb8, 0xa7, 0xe6,
+	0xc4, 0x39, 0x81, 0x03, 0x5d, 0x84, 0x12, 0xb7, 0x17, 0x80, 0xb0, 0x1f, 0xef, 0x90, 0xe6, 0xd3,
+	0x56, 0x56, 0x94, 0xb8, 0x7f, 0x0c, 0x6f, 0x90, 0x73, 0x06, 0x8f, 0x1a, 0x85, 0x53, 0x5e, 0x70,
+	0xb5, 0x48, 0x72, 0x4a, 0x1b, 0xb7, 0xdf, 0xe9, 0xa3, 0x03, 0x7b, 0x4d, 0x69, 0xe3, 0xdc, 0xc2,
+	0xb1, 0x16, 0x13, 0x9c, 0x65, 0xb2, 0xa5, 0x24, 0xc1, 0xca, 0xfd, 0x1b, 0x80, 0xd0, 0xbe, 0x98,
+	0x20, 0xd3, 0x1f, 0xed, 0xfb, 0xa3, 0xfb, 0x7d, 0xff, 0xd9, 0x70, 0xf5, 0xe1, 0x5b, 0xcb, 0x4f,
+	0x1f, 0xc4, 0x23, 0x1d, 0x9e, 0x9a, 0xec, 0x54, 0x39, 0x01, 0xb4, 0x0b, 0xfe, 0xd4, 0x72, 0x82,
+	0x15, 0xaf, 0x98, 0x3b, 0x08, 0x40, 0x38, 0x8c, 0x7f, 0x52, 0xce, 0x15, 0xb4, 0x25, 0x7d, 0xe6,
+	0xf4, 0x25, 0xd1, 0xdf, 0xe1, 0xfe, 0xfb, 0xc5, 0x5b, 0xd0, 0x04, 0xb5, 0x34, 0xbb, 0x59, 0x6d,
+	0x3c, 0xb0, 0xde, 0x78, 0xe0, 0x6b, 0xe3, 0x81, 0xe5, 0xd6, 0xb3, 0xd6, 0x5b, 0xcf, 0x7a, 0xdf,
+	0x7a, 0xd6, 0x03, 0x62, 0x5c, 0x3d, 0xb6, 0x29, 0xca, 0x44, 0x19, 0xe9, 0x75, 0x58, 0x21, 0x52,
+	0x5c, 0x74, 0xe7, 0xb9, 0x5e, 0xf5, 0xf5, 0xb0, 0xab, 0x5a, 0xd4, 0xb4, 0x49, 0x07, 0xdd, 0x9b,
+	0x97, 0xdf, 0x03, 0x00, 0x52, 0x0f, 0x75, 0x03, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *MintInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReviewTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReviewTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintInfo(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Liquidating {
+		i--
+		if m.Liquidating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FeesAccruedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesAccruedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMintInfo(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.StabilityFees != 0 {
+		i = encodeVarintMintInfo(dAtA, i, uint64(m.StabilityFees))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Burned != 0 {
+		i = encodeVarintMintInfo(dAtA, i, uint64(m.Burned))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Minted != 0 {
+		i = encodeVarintMintInfo(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PropertyId) > 0 {
+		i -= len(m.PropertyId)
+		copy(dAtA[i:], m.PropertyId)
+		i = encodeVarintMintInfo(dAtA, i, uint64(len(m.PropertyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintInfo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintInfo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PropertyId)
+	if l > 0 {
+		n += 1 + l + sovMintInfo(uint64(l))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovMintInfo(uint64(m.Minted))
+	}
+	if m.Burned != 0 {
+		n += 1 + sovMintInfo(uint64(m.Burned))
+	}
+	if m.StabilityFees != 0 {
+		n += 1 + sovMintInfo(uint64(m.StabilityFees))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FeesAccruedAt)
+	n += 1 + l + sovMintInfo(uint64(l))
+	if m.Liquidating {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReviewTime)
+	n += 1 + l + sovMintInfo(uint64(l))
+	return n
+}
+
+func sovMintInfo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintInfo(x uint64) (n int) {
+	return sovMintInfo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropertyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PropertyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			m.Burned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFees", wireType)
+			}
+			m.StabilityFees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StabilityFees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesAccruedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FeesAccruedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Liquidating = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReviewTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintInfo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintInfo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintInfo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintInfo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintInfo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintInfo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintInfo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintInfo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintInfo = fmt.Errorf("proto: unexpected end of group")
+)
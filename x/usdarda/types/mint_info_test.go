@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccrueStabilityFee(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rate := math.LegacyMustNewDecFromStr("0.02")
+	info := MintInfo{Minted: 1000, Burned: 400, FeesAccruedAt: start}
+
+	// Fees below one usdarda keep accruing
+	require.False(t, info.AccrueStabilityFee(rate, start.Add(24*time.Hour)))
+	require.Equal(t, start, info.FeesAccruedAt)
+
+	require.True(t, info.AccrueStabilityFee(rate, start.Add(365*24*time.Hour)))
+	require.Equal(t, uint64(12), info.StabilityFees)
+	require.Equal(t, uint64(612), info.Debt())
+	require.Equal(t, start.Add(365*24*time.Hour), info.FeesAccruedAt)
+
+	// The fee does not start before it is scheduled
+	require.False(t, (&MintInfo{Minted: 1000}).AccrueStabilityFee(rate, start))
+}
+
+func TestUndercollateralized(t *testing.T) {
+	ratio := math.LegacyMustNewDecFromStr("1.5")
+	info := MintInfo{Minted: 600, StabilityFees: 66}
+
+	require.False(t, info.Undercollateralized(1000, ratio))
+	require.True(t, info.Undercollateralized(998, ratio))
+	require.False(t, MintInfo{}.Undercollateralized(0, ratio))
+}
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/usdarda/minter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Minter is an address the authority allows to mint and burn usdarda without
+// property collateral, up to its remaining allowances.
+type Minter struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MintAllowance uint64 `protobuf:"varint,2,opt,name=mint_allowance,json=mintAllowance,proto3" json:"mint_allowance,omitempty"`
+	BurnAllowance uint64 `protobuf:"varint,3,opt,name=burn_allowance,json=burnAllowance,proto3" json:"burn_allowance,omitempty"`
+}
+
+func (m *Minter) Reset()         { *m = Minter{} }
+func (m *Minter) String() string { return proto.CompactTextString(m) }
+func (*Minter) ProtoMessage()    {}
+func (*Minter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a718159ead4e3f66, []int{0}
+}
+func (m *Minter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Minter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minter.Merge(m, src)
+}
+func (m *Minter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Minter proto.InternalMessageInfo
+
+func (m *Minter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Minter) GetMintAllowance() uint64 {
+	if m != nil {
+		return m.MintAllowance
+	}
+	return 0
+}
+
+func (m *Minter) GetBurnAllowance() uint64 {
+	if m != nil {
+		return m.BurnAllowance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Minter)(nil), "ardapoc.usdarda.Minter")
+}
+
+func init() { proto.RegisterFile("ardapoc/usdarda/minter.proto", fileDescriptor_a718159ead4e3f66) }
+
+var fileDescriptor_a718159ead4e3f66 = []byte{
+	// 228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2c, 0x4a, 0x49,
+	0x2c, 0xc8, 0x4f, 0xd6, 0x2f, 0x2d, 0x4e, 0x01, 0x31, 0xf5, 0x73, 0x33, 0xf3, 0x4a, 0x52, 0x8b,
+	0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0xa1, 0xb2, 0x7a, 0x50, 0x59, 0x29, 0xc9, 0xe4,
+	0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0xb0, 0xb4, 0x3e, 0x84, 0x03, 0x51, 0xab, 0xd4, 0xc5, 0xc8,
+	0xc5, 0xe6, 0x0b, 0xd6, 0x2c, 0x64, 0xc4, 0xc5, 0x9e, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c,
+	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0x54, 0xb5, 0x23, 0x44,
+	0x26, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa6, 0x50, 0x48, 0x95, 0x8b, 0x0f, 0x64, 0x75,
+	0x7c, 0x62, 0x4e, 0x4e, 0x7e, 0x79, 0x62, 0x5e, 0x72, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b,
+	0x10, 0x2f, 0x48, 0xd4, 0x11, 0x26, 0x08, 0x52, 0x96, 0x54, 0x5a, 0x94, 0x87, 0xa4, 0x8c, 0x19,
+	0xa2, 0x0c, 0x24, 0x0a, 0x57, 0xe6, 0xe4, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
+	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
+	0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x20, 0x2f,
+	0xa5, 0xe7, 0xe4, 0x27, 0x25, 0xe6, 0x80, 0x99, 0xba, 0xa0, 0x70, 0xa8, 0x80, 0x87, 0x44, 0x49,
+	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x77, 0xc6, 0x80, 0x01, 0x00, 0xfd, 0x11, 0xe5, 0x27,
+	0x29, 0x01, 0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BurnAllowance != 0 {
+		i = encodeVarintMinter(dAtA, i, uint64(m.BurnAllowance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MintAllowance != 0 {
+		i = encodeVarintMinter(dAtA, i, uint64(m.MintAllowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMinter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMinter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMinter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	if m.MintAllowance != 0 {
+		n += 1 + sovMinter(uint64(m.MintAllowance))
+	}
+	if m.BurnAllowance != 0 {
+		n += 1 + sovMinter(uint64(m.BurnAllowance))
+	}
+	return n
+}
+
+func sovMinter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMinter(x uint64) (n int) {
+	return sovMinter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			m.MintAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAllowance", wireType)
+			}
+			m.BurnAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMinter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMinter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMinter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMinter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMinter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMinter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMinter = fmt.Errorf("proto: unexpected end of group")
+)
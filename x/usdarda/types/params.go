@@ -1,32 +1,154 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMinCollateralRatio     = []byte("MinCollateralRatio")
+	DefaultMinCollateralRatio = "1.5"
+
+	KeyLiquidationThreshold     = []byte("LiquidationThreshold")
+	DefaultLiquidationThreshold = "1.2"
+
+	KeyStabilityFee     = []byte("StabilityFee")
+	DefaultStabilityFee = "0.02"
+
+	KeyLiquidationDiscount     = []byte("LiquidationDiscount")
+	DefaultLiquidationDiscount = "0.1"
+
+	KeyMinReserveCoverage     = []byte("MinReserveCoverage")
+	DefaultMinReserveCoverage = "1"
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	minCollateralRatio string,
+	liquidationThreshold string,
+	stabilityFee string,
+	liquidationDiscount string,
+	minReserveCoverage string,
+) Params {
+	return Params{
+		MinCollateralRatio:   minCollateralRatio,
+		LiquidationThreshold: liquidationThreshold,
+		StabilityFee:         stabilityFee,
+		LiquidationDiscount:  liquidationDiscount,
+		MinReserveCoverage:   minReserveCoverage,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMinCollateralRatio,
+		DefaultLiquidationThreshold,
+		DefaultStabilityFee,
+		DefaultLiquidationDiscount,
+		DefaultMinReserveCoverage,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinCollateralRatio, &p.MinCollateralRatio, validateCollateralRatio),
+		paramtypes.NewParamSetPair(KeyLiquidationThreshold, &p.LiquidationThreshold, validateCollateralRatio),
+		paramtypes.NewParamSetPair(KeyStabilityFee, &p.StabilityFee, validateStabilityFee),
+		paramtypes.NewParamSetPair(KeyLiquidationDiscount, &p.LiquidationDiscount, validateLiquidationDiscount),
+		paramtypes.NewParamSetPair(KeyMinReserveCoverage, &p.MinReserveCoverage, validateMinReserveCoverage),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateCollateralRatio(p.MinCollateralRatio); err != nil {
+		return fmt.Errorf("invalid min collateral ratio: %w", err)
+	}
+	if err := validateCollateralRatio(p.LiquidationThreshold); err != nil {
+		return fmt.Errorf("invalid liquidation threshold: %w", err)
+	}
+	if math.LegacyMustNewDecFromStr(p.LiquidationThreshold).GT(math.LegacyMustNewDecFromStr(p.MinCollateralRatio)) {
+		return fmt.Errorf("liquidation threshold %s cannot exceed the min collateral ratio %s", p.LiquidationThreshold, p.MinCollateralRatio)
+	}
+	if err := validateStabilityFee(p.StabilityFee); err != nil {
+		return err
+	}
+	if err := validateLiquidationDiscount(p.LiquidationDiscount); err != nil {
+		return err
+	}
+	if err := validateMinReserveCoverage(p.MinReserveCoverage); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateCollateralRatio(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	ratio, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid ratio %q: %w", v, err)
+	}
+	if ratio.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("ratio must be at least 1: %s", ratio)
+	}
+	return nil
+}
+
+func validateStabilityFee(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	fee, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid stability fee %q: %w", v, err)
+	}
+	if fee.IsNegative() || fee.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("stability fee must be between 0 and 1: %s", fee)
+	}
+	return nil
+}
+
+func validateLiquidationDiscount(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	discount, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid liquidation discount %q: %w", v, err)
+	}
+	if discount.IsNegative() || discount.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("liquidation discount must be at least 0 and below 1: %s", discount)
+	}
+	return nil
+}
+
+func validateMinReserveCoverage(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	coverage, err := math.LegacyNewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid min reserve coverage %q: %w", v, err)
+	}
+	if coverage.IsNegative() {
+		return fmt.Errorf("min reserve coverage cannot be negative: %s", coverage)
+	}
 	return nil
 }
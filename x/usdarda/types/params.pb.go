@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// min_collateral_ratio is the lowest ratio of a property's value to the
+	// usdarda owed against it that a mint may leave, as a decimal, e.g. "1.5".
+	MinCollateralRatio string `protobuf:"bytes,1,opt,name=min_collateral_ratio,json=minCollateralRatio,proto3" json:"min_collateral_ratio,omitempty"`
+	// liquidation_threshold is the ratio of a property's value to the usdarda
+	// owed against it below which the property is liquidated.
+	LiquidationThreshold string `protobuf:"bytes,2,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+	// stability_fee is the annual fee charged on the usdarda minted against a
+	// property, as a decimal fraction.
+	StabilityFee string `protobuf:"bytes,3,opt,name=stability_fee,json=stabilityFee,proto3" json:"stability_fee,omitempty"`
+	// liquidation_discount is the discount on the property value at which
+	// liquidators receive shares, as a decimal fraction.
+	LiquidationDiscount string `protobuf:"bytes,4,opt,name=liquidation_discount,json=liquidationDiscount,proto3" json:"liquidation_discount,omitempty"`
+	// min_reserve_coverage is the lowest share of the usdarda supply that must be
+	// backed by attested reserves and property collateral for new mints, as a
+	// decimal, e.g. "1".
+	MinReserveCoverage string `protobuf:"bytes,5,opt,name=min_reserve_coverage,json=minReserveCoverage,proto3" json:"min_reserve_coverage,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +78,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinCollateralRatio() string {
+	if m != nil {
+		return m.MinCollateralRatio
+	}
+	return ""
+}
+
+func (m *Params) GetLiquidationThreshold() string {
+	if m != nil {
+		return m.LiquidationThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetStabilityFee() string {
+	if m != nil {
+		return m.StabilityFee
+	}
+	return ""
+}
+
+func (m *Params) GetLiquidationDiscount() string {
+	if m != nil {
+		return m.LiquidationDiscount
+	}
+	return ""
+}
+
+func (m *Params) GetMinReserveCoverage() string {
+	if m != nil {
+		return m.MinReserveCoverage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ardapoc.usdarda.Params")
 }
@@ -68,18 +120,29 @@ func init() {
 func init() { proto.RegisterFile("ardapoc/usdarda/params.proto", fileDescriptor_b250d48239fa8156) }
 
 var fileDescriptor_b250d48239fa8156 = []byte{
-	// 172 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2c, 0x4a, 0x49,
-	0x2c, 0xc8, 0x4f, 0xd6, 0x2f, 0x2d, 0x4e, 0x01, 0x31, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b,
-	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0xa1, 0xb2, 0x7a, 0x50, 0x59, 0x29, 0xc1, 0xc4,
-	0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x51, 0x23, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66,
-	0xea, 0x83, 0x58, 0x10, 0x51, 0x25, 0x6d, 0x2e, 0xb6, 0x00, 0xb0, 0x49, 0x56, 0x8a, 0x2f, 0x16,
-	0xc8, 0x33, 0x76, 0x3d, 0xdf, 0xa0, 0x25, 0x01, 0xb3, 0xaa, 0x02, 0x6e, 0x19, 0x44, 0x89, 0x93,
-	0xc7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x74, 0xa4, 0xe7, 0xe4, 0x27, 0x25, 0xe6, 0x80,
-	0x99, 0xba, 0xa8, 0x46, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x6d, 0x37, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x63, 0xc7, 0xa0, 0xc2, 0xd7, 0x00, 0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbb, 0x4e, 0xe3, 0x40,
+	0x14, 0x86, 0xe3, 0xec, 0x6e, 0xa4, 0x1d, 0xed, 0x82, 0x30, 0x41, 0x32, 0x11, 0x32, 0x97, 0x0a,
+	0x21, 0xc5, 0x2e, 0xd2, 0x51, 0x41, 0x12, 0x21, 0x4a, 0x14, 0x51, 0xd1, 0x8c, 0xc6, 0xe3, 0x83,
+	0x33, 0xd2, 0xd8, 0xc7, 0xcc, 0x8c, 0x23, 0xf2, 0x0a, 0x54, 0x3c, 0x02, 0x8f, 0x40, 0xc1, 0x43,
+	0x50, 0x46, 0x54, 0x94, 0x28, 0x29, 0xa0, 0xe0, 0x21, 0x90, 0x2f, 0x89, 0x82, 0x14, 0x1a, 0xeb,
+	0x5c, 0xbe, 0xff, 0xb7, 0xce, 0xfc, 0x64, 0x87, 0xa9, 0x90, 0xa5, 0xc8, 0xfd, 0x4c, 0x87, 0x79,
+	0xe9, 0xa7, 0x4c, 0xb1, 0x58, 0x7b, 0xa9, 0x42, 0x83, 0xf6, 0x7a, 0xb5, 0xf5, 0xaa, 0x6d, 0x6b,
+	0x83, 0xc5, 0x22, 0x41, 0xbf, 0xf8, 0x96, 0x4c, 0x6b, 0x9b, 0xa3, 0x8e, 0x51, 0xd3, 0xa2, 0xf3,
+	0xcb, 0xa6, 0x5a, 0x35, 0x23, 0x8c, 0xb0, 0x9c, 0xe7, 0x55, 0x39, 0x3d, 0xf8, 0xac, 0x93, 0xc6,
+	0x45, 0xf1, 0x17, 0xfb, 0x84, 0x34, 0x63, 0x91, 0x50, 0x8e, 0x52, 0x32, 0x03, 0x8a, 0x49, 0xaa,
+	0x98, 0x11, 0xe8, 0x58, 0x7b, 0xd6, 0xe1, 0xdf, 0xee, 0xda, 0xcb, 0x53, 0x9b, 0x54, 0x86, 0x7d,
+	0xe0, 0x03, 0x3b, 0x16, 0x49, 0x6f, 0x81, 0x0e, 0x72, 0xd2, 0xee, 0x91, 0x2d, 0x29, 0x6e, 0x32,
+	0x11, 0xe6, 0x5d, 0x42, 0xcd, 0x50, 0x81, 0x1e, 0xa2, 0x0c, 0x9d, 0xfa, 0x4a, 0x8b, 0xe6, 0x12,
+	0x7c, 0x39, 0x67, 0xed, 0x0e, 0xf9, 0xaf, 0x0d, 0x0b, 0x84, 0x14, 0x66, 0x4c, 0xaf, 0x01, 0x9c,
+	0x5f, 0x2b, 0xc5, 0xff, 0x16, 0xd0, 0x19, 0x80, 0x7d, 0x4a, 0x96, 0xcd, 0x68, 0x28, 0x34, 0xc7,
+	0x2c, 0x31, 0xce, 0xef, 0x95, 0xda, 0xcd, 0x25, 0xb6, 0x5f, 0xa1, 0xf3, 0xf3, 0x15, 0x68, 0x50,
+	0x23, 0xa0, 0x1c, 0x47, 0xa0, 0x58, 0x04, 0xce, 0x9f, 0x1f, 0xcf, 0x1f, 0x94, 0x68, 0xaf, 0x22,
+	0x8f, 0xf7, 0x3f, 0x1e, 0x76, 0xad, 0xbb, 0xf7, 0xc7, 0x23, 0x67, 0x9e, 0xe3, 0xed, 0x22, 0xc9,
+	0xf2, 0x8d, 0xbb, 0xe7, 0xcf, 0x53, 0xd7, 0x9a, 0x4c, 0x5d, 0xeb, 0x6d, 0xea, 0x5a, 0xf7, 0x33,
+	0xb7, 0x36, 0x99, 0xb9, 0xb5, 0xd7, 0x99, 0x5b, 0xbb, 0xf2, 0x22, 0x61, 0x86, 0x59, 0xe0, 0x71,
+	0x8c, 0xfd, 0x5c, 0x11, 0x49, 0x0c, 0x98, 0x2c, 0xca, 0xf6, 0x77, 0x2b, 0x33, 0x4e, 0x41, 0x07,
+	0x8d, 0x22, 0xbf, 0xce, 0xd7, 0x00, 0x05, 0x5a, 0xde, 0x09, 0x34, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +164,21 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MinCollateralRatio != that1.MinCollateralRatio {
+		return false
+	}
+	if this.LiquidationThreshold != that1.LiquidationThreshold {
+		return false
+	}
+	if this.StabilityFee != that1.StabilityFee {
+		return false
+	}
+	if this.LiquidationDiscount != that1.LiquidationDiscount {
+		return false
+	}
+	if this.MinReserveCoverage != that1.MinReserveCoverage {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +201,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinReserveCoverage) > 0 {
+		i -= len(m.MinReserveCoverage)
+		copy(dAtA[i:], m.MinReserveCoverage)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinReserveCoverage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LiquidationDiscount) > 0 {
+		i -= len(m.LiquidationDiscount)
+		copy(dAtA[i:], m.LiquidationDiscount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LiquidationDiscount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StabilityFee) > 0 {
+		i -= len(m.StabilityFee)
+		copy(dAtA[i:], m.StabilityFee)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StabilityFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LiquidationThreshold) > 0 {
+		i -= len(m.LiquidationThreshold)
+		copy(dAtA[i:], m.LiquidationThreshold)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LiquidationThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinCollateralRatio) > 0 {
+		i -= len(m.MinCollateralRatio)
+		copy(dAtA[i:], m.MinCollateralRatio)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinCollateralRatio)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -143,6 +256,26 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.MinCollateralRatio)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.LiquidationThreshold)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.StabilityFee)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.LiquidationDiscount)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MinReserveCoverage)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -181,6 +314,166 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCollateralRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCollateralRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StabilityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StabilityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationDiscount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinReserveCoverage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
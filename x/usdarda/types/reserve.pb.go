@@ -0,0 +1,560 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/usdarda/reserve.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReserveAttestation is an attestor's statement of the reserves backing the
+// usdarda not minted against property.
+type ReserveAttestation struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attestor string `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	Reserves uint64 `protobuf:"varint,3,opt,name=reserves,proto3" json:"reserves,omitempty"`
+	// report_hash is the hash of the audit report the attestation rests on.
+	ReportHash string `protobuf:"bytes,4,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// as_of is when the reserves were measured.
+	AsOf time.Time `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3,stdtime" json:"as_of"`
+	// recorded_at is the block time the attestation was submitted at.
+	RecordedAt time.Time `protobuf:"bytes,6,opt,name=recorded_at,json=recordedAt,proto3,stdtime" json:"recorded_at"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30877dd034d4803f, []int{0}
+}
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetReserves() uint64 {
+	if m != nil {
+		return m.Reserves
+	}
+	return 0
+}
+
+func (m *ReserveAttestation) GetReportHash() string {
+	if m != nil {
+		return m.ReportHash
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetAsOf() time.Time {
+	if m != nil {
+		return m.AsOf
+	}
+	return time.Time{}
+}
+
+func (m *ReserveAttestation) GetRecordedAt() time.Time {
+	if m != nil {
+		return m.RecordedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ReserveAttestation)(nil), "ardapoc.usdarda.ReserveAttestation")
+}
+
+func init() { proto.RegisterFile("ardapoc/usdarda/reserve.proto", fileDescriptor_30877dd034d4803f) }
+
+var fileDescriptor_30877dd034d4803f = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xb1, 0x6a, 0xeb, 0x30,
+	0x14, 0xb5, 0xfc, 0x92, 0x90, 0xa7, 0xc0, 0x7b, 0x20, 0x32, 0xb8, 0x86, 0xda, 0xa1, 0x53, 0x96,
+	0xd8, 0xd0, 0x76, 0xe9, 0x98, 0x40, 0x21, 0x5b, 0xc1, 0xed, 0xd4, 0xc5, 0xc8, 0x96, 0x22, 0x1b,
+	0xe2, 0x5c, 0x23, 0x29, 0xa5, 0xfd, 0x8b, 0xac, 0xfd, 0x8f, 0x7e, 0x44, 0xc6, 0xd0, 0xa9, 0x53,
+	0x5b, 0x92, 0x1f, 0x29, 0xb2, 0x9c, 0xec, 0xdd, 0xee, 0x3d, 0xe7, 0xe8, 0x5c, 0x1d, 0x0e, 0x3e,
+	0xa7, 0x92, 0xd1, 0x1a, 0xf2, 0x78, 0xad, 0x98, 0x19, 0x63, 0xc9, 0x15, 0x97, 0x4f, 0x3c, 0xaa,
+	0x25, 0x68, 0x20, 0xff, 0x5b, 0x3a, 0x6a, 0x69, 0xff, 0x2c, 0x07, 0x55, 0x81, 0x4a, 0x1b, 0x3a,
+	0xb6, 0x8b, 0xd5, 0xfa, 0x43, 0x01, 0x02, 0x2c, 0x6e, 0xa6, 0x16, 0x0d, 0x05, 0x80, 0x58, 0xf2,
+	0xb8, 0xd9, 0xb2, 0xf5, 0x22, 0xd6, 0x65, 0xc5, 0x95, 0xa6, 0x55, 0x6d, 0x05, 0x17, 0xaf, 0x2e,
+	0x26, 0x89, 0x3d, 0x3a, 0xd5, 0xda, 0x50, 0xba, 0x84, 0x15, 0xf9, 0x87, 0xdd, 0x92, 0x79, 0x68,
+	0x84, 0xc6, 0x9d, 0xc4, 0x2d, 0x19, 0xb9, 0xc6, 0x7d, 0xda, 0xd0, 0x20, 0x3d, 0x77, 0x84, 0xc6,
+	0x7f, 0x67, 0xde, 0xfb, 0xdb, 0x64, 0xd8, 0xfe, 0x60, 0xca, 0x98, 0xe4, 0x4a, 0xdd, 0x6b, 0x59,
+	0xae, 0x44, 0x72, 0x52, 0x12, 0x1f, 0xf7, 0xdb, 0x40, 0xca, 0xfb, 0xd3, 0x78, 0x9d, 0x76, 0x12,
+	0xe2, 0x81, 0xe4, 0x35, 0x48, 0x9d, 0x16, 0x54, 0x15, 0x5e, 0xc7, 0x98, 0x26, 0xd8, 0x42, 0x73,
+	0xaa, 0x0a, 0x72, 0x83, 0xbb, 0x54, 0xa5, 0xb0, 0xf0, 0xba, 0x23, 0x34, 0x1e, 0x5c, 0xfa, 0x91,
+	0x8d, 0x12, 0x1d, 0xa3, 0x44, 0x0f, 0xc7, 0x28, 0xb3, 0xfe, 0xf6, 0x33, 0x74, 0x36, 0x5f, 0x21,
+	0x4a, 0x3a, 0x54, 0xdd, 0x2d, 0xc8, 0xad, 0xf1, 0xce, 0x41, 0x32, 0xce, 0x52, 0xaa, 0xbd, 0xde,
+	0x2f, 0x0c, 0xf0, 0xf1, 0xe1, 0x54, 0xcf, 0xe6, 0xdb, 0x7d, 0x80, 0x76, 0xfb, 0x00, 0x7d, 0xef,
+	0x03, 0xb4, 0x39, 0x04, 0xce, 0xee, 0x10, 0x38, 0x1f, 0x87, 0xc0, 0x79, 0x8c, 0x44, 0xa9, 0x8b,
+	0x75, 0x16, 0xe5, 0x50, 0xc5, 0xa6, 0x18, 0xb1, 0x84, 0x8c, 0x2e, 0x9b, 0x71, 0x62, 0xea, 0x7c,
+	0x3e, 0x15, 0xaa, 0x5f, 0x6a, 0xae, 0xb2, 0x5e, 0x73, 0xf3, 0xea, 0x67, 0x00, 0x45, 0x54, 0x3b,
+	0xea, 0xf0, 0x01, 0x00, 0x00,
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecordedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecordedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReserve(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AsOf, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintReserve(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reserves != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.Reserves))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReserve(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReserve(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovReserve(uint64(m.Id))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	if m.Reserves != 0 {
+		n += 1 + sovReserve(uint64(m.Reserves))
+	}
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AsOf)
+	n += 1 + l + sovReserve(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecordedAt)
+	n += 1 + l + sovReserve(uint64(l))
+	return n
+}
+
+func sovReserve(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReserve(x uint64) (n int) {
+	return sovReserve(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			m.Reserves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reserves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AsOf, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecordedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReserve(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReserve
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReserve
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReserve
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReserve        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReserve          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReserve = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// VaultApyWindow is how long the share price growth behind the vault APY is
+// measured over.
+const VaultApyWindow = 30 * 24 * time.Hour
+
+// TotalAssets returns the usdarda the vault shares are worth: what the vault
+// holds and has lent, less what it owes to queued withdrawals.
+func (v Vault) TotalAssets() uint64 {
+	assets := v.Liquid + v.Lent
+	if v.Queued >= assets {
+		return 0
+	}
+	return assets - v.Queued
+}
+
+// Available returns the usdarda the vault holds beyond what it owes to queued
+// withdrawals.
+func (v Vault) Available() uint64 {
+	if v.Queued >= v.Liquid {
+		return 0
+	}
+	return v.Liquid - v.Queued
+}
+
+// SharePrice returns the usdarda one of shares vault shares is worth. A vault
+// without shares prices them at one usdarda.
+func (v Vault) SharePrice(shares uint64) math.LegacyDec {
+	if shares == 0 {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(v.TotalAssets())).QuoInt(math.NewIntFromUint64(shares))
+}
+
+// SharesFor returns the vault shares a deposit of amount buys when shares are
+// issued, rounded down. The first deposit buys one share per usdarda.
+func (v Vault) SharesFor(amount uint64, shares uint64) (uint64, error) {
+	if shares == 0 {
+		return amount, nil
+	}
+	assets := v.TotalAssets()
+	if assets == 0 {
+		return 0, ErrVaultInsolvent
+	}
+	return math.NewIntFromUint64(amount).Mul(math.NewIntFromUint64(shares)).Quo(math.NewIntFromUint64(assets)).Uint64(), nil
+}
+
+// AssetsFor returns the usdarda redeemed shares are worth out of the shares
+// issued, rounded down.
+func (v Vault) AssetsFor(redeemed uint64, shares uint64) uint64 {
+	if shares == 0 {
+		return 0
+	}
+	return math.NewIntFromUint64(redeemed).Mul(math.NewIntFromUint64(v.TotalAssets())).Quo(math.NewIntFromUint64(shares)).Uint64()
+}
+
+// GrowthSince annualizes the growth of the share price from the reference
+// price to price over the time since the reference. It is zero without a
+// reference.
+func (v Vault) GrowthSince(price math.LegacyDec, now time.Time) math.LegacyDec {
+	elapsed := int64(now.Sub(v.ReferenceTime) / time.Second)
+	reference, err := math.LegacyNewDecFromStr(v.ReferencePrice)
+	if v.ReferenceTime.IsZero() || elapsed <= 0 || err != nil || !reference.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return price.Quo(reference).Sub(math.LegacyOneDec()).MulInt64(secondsPerYear).QuoInt64(elapsed)
+}
+
+// RollApy closes the APY window once it has run for VaultApyWindow: the APY
+// becomes the growth over the window and the next window starts at price. It
+// reports whether the vault changed.
+func (v *Vault) RollApy(price math.LegacyDec, now time.Time) bool {
+	if !v.ReferenceTime.IsZero() && now.Sub(v.ReferenceTime) < VaultApyWindow {
+		return false
+	}
+	if !v.ReferenceTime.IsZero() {
+		v.Apy = v.GrowthSince(price, now).String()
+	}
+	v.ReferencePrice = price.String()
+	v.ReferenceTime = now
+	return true
+}
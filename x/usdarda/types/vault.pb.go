@@ -0,0 +1,1183 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ardapoc/usdarda/vault.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Vault is the savings vault: usdarda deposited in exchange for vault shares
+// and grown by the stability fees and mortgage interest paid into it. Its
+// assets are the usdarda it holds and has lent, less what it owes to queued
+// withdrawals.
+type Vault struct {
+	// liquid is the usdarda held by the vault account.
+	Liquid uint64 `protobuf:"varint,1,opt,name=liquid,proto3" json:"liquid,omitempty"`
+	// lent is the usdarda lent out of the vault and not yet repaid.
+	Lent uint64 `protobuf:"varint,2,opt,name=lent,proto3" json:"lent,omitempty"`
+	// queued is the usdarda owed to withdrawals waiting for liquidity.
+	Queued uint64 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// yield is the usdarda paid into the vault as yield since it opened.
+	Yield uint64 `protobuf:"varint,4,opt,name=yield,proto3" json:"yield,omitempty"`
+	// reference_price and reference_time are the share price and time the APY
+	// is measured from.
+	ReferencePrice string    `protobuf:"bytes,5,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	ReferenceTime  time.Time `protobuf:"bytes,6,opt,name=reference_time,json=referenceTime,proto3,stdtime" json:"reference_time"`
+	// apy is the annualized growth of the share price over the last completed
+	// APY window.
+	Apy string `protobuf:"bytes,7,opt,name=apy,proto3" json:"apy,omitempty"`
+}
+
+func (m *Vault) Reset()         { *m = Vault{} }
+func (m *Vault) String() string { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()    {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0278869c5898033, []int{0}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
+}
+func (m *Vault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vault proto.InternalMessageInfo
+
+func (m *Vault) GetLiquid() uint64 {
+	if m != nil {
+		return m.Liquid
+	}
+	return 0
+}
+
+func (m *Vault) GetLent() uint64 {
+	if m != nil {
+		return m.Lent
+	}
+	return 0
+}
+
+func (m *Vault) GetQueued() uint64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *Vault) GetYield() uint64 {
+	if m != nil {
+		return m.Yield
+	}
+	return 0
+}
+
+func (m *Vault) GetReferencePrice() string {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return ""
+}
+
+func (m *Vault) GetReferenceTime() time.Time {
+	if m != nil {
+		return m.ReferenceTime
+	}
+	return time.Time{}
+}
+
+func (m *Vault) GetApy() string {
+	if m != nil {
+		return m.Apy
+	}
+	return ""
+}
+
+// WithdrawalRequest is a withdrawal waiting for the vault to have liquidity.
+// The shares were burned when it was requested; amount is the usdarda still
+// owed for them.
+type WithdrawalRequest struct {
+	Id          uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner       string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount      uint64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RequestedAt time.Time `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3,stdtime" json:"requested_at"`
+}
+
+func (m *WithdrawalRequest) Reset()         { *m = WithdrawalRequest{} }
+func (m *WithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalRequest) ProtoMessage()    {}
+func (*WithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0278869c5898033, []int{1}
+}
+func (m *WithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalRequest.Merge(m, src)
+}
+func (m *WithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalRequest proto.InternalMessageInfo
+
+func (m *WithdrawalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *WithdrawalRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *WithdrawalRequest) GetRequestedAt() time.Time {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return time.Time{}
+}
+
+// VaultLoan is the usdarda lent out of the vault to a borrower.
+type VaultLoan struct {
+	Borrower  string `protobuf:"bytes,1,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Principal uint64 `protobuf:"varint,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// interest_paid is the interest the borrower has paid into the vault.
+	InterestPaid uint64    `protobuf:"varint,3,opt,name=interest_paid,json=interestPaid,proto3" json:"interest_paid,omitempty"`
+	LentAt       time.Time `protobuf:"bytes,4,opt,name=lent_at,json=lentAt,proto3,stdtime" json:"lent_at"`
+}
+
+func (m *VaultLoan) Reset()         { *m = VaultLoan{} }
+func (m *VaultLoan) String() string { return proto.CompactTextString(m) }
+func (*VaultLoan) ProtoMessage()    {}
+func (*VaultLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0278869c5898033, []int{2}
+}
+func (m *VaultLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultLoan.Merge(m, src)
+}
+func (m *VaultLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultLoan proto.InternalMessageInfo
+
+func (m *VaultLoan) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *VaultLoan) GetPrincipal() uint64 {
+	if m != nil {
+		return m.Principal
+	}
+	return 0
+}
+
+func (m *VaultLoan) GetInterestPaid() uint64 {
+	if m != nil {
+		return m.InterestPaid
+	}
+	return 0
+}
+
+func (m *VaultLoan) GetLentAt() time.Time {
+	if m != nil {
+		return m.LentAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Vault)(nil), "ardapoc.usdarda.Vault")
+	proto.RegisterType((*WithdrawalRequest)(nil), "ardapoc.usdarda.WithdrawalRequest")
+	proto.RegisterType((*VaultLoan)(nil), "ardapoc.usdarda.VaultLoan")
+}
+
+func init() { proto.RegisterFile("ardapoc/usdarda/vault.proto", fileDescriptor_f0278869c5898033) }
+
+var fileDescriptor_f0278869c5898033 = []byte{
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xa6, 0x49, 0xda, 0x6c, 0xdb, 0x54, 0xac, 0x22, 0xb4, 0x04, 0xe4, 0x44, 0xe1, 0x92,
+	0x4b, 0x6c, 0x09, 0x90, 0x38, 0x71, 0x48, 0x84, 0x04, 0x12, 0x1c, 0xaa, 0x80, 0x40, 0xe2, 0x12,
+	0xad, 0xbd, 0x53, 0x77, 0x25, 0xdb, 0xeb, 0xac, 0xd7, 0x84, 0x7c, 0x02, 0xb7, 0x7e, 0x4c, 0xfe,
+	0x80, 0x4b, 0x8f, 0x55, 0x4f, 0x9c, 0x00, 0x25, 0x3f, 0x82, 0xd6, 0x6b, 0xbb, 0x17, 0x24, 0xd4,
+	0xdb, 0xbc, 0x37, 0x6f, 0x3c, 0xfb, 0x66, 0x3c, 0xf8, 0x31, 0x53, 0x9c, 0xa5, 0x32, 0xf0, 0xf2,
+	0x8c, 0x9b, 0xd0, 0xfb, 0xca, 0xf2, 0x48, 0xbb, 0xa9, 0x92, 0x5a, 0x92, 0xb3, 0x32, 0xe9, 0x96,
+	0xc9, 0xc1, 0xa3, 0x40, 0x66, 0xb1, 0xcc, 0x96, 0x45, 0xda, 0xb3, 0xc0, 0x6a, 0x07, 0xfd, 0x50,
+	0x86, 0xd2, 0xf2, 0x26, 0x2a, 0xd9, 0x61, 0x28, 0x65, 0x18, 0x81, 0x57, 0x20, 0x3f, 0xbf, 0xf0,
+	0xb4, 0x88, 0x21, 0xd3, 0x2c, 0x4e, 0xad, 0x60, 0xfc, 0xbd, 0x89, 0xdb, 0x9f, 0x4c, 0x4b, 0xf2,
+	0x10, 0x77, 0x22, 0xb1, 0xca, 0x05, 0xa7, 0x68, 0x84, 0x26, 0xad, 0x45, 0x89, 0x08, 0xc1, 0xad,
+	0x08, 0x12, 0x4d, 0x9b, 0x05, 0x5b, 0xc4, 0x46, 0xbb, 0xca, 0x21, 0x07, 0x4e, 0x0f, 0xac, 0xd6,
+	0x22, 0xd2, 0xc7, 0xed, 0x8d, 0x80, 0x88, 0xd3, 0x56, 0x41, 0x5b, 0x40, 0x5e, 0xe2, 0x33, 0x05,
+	0x17, 0xa0, 0x20, 0x09, 0x60, 0x99, 0x2a, 0x11, 0x00, 0x6d, 0x8f, 0xd0, 0xa4, 0x3b, 0xef, 0xdd,
+	0x6e, 0xa7, 0xb8, 0x74, 0xf1, 0x1a, 0x82, 0x45, 0xaf, 0x96, 0x9d, 0x1b, 0x15, 0x79, 0x87, 0xef,
+	0x98, 0xa5, 0x79, 0x39, 0xed, 0x8c, 0xd0, 0xe4, 0xf8, 0xd9, 0xc0, 0xb5, 0xb6, 0xdc, 0xca, 0x96,
+	0xfb, 0xb1, 0xb2, 0x35, 0x3f, 0xba, 0xfe, 0x35, 0x6c, 0x5c, 0xfd, 0x1e, 0xa2, 0xc5, 0x69, 0x5d,
+	0x6b, 0xb2, 0x64, 0x84, 0x0f, 0x58, 0xba, 0xa1, 0x87, 0xff, 0xec, 0x6c, 0x52, 0xe3, 0x2d, 0xc2,
+	0x0f, 0x3e, 0x0b, 0x7d, 0xc9, 0x15, 0x5b, 0xb3, 0x68, 0x01, 0xab, 0x1c, 0x32, 0x4d, 0x7a, 0xb8,
+	0x59, 0xcf, 0xa4, 0x29, 0x38, 0x71, 0x71, 0x5b, 0xae, 0x13, 0x50, 0xc5, 0x40, 0xba, 0x73, 0x7a,
+	0xbb, 0x9d, 0xf6, 0xcb, 0x2f, 0xcd, 0x38, 0x57, 0x90, 0x65, 0x1f, 0xb4, 0x12, 0x49, 0xb8, 0xb0,
+	0x32, 0x33, 0x2b, 0x16, 0xcb, 0x3c, 0xd1, 0xd5, 0xac, 0x2c, 0x22, 0x6f, 0xf0, 0x89, 0xb2, 0x2d,
+	0x80, 0x2f, 0x99, 0xa6, 0xad, 0x7b, 0x58, 0x3b, 0xae, 0x2b, 0x67, 0x7a, 0xfc, 0x03, 0xe1, 0x6e,
+	0xb1, 0xc2, 0xf7, 0x92, 0x25, 0xe4, 0x05, 0x3e, 0xf2, 0xa5, 0x52, 0x72, 0x0d, 0x8a, 0xa2, 0xff,
+	0xbc, 0xb0, 0x56, 0x92, 0x27, 0xb8, 0x9b, 0x2a, 0x91, 0x04, 0x22, 0x65, 0x51, 0xb9, 0xe9, 0x3b,
+	0x82, 0x3c, 0xc5, 0xa7, 0x22, 0xd1, 0xa0, 0x20, 0xd3, 0xcb, 0x94, 0x89, 0x6a, 0xeb, 0x27, 0x15,
+	0x79, 0xce, 0x04, 0x27, 0xaf, 0xf0, 0xa1, 0xf9, 0x37, 0xee, 0x6b, 0xa5, 0x63, 0x8a, 0x66, 0x7a,
+	0xfe, 0xf6, 0x7a, 0xe7, 0xa0, 0x9b, 0x9d, 0x83, 0xfe, 0xec, 0x1c, 0x74, 0xb5, 0x77, 0x1a, 0x37,
+	0x7b, 0xa7, 0xf1, 0x73, 0xef, 0x34, 0xbe, 0xb8, 0xa1, 0xd0, 0x97, 0xb9, 0xef, 0x06, 0x32, 0xf6,
+	0xcc, 0x15, 0x84, 0x91, 0xf4, 0x59, 0x54, 0x84, 0x53, 0x73, 0x39, 0xdf, 0xea, 0xdb, 0xd1, 0x9b,
+	0x14, 0x32, 0xbf, 0x53, 0xf4, 0x7b, 0xfe, 0x77, 0x00, 0x9a, 0x1a, 0x7a, 0x67, 0x5b, 0x03, 0x00,
+	0x00,
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Apy) > 0 {
+		i -= len(m.Apy)
+		copy(dAtA[i:], m.Apy)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Apy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReferenceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVault(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReferencePrice) > 0 {
+		i -= len(m.ReferencePrice)
+		copy(dAtA[i:], m.ReferencePrice)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.ReferencePrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Yield != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Yield))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Queued != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Queued))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Lent != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Lent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Liquid != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Liquid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RequestedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintVault(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Amount != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LentAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LentAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVault(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.InterestPaid != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.InterestPaid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Principal != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Principal))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Liquid != 0 {
+		n += 1 + sovVault(uint64(m.Liquid))
+	}
+	if m.Lent != 0 {
+		n += 1 + sovVault(uint64(m.Lent))
+	}
+	if m.Queued != 0 {
+		n += 1 + sovVault(uint64(m.Queued))
+	}
+	if m.Yield != 0 {
+		n += 1 + sovVault(uint64(m.Yield))
+	}
+	l = len(m.ReferencePrice)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReferenceTime)
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.Apy)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVault(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovVault(uint64(m.Amount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestedAt)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Borrower)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Principal != 0 {
+		n += 1 + sovVault(uint64(m.Principal))
+	}
+	if m.InterestPaid != 0 {
+		n += 1 + sovVault(uint64(m.InterestPaid))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LentAt)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			m.Liquid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Liquid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lent", wireType)
+			}
+			m.Lent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			m.Queued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Queued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yield", wireType)
+			}
+			m.Yield = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Yield |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferencePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReferenceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RequestedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			m.Principal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Principal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestPaid", wireType)
+			}
+			m.InterestPaid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InterestPaid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LentAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LentAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVault(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVault
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVault
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVault
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVault        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVault          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVault = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultShares(t *testing.T) {
+	// The first deposit buys a share per usdarda
+	shares, err := Vault{}.SharesFor(1000, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), shares)
+	require.Equal(t, math.LegacyOneDec(), Vault{}.SharePrice(0))
+
+	// Yield and loans raise the assets behind the shares, queued withdrawals
+	// do not count
+	vault := Vault{Liquid: 800, Lent: 500, Queued: 100}
+	require.Equal(t, uint64(1200), vault.TotalAssets())
+	require.Equal(t, uint64(700), vault.Available())
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.2"), vault.SharePrice(1000))
+
+	shares, err = vault.SharesFor(600, 1000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), shares)
+	require.Equal(t, uint64(598), vault.AssetsFor(499, 1000))
+
+	// Shares worth nothing cannot be bought
+	_, err = Vault{Liquid: 10, Queued: 10}.SharesFor(100, 1000)
+	require.ErrorIs(t, err, ErrVaultInsolvent)
+}
+
+func TestVaultRollApy(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	vault := Vault{}
+
+	require.True(t, vault.RollApy(math.LegacyOneDec(), start))
+	require.Equal(t, start, vault.ReferenceTime)
+	require.Empty(t, vault.Apy)
+
+	// Growth of 1% over the window so far, annualized
+	price := math.LegacyMustNewDecFromStr("1.01")
+	require.False(t, vault.RollApy(price, start.Add(VaultApyWindow/2)))
+	require.Equal(t, "0.243333333333333333", vault.GrowthSince(price, start.Add(VaultApyWindow/2)).String())
+
+	require.True(t, vault.RollApy(price, start.Add(VaultApyWindow)))
+	require.Equal(t, "0.121666666666666666", vault.Apy)
+	require.Equal(t, start.Add(VaultApyWindow), vault.ReferenceTime)
+	require.Equal(t, price.String(), vault.ReferencePrice)
+	require.True(t, vault.GrowthSince(price, start.Add(VaultApyWindow)).IsZero())
+}